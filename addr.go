@@ -0,0 +1,128 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+)
+
+// CountryAddr is Country for an already parsed address
+func (a *Api) CountryAddr(ctx context.Context, addr netip.Addr) (Response, error) {
+	return a.fetch(ctx, "country", addr.String())
+}
+
+// CityAddr is City for an already parsed address
+func (a *Api) CityAddr(ctx context.Context, addr netip.Addr) (Response, error) {
+	return a.fetch(ctx, "city", addr.String())
+}
+
+// InsightsAddr is Insights for an already parsed address
+func (a *Api) InsightsAddr(ctx context.Context, addr netip.Addr) (Response, error) {
+	return a.fetch(ctx, "insights", addr.String())
+}
+
+// WithRejectReserved fails lookups of private, loopback, link-local,
+// multicast, shared and documentation addresses locally with
+// IP_ADDRESS_RESERVED, instead of paying for the web service to say so
+func WithRejectReserved() Option {
+	return func(a *Api) {
+		a.rejectReserved = true
+	}
+}
+
+// WithKeepIPv4Mapped sends IPv4-mapped IPv6 addresses, such as
+// ::ffff:192.0.2.1, as they are rather than as the IPv4 address they map,
+// which is the default.  The cache then holds them apart from their IPv4
+// forms.
+func WithKeepIPv4Mapped() Option {
+	return func(a *Api) {
+		a.keepMapped = true
+	}
+}
+
+// NormalizeIP returns the canonical text of an address as the Api sends
+// and caches it: IPv6 compressed in lower case, without a zone, and
+// IPv4-mapped addresses as IPv4.  Equivalent spellings, such as
+// 2001:DB8:0:0::1 and 2001:db8::1%eth0, give the same text, so it suits
+// keys of callers' own maps and caches.  It reports false for text that
+// isn't an address.
+func NormalizeIP(ipAddress string) (string, bool) {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return "", false
+	}
+	return addr.Unmap().WithZone("").String(), true
+}
+
+// reservedPrefixes are the special-purpose ranges not covered by the
+// netip predicates
+// https://www.iana.org/assignments/iana-ipv4-special-registry
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+// IsReserved reports whether addr is in a private or special-purpose
+// range that the web service cannot locate
+func IsReserved(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsPrivate() || addr.IsLoopback() || addr.IsUnspecified() || addr.IsMulticast() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() {
+		return true
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize validates ipAddress locally, so a typo doesn't cost a query,
+// and returns the canonical form used in the request and cache keys
+func (a *Api) normalize(ipAddress string) (string, error) {
+	if ipAddress == me {
+		return me, nil
+	}
+
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return "", Error{
+			Code: CodeIPAddressInvalid,
+			Err:  fmt.Sprintf("The value %q is not a valid IP address.", ipAddress),
+		}
+	}
+	addr = addr.WithZone("")
+	if !a.keepMapped {
+		addr = addr.Unmap()
+	}
+
+	if a.rejectReserved && IsReserved(addr) {
+		return "", Error{
+			Code: CodeIPAddressReserved,
+			Err:  fmt.Sprintf("The value %s belongs to a reserved or private range.", addr),
+		}
+	}
+	return addr.String(), nil
+}
@@ -0,0 +1,99 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/netip"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAddressValidation(t *testing.T) {
+	Convey("Given an Api that records requested paths", t, func() {
+		var paths []string
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			paths = append(paths, req.URL.Path)
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		})
+
+		Convey("I expect invalid addresses to fail without a request", func() {
+			_, err := api.City(nil, "1.2.3")
+			So(err.(Error).Code, ShouldEqual, "IP_ADDRESS_INVALID")
+			So(paths, ShouldBeEmpty)
+		})
+
+		Convey("I expect addresses to be normalized before the request", func() {
+			api.City(nil, "2001:DB8:0:0::1")
+			api.City(nil, "::ffff:1.2.3.4")
+			api.CityAddr(nil, netip.MustParseAddr("fe80::1%eth0"))
+			So(paths, ShouldResemble, []string{
+				"/geoip/v2.1/city/2001:db8::1",
+				"/geoip/v2.1/city/1.2.3.4",
+				"/geoip/v2.1/city/fe80::1",
+			})
+		})
+
+		Convey("When IPv4-mapped addresses are kept", func() {
+			api.Clone(WithKeepIPv4Mapped()).City(nil, "::FFFF:1.2.3.4%eth0")
+
+			Convey("I expect them sent as IPv6", func() {
+				So(paths, ShouldResemble, []string{"/geoip/v2.1/city/::ffff:1.2.3.4"})
+			})
+		})
+
+		Convey("When reserved addresses are rejected", func() {
+			strict := api.Clone(WithRejectReserved())
+
+			Convey("I expect private and documentation ranges to fail locally", func() {
+				for _, ipAddress := range []string{"10.0.0.1", "192.168.1.1", "127.0.0.1", "100.64.0.1", "2001:db8::1", "::ffff:172.16.0.1"} {
+					_, err := strict.Country(nil, ipAddress)
+					So(err.(Error).Code, ShouldEqual, "IP_ADDRESS_RESERVED")
+				}
+				So(paths, ShouldBeEmpty)
+
+				_, err := strict.Country(nil, "8.8.8.8")
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestNormalizeIP(t *testing.T) {
+	Convey("Given equivalent spellings of an address", t, func() {
+		Convey("I expect one canonical text", func() {
+			for _, spelling := range []string{"2001:DB8::1", "2001:db8:0:0:0:0:0:1", "2001:db8::1%eth0"} {
+				ipAddress, ok := NormalizeIP(spelling)
+				So(ok, ShouldBeTrue)
+				So(ipAddress, ShouldEqual, "2001:db8::1")
+			}
+			ipAddress, ok := NormalizeIP("::ffff:192.0.2.1")
+			So(ok, ShouldBeTrue)
+			So(ipAddress, ShouldEqual, "192.0.2.1")
+		})
+
+		Convey("I expect text that isn't an address rejected", func() {
+			_, ok := NormalizeIP("me")
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
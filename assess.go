@@ -0,0 +1,165 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"strings"
+)
+
+// Action is the recommendation of a Verdict
+type Action int
+
+const (
+	ActionAllow Action = iota
+	ActionReview
+	ActionBlock
+)
+
+func (a Action) String() string {
+	switch a {
+	case ActionReview:
+		return "review"
+	case ActionBlock:
+		return "block"
+	default:
+		return "allow"
+	}
+}
+
+// AssessPolicy turns the traits of an Insights answer into an Action.
+// High risk addresses are blocked and medium risk ones reviewed, graded by
+// RiskLevel with Thresholds; the zero policy is DefaultRiskThresholds with
+// no country rules.
+type AssessPolicy struct {
+	Thresholds RiskThresholds
+
+	// ReviewHighRisk reviews rather than blocks high risk addresses, where
+	// turning away a genuine user costs more than a manual check
+	ReviewHighRisk bool
+
+	// BlockCountries and ReviewCountries hold ISO codes of countries whose
+	// addresses are always blocked or at least reviewed.  The country is
+	// the one the address is located in.
+	BlockCountries  []string
+	ReviewCountries []string
+}
+
+// Verdict is the compact outcome of Assess: the facts a fraud decision
+// usually needs, a risk grade and the recommended action, with the
+// reasons behind it
+type Verdict struct {
+	IpAddress         string    `json:"ip_address"`
+	Country           string    `json:"country,omitempty"`
+	RegisteredCountry string    `json:"registered_country,omitempty"`
+	Anonymous         bool      `json:"anonymous"`
+	AnonymousVpn      bool      `json:"anonymous_vpn"`
+	PublicProxy       bool      `json:"public_proxy"`
+	ResidentialProxy  bool      `json:"residential_proxy"`
+	TorExitNode       bool      `json:"tor_exit_node"`
+	HostingProvider   bool      `json:"hosting_provider"`
+	StaticIpScore     Decimal   `json:"static_ip_score,omitzero"`
+	UserType          string    `json:"user_type,omitempty"`
+	Risk              RiskLevel `json:"risk"`
+	Action            Action    `json:"action"`
+	Reasons           []string  `json:"reasons,omitempty"`
+}
+
+// Assess grades the response under policy.  Only Insights answers carry
+// the traits it weighs; Country and City answers are only subject to the
+// country rules.
+func (r Response) Assess(policy AssessPolicy) Verdict {
+	t := r.Traits
+	v := Verdict{
+		Country:           r.Country.IsoCode,
+		RegisteredCountry: r.RegisteredCountry.IsoCode,
+		Anonymous:         t.IsAnonymized(),
+		AnonymousVpn:      t.IsAnonymousVpn,
+		PublicProxy:       t.IsPublicProxy,
+		ResidentialProxy:  t.IsResidentialProxy,
+		TorExitNode:       t.IsTorExitNode,
+		HostingProvider:   t.IsHostingProvider,
+		StaticIpScore:     t.StaticIpScore,
+		UserType:          t.UserType,
+		Risk:              r.RiskLevel(policy.Thresholds),
+	}
+	if t.IpAddress.IsValid() {
+		v.IpAddress = t.IpAddress.String()
+	}
+
+	for _, reason := range []struct {
+		flagged bool
+		reason  string
+	}{
+		{t.IsTorExitNode, "tor_exit_node"},
+		{t.IsAnonymousVpn, "anonymous_vpn"},
+		{t.IsPublicProxy, "public_proxy"},
+		{t.IsResidentialProxy, "residential_proxy"},
+		{t.IsHostingProvider, "hosting_provider"},
+		{t.IsAnonymous && !t.IsTorExitNode && !t.IsAnonymousVpn && !t.IsPublicProxy && !t.IsResidentialProxy, "anonymous"},
+		{policy.Thresholds.MinStaticIpScore > 0 && !t.StaticIpScore.IsZero() && t.StaticIpScore.Float64() < policy.Thresholds.MinStaticIpScore, "low_static_ip_score"},
+	} {
+		if reason.flagged {
+			v.Reasons = append(v.Reasons, reason.reason)
+		}
+	}
+
+	switch v.Risk {
+	case RiskHigh:
+		v.Action = ActionBlock
+		if policy.ReviewHighRisk {
+			v.Action = ActionReview
+		}
+	case RiskMedium:
+		v.Action = ActionReview
+	}
+
+	if hasCountry(policy.BlockCountries, v.Country) {
+		v.Action = ActionBlock
+		v.Reasons = append(v.Reasons, "blocked_country")
+	} else if hasCountry(policy.ReviewCountries, v.Country) {
+		if v.Action < ActionReview {
+			v.Action = ActionReview
+		}
+		v.Reasons = append(v.Reasons, "review_country")
+	}
+	return v
+}
+
+func hasCountry(countries []string, isoCode string) bool {
+	if isoCode == "" {
+		return false
+	}
+	for _, country := range countries {
+		if strings.EqualFold(country, isoCode) {
+			return true
+		}
+	}
+	return false
+}
+
+// Assess looks up the address with Insights and grades it under policy, for
+// fraud checks that want one call and one answer
+func (a *Api) Assess(ctx context.Context, ipAddress string, policy AssessPolicy) (Verdict, error) {
+	resp, err := a.Insights(ctx, ipAddress)
+	if err != nil {
+		return Verdict{}, err
+	}
+	v := resp.Assess(policy)
+	if v.IpAddress == "" {
+		v.IpAddress = ipAddress
+	}
+	return v, nil
+}
@@ -0,0 +1,106 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAssess(t *testing.T) {
+	Convey("Given Insights answers with various traits", t, func() {
+		score, _ := ParseDecimal("0.5")
+		tor := Response{
+			Country: Country{IsoCode: "DE"},
+			Traits:  Traits{IpAddress: netip.MustParseAddr("81.2.69.160"), IsTorExitNode: true, IsAnonymous: true},
+		}
+		hosting := Response{Country: Country{IsoCode: "US"}, Traits: Traits{IsHostingProvider: true, UserType: "hosting"}}
+		home := Response{Country: Country{IsoCode: "GB"}, Traits: Traits{UserType: "residential", StaticIpScore: score}}
+
+		Convey("When I assess them under the default policy", func() {
+			Convey("I expect Tor blocked with its reasons", func() {
+				v := tor.Assess(AssessPolicy{})
+				So(v.Action, ShouldEqual, ActionBlock)
+				So(v.Risk, ShouldEqual, RiskHigh)
+				So(v.Reasons, ShouldResemble, []string{"tor_exit_node"})
+				So(v.IpAddress, ShouldEqual, "81.2.69.160")
+				So(v.Anonymous, ShouldBeTrue)
+			})
+
+			Convey("I expect hosting reviewed and a home connection allowed", func() {
+				So(hosting.Assess(AssessPolicy{}).Action, ShouldEqual, ActionReview)
+				v := home.Assess(AssessPolicy{})
+				So(v.Action, ShouldEqual, ActionAllow)
+				So(v.Reasons, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the policy is stricter or gentler", func() {
+			policy := AssessPolicy{
+				Thresholds:      RiskThresholds{MinStaticIpScore: 1},
+				ReviewHighRisk:  true,
+				BlockCountries:  []string{"us"},
+				ReviewCountries: []string{"DE"},
+			}
+
+			Convey("I expect its rules applied", func() {
+				v := tor.Assess(policy)
+				So(v.Action, ShouldEqual, ActionReview)
+				So(v.Reasons, ShouldResemble, []string{"tor_exit_node", "review_country"})
+
+				v = hosting.Assess(policy)
+				So(v.Action, ShouldEqual, ActionBlock)
+				So(v.Reasons, ShouldResemble, []string{"hosting_provider", "blocked_country"})
+
+				v = home.Assess(policy)
+				So(v.Action, ShouldEqual, ActionReview)
+				So(v.Reasons, ShouldResemble, []string{"low_static_ip_score"})
+			})
+		})
+	})
+
+	Convey("Given an Api", t, func() {
+		var path string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			path = req.URL.Path
+			w.Write([]byte(`{"country":{"iso_code":"NL"},"traits":{"is_anonymous_vpn":true,"is_anonymous":true}}`))
+		}))
+		defer server.Close()
+		api := New("blah-user-id", "blah-license-key", WithBaseURL(server.URL))
+
+		Convey("When I assess an address", func() {
+			v, err := api.Assess(context.Background(), "1.2.3.4", AssessPolicy{})
+			So(err, ShouldBeNil)
+
+			Convey("I expect one Insights call and a compact verdict", func() {
+				So(path, ShouldEqual, "/insights/1.2.3.4")
+				So(v.IpAddress, ShouldEqual, "1.2.3.4")
+				So(v.Country, ShouldEqual, "NL")
+				So(v.Action, ShouldEqual, ActionBlock)
+
+				data, err := json.Marshal(v)
+				So(err, ShouldBeNil)
+				So(string(data), ShouldContainSubstring, `"anonymous_vpn":true`)
+				So(string(data), ShouldContainSubstring, `"reasons":["anonymous_vpn"]`)
+			})
+		})
+	})
+}
@@ -0,0 +1,103 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Authenticator adds credentials to each outgoing request.  The default is
+// BasicAuth with the account ID and license key passed to New.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// AuthenticatorFunc adapts a function to the Authenticator interface
+type AuthenticatorFunc func(req *http.Request) error
+
+func (fn AuthenticatorFunc) Authenticate(req *http.Request) error {
+	return fn(req)
+}
+
+// BasicAuth authenticates with MaxMind's account ID and license key
+// http://dev.maxmind.com/geoip/geoip2/web-services/#Authorization
+type BasicAuth struct {
+	UserId     string
+	LicenseKey string
+}
+
+func (b BasicAuth) Authenticate(req *http.Request) error {
+	req.SetBasicAuth(b.UserId, b.LicenseKey)
+	return nil
+}
+
+func (b BasicAuth) String() string {
+	return fmt.Sprintf("geoip2.BasicAuth{UserId: %q, LicenseKey: %s}", b.UserId, redact(b.LicenseKey))
+}
+
+func (b BasicAuth) GoString() string {
+	return b.String()
+}
+
+// BearerToken authenticates with an "Authorization: Bearer" header, as
+// expected by some gateways and auth-translating proxies
+type BearerToken string
+
+func (t BearerToken) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+string(t))
+	return nil
+}
+
+func (t BearerToken) String() string {
+	return redact(string(t))
+}
+
+func (t BearerToken) GoString() string {
+	return t.String()
+}
+
+// HeaderAuth authenticates by setting fixed headers, e.g. an API key header
+// required by an internal proxy
+type HeaderAuth map[string]string
+
+func (h HeaderAuth) Authenticate(req *http.Request) error {
+	for k, v := range h {
+		req.Header.Set(k, v)
+	}
+	return nil
+}
+
+func (h HeaderAuth) String() string {
+	return fmt.Sprintf("geoip2.HeaderAuth{%d headers}", len(h))
+}
+
+func (h HeaderAuth) GoString() string {
+	return h.String()
+}
+
+// WithAuthenticator replaces the default basic authentication
+func WithAuthenticator(auth Authenticator) Option {
+	return func(a *Api) {
+		a.auth = auth
+	}
+}
+
+func (a *Api) authenticator() Authenticator {
+	if a.auth != nil {
+		return a.auth
+	}
+	return BasicAuth{UserId: a.userId, LicenseKey: a.licenseKey}
+}
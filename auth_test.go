@@ -0,0 +1,82 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAuthenticator(t *testing.T) {
+	Convey("Given an Api that records outgoing requests", t, func() {
+		var sent *http.Request
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			sent = req
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		})
+
+		Convey("I expect basic auth by default", func() {
+			_, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			user, key, ok := sent.BasicAuth()
+			So(ok, ShouldBeTrue)
+			So(user, ShouldEqual, "blah-user-id")
+			So(key, ShouldEqual, "blah-license-key")
+		})
+
+		Convey("I expect a bearer token to replace basic auth", func() {
+			_, err := api.Clone(WithAuthenticator(BearerToken("secret-token"))).City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(sent.Header.Get("Authorization"), ShouldEqual, "Bearer secret-token")
+		})
+
+		Convey("I expect custom headers to be set", func() {
+			auth := HeaderAuth{"X-Api-Key": "secret-key"}
+			_, err := api.Clone(WithAuthenticator(auth)).City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(sent.Header.Get("X-Api-Key"), ShouldEqual, "secret-key")
+			So(sent.Header.Get("Authorization"), ShouldBeEmpty)
+		})
+
+		Convey("I expect authentication failures to abort the lookup", func() {
+			failure := errors.New("no token")
+			auth := AuthenticatorFunc(func(*http.Request) error { return failure })
+			sent = nil
+			_, err := api.Clone(WithAuthenticator(auth)).City(nil, "1.2.3.4")
+			So(err, ShouldEqual, failure)
+			So(sent, ShouldBeNil)
+		})
+
+		Convey("I expect credentials never to be printed", func() {
+			for _, v := range []interface{}{
+				BasicAuth{UserId: "blah-user-id", LicenseKey: "blah-license-key"},
+				BearerToken("blah-license-key"),
+				HeaderAuth{"X-Api-Key": "blah-license-key"},
+			} {
+				So(fmt.Sprintf("%v %+v %#v", v, v, v), ShouldNotContainSubstring, "blah-license-key")
+			}
+		})
+	})
+}
@@ -0,0 +1,247 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/netip"
+)
+
+// Result is the outcome of one lookup in a batch
+type Result struct {
+	IpAddress string
+	Response  Response
+	Err       error
+}
+
+// LookupFunc is the signature shared by Country, City and Insights
+type LookupFunc func(ctx context.Context, ipAddress string) (Response, error)
+
+// Stream performs lookup for each address in ips with at most concurrency
+// lookups in flight, yielding results as they complete rather than in input
+// order.  Memory use is bounded by concurrency however many addresses ips
+// produces, and ips is only read as fast as results are consumed.
+//
+// Breaking out of the loop cancels any lookups still in flight.  Once ctx
+// is done no further lookups are started; callers should check ctx.Err()
+// after the loop to tell a canceled stream from a finished one.
+func Stream(ctx context.Context, lookup LookupFunc, ips iter.Seq[string], concurrency int) iter.Seq2[string, Result] {
+	return func(yield func(string, Result) bool) {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		if concurrency <= 0 {
+			concurrency = 1
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		next, stop := iter.Pull(ips)
+		defer stop()
+
+		// buffered so that abandoned lookups never block once we return
+		results := make(chan Result, concurrency)
+		inflight, more := 0, true
+		for {
+			for more && inflight < concurrency && ctx.Err() == nil {
+				ipAddress, ok := next()
+				if !ok {
+					more = false
+					break
+				}
+				inflight++
+				go func(ipAddress string) {
+					response, err := lookup(ctx, ipAddress)
+					results <- Result{IpAddress: ipAddress, Response: response, Err: err}
+				}(ipAddress)
+			}
+			if inflight == 0 {
+				return
+			}
+
+			result := <-results
+			inflight--
+			if !yield(result.IpAddress, result) {
+				return
+			}
+		}
+	}
+}
+
+// StreamChannel is Stream for channel-based pipelines: addresses are read
+// from in and results are sent on the returned channel, which is closed
+// once in is closed and drained or ctx is done.  Reading from in stops while
+// the consumer is not receiving, so backpressure reaches the producer.
+func StreamChannel(ctx context.Context, lookup LookupFunc, in <-chan string, concurrency int) <-chan Result {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ips := func(yield func(string) bool) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ipAddress, ok := <-in:
+				if !ok || !yield(ipAddress) {
+					return
+				}
+			}
+		}
+	}
+
+	out := make(chan Result)
+	go func() {
+		defer close(out)
+		for _, result := range Stream(ctx, lookup, ips, concurrency) {
+			select {
+			case out <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// PartialError is returned by the batch lookups when ctx is done before
+// every address has been looked up.  The results returned alongside it are
+// the lookups that completed, which have already been paid for.
+type PartialError struct {
+	Completed int
+	Remaining []string
+	Err       error
+}
+
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("geoip2: batch interrupted after %d lookups with %d remaining: %v", e.Completed, len(e.Remaining), e.Err)
+}
+
+func (e *PartialError) Unwrap() error {
+	return e.Err
+}
+
+// BatchCountry looks up every address with at most concurrency lookups in
+// flight; see Batch
+func (a *Api) BatchCountry(ctx context.Context, ipAddresses []string, concurrency int) ([]Result, error) {
+	return batch(ctx, a.Country, ipAddresses, concurrency, a.batchKey(ctx))
+}
+
+// BatchCity looks up every address with at most concurrency lookups in
+// flight; see Batch
+func (a *Api) BatchCity(ctx context.Context, ipAddresses []string, concurrency int) ([]Result, error) {
+	return batch(ctx, a.City, ipAddresses, concurrency, a.batchKey(ctx))
+}
+
+// BatchInsights looks up every address with at most concurrency lookups in
+// flight; see Batch
+func (a *Api) BatchInsights(ctx context.Context, ipAddresses []string, concurrency int) ([]Result, error) {
+	return batch(ctx, a.Insights, ipAddresses, concurrency, a.batchKey(ctx))
+}
+
+// Batch performs lookup for each address and returns the results in input
+// order.  Failed lookups are reported in each Result's Err rather than
+// failing the batch; the error is non-nil only when ctx is done early, in
+// which case it is a *PartialError and the completed results are returned.
+// Repeated addresses, however they are spelled, are looked up once.  An
+// IPv4-mapped address is kept apart from the IPv4 address it maps, as
+// lookup may tell them apart; the Api's batch methods merge them unless it
+// was given WithKeepIPv4Mapped.
+func Batch(ctx context.Context, lookup LookupFunc, ipAddresses []string, concurrency int) ([]Result, error) {
+	return batch(ctx, lookup, ipAddresses, concurrency, sameAddress)
+}
+
+// batch is Batch with the addresses deduplicated by key
+func batch(ctx context.Context, lookup LookupFunc, ipAddresses []string, concurrency int, key func(string) string) ([]Result, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// equivalent spellings of an address, e.g. 2001:DB8::1 and 2001:db8::1,
+	// are looked up once
+	keys := make([]string, len(ipAddresses))
+	positions := map[string][]int{}
+	for i, ipAddress := range ipAddresses {
+		keys[i] = key(ipAddress)
+		positions[keys[i]] = append(positions[keys[i]], i)
+	}
+	unique := func(yield func(string) bool) {
+		for i, ipAddress := range ipAddresses {
+			if positions[keys[i]][0] == i && !yield(ipAddress) {
+				return
+			}
+		}
+	}
+
+	results := make([]Result, len(ipAddresses))
+	done := make([]bool, len(ipAddresses))
+	for ipAddress, result := range Stream(ctx, lookup, unique, concurrency) {
+		if ctx.Err() != nil && errors.Is(result.Err, ctx.Err()) {
+			// interrupted rather than answered
+			continue
+		}
+		for _, i := range positions[key(ipAddress)] {
+			results[i] = result
+			results[i].IpAddress = ipAddresses[i]
+			done[i] = true
+		}
+	}
+
+	var remaining []string
+	for i, ipAddress := range ipAddresses {
+		if !done[i] && positions[keys[i]][0] == i {
+			remaining = append(remaining, ipAddress)
+		}
+	}
+	if remaining == nil {
+		return results, nil
+	}
+
+	var completed []Result
+	for i := range results {
+		if done[i] {
+			completed = append(completed, results[i])
+		}
+	}
+	return completed, &PartialError{Completed: len(completed), Remaining: remaining, Err: ctx.Err()}
+}
+
+// sameAddress is the key under which Batch merges spellings of an address:
+// its canonical text without a zone, or the text itself if it isn't one
+func sameAddress(ipAddress string) string {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return ipAddress
+	}
+	return addr.WithZone("").String()
+}
+
+// batchKey merges the spellings of an address that lookups with ctx send
+// and cache as the same address
+func (a *Api) batchKey(ctx context.Context) func(string) string {
+	if ctx != nil {
+		a = a.forRequest(ctx)
+	}
+	return func(ipAddress string) string {
+		if key, err := a.normalize(ipAddress); err == nil {
+			return key
+		}
+		return sameAddress(ipAddress)
+	}
+}
@@ -0,0 +1,233 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStream(t *testing.T) {
+	Convey("Given a lookup that tracks concurrency", t, func() {
+		var inflight, peak, calls int32
+		failure := errors.New("boom")
+		lookup := func(ctx context.Context, ipAddress string) (Response, error) {
+			atomic.AddInt32(&calls, 1)
+			n := atomic.AddInt32(&inflight, 1)
+			defer atomic.AddInt32(&inflight, -1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return Response{}, ctx.Err()
+			case <-time.After(5 * time.Millisecond):
+			}
+			if ipAddress == "bad" {
+				return Response{}, failure
+			}
+			return Response{Traits: Traits{Isp: ipAddress}}, nil
+		}
+		ips := slices.Values([]string{"1.1.1.1", "2.2.2.2", "bad", "3.3.3.3", "4.4.4.4", "5.5.5.5"})
+
+		Convey("When I range over every result", func() {
+			seen := map[string]Result{}
+			for ipAddress, result := range Stream(nil, lookup, ips, 2) {
+				seen[ipAddress] = result
+			}
+
+			Convey("I expect one result per address with errors reported per item", func() {
+				So(len(seen), ShouldEqual, 6)
+				So(seen["2.2.2.2"].Response.Traits.Isp, ShouldEqual, "2.2.2.2")
+				So(seen["2.2.2.2"].Err, ShouldBeNil)
+				So(seen["bad"].Err, ShouldEqual, failure)
+				So(atomic.LoadInt32(&peak), ShouldBeLessThanOrEqualTo, 2)
+			})
+		})
+
+		Convey("When I stop after the first result", func() {
+			for range Stream(nil, lookup, ips, 2) {
+				break
+			}
+
+			Convey("I expect no further lookups to start", func() {
+				So(atomic.LoadInt32(&calls), ShouldBeLessThanOrEqualTo, 2)
+			})
+		})
+
+		Convey("When the context is canceled partway", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			count := 0
+			for range Stream(ctx, lookup, ips, 1) {
+				count++
+				cancel()
+			}
+
+			Convey("I expect the stream to end early", func() {
+				So(count, ShouldEqual, 1)
+				So(ctx.Err(), ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestBatch(t *testing.T) {
+	Convey("Given an Api and a batch with an invalid address", t, func() {
+		var calls int32
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&calls, 1)
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		})
+
+		Convey("When I look up a batch", func() {
+			results, err := api.BatchCity(nil, []string{"1.1.1.1", "bad", "2.2.2.2", "1.1.1.1"}, 2)
+
+			Convey("I expect per-address results in input order", func() {
+				So(err, ShouldBeNil)
+				So(len(results), ShouldEqual, 4)
+				So(results[0].IpAddress, ShouldEqual, "1.1.1.1")
+				So(results[0].Err, ShouldBeNil)
+				So(results[1].Err.(Error).Code, ShouldEqual, "IP_ADDRESS_INVALID")
+				So(results[2].IpAddress, ShouldEqual, "2.2.2.2")
+				So(results[3].IpAddress, ShouldEqual, "1.1.1.1")
+				So(atomic.LoadInt32(&calls), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When the batch spells an address several ways", func() {
+			results, err := api.BatchCity(nil, []string{"2001:DB8::1", "2001:db8:0:0::1", "::ffff:1.1.1.1", "1.1.1.1"}, 1)
+
+			Convey("I expect one lookup each, reported under the caller's spelling", func() {
+				So(err, ShouldBeNil)
+				So(atomic.LoadInt32(&calls), ShouldEqual, 2)
+				So(results[1].IpAddress, ShouldEqual, "2001:db8:0:0::1")
+				So(results[1].Response.City, ShouldResemble, results[0].Response.City)
+				So(results[3].IpAddress, ShouldEqual, "1.1.1.1")
+				So(results[3].Err, ShouldBeNil)
+			})
+		})
+
+		Convey("When the Api keeps IPv4-mapped addresses", func() {
+			results, err := api.Clone(WithKeepIPv4Mapped()).BatchCity(nil, []string{"::ffff:1.1.1.1", "1.1.1.1", "::FFFF:1.1.1.1%eth0"}, 1)
+
+			Convey("I expect the mapped and IPv4 forms looked up apart", func() {
+				So(err, ShouldBeNil)
+				So(atomic.LoadInt32(&calls), ShouldEqual, 2)
+				So(results[2].IpAddress, ShouldEqual, "::FFFF:1.1.1.1%eth0")
+				So(results[2].Err, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a lookup that tells IPv4-mapped addresses apart", t, func() {
+		var seen []string
+		lookup := func(ctx context.Context, ipAddress string) (Response, error) {
+			seen = append(seen, ipAddress)
+			return Response{Traits: Traits{Isp: ipAddress}}, nil
+		}
+
+		results, err := Batch(nil, lookup, []string{"::ffff:1.1.1.1", "1.1.1.1", "2001:DB8::1", "2001:db8::1%eth0"}, 1)
+
+		Convey("I expect only equivalent spellings merged", func() {
+			So(err, ShouldBeNil)
+			So(seen, ShouldResemble, []string{"::ffff:1.1.1.1", "1.1.1.1", "2001:DB8::1"})
+			So(results[1].Response.Traits.Isp, ShouldEqual, "1.1.1.1")
+			So(results[3].Response.Traits.Isp, ShouldEqual, "2001:DB8::1")
+		})
+	})
+
+	Convey("Given a batch that is canceled partway", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		lookup := func(ctx context.Context, ipAddress string) (Response, error) {
+			if ipAddress == "3.3.3.3" {
+				cancel()
+				return Response{}, ctx.Err()
+			}
+			return Response{Traits: Traits{Isp: ipAddress}}, nil
+		}
+
+		results, err := Batch(ctx, lookup, []string{"1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4"}, 1)
+
+		Convey("I expect the completed results and a PartialError", func() {
+			So(len(results), ShouldEqual, 2)
+			So(results[1].Response.Traits.Isp, ShouldEqual, "2.2.2.2")
+
+			partial, ok := err.(*PartialError)
+			So(ok, ShouldBeTrue)
+			So(partial.Completed, ShouldEqual, 2)
+			So(partial.Remaining, ShouldResemble, []string{"3.3.3.3", "4.4.4.4"})
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+		})
+	})
+}
+
+func TestStreamChannel(t *testing.T) {
+	Convey("Given addresses fed over a channel", t, func() {
+		lookup := func(ctx context.Context, ipAddress string) (Response, error) {
+			return Response{Traits: Traits{Isp: ipAddress}}, nil
+		}
+		in := make(chan string)
+		go func() {
+			defer close(in)
+			for _, ipAddress := range []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"} {
+				in <- ipAddress
+			}
+		}()
+
+		Convey("I expect a result for each address and the output to close", func() {
+			seen := map[string]bool{}
+			for result := range StreamChannel(nil, lookup, in, 2) {
+				So(result.Err, ShouldBeNil)
+				So(result.Response.Traits.Isp, ShouldEqual, result.IpAddress)
+				seen[result.IpAddress] = true
+			}
+			So(len(seen), ShouldEqual, 3)
+		})
+	})
+
+	Convey("Given a consumer that gives up", t, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		lookup := func(ctx context.Context, ipAddress string) (Response, error) {
+			return Response{}, nil
+		}
+		in := make(chan string)
+		out := StreamChannel(ctx, lookup, in, 1)
+		in <- "1.1.1.1"
+		<-out
+		cancel()
+
+		Convey("I expect the output to close without closing the input", func() {
+			for range out {
+			}
+			So(ctx.Err(), ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,92 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// The benchmarks decode the Insights sample, the largest answer, and run
+// whole lookups against an in-memory transport, e.g.
+//
+//	go test -run - -bench . -benchmem
+
+func BenchmarkDecode(b *testing.B) {
+	data := []byte(sample)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var resp Response
+		if err := decode(data, &resp); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeSections(b *testing.B) {
+	data := []byte(sample)
+	sections := []string{"country", "traits"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var resp Response
+		if err := decodeSections(data, &resp, sections); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkReadBody(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := readBody(strings.NewReader(sample), 0); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkLookup(b *testing.B, opts ...Option) {
+	api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(sample)),
+		}, nil
+	}).Clone(opts...)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := api.Insights(ctx, "1.2.3.4"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkLookup(b *testing.B) {
+	benchmarkLookup(b)
+}
+
+func BenchmarkLookupSections(b *testing.B) {
+	benchmarkLookup(b, WithSections("country"))
+}
+
+func BenchmarkLookupCached(b *testing.B) {
+	benchmarkLookup(b, WithCache(NewLRUCache(10)))
+}
@@ -0,0 +1,86 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// binaryVersion prefixes the encoding written by Response.MarshalBinary,
+// so that later layouts can still read entries stored by earlier versions
+const binaryVersion = 1
+
+var errBinaryVersion = errors.New("geoip2: unsupported response encoding")
+
+// binaryResponse is the layout of Response.MarshalBinary.  Unlike the
+// response's own JSON it keeps the raw body and the request metadata.
+type binaryResponse struct {
+	Response responseFields `json:"response"`
+	Raw      []byte         `json:"raw,omitempty"` // exactly as received
+	Meta     *Meta          `json:"meta,omitempty"`
+	NotFound bool           `json:"not_found,omitempty"`
+}
+
+// responseFields has Response's fields without its methods, so encoding
+// it doesn't recurse into MarshalBinary
+type responseFields Response
+
+// MarshalBinary encodes the response, including its raw body and
+// metadata, for external caches such as Redis or a disk; encoding/gob uses
+// it too.  DecodeErrors are not kept.
+func (r Response) MarshalBinary() ([]byte, error) {
+	data, err := json.Marshal(binaryResponse{
+		Response: responseFields(r),
+		Raw:      r.raw,
+		Meta:     r.meta,
+		NotFound: r.notFound,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{binaryVersion}, data...), nil
+}
+
+// UnmarshalBinary decodes a response encoded by MarshalBinary
+func (r *Response) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != binaryVersion {
+		return errBinaryVersion
+	}
+	var v binaryResponse
+	if err := json.Unmarshal(data[1:], &v); err != nil {
+		return err
+	}
+	*r = Response(v.Response)
+	r.meta = v.Meta
+	r.notFound = v.NotFound
+	r.raw = v.Raw
+	return nil
+}
+
+// MarshalBinary encodes the decimal as its text, so that encoding/gob can
+// hold it
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	v, err := ParseDecimal(string(data))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
@@ -0,0 +1,99 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBinary(t *testing.T) {
+	Convey("Given an Insights answer from the web service", t, func() {
+		api := WithClientFunc(New("blah-user-id", "blah-license-key", WithLocales("fr")), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/vnd.maxmind.com-insights+json"}, "X-Request-Id": {"req-1"}},
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		})
+		resp, err := api.Insights(nil, "1.2.3.4")
+		So(err, ShouldBeNil)
+
+		Convey("When I round-trip it through MarshalBinary", func() {
+			data, err := resp.MarshalBinary()
+			So(err, ShouldBeNil)
+			var decoded Response
+			So(decoded.UnmarshalBinary(data), ShouldBeNil)
+
+			Convey("I expect every field, the raw body and the metadata back", func() {
+				So(decoded, ShouldResemble, resp)
+				So(decoded.Traits.StaticIpScore, ShouldResemble, resp.Traits.StaticIpScore)
+				So(string(decoded.Raw()), ShouldEqual, string(resp.Raw()))
+				So(decoded.Meta().RequestId, ShouldEqual, "req-1")
+				So(decoded.Meta().Locales, ShouldResemble, []string{"fr"})
+			})
+		})
+
+		Convey("When I round-trip it through encoding/gob", func() {
+			buf := &bytes.Buffer{}
+			So(gob.NewEncoder(buf).Encode(resp), ShouldBeNil)
+			var decoded Response
+			So(gob.NewDecoder(buf).Decode(&decoded), ShouldBeNil)
+
+			Convey("I expect it whole", func() {
+				So(decoded, ShouldResemble, resp)
+			})
+
+			Convey("I expect its parts to encode on their own", func() {
+				buf := &bytes.Buffer{}
+				So(gob.NewEncoder(buf).Encode(resp.Traits), ShouldBeNil)
+				var traits Traits
+				So(gob.NewDecoder(buf).Decode(&traits), ShouldBeNil)
+				So(traits, ShouldResemble, resp.Traits)
+			})
+		})
+
+		Convey("When I round-trip it through JSON", func() {
+			data, err := json.Marshal(resp)
+			So(err, ShouldBeNil)
+			var decoded Response
+			So(json.Unmarshal(data, &decoded), ShouldBeNil)
+
+			Convey("I expect the fields, names included, back", func() {
+				So(decoded.City, ShouldResemble, resp.City)
+				So(decoded.Subdivisions, ShouldResemble, resp.Subdivisions)
+				So(decoded.Traits, ShouldResemble, resp.Traits)
+				So(decoded.Location, ShouldResemble, resp.Location)
+			})
+		})
+	})
+
+	Convey("Given data that isn't a MarshalBinary encoding", t, func() {
+		var resp Response
+
+		Convey("I expect an error", func() {
+			So(resp.UnmarshalBinary(nil), ShouldEqual, errBinaryVersion)
+			So(resp.UnmarshalBinary([]byte(`{"city":{}}`)), ShouldEqual, errBinaryVersion)
+		})
+	})
+}
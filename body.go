@@ -0,0 +1,187 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrBodyTooLarge is returned when a response body exceeds the size set by
+// WithMaxBodySize
+var ErrBodyTooLarge = errors.New("geoip2: response body too large")
+
+// WithMaxBodySize fails lookups whose response body, once decompressed, is
+// larger than n bytes rather than reading it all into memory, to guard
+// against a misbehaving proxy.  The largest Insights answers are a few
+// kilobytes.  Zero, the default, sets no limit.
+func WithMaxBodySize(n int64) Option {
+	return func(a *Api) {
+		a.maxBodySize = n
+	}
+}
+
+// WithStrictDecoding fails lookups whose answers carry fields Response
+// doesn't know, to detect changes to the web services' schema, e.g. in
+// staging.  The rest of the answer is still returned alongside the error.
+// It is off by default, since MaxMind adds fields from time to time.
+func WithStrictDecoding() Option {
+	return func(a *Api) {
+		a.strict = true
+	}
+}
+
+// WithLenientDecoding returns answers with fields that could not be decoded
+// as successes rather than with a DecodeError, so that pipelines degrade
+// gracefully: the offending fields are left zero and listed by
+// Response.DecodeErrors.  Such answers are cached like any other.
+func WithLenientDecoding() Option {
+	return func(a *Api) {
+		a.lenient = true
+	}
+}
+
+// WithSections decodes only the named sections of each answer, e.g.
+// "country" and "traits", leaving the rest zero, which saves most of the
+// allocation of decoding a full Insights answer when only part of it is
+// used.  Sections are named by their JSON keys: city, continent, country,
+// location, maxmind, postal, registered_country, represented_country,
+// subdivisions and traits; other names are ignored.  Raw still returns the
+// whole body.
+func WithSections(sections ...string) Option {
+	return func(a *Api) {
+		a.sections = nil
+		for _, section := range sections {
+			if sectionIndex(section) >= 0 {
+				a.sections = append(a.sections, section)
+			}
+		}
+		sort.Strings(a.sections)
+		a.sections = slices.Compact(a.sections)
+	}
+}
+
+// responseSections are the JSON keys of Response's fields
+var responseSections = []string{
+	"city", "continent", "country", "location", "postal",
+	"registered_country", "represented_country", "subdivisions", "traits", "maxmind",
+}
+
+func sectionIndex(section string) int {
+	for i, s := range responseSections {
+		if s == section {
+			return i
+		}
+	}
+	return -1
+}
+
+// keepSections filters errs and zeroes the fields of r to leave only the
+// named sections, for decoding paths that decode the whole answer
+func keepSections(r *Response, keep []string, errs []FieldError) []FieldError {
+	wanted := func(section string) bool {
+		i := sort.SearchStrings(keep, section)
+		return i < len(keep) && keep[i] == section
+	}
+
+	full := *r
+	*r = Response{raw: full.raw}
+	for _, section := range keep {
+		switch section {
+		case "city":
+			r.City = full.City
+		case "continent":
+			r.Continent = full.Continent
+		case "country":
+			r.Country = full.Country
+		case "location":
+			r.Location = full.Location
+		case "maxmind":
+			r.MaxMind = full.MaxMind
+		case "postal":
+			r.Postal = full.Postal
+		case "registered_country":
+			r.RegisteredCountry = full.RegisteredCountry
+		case "represented_country":
+			r.RepresentedCountry = full.RepresentedCountry
+		case "subdivisions":
+			r.Subdivisions = full.Subdivisions
+		case "traits":
+			r.Traits = full.Traits
+		}
+	}
+
+	var kept []FieldError
+	for _, e := range errs {
+		section := e.Field
+		if i := strings.IndexAny(section, ".["); i >= 0 {
+			section = section[:i]
+		}
+		if wanted(section) {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// bodyBuffers are reused to read response bodies, so each read allocates
+// only the exact-size copy the response keeps
+var bodyBuffers = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// maxPooledBuffer bounds the buffers returned to the pool, so one huge
+// body doesn't pin its memory
+const maxPooledBuffer = 64 << 10
+
+// readBody reads r, failing with ErrBodyTooLarge beyond max bytes when max
+// is positive
+func readBody(r io.Reader, max int64) ([]byte, error) {
+	if max > 0 {
+		r = io.LimitReader(r, max+1)
+	}
+	buf := bodyBuffers.Get().(*bytes.Buffer)
+	defer func() {
+		if buf.Cap() <= maxPooledBuffer {
+			buf.Reset()
+			bodyBuffers.Put(buf)
+		}
+	}()
+
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+	if max > 0 && int64(buf.Len()) > max {
+		return nil, ErrBodyTooLarge
+	}
+	return append(make([]byte, 0, buf.Len()), buf.Bytes()...), nil
+}
+
+// strictDecode reports the first field of data that Response doesn't know
+func strictDecode(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&Response{}); err != nil {
+		return fmt.Errorf("geoip2: strict decoding: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBodyOptions(t *testing.T) {
+	Convey("Given an Api answered with a given body", t, func() {
+		status := http.StatusOK
+		body := sample
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: status,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		})
+
+		Convey("When the body fits within the maximum size", func() {
+			resp, err := api.Clone(WithMaxBodySize(int64(len(sample)))).City(nil, "1.2.3.4")
+
+			Convey("I expect the answer", func() {
+				So(err, ShouldBeNil)
+				So(resp.City.Confidence, ShouldEqual, 25)
+			})
+		})
+
+		Convey("When the body is too large", func() {
+			api = api.Clone(WithMaxBodySize(int64(len(sample) - 1)))
+			_, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldEqual, ErrBodyTooLarge)
+
+			Convey("I expect error bodies to be limited too", func() {
+				status = http.StatusBadGateway
+				body = strings.Repeat("<html>", len(sample))
+				_, err := api.City(nil, "1.2.3.4")
+				So(err, ShouldEqual, ErrBodyTooLarge)
+			})
+		})
+
+		Convey("When the answer carries a field Response doesn't know", func() {
+			body = `{"city":{"confidence":25,"shape":"round"},"country":{"iso_code":"GB"}}`
+
+			Convey("I expect lenient decoding by default", func() {
+				resp, err := api.City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+				So(resp.City.Confidence, ShouldEqual, 25)
+			})
+
+			Convey("I expect strict decoding to name it and keep the rest", func() {
+				resp, err := api.Clone(WithStrictDecoding()).City(nil, "1.2.3.4")
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, `"shape"`)
+				So(resp.Country.IsoCode, ShouldEqual, "GB")
+
+				var decodeErr DecodeError
+				So(errors.As(err, &decodeErr), ShouldBeFalse)
+			})
+		})
+
+		Convey("I expect strict decoding to accept a complete answer", func() {
+			_, err := api.Clone(WithStrictDecoding()).Insights(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+		})
+	})
+
+	Convey("Given an Api that decodes only some sections", t, func() {
+		body := sample
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		}).Clone(WithSections("traits", "country", "country", "nonsense"))
+
+		Convey("When I look up an address", func() {
+			resp, err := api.Insights(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			Convey("I expect only those sections decoded, and the raw body whole", func() {
+				So(resp.Country.IsoCode, ShouldEqual, "US")
+				So(resp.Traits.Isp, ShouldNotBeEmpty)
+				So(resp.City, ShouldResemble, City{})
+				So(resp.Subdivisions, ShouldBeNil)
+				So(resp.Location, ShouldResemble, Location{})
+				So(string(resp.Raw()), ShouldEqual, sample)
+			})
+		})
+
+		Convey("When a section I didn't ask for is malformed", func() {
+			body = `{"city":{"confidence":"high"},"country":{"iso_code":"GB"}}`
+			resp, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect no error", func() {
+				So(err, ShouldBeNil)
+				So(resp.Country.IsoCode, ShouldEqual, "GB")
+				So(resp.City, ShouldResemble, City{})
+			})
+		})
+
+		Convey("When a section I asked for is malformed", func() {
+			body = `{"country":{"iso_code":42},"city":{"confidence":"high"}}`
+			_, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect a DecodeError for it alone", func() {
+				e, ok := err.(DecodeError)
+				So(ok, ShouldBeTrue)
+				So(len(e.Fields), ShouldEqual, 1)
+				So(e.Fields[0].Field, ShouldEqual, "country.iso_code")
+			})
+		})
+	})
+
+	Convey("Given bodies read through the pool", t, func() {
+		first, err := readBody(strings.NewReader("first body"), 0)
+		So(err, ShouldBeNil)
+		second, err := readBody(strings.NewReader("second"), 0)
+		So(err, ShouldBeNil)
+
+		Convey("I expect each to own its bytes", func() {
+			So(string(first), ShouldEqual, "first body")
+			So(string(second), ShouldEqual, "second")
+			empty, err := readBody(strings.NewReader(""), 0)
+			So(err, ShouldBeNil)
+			So(empty, ShouldNotBeNil)
+		})
+	})
+}
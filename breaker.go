@@ -0,0 +1,213 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without a request while the circuit breaker is
+// open.  A Chain treats it like any other failure and moves on to its
+// fallbacks.
+var ErrCircuitOpen = errors.New("geoip2: circuit breaker open")
+
+// BreakerState is the state of a circuit breaker
+type BreakerState int
+
+const (
+	// BreakerClosed sends every request
+	BreakerClosed BreakerState = iota
+	// BreakerOpen fails every request with ErrCircuitOpen
+	BreakerOpen
+	// BreakerHalfOpen lets a few probes through to test for recovery
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// BreakerPolicy configures WithCircuitBreaker.  Zero values take the
+// defaults noted.
+type BreakerPolicy struct {
+	// FailureThreshold is the number of consecutive failures, network errors
+	// or 5xx responses, that opens the breaker, 5 by default
+	FailureThreshold int
+
+	// OpenDuration is how long the breaker fails fast before probing, 30s by
+	// default
+	OpenDuration time.Duration
+
+	// HalfOpenProbes is the number of requests let through once
+	// OpenDuration has passed, 1 by default.  The breaker closes when they
+	// all succeed and opens again on the first failure.
+	HalfOpenProbes int
+
+	// OnStateChange, if set, is called on every transition, e.g. to alert
+	OnStateChange func(from, to BreakerState)
+}
+
+func (p BreakerPolicy) withDefaults() BreakerPolicy {
+	if p.FailureThreshold <= 0 {
+		p.FailureThreshold = 5
+	}
+	if p.OpenDuration <= 0 {
+		p.OpenDuration = 30 * time.Second
+	}
+	if p.HalfOpenProbes <= 0 {
+		p.HalfOpenProbes = 1
+	}
+	return p
+}
+
+// WithCircuitBreaker stops calling the web service after repeated
+// failures, so lookups fail fast with ErrCircuitOpen during an outage
+// instead of piling up.  The breaker sits outside any WithRetries, so that
+// a lookup counts once however often it was retried, and wraps whatever
+// transport is configured, whether before or after this option.  Clones
+// share the breaker.  To answer from elsewhere while it is open, put a
+// fallback behind the api in a Chain:
+//
+//	api := geoip2.New(userId, licenseKey, geoip2.WithCircuitBreaker(geoip2.BreakerPolicy{}))
+//	lookup := geoip2.NewChain(api, reader)
+func WithCircuitBreaker(policy BreakerPolicy) Option {
+	b := &breaker{policy: policy.withDefaults(), now: time.Now}
+	return func(a *Api) {
+		a.breaker = b
+	}
+}
+
+type breaker struct {
+	policy BreakerPolicy
+	now    func() time.Time
+
+	mutex     sync.Mutex
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	probes    int // probes sent while half-open
+	succeeded int // probes succeeded while half-open
+	changes   []stateChange
+}
+
+type stateChange struct {
+	from, to BreakerState
+}
+
+func (b *breaker) wrap(doFunc DoFunc) DoFunc {
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		if !b.allow() {
+			return nil, ErrCircuitOpen
+		}
+		resp, err := doFunc(ctx, req)
+		if err != nil && (ctx.Err() != nil || err == context.DeadlineExceeded) {
+			// the caller giving up, or the rate limit refusing to wait past
+			// the deadline, says nothing about the web service
+			b.release()
+			return resp, err
+		}
+		b.record(err != nil || resp.StatusCode >= 500)
+		return resp, err
+	}
+}
+
+// allow reports whether a request may be sent, reserving a probe when half
+// open
+func (b *breaker) allow() bool {
+	b.mutex.Lock()
+	defer b.unlock()
+
+	if b.state == BreakerOpen {
+		if b.now().Sub(b.openedAt) < b.policy.OpenDuration {
+			return false
+		}
+		b.transition(BreakerHalfOpen)
+	}
+	if b.state == BreakerHalfOpen {
+		if b.probes >= b.policy.HalfOpenProbes {
+			return false
+		}
+		b.probes++
+	}
+	return true
+}
+
+// release returns an unused probe
+func (b *breaker) release() {
+	b.mutex.Lock()
+	defer b.unlock()
+
+	if b.state == BreakerHalfOpen && b.probes > b.succeeded {
+		b.probes--
+	}
+}
+
+func (b *breaker) record(failed bool) {
+	b.mutex.Lock()
+	defer b.unlock()
+
+	switch {
+	case failed && b.state == BreakerHalfOpen:
+		b.transition(BreakerOpen)
+	case failed && b.state == BreakerClosed:
+		b.failures++
+		if b.failures >= b.policy.FailureThreshold {
+			b.transition(BreakerOpen)
+		}
+	case !failed && b.state == BreakerHalfOpen:
+		b.succeeded++
+		if b.succeeded >= b.policy.HalfOpenProbes {
+			b.transition(BreakerClosed)
+		}
+	case !failed && b.state == BreakerClosed:
+		b.failures = 0
+	}
+}
+
+// transition must be called with the mutex held.  OnStateChange is called
+// by unlock, so that it may use the Api without deadlocking.
+func (b *breaker) transition(to BreakerState) {
+	from := b.state
+	b.state = to
+	b.failures, b.probes, b.succeeded = 0, 0, 0
+	if to == BreakerOpen {
+		b.openedAt = b.now()
+	}
+	if b.policy.OnStateChange != nil && from != to {
+		b.changes = append(b.changes, stateChange{from: from, to: to})
+	}
+}
+
+// unlock releases the mutex, then reports the transitions made under it
+func (b *breaker) unlock() {
+	changes := b.changes
+	b.changes = nil
+	b.mutex.Unlock()
+
+	for _, change := range changes {
+		b.policy.OnStateChange(change.from, change.to)
+	}
+}
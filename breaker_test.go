@@ -0,0 +1,139 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	Convey("Given an Api behind a circuit breaker", t, func() {
+		var calls int32
+		var healthy atomic.Bool
+		var transitions []string
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&calls, 1)
+			if !healthy.Load() {
+				return &http.Response{StatusCode: 503, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+			}
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(sample))}, nil
+		}).Clone(WithCircuitBreaker(BreakerPolicy{
+			FailureThreshold: 3,
+			OpenDuration:     50 * time.Millisecond,
+			OnStateChange: func(from, to BreakerState) {
+				transitions = append(transitions, from.String()+">"+to.String())
+			},
+		}))
+
+		Convey("When the web service keeps failing", func() {
+			for i := 0; i < 3; i++ {
+				api.City(nil, "1.2.3.4")
+			}
+			_, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect lookups to fail fast once the threshold is reached", func() {
+				So(errors.Is(err, ErrCircuitOpen), ShouldBeTrue)
+				So(atomic.LoadInt32(&calls), ShouldEqual, 3)
+				So(transitions, ShouldResemble, []string{"closed>open"})
+			})
+
+			Convey("I expect a fallback in a Chain to answer meanwhile", func() {
+				resp, err := NewChain(api, StaticResponse(MockResponse("1.2.3.4"))).City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+				So(resp.Traits.IpAddress.String(), ShouldEqual, "1.2.3.4")
+			})
+
+			Convey("When the open duration passes and a probe fails", func() {
+				time.Sleep(60 * time.Millisecond)
+				api.City(nil, "1.2.3.4")
+				_, err := api.City(nil, "1.2.3.4")
+
+				Convey("I expect the breaker to open again", func() {
+					So(errors.Is(err, ErrCircuitOpen), ShouldBeTrue)
+					So(atomic.LoadInt32(&calls), ShouldEqual, 4)
+					So(transitions, ShouldResemble, []string{"closed>open", "open>half-open", "half-open>open"})
+				})
+			})
+
+			Convey("When the web service recovers", func() {
+				healthy.Store(true)
+				time.Sleep(60 * time.Millisecond)
+				_, err := api.City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+
+				Convey("I expect the probe to close the breaker", func() {
+					_, err := api.City(nil, "1.2.3.4")
+					So(err, ShouldBeNil)
+					So(transitions, ShouldResemble, []string{"closed>open", "open>half-open", "half-open>closed"})
+				})
+			})
+		})
+
+		Convey("When failures are interleaved with successes", func() {
+			healthy.Store(false)
+			api.City(nil, "1.2.3.4")
+			api.City(nil, "1.2.3.4")
+			healthy.Store(true)
+			api.City(nil, "1.2.3.4")
+			healthy.Store(false)
+			api.City(nil, "1.2.3.4")
+			api.City(nil, "1.2.3.4")
+
+			Convey("I expect the breaker to stay closed", func() {
+				So(transitions, ShouldBeEmpty)
+				So(atomic.LoadInt32(&calls), ShouldEqual, 5)
+			})
+		})
+	})
+
+	Convey("Given a state change callback that uses the Api", t, func() {
+		var api *Api
+		var reentered error
+		api = WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 503, Body: ioutil.NopCloser(strings.NewReader(`{}`))}, nil
+		}).Clone(WithCircuitBreaker(BreakerPolicy{
+			FailureThreshold: 1,
+			OnStateChange: func(from, to BreakerState) {
+				_, reentered = api.City(nil, "1.2.3.4")
+			},
+		}))
+
+		Convey("When the breaker opens", func() {
+			done := make(chan struct{})
+			go func() {
+				api.City(nil, "1.2.3.4")
+				close(done)
+			}()
+
+			Convey("I expect the callback to run without deadlocking", func() {
+				select {
+				case <-done:
+				case <-time.After(time.Second):
+					t.Fatal("OnStateChange deadlocked")
+				}
+				So(errors.Is(reentered, ErrCircuitOpen), ShouldBeTrue)
+			})
+		})
+	})
+}
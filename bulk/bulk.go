@@ -0,0 +1,258 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package bulk enriches files of addresses, such as access logs, with the
+// location and network of each address.  Records are read from CSV or JSON
+// Lines and written in the same format with the selected fields added.
+//
+//	api := geoip2.New(userId, licenseKey,
+//		geoip2.WithRateLimit(100, time.Second),
+//		geoip2.WithCache(geoip2.NewLRUCache(100000)),
+//	)
+//	stats, err := bulk.Process(ctx, api.City, in, out, bulk.Options{Column: "client_ip"})
+//
+// Rate limits and caching are configured on the Api, so a repeated address
+// costs one lookup however often it appears.
+package bulk
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/savaki/geoip2"
+)
+
+// Format is the encoding of the records read and written
+type Format string
+
+const (
+	// CSV records follow a header row naming the columns
+	CSV Format = "csv"
+	// JSONL records are JSON objects, one per line
+	JSONL Format = "jsonl"
+)
+
+// Field extracts a value from a response.  A nil value means none, written
+// as an empty CSV column or a JSON null.
+type Field func(resp geoip2.Response) interface{}
+
+// Fields lists the fields that may be selected, by name
+var Fields = map[string]Field{
+	"country_iso":     func(r geoip2.Response) interface{} { return str(r.Country.IsoCode) },
+	"country":         func(r geoip2.Response) interface{} { return str(r.CountryName()) },
+	"subdivision_iso": subdivisionIso,
+	"subdivision":     func(r geoip2.Response) interface{} { return str(r.SubdivisionName()) },
+	"city":            func(r geoip2.Response) interface{} { return str(r.CityName()) },
+	"postal":          func(r geoip2.Response) interface{} { return str(r.Postal.Code) },
+	"latitude":        func(r geoip2.Response) interface{} { return coordinate(r, r.Location.Latitude) },
+	"longitude":       func(r geoip2.Response) interface{} { return coordinate(r, r.Location.Longitude) },
+	"accuracy_radius": func(r geoip2.Response) interface{} { return num(r.Location.AccuracyRadius) },
+	"time_zone":       func(r geoip2.Response) interface{} { return str(r.Location.TimeZone) },
+	"asn":             func(r geoip2.Response) interface{} { return num(r.Traits.AutonomousSystemNumber) },
+	"as_org":          func(r geoip2.Response) interface{} { return str(r.Traits.AutonomousSystemOrganization) },
+	"isp":             func(r geoip2.Response) interface{} { return str(r.Traits.Isp) },
+	"user_type":       func(r geoip2.Response) interface{} { return str(r.Traits.UserType) },
+	"network":         network,
+}
+
+// DefaultFields are added when Options.Fields is empty
+var DefaultFields = []string{"country_iso", "city", "latitude", "longitude", "asn"}
+
+// ErrorField follows the selected fields, holding the error code of a failed
+// lookup
+const ErrorField = "geoip2_error"
+
+// chunkSize is the number of records looked up together, which bounds
+// memory use however large the input
+const chunkSize = 1024
+
+// Options configures Process
+type Options struct {
+	// Input is the format of the records, CSV by default.  The output is
+	// written in the same format.
+	Input Format
+	// Column names the CSV column or JSON key holding the address, by
+	// default the first CSV column or the "ip" key
+	Column string
+	// Fields are the names in Fields to add, DefaultFields when empty
+	Fields []string
+	// Concurrency is the number of lookups in flight at once, 4 by default
+	Concurrency int
+}
+
+// Stats summarizes a call to Process
+type Stats struct {
+	Records int // records written
+	Lookups int // distinct addresses looked up
+	Failed  int // records whose lookup failed, or that held no address
+}
+
+// Process reads records from r, looks up the address of each with lookup,
+// and writes them to w in input order with the selected fields added.  A
+// failed lookup is reported in its record's ErrorField rather than failing
+// the run.  An error is returned for unreadable input or unknown fields, or
+// when ctx is done, in which case the records already written are kept.
+func Process(ctx context.Context, lookup geoip2.LookupFunc, r io.Reader, w io.Writer, opts Options) (Stats, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	names := opts.Fields
+	if len(names) == 0 {
+		names = DefaultFields
+	}
+	fields := make([]Field, len(names))
+	for i, name := range names {
+		field, ok := Fields[name]
+		if !ok {
+			return Stats{}, fmt.Errorf("bulk: unknown field %q", name)
+		}
+		fields[i] = field
+	}
+	names = append(append([]string(nil), names...), ErrorField)
+
+	var c codec
+	switch opts.Input {
+	case CSV, "":
+		c = &csvCodec{reader: csv.NewReader(r), writer: csv.NewWriter(w), column: opts.Column}
+	case JSONL:
+		column := opts.Column
+		if column == "" {
+			column = "ip"
+		}
+		c = &jsonlCodec{scanner: bufio.NewScanner(r), writer: bufio.NewWriter(w), column: column}
+	default:
+		return Stats{}, fmt.Errorf("bulk: unknown format %q", opts.Input)
+	}
+
+	stats := Stats{}
+	err := c.start(names)
+	for err == nil {
+		var records []record
+		var ipAddresses []string
+		for len(records) < chunkSize {
+			var rec record
+			if rec, err = c.read(); err != nil {
+				break
+			}
+			records = append(records, rec)
+			if rec.ipAddress != "" {
+				ipAddresses = append(ipAddresses, rec.ipAddress)
+			}
+		}
+		if err != nil && err != io.EOF {
+			break
+		}
+
+		// an interrupted batch returns the lookups that completed; records
+		// are written up to the first that wasn't looked up
+		results, berr := geoip2.Batch(ctx, lookup, ipAddresses, opts.Concurrency)
+		looked := map[string]geoip2.Result{}
+		for _, result := range results {
+			if result.IpAddress != "" {
+				looked[result.IpAddress] = result
+			}
+		}
+		stats.Lookups += len(looked)
+
+		for _, rec := range records {
+			result, ok := looked[rec.ipAddress]
+			if !ok && rec.ipAddress != "" {
+				break
+			}
+
+			values := make([]interface{}, len(names))
+			switch {
+			case rec.ipAddress == "":
+				values[len(fields)] = geoip2.CodeIPAddressRequired
+			case result.Err != nil:
+				values[len(fields)] = errorCode(result.Err)
+			default:
+				for i, field := range fields {
+					values[i] = field(result.Response)
+				}
+			}
+			if values[len(fields)] != nil {
+				stats.Failed++
+			}
+			if werr := c.write(rec, values); werr != nil {
+				return stats, werr
+			}
+			stats.Records++
+		}
+		if berr != nil {
+			err = berr
+		}
+	}
+
+	if ferr := c.flush(); ferr != nil && (err == nil || err == io.EOF) {
+		err = ferr
+	}
+	if err == io.EOF {
+		err = nil
+	}
+	return stats, err
+}
+
+// errorCode prefers MaxMind's code, which is stable, to the message
+func errorCode(err error) string {
+	var e geoip2.Error
+	if errors.As(err, &e) && e.Code != "" {
+		return e.Code
+	}
+	return err.Error()
+}
+
+func str(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func num(n int) interface{} {
+	if n == 0 {
+		return nil
+	}
+	return n
+}
+
+// coordinate treats 0,0 as no location, as the web services omit both
+func coordinate(r geoip2.Response, v float64) interface{} {
+	if (r.Location.Latitude == 0 && r.Location.Longitude == 0) || math.IsNaN(v) {
+		return nil
+	}
+	return v
+}
+
+func subdivisionIso(r geoip2.Response) interface{} {
+	if len(r.Subdivisions) == 0 {
+		return nil
+	}
+	return str(r.Subdivisions[len(r.Subdivisions)-1].IsoCode)
+}
+
+func network(r geoip2.Response) interface{} {
+	if !r.Traits.Network.IsValid() {
+		return nil
+	}
+	return r.Traits.Network.String()
+}
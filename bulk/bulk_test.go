@@ -0,0 +1,102 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/savaki/geoip2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestProcess(t *testing.T) {
+	Convey("Given a lookup answering from a StaticLookuper", t, func() {
+		stub := geoip2.NewStaticLookuper()
+		stub.Add("1.2.3.0/24", geoip2.Response{
+			City:     geoip2.City{Names: map[string]string{"en": "Hayward"}},
+			Country:  geoip2.Country{IsoCode: "US"},
+			Location: geoip2.Location{Latitude: 37.6293, Longitude: -122.1163},
+			Traits:   geoip2.Traits{AutonomousSystemNumber: 64500},
+		})
+		var lookups int32
+		lookup := func(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+			atomic.AddInt32(&lookups, 1)
+			return stub.City(ctx, ipAddress)
+		}
+		process := func(input string, opts Options) (Stats, string, error) {
+			out := &bytes.Buffer{}
+			stats, err := Process(context.Background(), lookup, strings.NewReader(input), out, opts)
+			return stats, out.String(), err
+		}
+
+		Convey("When I process csv", func() {
+			stats, out, err := process(""+
+				"when,client_ip\n"+
+				"09:00,1.2.3.4\n"+
+				"09:01,8.8.8.8\n"+
+				"09:02,\n"+
+				"09:03,1.2.3.4\n", Options{Column: "client_ip"})
+
+			Convey("I expect every record enriched in order, with each address looked up once", func() {
+				So(err, ShouldBeNil)
+				So(out, ShouldEqual, ""+
+					"when,client_ip,country_iso,city,latitude,longitude,asn,geoip2_error\n"+
+					"09:00,1.2.3.4,US,Hayward,37.6293,-122.1163,64500,\n"+
+					"09:01,8.8.8.8,,,,,,IP_ADDRESS_NOT_FOUND\n"+
+					"09:02,,,,,,,IP_ADDRESS_REQUIRED\n"+
+					"09:03,1.2.3.4,US,Hayward,37.6293,-122.1163,64500,\n")
+				So(stats, ShouldResemble, Stats{Records: 4, Lookups: 2, Failed: 2})
+				So(lookups, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When I process json lines with selected fields", func() {
+			_, out, err := process(`{"ip":"1.2.3.4","path":"/"}`+"\n\n"+`{}`+"\n", Options{Input: JSONL, Fields: []string{"country_iso", "network"}})
+
+			Convey("I expect the fields appended to each object", func() {
+				So(err, ShouldBeNil)
+				So(out, ShouldEqual, ""+
+					`{"ip":"1.2.3.4","path":"/","country_iso":"US","network":"1.2.3.0/24","geoip2_error":null}`+"\n"+
+					`{"country_iso":null,"network":null,"geoip2_error":"IP_ADDRESS_REQUIRED"}`+"\n")
+			})
+		})
+
+		Convey("I expect bad input to be reported", func() {
+			_, _, err := process("a,b\n", Options{Column: "ip"})
+			So(err, ShouldNotBeNil)
+
+			_, _, err = process("ip\n", Options{Fields: []string{"nope"}})
+			So(err, ShouldNotBeNil)
+
+			_, _, err = process("[1]\n", Options{Input: JSONL})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("I expect a canceled run to keep no unlooked records", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			out := &bytes.Buffer{}
+			stats, err := Process(ctx, lookup, strings.NewReader("ip\n1.2.3.4\n"), out, Options{})
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+			So(stats.Records, ShouldEqual, 0)
+			So(out.String(), ShouldEqual, "ip,country_iso,city,latitude,longitude,asn,geoip2_error\n")
+		})
+	})
+}
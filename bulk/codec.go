@@ -0,0 +1,175 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package bulk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// record is one input record and the address it holds
+type record struct {
+	ipAddress string
+	row       []string // csv
+	line      []byte   // jsonl
+}
+
+type codec interface {
+	// start prepares to read records, and writes any header, given the
+	// names of the fields to add
+	start(names []string) error
+	read() (record, error)
+	write(rec record, values []interface{}) error
+	flush() error
+}
+
+type csvCodec struct {
+	reader *csv.Reader
+	writer *csv.Writer
+	column string
+	index  int
+}
+
+func (c *csvCodec) start(names []string) error {
+	header, err := c.reader.Read()
+	if err != nil {
+		return err
+	}
+	if c.column != "" {
+		c.index = -1
+		for i, name := range header {
+			if name == c.column {
+				c.index = i
+				break
+			}
+		}
+		if c.index < 0 {
+			return fmt.Errorf("bulk: no column %q in csv header", c.column)
+		}
+	}
+	return c.writer.Write(append(header[:len(header):len(header)], names...))
+}
+
+func (c *csvCodec) read() (record, error) {
+	row, err := c.reader.Read()
+	if err != nil {
+		return record{}, err
+	}
+	rec := record{row: row}
+	if c.index < len(row) {
+		rec.ipAddress = row[c.index]
+	}
+	return rec, nil
+}
+
+func (c *csvCodec) write(rec record, values []interface{}) error {
+	row := rec.row[:len(rec.row):len(rec.row)]
+	for _, value := range values {
+		row = append(row, formatCSV(value))
+	}
+	return c.writer.Write(row)
+}
+
+func (c *csvCodec) flush() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func formatCSV(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// jsonlCodec adds the fields to the end of each object as written, so the
+// original keys keep their order and formatting
+type jsonlCodec struct {
+	scanner *bufio.Scanner
+	writer  *bufio.Writer
+	column  string
+	names   []string
+	number  int
+}
+
+// maxLine bounds the length of a line, well beyond any log record
+const maxLine = 1 << 20
+
+func (c *jsonlCodec) start(names []string) error {
+	c.scanner.Buffer(nil, maxLine)
+	c.names = names
+	return nil
+}
+
+func (c *jsonlCodec) read() (record, error) {
+	for c.scanner.Scan() {
+		c.number++
+		line := bytes.TrimSpace(c.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var v map[string]json.RawMessage
+		if err := json.Unmarshal(line, &v); err != nil {
+			return record{}, fmt.Errorf("bulk: line %d: %w", c.number, err)
+		}
+		rec := record{line: append([]byte(nil), line...)}
+		var ipAddress string
+		if json.Unmarshal(v[c.column], &ipAddress) == nil {
+			rec.ipAddress = ipAddress
+		}
+		return rec, nil
+	}
+	if err := c.scanner.Err(); err != nil {
+		return record{}, err
+	}
+	return record{}, io.EOF
+}
+
+func (c *jsonlCodec) write(rec record, values []interface{}) error {
+	// the line is an object, so ends with a closing brace
+	line := bytes.TrimSuffix(rec.line, []byte("}"))
+	empty := len(bytes.TrimSpace(bytes.TrimPrefix(line, []byte("{")))) == 0
+	for i, name := range c.names {
+		key, _ := json.Marshal(name)
+		value, err := json.Marshal(values[i])
+		if err != nil {
+			return err
+		}
+		if !empty || i > 0 {
+			line = append(line, ',')
+		}
+		line = append(append(append(line, key...), ':'), value...)
+	}
+	line = append(line, '}', '\n')
+	_, err := c.writer.Write(line)
+	return err
+}
+
+func (c *jsonlCodec) flush() error {
+	return c.writer.Flush()
+}
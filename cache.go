@@ -0,0 +1,285 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net/netip"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores responses between lookups.  Keys combine the service and
+// address, e.g. "city/1.2.3.4", where the service may be qualified by the
+// settings that shaped the response and should be treated as opaque.
+// Implementations must be safe for concurrent use; a failing remote cache
+// should report a miss rather than fail the lookup.
+type Cache interface {
+	Get(ctx context.Context, key string) (Response, bool)
+	Set(ctx context.Context, key string, resp Response, ttl time.Duration)
+}
+
+// WithCache consults cache before each request and stores successful
+// responses for the duration chosen by RecommendedTTL
+func WithCache(cache Cache) Option {
+	return func(a *Api) {
+		a.cache = cache
+	}
+}
+
+// WithTTLPolicy replaces RecommendedTTL as the policy for cached responses.
+// A policy returning zero or less leaves the response uncached.
+func WithTTLPolicy(policy TTLPolicy) Option {
+	return func(a *Api) {
+		a.ttlPolicy = policy
+	}
+}
+
+func (a *Api) ttl(service string, resp Response) time.Duration {
+	if a.ttlPolicy != nil {
+		return a.ttlPolicy(service, resp)
+	}
+	return RecommendedTTL(service, resp)
+}
+
+// fromCache marks resp as served from the cache to a client preferring
+// locales
+func fromCache(resp Response, started time.Time, locales []string) Response {
+	meta := resp.Meta()
+	meta.Cached = true
+	meta.Billable = false
+	meta.Locales = locales
+	meta.Retries = 0
+	meta.Latency = time.Since(started)
+	resp.meta = &meta
+	return resp
+}
+
+// LRUCache is an in-memory Cache that evicts the least recently used entry
+// once it holds size entries.  Responses that carry a network are indexed
+// by it, so a response cached for 1.2.3.4 in 1.2.3.0/24 also answers a
+// later lookup for 1.2.3.7.
+type LRUCache struct {
+	lru *prefixLRU[Response]
+}
+
+var _ Cache = (*LRUCache)(nil)
+
+// NewLRUCache returns an LRUCache holding up to size responses
+func NewLRUCache(size int) *LRUCache {
+	if size <= 0 {
+		size = 10000
+	}
+	return &LRUCache{lru: newPrefixLRU[Response](size)}
+}
+
+// cacheKey is the key under which a lookup is cached.  The answer depends
+// on the account, endpoint and sections as well as the address, so when any
+// is set the service is qualified by a digest of them, as in
+// "city-5d9e2c1b7a3f4e60/1.2.3.4", and Apis sharing a cache, such as a
+// clone WithSections and its parent, only answer for each other when they
+// would have received the same response.  Locales are left out: responses
+// carry names in every locale, and fromCache applies the reader's own.
+func (a *Api) cacheKey(service, ipAddress string) string {
+	settings := []string{a.baseURL, a.userId, strings.Join(a.sections, ",")}
+	if strings.Join(settings, "") != "" {
+		h := fnv.New64a()
+		for _, v := range settings {
+			h.Write([]byte(v))
+			h.Write([]byte{0})
+		}
+		service = fmt.Sprintf("%s-%016x", service, h.Sum64())
+	}
+	return service + "/" + ipAddress
+}
+
+// splitKey separates a key such as "city/1.2.3.4" into service and address
+func splitKey(key string) (string, netip.Addr, bool) {
+	i := strings.IndexByte(key, '/')
+	if i < 0 {
+		return "", netip.Addr{}, false
+	}
+	addr, err := netip.ParseAddr(key[i+1:])
+	if err != nil {
+		return "", netip.Addr{}, false
+	}
+	return key[:i], addr.Unmap(), true
+}
+
+func (c *LRUCache) Get(ctx context.Context, key string) (Response, bool) {
+	if resp, ok := c.lru.get(key); ok {
+		return resp, true
+	}
+	service, addr, ok := splitKey(key)
+	if !ok {
+		return Response{}, false
+	}
+	resp, ok := c.lru.lookup(service, addr)
+	if ok {
+		resp.Traits.IpAddress = addr
+	}
+	return resp, ok
+}
+
+func (c *LRUCache) Set(ctx context.Context, key string, resp Response, ttl time.Duration) {
+	if service, _, ok := splitKey(key); ok && resp.Traits.Network.IsValid() {
+		c.lru.setPrefix(service, resp.Traits.Network, resp, ttl)
+		return
+	}
+	c.lru.set(key, resp, ttl)
+}
+
+// Len returns the number of cached responses, including expired ones not
+// yet evicted
+func (c *LRUCache) Len() int {
+	return c.lru.len()
+}
+
+// prefixLRU is a least recently used cache whose entries are keyed either
+// exactly or by a network within a namespace, e.g. a service.  Networks
+// are found for an address by trying each prefix length in use, longest
+// first.
+type prefixLRU[V any] struct {
+	size int
+
+	mutex   sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+	lengths map[string]map[int]int // namespace -> prefix length -> entries
+}
+
+type lruEntry[V any] struct {
+	key       string
+	namespace string
+	bits      int
+	value     V
+	expires   time.Time
+}
+
+func newPrefixLRU[V any](size int) *prefixLRU[V] {
+	return &prefixLRU[V]{
+		size:    size,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+		lengths: map[string]map[int]int{},
+	}
+}
+
+// get returns the entry stored with set under key
+func (c *prefixLRU[V]) get(key string) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.entries[key]; ok {
+		return c.hit(element)
+	}
+	var zero V
+	return zero, false
+}
+
+// lookup returns the entry for the longest network in namespace that
+// contains addr
+func (c *prefixLRU[V]) lookup(namespace string, addr netip.Addr) (V, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	bits := make([]int, 0, len(c.lengths[namespace]))
+	for n := range c.lengths[namespace] {
+		bits = append(bits, n)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(bits)))
+
+	for _, n := range bits {
+		prefix, err := addr.Prefix(n)
+		if err != nil {
+			continue
+		}
+		if element, ok := c.entries[namespace+"/"+prefix.String()]; ok {
+			if value, ok := c.hit(element); ok {
+				return value, true
+			}
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+func (c *prefixLRU[V]) hit(element *list.Element) (V, bool) {
+	entry := element.Value.(*lruEntry[V])
+	if time.Now().After(entry.expires) {
+		c.remove(element)
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(element)
+	return entry.value, true
+}
+
+func (c *prefixLRU[V]) set(key string, value V, ttl time.Duration) {
+	c.store(&lruEntry[V]{key: key, bits: -1, value: value, expires: time.Now().Add(ttl)})
+}
+
+func (c *prefixLRU[V]) setPrefix(namespace string, network netip.Prefix, value V, ttl time.Duration) {
+	network = network.Masked()
+	c.store(&lruEntry[V]{
+		key:       namespace + "/" + network.String(),
+		namespace: namespace,
+		bits:      network.Bits(),
+		value:     value,
+		expires:   time.Now().Add(ttl),
+	})
+}
+
+func (c *prefixLRU[V]) store(entry *lruEntry[V]) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.entries[entry.key]; ok {
+		c.remove(element)
+	}
+	c.entries[entry.key] = c.order.PushFront(entry)
+	if entry.bits >= 0 {
+		if c.lengths[entry.namespace] == nil {
+			c.lengths[entry.namespace] = map[int]int{}
+		}
+		c.lengths[entry.namespace][entry.bits]++
+	}
+
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *prefixLRU[V]) len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.order.Len()
+}
+
+func (c *prefixLRU[V]) remove(element *list.Element) {
+	entry := element.Value.(*lruEntry[V])
+	c.order.Remove(element)
+	delete(c.entries, entry.key)
+	if entry.bits >= 0 {
+		if c.lengths[entry.namespace][entry.bits]--; c.lengths[entry.namespace][entry.bits] == 0 {
+			delete(c.lengths[entry.namespace], entry.bits)
+		}
+	}
+}
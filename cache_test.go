@@ -0,0 +1,157 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCache(t *testing.T) {
+	Convey("Given a cached Api", t, func() {
+		calls := 0
+		cache := NewLRUCache(2)
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		}).Clone(WithCache(cache))
+
+		Convey("When I look up the same address twice", func() {
+			first, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			second, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			Convey("I expect one request and a cached second answer", func() {
+				So(calls, ShouldEqual, 1)
+				So(first.Meta().Cached, ShouldBeFalse)
+				So(second.Meta().Cached, ShouldBeTrue)
+				So(second.Meta().Billable, ShouldBeFalse)
+				So(second.Meta().StatusCode, ShouldEqual, 200)
+				So(second.City, ShouldResemble, first.City)
+				So(Enrich(nil, "1.2.3.4", second).Provenance.Cached, ShouldBeTrue)
+			})
+
+			Convey("I expect the answer to cover its whole network", func() {
+				neighbour, err := api.City(nil, "1.2.3.7")
+				So(err, ShouldBeNil)
+				So(calls, ShouldEqual, 1)
+				So(neighbour.Meta().Cached, ShouldBeTrue)
+				So(neighbour.Traits.IpAddress.String(), ShouldEqual, "1.2.3.7")
+
+				api.City(nil, "1.2.4.1")
+				So(calls, ShouldEqual, 2)
+			})
+
+			Convey("I expect other services to be cached separately", func() {
+				api.Country(nil, "1.2.3.4")
+				So(calls, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When a slim clone shares the cache with the full Api", func() {
+			slim := api.Clone(WithSections("country"))
+			full, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			country, err := slim.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			Convey("I expect neither to answer with the other's response", func() {
+				So(calls, ShouldEqual, 2)
+				So(country.Meta().Cached, ShouldBeFalse)
+				So(country.City.Names, ShouldBeEmpty)
+				So(country.Country.IsoCode, ShouldEqual, full.Country.IsoCode)
+
+				again, _ := api.City(nil, "1.2.3.4")
+				So(again.Meta().Cached, ShouldBeTrue)
+				So(again.City, ShouldResemble, full.City)
+				again, _ = slim.City(nil, "1.2.3.4")
+				So(again.Meta().Cached, ShouldBeTrue)
+				So(again.City.Names, ShouldBeEmpty)
+				So(calls, ShouldEqual, 2)
+			})
+
+			Convey("I expect accounts and endpoints to be kept apart too, but not locales", func() {
+				So(api.Clone(WithCredentials("other-user-id", "blah-license-key")).cacheKey("city", "1.2.3.4"), ShouldNotEqual, api.cacheKey("city", "1.2.3.4"))
+				So(api.Clone(WithBaseURL("https://example.com/")).cacheKey("city", "1.2.3.4"), ShouldNotEqual, api.cacheKey("city", "1.2.3.4"))
+				So(api.Clone(WithLocales("fr")).cacheKey("city", "1.2.3.4"), ShouldEqual, api.cacheKey("city", "1.2.3.4"))
+			})
+		})
+
+		Convey("When the TTL policy declines to cache", func() {
+			uncached := api.Clone(WithTTLPolicy(func(string, Response) time.Duration { return 0 }))
+			uncached.City(nil, "1.2.3.4")
+			uncached.City(nil, "1.2.3.4")
+
+			Convey("I expect every lookup to be sent", func() {
+				So(calls, ShouldEqual, 2)
+				So(cache.Len(), ShouldEqual, 0)
+			})
+		})
+	})
+
+	Convey("Given an LRUCache", t, func() {
+		cache := NewLRUCache(2)
+		resp := Response{Country: Country{IsoCode: "US"}}
+
+		Convey("I expect the least recently used entry to be evicted", func() {
+			cache.Set(nil, "a", resp, time.Hour)
+			cache.Set(nil, "b", resp, time.Hour)
+			cache.Get(nil, "a")
+			cache.Set(nil, "c", resp, time.Hour)
+
+			_, ok := cache.Get(nil, "b")
+			So(ok, ShouldBeFalse)
+			_, ok = cache.Get(nil, "a")
+			So(ok, ShouldBeTrue)
+			So(cache.Len(), ShouldEqual, 2)
+		})
+
+		Convey("I expect the most specific network to answer", func() {
+			wide := Response{Country: Country{IsoCode: "US"}, Traits: Traits{Network: netip.MustParsePrefix("10.0.0.0/8")}}
+			narrow := Response{Country: Country{IsoCode: "CA"}, Traits: Traits{Network: netip.MustParsePrefix("10.1.2.0/24")}}
+			cache.Set(nil, "city/10.9.9.9", wide, time.Hour)
+			cache.Set(nil, "city/10.1.2.3", narrow, time.Hour)
+
+			v, ok := cache.Get(nil, "city/10.1.2.200")
+			So(ok, ShouldBeTrue)
+			So(v.Country.IsoCode, ShouldEqual, "CA")
+
+			v, ok = cache.Get(nil, "city/10.200.0.1")
+			So(ok, ShouldBeTrue)
+			So(v.Country.IsoCode, ShouldEqual, "US")
+
+			_, ok = cache.Get(nil, "country/10.1.2.3")
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("I expect expired entries to miss", func() {
+			cache.Set(nil, "a", resp, -time.Second)
+			_, ok := cache.Get(nil, "a")
+			So(ok, ShouldBeFalse)
+			So(cache.Len(), ShouldEqual, 0)
+		})
+	})
+}
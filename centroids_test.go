@@ -0,0 +1,109 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCentroids(t *testing.T) {
+	Convey("Given a response without coordinates", t, func() {
+		resp := Response{Country: Country{IsoCode: "DE"}}
+
+		Convey("I expect the country centroid as a fallback", func() {
+			located, ok := resp.WithCentroidFallback()
+			So(ok, ShouldBeTrue)
+			So(located.Location.Latitude, ShouldAlmostEqual, 51.165691)
+			So(located.Location.Longitude, ShouldAlmostEqual, 10.451526)
+			So(located.Location.AccuracyRadius, ShouldEqual, CentroidAccuracyRadius)
+			So(resp.Location.Latitude, ShouldEqual, 0)
+		})
+
+		Convey("I expect distances measured from the centroid", func() {
+			So(resp.DistanceTo(51.165691, 10.451526), ShouldAlmostEqual, 0)
+			So(resp.MayBeWithinKm(52.52, 13.405, 10), ShouldBeTrue)     // Berlin
+			So(resp.MayBeWithinKm(40.4168, -3.7038, 10), ShouldBeFalse) // Madrid
+			So(math.IsNaN(Response{}.DistanceTo(52.52, 13.405)), ShouldBeTrue)
+		})
+
+		Convey("I expect the registered country when the country is unknown", func() {
+			resp = Response{RegisteredCountry: RegisteredCountry{IsoCode: "jp"}}
+			located, ok := resp.WithCentroidFallback()
+			So(ok, ShouldBeTrue)
+			So(located.Location.Latitude, ShouldAlmostEqual, 36.204824)
+		})
+
+		Convey("I expect real coordinates to be left alone", func() {
+			resp.Location = Location{Latitude: 48.1, Longitude: 11.6}
+			located, ok := resp.WithCentroidFallback()
+			So(ok, ShouldBeFalse)
+			So(located.Location, ShouldResemble, resp.Location)
+		})
+
+		Convey("I expect no fallback without a known country", func() {
+			_, ok := Response{}.WithCentroidFallback()
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
+
+func TestWithCentroidFallback(t *testing.T) {
+	Convey("Given an Api with the centroid fallback and a cache", t, func() {
+		body := `{"country":{"iso_code":"DE"},"location":{"time_zone":"Europe/Berlin"}}`
+		plain := WithClientFunc(New("blah-user-id", "blah-license-key"), func(context.Context, *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		}).Clone(WithCache(NewLRUCache(10)))
+		api := plain.Clone(WithCentroidFallback())
+
+		Convey("I expect answers without coordinates placed at the centroid, and flagged", func() {
+			resp, err := api.City(context.Background(), "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.Location.Latitude, ShouldAlmostEqual, 51.165691)
+			So(resp.Location.AccuracyRadius, ShouldEqual, CentroidAccuracyRadius)
+			So(resp.Meta().Centroid, ShouldBeTrue)
+			So(resp.Location.DistanceTo(52.52, 13.405), ShouldBeLessThan, 300)
+		})
+
+		Convey("I expect the cache kept as MaxMind answered", func() {
+			_, err := api.City(context.Background(), "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			resp, err := plain.City(context.Background(), "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.Meta().Cached, ShouldBeTrue)
+			So(resp.Meta().Centroid, ShouldBeFalse)
+			So(resp.Location.Latitude, ShouldEqual, 0)
+		})
+
+		Convey("I expect real coordinates left unflagged", func() {
+			body = `{"country":{"iso_code":"DE"},"location":{"latitude":48.1,"longitude":11.6,"accuracy_radius":20}}`
+			resp, err := api.City(context.Background(), "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.Location.Latitude, ShouldEqual, 48.1)
+			So(resp.Location.AccuracyRadius, ShouldEqual, 20)
+			So(resp.Meta().Centroid, ShouldBeFalse)
+		})
+	})
+}
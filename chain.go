@@ -0,0 +1,92 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+)
+
+// Chain is a Lookuper that falls back to the next Lookuper when one fails,
+// e.g. from the web service to a local database to a StaticResponse
+type Chain struct {
+	lookupers []Lookuper
+}
+
+var _ Lookuper = (*Chain)(nil)
+
+// NewChain returns a Chain that tries primary and then each fallback in turn
+func NewChain(primary Lookuper, fallbacks ...Lookuper) *Chain {
+	return &Chain{lookupers: append([]Lookuper{primary}, fallbacks...)}
+}
+
+func (c *Chain) Country(ctx context.Context, ipAddress string) (Response, error) {
+	return c.lookup(ctx, ipAddress, Lookuper.Country)
+}
+
+func (c *Chain) City(ctx context.Context, ipAddress string) (Response, error) {
+	return c.lookup(ctx, ipAddress, Lookuper.City)
+}
+
+func (c *Chain) Insights(ctx context.Context, ipAddress string) (Response, error) {
+	return c.lookup(ctx, ipAddress, Lookuper.Insights)
+}
+
+// lookup stops early when a failure would recur at every source: an invalid
+// address, or a caller that has given up
+func (c *Chain) lookup(ctx context.Context, ipAddress string, fn func(Lookuper, context.Context, string) (Response, error)) (Response, error) {
+	var errs []error
+	for _, lookuper := range c.lookupers {
+		resp, err := fn(lookuper, ctx, ipAddress)
+		if err == nil {
+			return resp, nil
+		}
+		errs = append(errs, err)
+
+		if invalidAddress(err) || (ctx != nil && ctx.Err() != nil) {
+			break
+		}
+	}
+	if len(errs) == 1 {
+		return Response{}, errs[0]
+	}
+	return Response{}, errors.Join(errs...)
+}
+
+func invalidAddress(err error) bool {
+	return errors.Is(err, ErrIPAddressInvalid) || errors.Is(err, ErrIPAddressRequired)
+}
+
+// StaticResponse returns a Lookuper that always answers with resp, for the
+// end of a Chain
+func StaticResponse(resp Response) Lookuper {
+	return staticResponse{resp: resp}
+}
+
+type staticResponse struct {
+	resp Response
+}
+
+func (s staticResponse) Country(ctx context.Context, ipAddress string) (Response, error) {
+	return s.resp, nil
+}
+
+func (s staticResponse) City(ctx context.Context, ipAddress string) (Response, error) {
+	return s.resp, nil
+}
+
+func (s staticResponse) Insights(ctx context.Context, ipAddress string) (Response, error) {
+	return s.resp, nil
+}
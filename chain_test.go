@@ -0,0 +1,90 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestChain(t *testing.T) {
+	Convey("Given a web service that is unavailable", t, func() {
+		calls := 0
+		unavailable := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: 503,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"code":"SERVICE_UNAVAILABLE","error":"try again"}`)),
+			}, nil
+		})
+		fallback := Response{Country: Country{IsoCode: "ZZ"}}
+
+		Convey("When it is chained to a static response", func() {
+			chain := NewChain(unavailable, StaticResponse(fallback))
+
+			Convey("I expect the fallback to answer", func() {
+				resp, err := chain.City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+				So(resp.Country.IsoCode, ShouldEqual, "ZZ")
+				So(calls, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When every source fails", func() {
+			other := errors.New("database missing")
+			failing := NewChain(WithClientFunc(unavailable, func(context.Context, *http.Request) (*http.Response, error) {
+				return nil, other
+			}), unavailable)
+
+			Convey("I expect every error to be reported", func() {
+				_, err := failing.Country(nil, "1.2.3.4")
+				So(errors.Is(err, other), ShouldBeTrue)
+				var v Error
+				So(errors.As(err, &v), ShouldBeTrue)
+				So(v.Code, ShouldEqual, "SERVICE_UNAVAILABLE")
+			})
+		})
+
+		Convey("When the address is invalid", func() {
+			invalid := WithClientFunc(unavailable, func(context.Context, *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 400,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"code":"IP_ADDRESS_INVALID","error":"bad"}`)),
+				}, nil
+			})
+
+			Convey("I expect no fallback", func() {
+				_, err := NewChain(invalid, StaticResponse(fallback)).Insights(nil, "nope")
+				So(err.(Error).Code, ShouldEqual, "IP_ADDRESS_INVALID")
+			})
+		})
+
+		Convey("When the caller cancels", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			Convey("I expect no fallback", func() {
+				_, err := NewChain(unavailable, StaticResponse(fallback)).City(ctx, "1.2.3.4")
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
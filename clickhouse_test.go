@@ -0,0 +1,106 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClickHouseSink(t *testing.T) {
+	Convey("Given a ClickHouse server that records queries", t, func() {
+		var queries, bodies []string
+		var user, password string
+		status := http.StatusOK
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			data, _ := ioutil.ReadAll(req.Body)
+			queries = append(queries, req.URL.Query().Get("query"))
+			bodies = append(bodies, string(data))
+			user, password, _ = req.BasicAuth()
+			w.WriteHeader(status)
+			w.Write([]byte("Code: 60. DB::Exception: Table doesn't exist\n"))
+		}))
+		defer server.Close()
+
+		endpoint := strings.Replace(server.URL, "http://", "http://writer:s3cret@", 1)
+		sink, err := NewClickHouseSink(nil, endpoint, "geo.lookups", 2)
+		So(err, ShouldBeNil)
+
+		results := func(yield func(string, Result) bool) {
+			for _, result := range []Result{
+				{IpAddress: "1.2.3.4", Response: Response{Country: Country{IsoCode: "US"}, Location: Location{Latitude: 37.75, Longitude: -122.4}}},
+				{IpAddress: "5.6.7.8", Err: errors.New("boom")},
+				{IpAddress: "9.9.9.9", Response: Response{City: City{Names: map[string]string{"en": "Berkeley"}}}},
+			} {
+				if !yield(result.IpAddress, result) {
+					return
+				}
+			}
+		}
+
+		Convey("When I create the table", func() {
+			So(sink.CreateTable(nil), ShouldBeNil)
+
+			Convey("I expect an idempotent DDL statement with credentials as basic auth", func() {
+				So(queries[0], ShouldStartWith, "CREATE TABLE IF NOT EXISTS geo.lookups (")
+				So(user, ShouldEqual, "writer")
+				So(password, ShouldEqual, "s3cret")
+			})
+		})
+
+		Convey("When I write results", func() {
+			n, err := sink.Write(nil, results)
+			So(err, ShouldBeNil)
+
+			Convey("I expect them to be inserted in batches as JSON rows", func() {
+				So(n, ShouldEqual, 3)
+				So(queries, ShouldResemble, []string{
+					"INSERT INTO geo.lookups FORMAT JSONEachRow",
+					"INSERT INTO geo.lookups FORMAT JSONEachRow",
+				})
+				So(strings.Count(bodies[0], "\n"), ShouldEqual, 2)
+				So(bodies[0], ShouldContainSubstring, `"country_iso_code":"US"`)
+				So(bodies[0], ShouldContainSubstring, `"latitude":37.75`)
+				So(bodies[0], ShouldContainSubstring, `"error":"boom"`)
+				So(bodies[1], ShouldContainSubstring, `"city":"Berkeley","latitude":null`)
+			})
+		})
+
+		Convey("When the server rejects an insert", func() {
+			status = http.StatusNotFound
+			n, err := sink.Write(nil, results)
+
+			Convey("I expect the server message without the password", func() {
+				So(n, ShouldEqual, 0)
+				So(err.Error(), ShouldContainSubstring, "DB::Exception")
+				So(err.Error(), ShouldNotContainSubstring, "s3cret")
+			})
+		})
+	})
+
+	Convey("Given an unsafe table name", t, func() {
+		_, err := NewClickHouseSink(nil, "http://localhost:8123", "t; DROP TABLE x", 0)
+
+		Convey("I expect it to be rejected", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
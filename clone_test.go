@@ -0,0 +1,79 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClone(t *testing.T) {
+	Convey("Given an Api whose transport honours cancellation", t, func() {
+		calls := 0
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			calls++
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-time.After(50 * time.Millisecond):
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(strings.NewReader(sample)),
+				}, nil
+			}
+		})
+
+		Convey("When I clone it with a shorter timeout", func() {
+			fast := api.Clone(WithTimeout(5 * time.Millisecond))
+
+			Convey("I expect the clone to time out using the shared transport", func() {
+				_, err := fast.City(nil, "1.2.3.4")
+				So(err, ShouldEqual, context.DeadlineExceeded)
+				So(calls, ShouldEqual, 1)
+			})
+
+			Convey("I expect the original to be unaffected", func() {
+				_, err := api.City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When I clone it with other credentials", func() {
+			other := api.Clone(WithCredentials("other-user-id", "other-license-key"))
+
+			Convey("I expect only the clone to change", func() {
+				So(other.userId, ShouldEqual, "other-user-id")
+				So(api.userId, ShouldEqual, "blah-user-id")
+			})
+		})
+
+		Convey("When I format it", func() {
+			Convey("I expect the license key never to be printed", func() {
+				for _, format := range []string{"%v", "%+v", "%#v", "%s"} {
+					So(fmt.Sprintf(format, api), ShouldNotContainSubstring, "blah-license-key")
+					So(fmt.Sprintf(format, *api), ShouldNotContainSubstring, "blah-license-key")
+				}
+				So(api.String(), ShouldEqual, `geoip2.Api{userId: "blah-user-id", licenseKey: [REDACTED]}`)
+			})
+		})
+	})
+}
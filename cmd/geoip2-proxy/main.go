@@ -0,0 +1,191 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Command geoip2-proxy serves the GeoIP2 web service paths to internal
+// clients, forwarding to MaxMind with one shared cache, rate limit and
+// quota guard.
+//
+// MaxMind credentials are read from MAXMIND_USER_ID and MAXMIND_LICENSE_KEY.
+// Internal clients are listed in the accounts file, one account ID and
+// license key per line separated by a space; # begins a comment.
+//
+//	geoip2-proxy -addr :8080 -accounts /etc/geoip2-proxy/accounts -rate 100 -reserve 1000
+//
+// With -bulk, clients may also POST a JSON array of addresses and networks
+// to /bulk and read the results back as lines of JSON.
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/netip"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/savaki/geoip2"
+	"github.com/savaki/geoip2/geoip2proxy"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("geoip2-proxy", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	accountsFile := fs.String("accounts", "", "file of internal account IDs and license keys")
+	baseURL := fs.String("base-url", "", "web service root to forward to (default "+geoip2.DefaultBaseURL+")")
+	cacheSize := fs.Int("cache-size", 100000, "responses to cache in memory")
+	cacheDir := fs.String("cache", "", "directory in which to cache responses instead of memory")
+	rate := fs.Int("rate", 0, "maximum lookups per second sent to MaxMind, 0 for no limit")
+	reserve := fs.Int("reserve", -1, "stop forwarding once this many queries remain, -1 to never stop")
+	timeout := fs.Duration("timeout", 10*time.Second, "timeout for each lookup")
+	trusted := fs.String("trusted-proxies", "", "comma separated networks whose X-Forwarded-For is trusted")
+	bulk := fs.Int("bulk", 0, "addresses a client may look up in one POST /bulk request, 0 to disable it")
+	bulkConcurrency := fs.Int("bulk-concurrency", 4, "lookups in flight for each bulk request")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	logger := log.New(stderr, "geoip2-proxy: ", log.LstdFlags)
+
+	userId, licenseKey := os.Getenv("MAXMIND_USER_ID"), os.Getenv("MAXMIND_LICENSE_KEY")
+	if userId == "" || licenseKey == "" {
+		fmt.Fprintln(stderr, "geoip2-proxy: MAXMIND_USER_ID and MAXMIND_LICENSE_KEY must be set")
+		return 2
+	}
+	if *accountsFile == "" {
+		fmt.Fprintln(stderr, "geoip2-proxy: -accounts must be set")
+		return 2
+	}
+	accounts, err := readAccounts(*accountsFile)
+	if err != nil {
+		fmt.Fprintln(stderr, "geoip2-proxy:", err)
+		return 1
+	}
+
+	var cache geoip2.Cache = geoip2.NewLRUCache(*cacheSize)
+	if *cacheDir != "" {
+		if cache, err = geoip2.NewDiskCache(*cacheDir, *cacheSize); err != nil {
+			fmt.Fprintln(stderr, "geoip2-proxy:", err)
+			return 1
+		}
+	}
+	opts := []geoip2.Option{
+		geoip2.WithTimeout(*timeout),
+		geoip2.WithUserAgent("geoip2-proxy"),
+		geoip2.WithCache(cache),
+		geoip2.WithSingleflight(),
+	}
+	if *baseURL != "" {
+		opts = append(opts, geoip2.WithBaseURL(*baseURL))
+	}
+	if *rate > 0 {
+		opts = append(opts, geoip2.WithRateLimit(*rate, time.Second))
+	}
+	if *reserve >= 0 {
+		opts = append(opts, geoip2.WithQuotaGuard(&geoip2.QuotaGuard{
+			Threshold: *reserve * 2,
+			OnLow:     func(remaining int) { logger.Printf("%d queries remaining", remaining) },
+			HardStop:  true,
+			Reserve:   *reserve,
+		}))
+	}
+
+	proxyOpts := []geoip2proxy.Option{
+		geoip2proxy.WithAccounts(accounts),
+		geoip2proxy.WithErrorHandler(func(req *http.Request, err error) {
+			logger.Printf("%s: %v", req.URL.Path, geoip2.RedactIPs(err.Error()))
+		}),
+	}
+	if *trusted != "" {
+		var prefixes []netip.Prefix
+		for _, s := range strings.Split(*trusted, ",") {
+			prefix, err := netip.ParsePrefix(strings.TrimSpace(s))
+			if err != nil {
+				fmt.Fprintln(stderr, "geoip2-proxy:", err)
+				return 2
+			}
+			prefixes = append(prefixes, prefix)
+		}
+		proxyOpts = append(proxyOpts, geoip2proxy.WithTrustedProxies(prefixes...))
+	}
+	if *bulk > 0 {
+		proxyOpts = append(proxyOpts, geoip2proxy.WithBulk(*bulk, *bulkConcurrency))
+	}
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           geoip2proxy.New(geoip2.New(userId, licenseKey, opts...), proxyOpts...),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		server.Shutdown(shutdown)
+	}()
+
+	logger.Printf("serving %d accounts on %s", len(accounts), *addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintln(stderr, "geoip2-proxy:", err)
+		return 1
+	}
+	return 0
+}
+
+// readAccounts reads account IDs and license keys, one pair per line
+func readAccounts(filename string) (map[string]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	accounts := map[string]string{}
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.Index(text, "#"); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		switch len(fields) {
+		case 0:
+			continue
+		case 2:
+			accounts[fields[0]] = fields[1]
+		default:
+			return nil, fmt.Errorf("%s:%d: expected an account ID and license key", filename, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("%s: no accounts", filename)
+	}
+	return accounts, nil
+}
@@ -0,0 +1,64 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReadAccounts(t *testing.T) {
+	Convey("Given an accounts file", t, func() {
+		filename := filepath.Join(t.TempDir(), "accounts")
+
+		Convey("I expect each account ID and license key to be read", func() {
+			os.WriteFile(filename, []byte("# internal clients\nbilling s3cret\n\nsearch  hunter2 # rotated monthly\n"), 0o600)
+			accounts, err := readAccounts(filename)
+			So(err, ShouldBeNil)
+			So(accounts, ShouldResemble, map[string]string{"billing": "s3cret", "search": "hunter2"})
+		})
+
+		Convey("I expect malformed or empty files to be rejected", func() {
+			os.WriteFile(filename, []byte("billing\n"), 0o600)
+			_, err := readAccounts(filename)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, ":1:")
+
+			os.WriteFile(filename, []byte("# nobody\n"), 0o600)
+			_, err = readAccounts(filename)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Setenv("MAXMIND_USER_ID", "blah-user-id")
+	t.Setenv("MAXMIND_LICENSE_KEY", "blah-license-key")
+
+	Convey("Given no accounts file", t, func() {
+		stderr := &bytes.Buffer{}
+		status := run(context.Background(), nil, stderr)
+
+		Convey("I expect a usage error", func() {
+			So(status, ShouldEqual, 2)
+			So(stderr.String(), ShouldContainSubstring, "-accounts")
+		})
+	})
+}
@@ -0,0 +1,92 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/savaki/geoip2"
+	"github.com/savaki/geoip2/bulk"
+)
+
+// runBulk enriches CSV or JSON Lines records, from the file named or stdin,
+// with the fields selected
+//
+//	geoip2 bulk -column client_ip -fields country_iso,city,asn access.csv > enriched.csv
+func runBulk(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("geoip2 bulk", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("in", "csv", "record format: csv or jsonl")
+	column := fs.String("column", "", "csv column or json key holding the address (default the first column, or \"ip\")")
+	fields := fs.String("fields", strings.Join(bulk.DefaultFields, ","), "comma separated fields to add")
+	concurrency := fs.Int("concurrency", 4, "lookups in flight at once")
+	rate := fs.Int("rate", 0, "maximum lookups per second, 0 for no limit")
+	client := clientFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	var extra []geoip2.Option
+	if *rate > 0 {
+		extra = append(extra, geoip2.WithRateLimit(*rate, time.Second))
+	}
+	if *client.cacheDir == "" {
+		// access logs repeat addresses, so remember them for the run
+		extra = append(extra, geoip2.WithCache(geoip2.NewLRUCache(100000)))
+	}
+	lookup, status := client.lookup(stderr, extra...)
+	if lookup == nil {
+		return status
+	}
+
+	opts := bulk.Options{
+		Input:       bulk.Format(*format),
+		Column:      *column,
+		Fields:      strings.Split(*fields, ","),
+		Concurrency: *concurrency,
+	}
+	input := stdin
+	switch fs.NArg() {
+	case 0:
+	case 1:
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			fmt.Fprintln(stderr, "geoip2:", err)
+			return 1
+		}
+		defer f.Close()
+		input = f
+	default:
+		fmt.Fprintln(stderr, "geoip2: bulk reads a single file")
+		return 2
+	}
+
+	stats, err := bulk.Process(ctx, lookup, input, stdout, opts)
+	fmt.Fprintf(stderr, "geoip2: %d records, %d lookups, %d failed\n", stats.Records, stats.Lookups, stats.Failed)
+	if err != nil {
+		fmt.Fprintln(stderr, "geoip2:", err)
+		return 1
+	}
+	if stats.Failed > 0 {
+		return 1
+	}
+	return 0
+}
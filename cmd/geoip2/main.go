@@ -0,0 +1,285 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Command geoip2 looks up addresses with the GeoIP2 web services.
+//
+// Credentials are read from MAXMIND_USER_ID and MAXMIND_LICENSE_KEY.
+// Addresses are taken from the arguments or, when there are none, one per
+// line from stdin.
+//
+//	geoip2 -endpoint insights -format table 1.2.3.4 2001:db8::1
+//	cut -d, -f1 access.csv | geoip2 -format csv > located.csv
+//	geoip2 -format template -template report.tmpl 1.2.3.4
+//
+// Templates are rendered once with the slice of results, each with its
+// IpAddress and either a Response or an Err, using the same Renderer as
+// the package; files ending in .html are escaped as HTML.
+//
+// The bulk subcommand instead enriches each record of a CSV or JSON Lines
+// file, keeping its columns.
+//
+//	geoip2 bulk -in jsonl -column remote_addr -fields country_iso,asn access.jsonl
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/savaki/geoip2"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) > 0 && args[0] == "bulk" {
+		return runBulk(ctx, args[1:], stdin, stdout, stderr)
+	}
+
+	fs := flag.NewFlagSet("geoip2", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "json", "output format: json, table, csv, geojson or template")
+	templatePath := fs.String("template", "", "template file for -format template")
+	concurrency := fs.Int("concurrency", 4, "lookups in flight at once")
+	client := clientFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	lookup, status := client.lookup(stderr)
+	if lookup == nil {
+		return status
+	}
+
+	var write func(io.Writer, []geoip2.Result) error
+	switch *format {
+	case "json":
+		write = writeJSON
+	case "table":
+		write = writeTable
+	case "csv":
+		write = writeCSV
+	case "geojson":
+		write = writeGeoJSON
+	case "template":
+		if *templatePath == "" {
+			fmt.Fprintln(stderr, "geoip2: -format template requires -template")
+			return 2
+		}
+		var err error
+		if write, err = templateWriter(*templatePath); err != nil {
+			fmt.Fprintln(stderr, "geoip2:", err)
+			return 2
+		}
+	default:
+		fmt.Fprintf(stderr, "geoip2: unknown format %q\n", *format)
+		return 2
+	}
+
+	ipAddresses := fs.Args()
+	if len(ipAddresses) == 0 {
+		var err error
+		if ipAddresses, err = readAddresses(stdin); err != nil {
+			fmt.Fprintln(stderr, "geoip2:", err)
+			return 1
+		}
+	}
+
+	// an interrupted batch still prints what was looked up
+	results, err := geoip2.Batch(ctx, lookup, ipAddresses, *concurrency)
+	if werr := write(stdout, results); werr != nil {
+		fmt.Fprintln(stderr, "geoip2:", werr)
+		return 1
+	}
+
+	status = 0
+	if err != nil {
+		fmt.Fprintln(stderr, "geoip2:", err)
+		status = 1
+	}
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Fprintf(stderr, "geoip2: %s: %v\n", result.IpAddress, result.Err)
+			status = 1
+		}
+	}
+	return status
+}
+
+// apiFlags are the flags shared by the commands that configure the Api
+type apiFlags struct {
+	endpoint *string
+	baseURL  *string
+	locales  *string
+	timeout  *time.Duration
+	cacheDir *string
+}
+
+func clientFlags(fs *flag.FlagSet) *apiFlags {
+	return &apiFlags{
+		endpoint: fs.String("endpoint", "city", "web service to query: country, city or insights"),
+		baseURL:  fs.String("base-url", "", "web service root, e.g. a proxy (default "+geoip2.DefaultBaseURL+")"),
+		locales:  fs.String("locales", "", "comma separated locales for names, most preferred first"),
+		timeout:  fs.Duration("timeout", 10*time.Second, "timeout for each lookup"),
+		cacheDir: fs.String("cache", "", "directory in which to cache responses between runs"),
+	}
+}
+
+// lookup returns the lookup for the endpoint, or nil and the exit status
+// after reporting the problem to stderr
+func (f *apiFlags) lookup(stderr io.Writer, extra ...geoip2.Option) (geoip2.LookupFunc, int) {
+	userId, licenseKey := os.Getenv("MAXMIND_USER_ID"), os.Getenv("MAXMIND_LICENSE_KEY")
+	if userId == "" || licenseKey == "" {
+		fmt.Fprintln(stderr, "geoip2: MAXMIND_USER_ID and MAXMIND_LICENSE_KEY must be set")
+		return nil, 2
+	}
+
+	opts := []geoip2.Option{geoip2.WithTimeout(*f.timeout), geoip2.WithUserAgent("geoip2-cli")}
+	if *f.baseURL != "" {
+		opts = append(opts, geoip2.WithBaseURL(*f.baseURL))
+	}
+	if *f.locales != "" {
+		opts = append(opts, geoip2.WithLocales(strings.Split(*f.locales, ",")...))
+	}
+	if *f.cacheDir != "" {
+		cache, err := geoip2.NewDiskCache(*f.cacheDir, 0)
+		if err != nil {
+			fmt.Fprintln(stderr, "geoip2:", err)
+			return nil, 1
+		}
+		opts = append(opts, geoip2.WithCache(cache))
+	}
+	api := geoip2.New(userId, licenseKey, append(opts, extra...)...)
+
+	switch *f.endpoint {
+	case "country":
+		return api.Country, 0
+	case "city":
+		return api.City, 0
+	case "insights":
+		return api.Insights, 0
+	default:
+		fmt.Fprintf(stderr, "geoip2: unknown endpoint %q\n", *f.endpoint)
+		return nil, 2
+	}
+}
+
+// readAddresses returns the non-blank lines of r, ignoring # comments
+func readAddresses(r io.Reader) ([]string, error) {
+	var ipAddresses []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			ipAddresses = append(ipAddresses, line)
+		}
+	}
+	return ipAddresses, scanner.Err()
+}
+
+// writeJSON writes one response per line, so the output can be piped to jq
+func writeJSON(w io.Writer, results []geoip2.Result) error {
+	encoder := json.NewEncoder(w)
+	for _, result := range results {
+		if result.Err != nil {
+			continue
+		}
+		if err := encoder.Encode(result.Response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var columns = []string{"ip_address", "country", "subdivision", "city", "latitude", "longitude", "asn", "organization", "error"}
+
+func row(result geoip2.Result) []string {
+	if result.Err != nil {
+		message := result.Err.Error()
+		var e geoip2.Error
+		if errors.As(result.Err, &e) && e.Code != "" {
+			message = e.Code
+		}
+		return []string{result.IpAddress, "", "", "", "", "", "", "", message}
+	}
+
+	resp := result.Response
+	latitude, longitude := "", ""
+	if resp.Location.Latitude != 0 || resp.Location.Longitude != 0 {
+		latitude = strconv.FormatFloat(resp.Location.Latitude, 'f', -1, 64)
+		longitude = strconv.FormatFloat(resp.Location.Longitude, 'f', -1, 64)
+	}
+	asn := ""
+	if resp.Traits.AutonomousSystemNumber != 0 {
+		asn = strconv.Itoa(resp.Traits.AutonomousSystemNumber)
+	}
+	return []string{
+		result.IpAddress,
+		resp.CountryName(),
+		resp.SubdivisionName(),
+		resp.CityName(),
+		latitude,
+		longitude,
+		asn,
+		resp.Traits.AutonomousSystemOrganization,
+		"",
+	}
+}
+
+func writeTable(w io.Writer, results []geoip2.Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+	for _, result := range results {
+		fmt.Fprintln(tw, strings.Join(row(result), "\t"))
+	}
+	return tw.Flush()
+}
+
+func writeCSV(w io.Writer, results []geoip2.Result) error {
+	cw := csv.NewWriter(w)
+	cw.Write(columns)
+	for _, result := range results {
+		cw.Write(row(result))
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeGeoJSON(w io.Writer, results []geoip2.Result) error {
+	var responses []geoip2.Response
+	for _, result := range results {
+		if result.Err == nil {
+			responses = append(responses, result.Response)
+		}
+	}
+	return geoip2.WriteGeoJSON(w, responses)
+}
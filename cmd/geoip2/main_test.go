@@ -0,0 +1,150 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRun(t *testing.T) {
+	t.Setenv("MAXMIND_USER_ID", "blah-user-id")
+	t.Setenv("MAXMIND_LICENSE_KEY", "blah-license-key")
+
+	Convey("Given a server standing in for MaxMind", t, func() {
+		var paths []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			paths = append(paths, req.URL.Path)
+			if strings.HasSuffix(req.URL.Path, "/8.8.8.8") {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"code":"IP_ADDRESS_NOT_FOUND","error":"not found"}`))
+				return
+			}
+			w.Write([]byte(`{
+				"city": {"names": {"en": "Hayward"}},
+				"country": {"iso_code": "US", "names": {"en": "United States"}},
+				"location": {"latitude": 37.6293, "longitude": -122.1163},
+				"traits": {"ip_address": "1.2.3.4", "autonomous_system_number": 64500}
+			}`))
+		}))
+		defer server.Close()
+
+		exec := func(stdin string, args ...string) (int, string, string) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			args = append([]string{"-base-url", server.URL, "-concurrency", "1"}, args...)
+			status := run(context.Background(), args, strings.NewReader(stdin), stdout, stderr)
+			return status, stdout.String(), stderr.String()
+		}
+
+		Convey("When I look up addresses from the arguments as csv", func() {
+			status, stdout, stderr := exec("", "-endpoint", "country", "-format", "csv", "1.2.3.4", "8.8.8.8")
+
+			Convey("I expect a row per address and a failing status", func() {
+				So(status, ShouldEqual, 1)
+				So(paths, ShouldResemble, []string{"/country/1.2.3.4", "/country/8.8.8.8"})
+				So(stdout, ShouldEqual, ""+
+					"ip_address,country,subdivision,city,latitude,longitude,asn,organization,error\n"+
+					"1.2.3.4,United States,,Hayward,37.6293,-122.1163,64500,,\n"+
+					"8.8.8.8,,,,,,,,IP_ADDRESS_NOT_FOUND\n")
+				So(stderr, ShouldContainSubstring, "8.8.8.8")
+			})
+		})
+
+		Convey("When I pipe addresses on stdin", func() {
+			status, stdout, _ := exec("# suspicious\n1.2.3.4\n\n")
+
+			Convey("I expect one json response per line", func() {
+				So(status, ShouldEqual, 0)
+				So(paths, ShouldResemble, []string{"/city/1.2.3.4"})
+				So(strings.Count(stdout, "\n"), ShouldEqual, 1)
+				So(stdout, ShouldContainSubstring, `"Hayward"`)
+			})
+		})
+
+		Convey("When I ask for a table", func() {
+			status, stdout, _ := exec("", "-format", "table", "1.2.3.4")
+
+			Convey("I expect aligned columns under a header", func() {
+				So(status, ShouldEqual, 0)
+				So(stdout, ShouldStartWith, "IP_ADDRESS  COUNTRY")
+				So(stdout, ShouldContainSubstring, "1.2.3.4     United States")
+			})
+		})
+
+		Convey("When I ask for geojson", func() {
+			status, stdout, _ := exec("", "-format", "geojson", "1.2.3.4", "8.8.8.8")
+
+			Convey("I expect a collection of the located addresses", func() {
+				So(status, ShouldEqual, 1)
+				So(stdout, ShouldStartWith, `{"type":"FeatureCollection"`)
+				So(strings.Count(stdout, `"type":"Feature"`), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When I ask for a template without one", func() {
+			status, _, stderr := exec("", "-format", "template", "1.2.3.4")
+
+			Convey("I expect a usage error before any lookup", func() {
+				So(status, ShouldEqual, 2)
+				So(stderr, ShouldContainSubstring, "requires -template")
+				So(paths, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When I cache responses between runs", func() {
+			dir := t.TempDir()
+			exec("", "-cache", dir, "1.2.3.4")
+			status, stdout, _ := exec("", "-cache", dir, "1.2.3.4")
+
+			Convey("I expect the second run not to query again", func() {
+				So(status, ShouldEqual, 0)
+				So(paths, ShouldResemble, []string{"/city/1.2.3.4"})
+				So(stdout, ShouldContainSubstring, `"Hayward"`)
+			})
+		})
+
+		Convey("When I name an unknown endpoint", func() {
+			status, _, stderr := exec("", "-endpoint", "asn", "1.2.3.4")
+
+			Convey("I expect a usage error", func() {
+				So(status, ShouldEqual, 2)
+				So(stderr, ShouldContainSubstring, `unknown endpoint "asn"`)
+				So(paths, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When I enrich a csv file in bulk", func() {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			args := []string{"bulk", "-base-url", server.URL, "-fields", "country_iso,city,asn"}
+			status := run(context.Background(), args, strings.NewReader("ip,path\n1.2.3.4,/\n1.2.3.4,/about\n"), stdout, stderr)
+
+			Convey("I expect each record enriched and each address looked up once", func() {
+				So(status, ShouldEqual, 0)
+				So(paths, ShouldResemble, []string{"/city/1.2.3.4"})
+				So(stdout.String(), ShouldEqual, ""+
+					"ip,path,country_iso,city,asn,geoip2_error\n"+
+					"1.2.3.4,/,US,Hayward,64500,\n"+
+					"1.2.3.4,/about,US,Hayward,64500,\n")
+				So(stderr.String(), ShouldContainSubstring, "2 records, 1 lookups, 0 failed")
+			})
+		})
+	})
+}
@@ -0,0 +1,36 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package main
+
+import (
+	"io"
+
+	"github.com/savaki/geoip2"
+)
+
+// templateWriter renders the results with the template at path, through
+// the package's Renderer
+func templateWriter(path string) (func(io.Writer, []geoip2.Result) error, error) {
+	renderer, err := geoip2.NewRendererFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return func(w io.Writer, results []geoip2.Result) error {
+		return renderer.Render(w, results)
+	}, nil
+}
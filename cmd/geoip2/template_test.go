@@ -0,0 +1,79 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTemplate(t *testing.T) {
+	t.Setenv("MAXMIND_USER_ID", "blah-user-id")
+	t.Setenv("MAXMIND_LICENSE_KEY", "blah-license-key")
+
+	Convey("Given a server standing in for MaxMind", t, func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if strings.HasSuffix(req.URL.Path, "/8.8.8.8") {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"code":"IP_ADDRESS_NOT_FOUND","error":"not found"}`))
+				return
+			}
+			w.Write([]byte(`{"city": {"names": {"en": "Hayward"}}, "country": {"iso_code": "US"}}`))
+		}))
+		defer server.Close()
+
+		exec := func(args ...string) (int, string, string) {
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			args = append([]string{"-base-url", server.URL, "-concurrency", "1"}, args...)
+			status := run(context.Background(), args, strings.NewReader(""), stdout, stderr)
+			return status, stdout.String(), stderr.String()
+		}
+
+		Convey("When I render a template", func() {
+			path := filepath.Join(t.TempDir(), "report.tmpl")
+			So(os.WriteFile(path, []byte(`{{range .}}{{.IpAddress}}: {{if .Err}}unknown{{else}}{{.Response.CityName}}, {{countryName .Response.Country.IsoCode}}{{end}}
+{{end}}`), 0o644), ShouldBeNil)
+			status, stdout, _ := exec("-format", "template", "-template", path, "1.2.3.4", "8.8.8.8")
+
+			Convey("I expect the Renderer's output for every result", func() {
+				So(status, ShouldEqual, 1)
+				So(stdout, ShouldEqual, "1.2.3.4: Hayward, United States\n8.8.8.8: unknown\n")
+			})
+		})
+
+		Convey("When the template doesn't parse", func() {
+			path := filepath.Join(t.TempDir(), "broken.tmpl")
+			So(os.WriteFile(path, []byte(`{{range .}`), 0o644), ShouldBeNil)
+			status, stdout, stderr := exec("-format", "template", "-template", path, "1.2.3.4")
+
+			Convey("I expect a usage error", func() {
+				So(status, ShouldEqual, 2)
+				So(stdout, ShouldEqual, "")
+				So(stderr, ShouldNotBeEmpty)
+			})
+		})
+	})
+}
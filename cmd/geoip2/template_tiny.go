@@ -0,0 +1,31 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build tinygo || geoip2_tiny
+// +build tinygo geoip2_tiny
+
+package main
+
+import (
+	"errors"
+	"io"
+
+	"github.com/savaki/geoip2"
+)
+
+// templateWriter is unavailable as constrained builds leave out the
+// Renderer
+func templateWriter(path string) (func(io.Writer, []geoip2.Result) error, error) {
+	return nil, errors.New("template output is not available in this build")
+}
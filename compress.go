@@ -0,0 +1,69 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// acceptEncoding is sent with every request.  Insights answers shrink to a
+// fraction of their size compressed, which matters on constrained links.
+// Setting the header ourselves turns off the default transport's own
+// decompression, so decompress handles both it and custom DoFuncs that
+// never decompressed at all.
+const acceptEncoding = "gzip, deflate"
+
+// decompress replaces the body of resp with its decoded content, as the
+// default transport would, when the server compressed it
+func decompress(resp *http.Response) error {
+	var body io.ReadCloser
+	switch strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))) {
+	case "gzip":
+		r, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return err
+		}
+		body = r
+	case "deflate":
+		r, err := zlib.NewReader(resp.Body)
+		if err != nil {
+			return err
+		}
+		body = r
+	default:
+		return nil
+	}
+
+	resp.Body = &decompressedBody{ReadCloser: body, raw: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+type decompressedBody struct {
+	io.ReadCloser
+	raw io.Closer
+}
+
+func (b *decompressedBody) Close() error {
+	b.ReadCloser.Close()
+	return b.raw.Close()
+}
@@ -0,0 +1,111 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func compressed(encoding, body string) []byte {
+	buf := &bytes.Buffer{}
+	var w io.WriteCloser
+	if encoding == "gzip" {
+		w = gzip.NewWriter(buf)
+	} else {
+		w = zlib.NewWriter(buf)
+	}
+	io.WriteString(w, body)
+	w.Close()
+	return buf.Bytes()
+}
+
+func TestCompression(t *testing.T) {
+	Convey("Given a server that compresses its answers", t, func() {
+		var accepted string
+		status := http.StatusOK
+		body := sample
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			accepted = req.Header.Get("Accept-Encoding")
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(status)
+			w.Write(compressed("gzip", body))
+		}))
+		defer server.Close()
+		api := New("blah-user-id", "blah-license-key", WithBaseURL(server.URL))
+
+		Convey("When I look up an address through the default transport", func() {
+			resp, err := api.City(context.Background(), "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			Convey("I expect compression to be requested and the answer decoded", func() {
+				So(accepted, ShouldEqual, "gzip, deflate")
+				So(resp.City.Confidence, ShouldEqual, 25)
+				So(resp.Meta().Header.Get("Content-Encoding"), ShouldEqual, "")
+			})
+		})
+
+		Convey("When the answer is an error", func() {
+			status = http.StatusNotFound
+			body = `{"code":"IP_ADDRESS_NOT_FOUND","error":"not found"}`
+			_, err := api.City(context.Background(), "1.2.3.4")
+
+			Convey("I expect the error body decoded too", func() {
+				So(errors.Is(err, ErrIPAddressNotFound), ShouldBeTrue)
+			})
+		})
+	})
+
+	Convey("Given a DoFunc that returns deflated bodies", t, func() {
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Encoding": {"deflate"}},
+				Body:       ioutil.NopCloser(bytes.NewReader(compressed("deflate", sample))),
+			}, nil
+		})
+
+		Convey("I expect the answer decoded", func() {
+			resp, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.City.Confidence, ShouldEqual, 25)
+		})
+	})
+
+	Convey("Given a body that claims to be gzip but isn't", t, func() {
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Encoding": {"gzip"}},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(sample))),
+			}, nil
+		})
+
+		Convey("I expect an error", func() {
+			_, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
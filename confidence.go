@@ -0,0 +1,66 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+// FilterByConfidence returns r with the location components MaxMind is
+// less than min percent confident of blanked out, so that decisions aren't
+// made on a city it is only guessing at.  Finer components go with coarser
+// ones: blanking the country blanks the subdivisions, city, postal code and
+// location, and blanking the city blanks the postal code and coordinates.
+// Components without a confidence, as in Country and City answers, are
+// kept; only Insights reports confidences.
+func (r Response) FilterByConfidence(min int) Response {
+	below := func(confidence int) bool {
+		return confidence > 0 && confidence < min
+	}
+
+	if below(r.Country.Confidence) {
+		r.Country = Country{}
+		r.Subdivisions = nil
+		r.City = City{}
+		r.Postal = Postal{}
+		r.Location = Location{}
+		return r
+	}
+
+	var subdivisions []Subdivision
+	for _, subdivision := range r.Subdivisions {
+		if below(subdivision.Confidence) {
+			break // the finer subdivisions lie within this one
+		}
+		subdivisions = append(subdivisions, subdivision)
+	}
+	r.Subdivisions = subdivisions
+
+	if below(r.City.Confidence) {
+		r.City = City{}
+		r.Postal = Postal{}
+		r.Location.Latitude = 0
+		r.Location.Longitude = 0
+		r.Location.AccuracyRadius = 0
+		r.Location.MetroCode = 0
+	} else if below(r.Postal.Confidence) {
+		r.Postal = Postal{}
+	}
+	return r
+}
+
+// WithMinConfidence filters every answer with FilterByConfidence(min).  The
+// cache keeps the answers whole, so clones with other thresholds share it.
+func WithMinConfidence(min int) Option {
+	return func(a *Api) {
+		a.minConfidence = min
+	}
+}
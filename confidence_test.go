@@ -0,0 +1,108 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFilterByConfidence(t *testing.T) {
+	Convey("Given an Insights answer with mixed confidences", t, func() {
+		resp := Response{
+			Country: Country{IsoCode: "GB", Confidence: 99},
+			Subdivisions: []Subdivision{
+				{IsoCode: "ENG", Confidence: 90},
+				{IsoCode: "WSM", Confidence: 10},
+			},
+			City:     City{GeoNameId: 2643743, Confidence: 40},
+			Postal:   Postal{Code: "SW1A", Confidence: 5},
+			Location: Location{Latitude: 51.5, Longitude: -0.1, AccuracyRadius: 20, TimeZone: "Europe/London"},
+		}
+
+		Convey("When I filter at 30 percent", func() {
+			filtered := resp.FilterByConfidence(30)
+
+			Convey("I expect only the components below it blanked", func() {
+				So(filtered.Country.IsoCode, ShouldEqual, "GB")
+				So(filtered.Subdivisions, ShouldResemble, []Subdivision{{IsoCode: "ENG", Confidence: 90}})
+				So(filtered.City.GeoNameId, ShouldEqual, 2643743)
+				So(filtered.Postal, ShouldResemble, Postal{})
+				So(filtered.Location.Latitude, ShouldEqual, 51.5)
+			})
+
+			Convey("I expect the original untouched", func() {
+				So(len(resp.Subdivisions), ShouldEqual, 2)
+				So(resp.Postal.Code, ShouldEqual, "SW1A")
+			})
+		})
+
+		Convey("When I filter out the city", func() {
+			filtered := resp.FilterByConfidence(50)
+
+			Convey("I expect its postal code and coordinates to go with it", func() {
+				So(filtered.City, ShouldResemble, City{})
+				So(filtered.Postal, ShouldResemble, Postal{})
+				So(filtered.Location, ShouldResemble, Location{TimeZone: "Europe/London"})
+				So(filtered.Country.IsoCode, ShouldEqual, "GB")
+			})
+		})
+
+		Convey("When I filter out the country", func() {
+			filtered := resp.FilterByConfidence(100)
+
+			Convey("I expect every location component blanked", func() {
+				So(filtered.Country, ShouldResemble, Country{})
+				So(filtered.Subdivisions, ShouldBeNil)
+				So(filtered.City, ShouldResemble, City{})
+				So(filtered.Location, ShouldResemble, Location{})
+			})
+		})
+	})
+
+	Convey("Given an answer without confidences", t, func() {
+		resp := Response{Country: Country{IsoCode: "GB"}, City: City{GeoNameId: 2643743}}
+
+		Convey("I expect it kept whole", func() {
+			So(resp.FilterByConfidence(90), ShouldResemble, resp)
+		})
+	})
+
+	Convey("Given an Api with a minimum confidence", t, func() {
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(context.Context, *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		}).Clone(WithMinConfidence(50), WithCache(NewLRUCache(10)))
+
+		Convey("I expect its answers filtered, and the cache kept whole", func() {
+			resp, err := api.City(context.Background(), "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.Country.IsoCode, ShouldEqual, "US")
+			So(resp.City, ShouldResemble, City{})
+
+			resp, err = api.Clone(WithMinConfidence(0)).City(context.Background(), "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.Meta().Cached, ShouldBeTrue)
+			So(resp.City.Confidence, ShouldEqual, 25)
+		})
+	})
+}
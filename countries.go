@@ -0,0 +1,378 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import "strings"
+
+// countryCode holds the other ISO 3166-1 codes of a country and the code of
+// its continent, as MaxMind assigns them
+type countryCode struct {
+	alpha3    string
+	numeric   int
+	continent string
+}
+
+// NormalizeCountryCode returns the upper case ISO 3166-1 alpha-2 code for
+// code, which may be given in either case, surrounded by space, or as an
+// alpha-3 code.  It reports false for codes not assigned to a country.
+func NormalizeCountryCode(code string) (string, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	switch len(code) {
+	case 2:
+		if _, ok := countryCodes[code]; ok {
+			return code, true
+		}
+	case 3:
+		if alpha2, ok := alpha2Codes[code]; ok {
+			return alpha2, true
+		}
+	}
+	return "", false
+}
+
+// ValidCountryCode reports whether code is an assigned ISO 3166-1 alpha-2
+// code, in either case
+func ValidCountryCode(code string) bool {
+	_, ok := lookupCountryCode(code)
+	return ok
+}
+
+// CountryAlpha3 returns the ISO 3166-1 alpha-3 code for the alpha-2 code
+func CountryAlpha3(code string) (string, bool) {
+	c, ok := lookupCountryCode(code)
+	return c.alpha3, ok
+}
+
+// CountryNumeric returns the ISO 3166-1 numeric code for the alpha-2 code.
+// Kosovo, which MaxMind reports as XK, has none.
+func CountryNumeric(code string) (int, bool) {
+	c, ok := lookupCountryCode(code)
+	return c.numeric, ok && c.numeric != 0
+}
+
+// CountryContinent returns the code of the continent MaxMind places the
+// country in, one of AF, AN, AS, EU, NA, OC and SA
+func CountryContinent(code string) (string, bool) {
+	c, ok := lookupCountryCode(code)
+	return c.continent, ok
+}
+
+// CountryFlag returns the emoji flag for the alpha-2 code, which renders as
+// the letters of the code where the platform has no flag for it, or "" for
+// a code that isn't assigned
+func CountryFlag(code string) string {
+	if !ValidCountryCode(code) {
+		return ""
+	}
+	code = strings.ToUpper(code)
+	const regionalIndicatorA = 0x1F1E6
+	return string([]rune{
+		regionalIndicatorA + rune(code[0]-'A'),
+		regionalIndicatorA + rune(code[1]-'A'),
+	})
+}
+
+func lookupCountryCode(code string) (countryCode, bool) {
+	c, ok := countryCodes[strings.ToUpper(code)]
+	return c, ok
+}
+
+// Alpha3 returns the ISO 3166-1 alpha-3 code of the country
+func (c Country) Alpha3() string {
+	alpha3, _ := CountryAlpha3(c.IsoCode)
+	return alpha3
+}
+
+// Numeric returns the ISO 3166-1 numeric code of the country, or 0
+func (c Country) Numeric() int {
+	numeric, _ := CountryNumeric(c.IsoCode)
+	return numeric
+}
+
+// ContinentCode returns the code of the continent the country lies in
+func (c Country) ContinentCode() string {
+	continent, _ := CountryContinent(c.IsoCode)
+	return continent
+}
+
+// Flag returns the emoji flag of the country
+func (c Country) Flag() string {
+	return CountryFlag(c.IsoCode)
+}
+
+var alpha2Codes = func() map[string]string {
+	codes := make(map[string]string, len(countryCodes))
+	for alpha2, c := range countryCodes {
+		codes[c.alpha3] = alpha2
+	}
+	return codes
+}()
+
+// countryCodes are taken from the ISO 3166-1 table shipped by the iso-codes
+// project, https://salsa.debian.org/iso-codes-team/iso-codes, with XK, the
+// user-assigned code MaxMind uses for Kosovo.  Continents follow GeoNames,
+// as MaxMind's do.
+var countryCodes = map[string]countryCode{
+	"AD": {"AND", 20, "EU"},
+	"AE": {"ARE", 784, "AS"},
+	"AF": {"AFG", 4, "AS"},
+	"AG": {"ATG", 28, "NA"},
+	"AI": {"AIA", 660, "NA"},
+	"AL": {"ALB", 8, "EU"},
+	"AM": {"ARM", 51, "AS"},
+	"AO": {"AGO", 24, "AF"},
+	"AQ": {"ATA", 10, "AN"},
+	"AR": {"ARG", 32, "SA"},
+	"AS": {"ASM", 16, "OC"},
+	"AT": {"AUT", 40, "EU"},
+	"AU": {"AUS", 36, "OC"},
+	"AW": {"ABW", 533, "NA"},
+	"AX": {"ALA", 248, "EU"},
+	"AZ": {"AZE", 31, "AS"},
+	"BA": {"BIH", 70, "EU"},
+	"BB": {"BRB", 52, "NA"},
+	"BD": {"BGD", 50, "AS"},
+	"BE": {"BEL", 56, "EU"},
+	"BF": {"BFA", 854, "AF"},
+	"BG": {"BGR", 100, "EU"},
+	"BH": {"BHR", 48, "AS"},
+	"BI": {"BDI", 108, "AF"},
+	"BJ": {"BEN", 204, "AF"},
+	"BL": {"BLM", 652, "NA"},
+	"BM": {"BMU", 60, "NA"},
+	"BN": {"BRN", 96, "AS"},
+	"BO": {"BOL", 68, "SA"},
+	"BQ": {"BES", 535, "NA"},
+	"BR": {"BRA", 76, "SA"},
+	"BS": {"BHS", 44, "NA"},
+	"BT": {"BTN", 64, "AS"},
+	"BV": {"BVT", 74, "AN"},
+	"BW": {"BWA", 72, "AF"},
+	"BY": {"BLR", 112, "EU"},
+	"BZ": {"BLZ", 84, "NA"},
+	"CA": {"CAN", 124, "NA"},
+	"CC": {"CCK", 166, "AS"},
+	"CD": {"COD", 180, "AF"},
+	"CF": {"CAF", 140, "AF"},
+	"CG": {"COG", 178, "AF"},
+	"CH": {"CHE", 756, "EU"},
+	"CI": {"CIV", 384, "AF"},
+	"CK": {"COK", 184, "OC"},
+	"CL": {"CHL", 152, "SA"},
+	"CM": {"CMR", 120, "AF"},
+	"CN": {"CHN", 156, "AS"},
+	"CO": {"COL", 170, "SA"},
+	"CR": {"CRI", 188, "NA"},
+	"CU": {"CUB", 192, "NA"},
+	"CV": {"CPV", 132, "AF"},
+	"CW": {"CUW", 531, "NA"},
+	"CX": {"CXR", 162, "AS"},
+	"CY": {"CYP", 196, "EU"},
+	"CZ": {"CZE", 203, "EU"},
+	"DE": {"DEU", 276, "EU"},
+	"DJ": {"DJI", 262, "AF"},
+	"DK": {"DNK", 208, "EU"},
+	"DM": {"DMA", 212, "NA"},
+	"DO": {"DOM", 214, "NA"},
+	"DZ": {"DZA", 12, "AF"},
+	"EC": {"ECU", 218, "SA"},
+	"EE": {"EST", 233, "EU"},
+	"EG": {"EGY", 818, "AF"},
+	"EH": {"ESH", 732, "AF"},
+	"ER": {"ERI", 232, "AF"},
+	"ES": {"ESP", 724, "EU"},
+	"ET": {"ETH", 231, "AF"},
+	"FI": {"FIN", 246, "EU"},
+	"FJ": {"FJI", 242, "OC"},
+	"FK": {"FLK", 238, "SA"},
+	"FM": {"FSM", 583, "OC"},
+	"FO": {"FRO", 234, "EU"},
+	"FR": {"FRA", 250, "EU"},
+	"GA": {"GAB", 266, "AF"},
+	"GB": {"GBR", 826, "EU"},
+	"GD": {"GRD", 308, "NA"},
+	"GE": {"GEO", 268, "AS"},
+	"GF": {"GUF", 254, "SA"},
+	"GG": {"GGY", 831, "EU"},
+	"GH": {"GHA", 288, "AF"},
+	"GI": {"GIB", 292, "EU"},
+	"GL": {"GRL", 304, "NA"},
+	"GM": {"GMB", 270, "AF"},
+	"GN": {"GIN", 324, "AF"},
+	"GP": {"GLP", 312, "NA"},
+	"GQ": {"GNQ", 226, "AF"},
+	"GR": {"GRC", 300, "EU"},
+	"GS": {"SGS", 239, "AN"},
+	"GT": {"GTM", 320, "NA"},
+	"GU": {"GUM", 316, "OC"},
+	"GW": {"GNB", 624, "AF"},
+	"GY": {"GUY", 328, "SA"},
+	"HK": {"HKG", 344, "AS"},
+	"HM": {"HMD", 334, "AN"},
+	"HN": {"HND", 340, "NA"},
+	"HR": {"HRV", 191, "EU"},
+	"HT": {"HTI", 332, "NA"},
+	"HU": {"HUN", 348, "EU"},
+	"ID": {"IDN", 360, "AS"},
+	"IE": {"IRL", 372, "EU"},
+	"IL": {"ISR", 376, "AS"},
+	"IM": {"IMN", 833, "EU"},
+	"IN": {"IND", 356, "AS"},
+	"IO": {"IOT", 86, "AS"},
+	"IQ": {"IRQ", 368, "AS"},
+	"IR": {"IRN", 364, "AS"},
+	"IS": {"ISL", 352, "EU"},
+	"IT": {"ITA", 380, "EU"},
+	"JE": {"JEY", 832, "EU"},
+	"JM": {"JAM", 388, "NA"},
+	"JO": {"JOR", 400, "AS"},
+	"JP": {"JPN", 392, "AS"},
+	"KE": {"KEN", 404, "AF"},
+	"KG": {"KGZ", 417, "AS"},
+	"KH": {"KHM", 116, "AS"},
+	"KI": {"KIR", 296, "OC"},
+	"KM": {"COM", 174, "AF"},
+	"KN": {"KNA", 659, "NA"},
+	"KP": {"PRK", 408, "AS"},
+	"KR": {"KOR", 410, "AS"},
+	"KW": {"KWT", 414, "AS"},
+	"KY": {"CYM", 136, "NA"},
+	"KZ": {"KAZ", 398, "AS"},
+	"LA": {"LAO", 418, "AS"},
+	"LB": {"LBN", 422, "AS"},
+	"LC": {"LCA", 662, "NA"},
+	"LI": {"LIE", 438, "EU"},
+	"LK": {"LKA", 144, "AS"},
+	"LR": {"LBR", 430, "AF"},
+	"LS": {"LSO", 426, "AF"},
+	"LT": {"LTU", 440, "EU"},
+	"LU": {"LUX", 442, "EU"},
+	"LV": {"LVA", 428, "EU"},
+	"LY": {"LBY", 434, "AF"},
+	"MA": {"MAR", 504, "AF"},
+	"MC": {"MCO", 492, "EU"},
+	"MD": {"MDA", 498, "EU"},
+	"ME": {"MNE", 499, "EU"},
+	"MF": {"MAF", 663, "NA"},
+	"MG": {"MDG", 450, "AF"},
+	"MH": {"MHL", 584, "OC"},
+	"MK": {"MKD", 807, "EU"},
+	"ML": {"MLI", 466, "AF"},
+	"MM": {"MMR", 104, "AS"},
+	"MN": {"MNG", 496, "AS"},
+	"MO": {"MAC", 446, "AS"},
+	"MP": {"MNP", 580, "OC"},
+	"MQ": {"MTQ", 474, "NA"},
+	"MR": {"MRT", 478, "AF"},
+	"MS": {"MSR", 500, "NA"},
+	"MT": {"MLT", 470, "EU"},
+	"MU": {"MUS", 480, "AF"},
+	"MV": {"MDV", 462, "AS"},
+	"MW": {"MWI", 454, "AF"},
+	"MX": {"MEX", 484, "NA"},
+	"MY": {"MYS", 458, "AS"},
+	"MZ": {"MOZ", 508, "AF"},
+	"NA": {"NAM", 516, "AF"},
+	"NC": {"NCL", 540, "OC"},
+	"NE": {"NER", 562, "AF"},
+	"NF": {"NFK", 574, "OC"},
+	"NG": {"NGA", 566, "AF"},
+	"NI": {"NIC", 558, "NA"},
+	"NL": {"NLD", 528, "EU"},
+	"NO": {"NOR", 578, "EU"},
+	"NP": {"NPL", 524, "AS"},
+	"NR": {"NRU", 520, "OC"},
+	"NU": {"NIU", 570, "OC"},
+	"NZ": {"NZL", 554, "OC"},
+	"OM": {"OMN", 512, "AS"},
+	"PA": {"PAN", 591, "NA"},
+	"PE": {"PER", 604, "SA"},
+	"PF": {"PYF", 258, "OC"},
+	"PG": {"PNG", 598, "OC"},
+	"PH": {"PHL", 608, "AS"},
+	"PK": {"PAK", 586, "AS"},
+	"PL": {"POL", 616, "EU"},
+	"PM": {"SPM", 666, "NA"},
+	"PN": {"PCN", 612, "OC"},
+	"PR": {"PRI", 630, "NA"},
+	"PS": {"PSE", 275, "AS"},
+	"PT": {"PRT", 620, "EU"},
+	"PW": {"PLW", 585, "OC"},
+	"PY": {"PRY", 600, "SA"},
+	"QA": {"QAT", 634, "AS"},
+	"RE": {"REU", 638, "AF"},
+	"RO": {"ROU", 642, "EU"},
+	"RS": {"SRB", 688, "EU"},
+	"RU": {"RUS", 643, "EU"},
+	"RW": {"RWA", 646, "AF"},
+	"SA": {"SAU", 682, "AS"},
+	"SB": {"SLB", 90, "OC"},
+	"SC": {"SYC", 690, "AF"},
+	"SD": {"SDN", 729, "AF"},
+	"SE": {"SWE", 752, "EU"},
+	"SG": {"SGP", 702, "AS"},
+	"SH": {"SHN", 654, "AF"},
+	"SI": {"SVN", 705, "EU"},
+	"SJ": {"SJM", 744, "EU"},
+	"SK": {"SVK", 703, "EU"},
+	"SL": {"SLE", 694, "AF"},
+	"SM": {"SMR", 674, "EU"},
+	"SN": {"SEN", 686, "AF"},
+	"SO": {"SOM", 706, "AF"},
+	"SR": {"SUR", 740, "SA"},
+	"SS": {"SSD", 728, "AF"},
+	"ST": {"STP", 678, "AF"},
+	"SV": {"SLV", 222, "NA"},
+	"SX": {"SXM", 534, "NA"},
+	"SY": {"SYR", 760, "AS"},
+	"SZ": {"SWZ", 748, "AF"},
+	"TC": {"TCA", 796, "NA"},
+	"TD": {"TCD", 148, "AF"},
+	"TF": {"ATF", 260, "AN"},
+	"TG": {"TGO", 768, "AF"},
+	"TH": {"THA", 764, "AS"},
+	"TJ": {"TJK", 762, "AS"},
+	"TK": {"TKL", 772, "OC"},
+	"TL": {"TLS", 626, "AS"},
+	"TM": {"TKM", 795, "AS"},
+	"TN": {"TUN", 788, "AF"},
+	"TO": {"TON", 776, "OC"},
+	"TR": {"TUR", 792, "AS"},
+	"TT": {"TTO", 780, "NA"},
+	"TV": {"TUV", 798, "OC"},
+	"TW": {"TWN", 158, "AS"},
+	"TZ": {"TZA", 834, "AF"},
+	"UA": {"UKR", 804, "EU"},
+	"UG": {"UGA", 800, "AF"},
+	"UM": {"UMI", 581, "OC"},
+	"US": {"USA", 840, "NA"},
+	"UY": {"URY", 858, "SA"},
+	"UZ": {"UZB", 860, "AS"},
+	"VA": {"VAT", 336, "EU"},
+	"VC": {"VCT", 670, "NA"},
+	"VE": {"VEN", 862, "SA"},
+	"VG": {"VGB", 92, "NA"},
+	"VI": {"VIR", 850, "NA"},
+	"VN": {"VNM", 704, "AS"},
+	"VU": {"VUT", 548, "OC"},
+	"WF": {"WLF", 876, "OC"},
+	"WS": {"WSM", 882, "OC"},
+	"XK": {"XKX", 0, "EU"},
+	"YE": {"YEM", 887, "AS"},
+	"YT": {"MYT", 175, "AF"},
+	"ZA": {"ZAF", 710, "AF"},
+	"ZM": {"ZMB", 894, "AF"},
+	"ZW": {"ZWE", 716, "AF"},
+}
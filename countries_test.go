@@ -0,0 +1,94 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCountryCodes(t *testing.T) {
+	Convey("Given country codes as they arrive from forms and feeds", t, func() {
+		Convey("I expect them normalized to alpha-2", func() {
+			for input, expected := range map[string]string{
+				"gb":    "GB",
+				" US ":  "US",
+				"deu":   "DE",
+				"XK":    "XK",
+				"Jpn\n": "JP",
+			} {
+				code, ok := NormalizeCountryCode(input)
+				So(ok, ShouldBeTrue)
+				So(code, ShouldEqual, expected)
+			}
+		})
+
+		Convey("I expect unassigned codes rejected", func() {
+			for _, input := range []string{"", "UK", "EU", "ZZZ", "U", "GBRX"} {
+				_, ok := NormalizeCountryCode(input)
+				So(ok, ShouldBeFalse)
+				So(ValidCountryCode(input), ShouldBeFalse)
+			}
+		})
+	})
+
+	Convey("Given an alpha-2 code", t, func() {
+		Convey("I expect its other codes and continent", func() {
+			alpha3, ok := CountryAlpha3("br")
+			So(ok, ShouldBeTrue)
+			So(alpha3, ShouldEqual, "BRA")
+
+			numeric, ok := CountryNumeric("AF")
+			So(ok, ShouldBeTrue)
+			So(numeric, ShouldEqual, 4)
+
+			continent, ok := CountryContinent("AU")
+			So(ok, ShouldBeTrue)
+			So(continent, ShouldEqual, "OC")
+
+			_, ok = CountryNumeric("XK")
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("I expect its flag", func() {
+			So(CountryFlag("gb"), ShouldEqual, "🇬🇧")
+			So(CountryFlag("JP"), ShouldEqual, "🇯🇵")
+			So(CountryFlag("UK"), ShouldEqual, "")
+		})
+	})
+
+	Convey("Given a country record", t, func() {
+		country := Country{IsoCode: "US"}
+
+		Convey("I expect the conversions as methods", func() {
+			So(country.Alpha3(), ShouldEqual, "USA")
+			So(country.Numeric(), ShouldEqual, 840)
+			So(country.ContinentCode(), ShouldEqual, "NA")
+			So(country.Flag(), ShouldEqual, "🇺🇸")
+			So(Country{}.Flag(), ShouldEqual, "")
+		})
+	})
+
+	Convey("Given every country", t, func() {
+		Convey("I expect alpha-3 codes to map back", func() {
+			for alpha2, c := range countryCodes {
+				code, ok := NormalizeCountryCode(c.alpha3)
+				So(ok, ShouldBeTrue)
+				So(code, ShouldEqual, alpha2)
+			}
+		})
+	})
+}
@@ -0,0 +1,136 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// CredentialsProvider supplies the account ID and license key for each
+// request, so keys rotated in a secrets manager take effect without
+// recreating the Api.  It is called once per request sent, so a provider
+// backed by a remote store should cache what it fetches.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (userId, licenseKey string, err error)
+}
+
+// CredentialsFunc adapts a function to the CredentialsProvider interface
+type CredentialsFunc func(ctx context.Context) (userId, licenseKey string, err error)
+
+func (fn CredentialsFunc) Credentials(ctx context.Context) (string, string, error) {
+	return fn(ctx)
+}
+
+// WithCredentialsProvider authenticates each request with the credentials
+// provider returns at the time, in place of those passed to New.  A failure
+// to obtain them fails the lookup without sending a request.  The keys in
+// use are scrubbed from errors like any other license key.
+func WithCredentialsProvider(provider CredentialsProvider) Option {
+	return WithAuthenticator(&providerAuth{provider: provider})
+}
+
+// recentKeys is how many of the latest license keys a providerAuth keeps
+// for redaction, so that the key replaced by a rotation is still scrubbed
+// from the errors of requests that were in flight
+const recentKeys = 2
+
+type providerAuth struct {
+	provider CredentialsProvider
+
+	mutex sync.Mutex
+	keys  []string
+}
+
+func (p *providerAuth) Authenticate(req *http.Request) error {
+	userId, licenseKey, err := p.provider.Credentials(req.Context())
+	if err != nil {
+		return fmt.Errorf("geoip2: unable to obtain credentials: %w", err)
+	}
+	p.use(licenseKey)
+	req.SetBasicAuth(userId, licenseKey)
+	return nil
+}
+
+func (p *providerAuth) use(licenseKey string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if len(p.keys) > 0 && p.keys[len(p.keys)-1] == licenseKey {
+		return
+	}
+	p.keys = append(p.keys, licenseKey)
+	if len(p.keys) > recentKeys {
+		p.keys = append([]string(nil), p.keys[len(p.keys)-recentKeys:]...)
+	}
+}
+
+func (p *providerAuth) secrets() []string {
+	p.mutex.Lock()
+	secrets := append([]string(nil), p.keys...)
+	p.mutex.Unlock()
+
+	if holder, ok := p.provider.(secretHolder); ok {
+		secrets = append(secrets, holder.secrets()...)
+	}
+	return secrets
+}
+
+// AccountPool spreads requests over several accounts in turn, e.g. to share
+// query volume between accounts with separate quotas
+type AccountPool struct {
+	accounts []BasicAuth
+	next     uint64
+}
+
+var _ CredentialsProvider = (*AccountPool)(nil)
+
+// errEmptyPool is returned by an AccountPool without accounts
+var errEmptyPool = errors.New("geoip2: account pool is empty")
+
+// NewAccountPool returns a pool that serves accounts round-robin
+func NewAccountPool(accounts ...BasicAuth) *AccountPool {
+	return &AccountPool{accounts: append([]BasicAuth(nil), accounts...)}
+}
+
+// Credentials returns the next account in the pool
+func (p *AccountPool) Credentials(ctx context.Context) (string, string, error) {
+	if len(p.accounts) == 0 {
+		return "", "", errEmptyPool
+	}
+	n := atomic.AddUint64(&p.next, 1) - 1
+	account := p.accounts[n%uint64(len(p.accounts))]
+	return account.UserId, account.LicenseKey, nil
+}
+
+func (p *AccountPool) secrets() []string {
+	secrets := make([]string, 0, len(p.accounts))
+	for _, account := range p.accounts {
+		secrets = append(secrets, account.LicenseKey)
+	}
+	return secrets
+}
+
+func (p *AccountPool) String() string {
+	return fmt.Sprintf("geoip2.AccountPool{%d accounts}", len(p.accounts))
+}
+
+func (p *AccountPool) GoString() string {
+	return p.String()
+}
@@ -0,0 +1,88 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCredentialsProvider(t *testing.T) {
+	Convey("Given a server that records who asked", t, func() {
+		var users []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			userId, _, _ := req.BasicAuth()
+			users = append(users, userId)
+			w.Write([]byte(sample))
+		}))
+		defer server.Close()
+
+		Convey("When the credentials are rotated between lookups", func() {
+			current := "first"
+			api := New("", "", WithBaseURL(server.URL), WithCredentialsProvider(CredentialsFunc(func(ctx context.Context) (string, string, error) {
+				return current, "key-" + current, nil
+			})))
+			api.City(nil, "1.2.3.4")
+			current = "second"
+			api.City(nil, "1.2.3.4")
+
+			Convey("I expect each request to use the credentials of the time", func() {
+				So(users, ShouldResemble, []string{"first", "second"})
+			})
+		})
+
+		Convey("When the provider fails", func() {
+			api := New("", "", WithBaseURL(server.URL), WithCredentialsProvider(CredentialsFunc(func(ctx context.Context) (string, string, error) {
+				return "", "", context.DeadlineExceeded
+			})))
+			_, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect the lookup to fail without a request", func() {
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+				So(users, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When requests are spread over a pool", func() {
+			pool := NewAccountPool(BasicAuth{UserId: "a", LicenseKey: "ka"}, BasicAuth{UserId: "b", LicenseKey: "kb"})
+			api := New("", "", WithBaseURL(server.URL), WithCredentialsProvider(pool))
+			for i := 0; i < 3; i++ {
+				api.Country(nil, "1.2.3.4")
+			}
+
+			Convey("I expect the accounts to take turns", func() {
+				So(users, ShouldResemble, []string{"a", "b", "a"})
+			})
+
+			Convey("I expect the pool to keep its keys to itself", func() {
+				So(fmt.Sprintf("%v %#v", pool, pool), ShouldNotContainSubstring, "ka")
+			})
+		})
+	})
+
+	Convey("Given an empty pool", t, func() {
+		_, _, err := NewAccountPool().Credentials(context.Background())
+
+		Convey("I expect an error", func() {
+			So(err, ShouldEqual, errEmptyPool)
+		})
+	})
+}
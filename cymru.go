@@ -0,0 +1,216 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// Origin describes the route announcing an address.  Mismatch is set when
+// MaxMind attributes the address to an AS that does not originate the route.
+type Origin struct {
+	ASNs     []int  `json:"asns,omitempty"`
+	Prefix   string `json:"prefix,omitempty"`
+	Country  string `json:"country,omitempty"`
+	Registry string `json:"registry,omitempty"`
+	Mismatch bool   `json:"mismatch,omitempty"`
+}
+
+func (o *Origin) check(resp Response) {
+	asn := resp.Traits.AutonomousSystemNumber
+	if asn == 0 || len(o.ASNs) == 0 {
+		return
+	}
+	for _, v := range o.ASNs {
+		if v == asn {
+			return
+		}
+	}
+	o.Mismatch = true
+}
+
+// CymruEnricher is an Enricher that resolves the origin AS of an address
+// using Team Cymru's IP to ASN DNS service.
+// http://www.team-cymru.com/IP-ASN-mapping.html#dns
+type CymruEnricher struct {
+	lookupTXT func(ctx context.Context, name string) ([]string, error)
+}
+
+// NewCymruEnricher returns a CymruEnricher using resolver; a nil resolver
+// uses net.DefaultResolver.
+func NewCymruEnricher(resolver *net.Resolver) *CymruEnricher {
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	return &CymruEnricher{
+		lookupTXT: func(ctx context.Context, name string) ([]string, error) {
+			return resolver.LookupTXT(ctx, name)
+		},
+	}
+}
+
+func (c *CymruEnricher) Name() string {
+	return "origin"
+}
+
+func (c *CymruEnricher) Enrich(ctx context.Context, ipAddress string, resp Response) (interface{}, error) {
+	origin, err := c.Lookup(ctx, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+	origin.check(resp)
+	return origin, nil
+}
+
+func (c *CymruEnricher) Lookup(ctx context.Context, ipAddress string) (Origin, error) {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return Origin{}, err
+	}
+	addr = addr.Unmap()
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	records, err := c.lookupTXT(ctx, cymruName(addr))
+	if err != nil {
+		return Origin{}, err
+	}
+	if len(records) == 0 {
+		return Origin{}, fmt.Errorf("cymru: no origin for %s", addr)
+	}
+
+	// e.g. "23028 | 216.90.108.0/24 | US | arin | 1998-09-25"
+	fields := strings.Split(records[0], "|")
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	if len(fields) < 4 {
+		return Origin{}, fmt.Errorf("cymru: malformed record %q", records[0])
+	}
+
+	origin := Origin{
+		Prefix:   fields[1],
+		Country:  fields[2],
+		Registry: fields[3],
+	}
+	for _, v := range strings.Fields(fields[0]) {
+		asn, err := strconv.Atoi(v)
+		if err != nil {
+			return Origin{}, fmt.Errorf("cymru: malformed record %q", records[0])
+		}
+		origin.ASNs = append(origin.ASNs, asn)
+	}
+	return origin, nil
+}
+
+// cymruName returns the reversed query name for addr, e.g.
+// 4.3.2.1.origin.asn.cymru.com for 1.2.3.4
+func cymruName(addr netip.Addr) string {
+	if addr.Is4() {
+		b := addr.As4()
+		return fmt.Sprintf("%d.%d.%d.%d.origin.asn.cymru.com", b[3], b[2], b[1], b[0])
+	}
+
+	b := addr.As16()
+	nibbles := make([]string, 0, 32)
+	for i := len(b) - 1; i >= 0; i-- {
+		nibbles = append(nibbles, strconv.FormatUint(uint64(b[i]&0x0f), 16), strconv.FormatUint(uint64(b[i]>>4), 16))
+	}
+	return strings.Join(nibbles, ".") + ".origin6.asn.cymru.com"
+}
+
+// RouteTable is an Enricher that resolves origin ASNs from an offline
+// routing table rather than DNS.
+type RouteTable struct {
+	routes map[netip.Prefix][]int
+}
+
+// LoadRouteTable reads a routing table with one "prefix asn[,asn...]" entry
+// per line, as produced by pyasn_util_convert.py.  Blank lines and lines
+// starting with ';' or '#' are ignored.
+func LoadRouteTable(r io.Reader) (*RouteTable, error) {
+	table := &RouteTable{
+		routes: map[netip.Prefix][]int{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == ';' || text[0] == '#' {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("route table: line %d: expected prefix and asn", line)
+		}
+		prefix, err := netip.ParsePrefix(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("route table: line %d: %v", line, err)
+		}
+		prefix = prefix.Masked()
+
+		for _, v := range strings.Split(fields[1], ",") {
+			asn, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("route table: line %d: %v", line, err)
+			}
+			table.routes[prefix] = append(table.routes[prefix], asn)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
+func (t *RouteTable) Name() string {
+	return "origin"
+}
+
+func (t *RouteTable) Enrich(ctx context.Context, ipAddress string, resp Response) (interface{}, error) {
+	origin, err := t.Lookup(ipAddress)
+	if err != nil {
+		return nil, err
+	}
+	origin.check(resp)
+	return origin, nil
+}
+
+// Lookup returns the origin of the longest prefix containing ipAddress
+func (t *RouteTable) Lookup(ipAddress string) (Origin, error) {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return Origin{}, err
+	}
+	addr = addr.Unmap()
+
+	for bits := addr.BitLen(); bits >= 0; bits-- {
+		prefix := netip.PrefixFrom(addr, bits).Masked()
+		if asns, ok := t.routes[prefix]; ok {
+			return Origin{ASNs: asns, Prefix: prefix.String()}, nil
+		}
+	}
+	return Origin{}, fmt.Errorf("route table: no route for %s", addr)
+}
@@ -0,0 +1,102 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCymruEnricher(t *testing.T) {
+	Convey("Given a CymruEnricher", t, func() {
+		names := []string{}
+		enricher := NewCymruEnricher(nil)
+		enricher.lookupTXT = func(ctx context.Context, name string) ([]string, error) {
+			names = append(names, name)
+			return []string{"1239 | 1.2.3.0/24 | IT | ripencc | 2011-08-11"}, nil
+		}
+
+		Convey("When MaxMind agrees with the route origin", func() {
+			resp := Response{Traits: Traits{AutonomousSystemNumber: 1239}}
+			v, err := enricher.Enrich(nil, "1.2.3.4", resp)
+
+			Convey("I expect no mismatch", func() {
+				So(err, ShouldBeNil)
+				So(names, ShouldResemble, []string{"4.3.2.1.origin.asn.cymru.com"})
+
+				origin := v.(Origin)
+				So(origin.ASNs, ShouldResemble, []int{1239})
+				So(origin.Prefix, ShouldEqual, "1.2.3.0/24")
+				So(origin.Registry, ShouldEqual, "ripencc")
+				So(origin.Mismatch, ShouldBeFalse)
+			})
+		})
+
+		Convey("When MaxMind disagrees with the route origin", func() {
+			resp := Response{Traits: Traits{AutonomousSystemNumber: 64512}}
+			v, err := enricher.Enrich(nil, "1.2.3.4", resp)
+
+			Convey("I expect a mismatch", func() {
+				So(err, ShouldBeNil)
+				So(v.(Origin).Mismatch, ShouldBeTrue)
+			})
+		})
+
+		Convey("When I look up an IPv6 address", func() {
+			enricher.Lookup(nil, "2001:db8::1")
+
+			Convey("I expect a nibble-reversed query", func() {
+				So(names[0], ShouldEqual, "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.origin6.asn.cymru.com")
+			})
+		})
+	})
+}
+
+func TestRouteTable(t *testing.T) {
+	Convey("Given a route table", t, func() {
+		table, err := LoadRouteTable(strings.NewReader(`
+; IP-ASN32-DAT file
+1.0.0.0/8	64512
+1.2.3.0/24	1239
+2001:db8::/32	64513,64514
+`))
+		So(err, ShouldBeNil)
+
+		Convey("I expect the longest matching prefix to win", func() {
+			origin, err := table.Lookup("1.2.3.4")
+			So(err, ShouldBeNil)
+			So(origin.ASNs, ShouldResemble, []int{1239})
+			So(origin.Prefix, ShouldEqual, "1.2.3.0/24")
+
+			origin, err = table.Lookup("1.9.9.9")
+			So(err, ShouldBeNil)
+			So(origin.ASNs, ShouldResemble, []int{64512})
+		})
+
+		Convey("I expect multi-origin routes to be preserved", func() {
+			origin, err := table.Lookup("2001:db8::1")
+			So(err, ShouldBeNil)
+			So(origin.ASNs, ShouldResemble, []int{64513, 64514})
+		})
+
+		Convey("I expect an error for unrouted addresses", func() {
+			_, err := table.Lookup("10.0.0.1")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,166 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const decimalPlaces = 6
+
+var errDecimal = errors.New("geoip2: invalid decimal")
+
+// Decimal is an exact fixed-point number with six decimal places.  Scores
+// decode into Decimal rather than float64 so that results compare and
+// persist exactly as MaxMind reported them.
+type Decimal struct {
+	units int64
+}
+
+// ParseDecimal parses a JSON number literal such as "1.25", "-0.5" or
+// "1e-3".  Digits beyond the sixth decimal place are rounded, half away
+// from zero.
+func ParseDecimal(s string) (Decimal, error) {
+	negative := strings.HasPrefix(s, "-")
+	if negative {
+		s = s[1:]
+	}
+
+	exponent := 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e := s[i+1:]
+		if strings.HasPrefix(e, "+") || strings.HasPrefix(e, "-") {
+			e = e[1:]
+		}
+		if e == "" || strings.Trim(e, "0123456789") != "" {
+			return Decimal{}, errDecimal
+		}
+		v, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, errDecimal
+		}
+		s, exponent = s[:i], int(v)
+	}
+
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+		if fraction == "" {
+			return Decimal{}, errDecimal
+		}
+	}
+	if whole == "" {
+		return Decimal{}, errDecimal
+	}
+	for _, r := range whole + fraction {
+		if r < '0' || r > '9' {
+			return Decimal{}, errDecimal
+		}
+	}
+
+	// units are the digits shifted left by shift places
+	digits := strings.TrimLeft(whole+fraction, "0")
+	shift := decimalPlaces + exponent - len(fraction)
+	if digits == "" {
+		return Decimal{}, nil
+	}
+
+	var units int64
+	switch {
+	case shift >= 0:
+		if len(digits)+shift > 19 {
+			return Decimal{}, errDecimal
+		}
+		v, err := strconv.ParseInt(digits+strings.Repeat("0", shift), 10, 64)
+		if err != nil {
+			return Decimal{}, errDecimal
+		}
+		units = v
+	case len(digits)+shift >= 0:
+		kept, dropped := digits[:len(digits)+shift], digits[len(digits)+shift:]
+		if kept != "" {
+			v, err := strconv.ParseInt(kept, 10, 64)
+			if err != nil {
+				return Decimal{}, errDecimal
+			}
+			units = v
+		}
+		if dropped[0] >= '5' {
+			units++
+		}
+	}
+	if negative {
+		units = -units
+	}
+	return Decimal{units: units}, nil
+}
+
+func (d Decimal) String() string {
+	units := d.units
+	sign := ""
+	if units < 0 {
+		sign, units = "-", -units
+	}
+
+	s := strconv.FormatInt(units, 10)
+	if len(s) <= decimalPlaces {
+		s = strings.Repeat("0", decimalPlaces-len(s)+1) + s
+	}
+	whole, fraction := s[:len(s)-decimalPlaces], strings.TrimRight(s[len(s)-decimalPlaces:], "0")
+	if fraction == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + fraction
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+func (d Decimal) IsZero() bool {
+	return d.units == 0
+}
+
+// Cmp returns -1, 0 or +1 depending on whether d is less than, equal to or
+// greater than other
+func (d Decimal) Cmp(other Decimal) int {
+	switch {
+	case d.units < other.units:
+		return -1
+	case d.units > other.units:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	v, err := ParseDecimal(string(data))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
@@ -0,0 +1,93 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDecimal(t *testing.T) {
+	Convey("Given JSON scores", t, func() {
+		Convey("I expect them to round-trip exactly", func() {
+			for _, text := range []string{"0", "1.3", "0.1", "-0.05", "99.999999", "12"} {
+				d := Decimal{}
+				So(json.Unmarshal([]byte(text), &d), ShouldBeNil)
+
+				data, err := json.Marshal(d)
+				So(err, ShouldBeNil)
+				So(string(data), ShouldEqual, text)
+			}
+		})
+
+		Convey("I expect equal values to compare equal regardless of form", func() {
+			a, _ := ParseDecimal("0.30")
+			b, _ := ParseDecimal("0.3")
+			So(a, ShouldResemble, b)
+			So(a.Cmp(b), ShouldEqual, 0)
+
+			c, _ := ParseDecimal("0.31")
+			So(a.Cmp(c), ShouldEqual, -1)
+			So(c.Cmp(a), ShouldEqual, 1)
+			So(c.Float64(), ShouldEqual, 0.31)
+		})
+
+		Convey("I expect exponents to be accepted", func() {
+			for text, want := range map[string]string{
+				"1e3":      "1000",
+				"1E+2":     "100",
+				"1e-3":     "0.001",
+				"-2.5e-1":  "-0.25",
+				"12.5e-7":  "0.000001",
+				"0e9":      "0",
+				"1e-9":     "0",
+				"0.0001e2": "0.01",
+			} {
+				d, err := ParseDecimal(text)
+				So(err, ShouldBeNil)
+				So(d.String(), ShouldEqual, want)
+			}
+		})
+
+		Convey("I expect digits beyond six places to be rounded", func() {
+			for text, want := range map[string]string{
+				"0.1234567":  "0.123457",
+				"0.1234564":  "0.123456",
+				"-0.0000005": "-0.000001",
+				"0.9999999":  "1",
+				"0.00000049": "0",
+			} {
+				d, err := ParseDecimal(text)
+				So(err, ShouldBeNil)
+				So(d.String(), ShouldEqual, want)
+			}
+		})
+
+		Convey("I expect malformed or overflowing numbers to be rejected", func() {
+			for _, text := range []string{"", "-", ".5", "1.", "abc", "1e", "1e+", "1e--2", "1e+-2", "1e2.5", "e3", "1e30", "99999999999999"} {
+				_, err := ParseDecimal(text)
+				So(err, ShouldNotBeNil)
+			}
+		})
+
+		Convey("I expect the static IP score to decode as a Decimal", func() {
+			traits := Traits{}
+			So(json.Unmarshal([]byte(`{"static_ip_score": 1.3}`), &traits), ShouldBeNil)
+			So(traits.StaticIpScore.String(), ShouldEqual, "1.3")
+		})
+	})
+}
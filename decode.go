@@ -0,0 +1,37 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import "strings"
+
+// FieldError describes a field of the response that could not be decoded
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// DecodeError is returned alongside the successfully decoded portion of a
+// response when some of its fields could not be decoded
+type DecodeError struct {
+	Fields []FieldError
+}
+
+func (e DecodeError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		fields = append(fields, f.Field)
+	}
+	return "geoip2: unable to decode " + strings.Join(fields, ", ")
+}
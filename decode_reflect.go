@@ -0,0 +1,181 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// decode unmarshals data into v.  When that fails for anything other than
+// malformed JSON, each field is decoded independently so that one bad value
+// doesn't discard the rest of the response.
+func decode(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*json.SyntaxError); ok {
+		return err
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	rv.Set(reflect.Zero(rv.Type()))
+
+	errs := []FieldError{}
+	decodeFields(data, rv, "", &errs)
+	if len(errs) == 0 {
+		return nil
+	}
+	return DecodeError{Fields: errs}
+}
+
+var (
+	unmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+func customDecoding(t reflect.Type) bool {
+	return reflect.PtrTo(t).Implements(unmarshalerType) || reflect.PtrTo(t).Implements(textUnmarshalerType)
+}
+
+func decodeFields(data []byte, v reflect.Value, path string, errs *[]FieldError) {
+	err := json.Unmarshal(data, v.Addr().Interface())
+	if err == nil {
+		return
+	}
+
+	// descend into structs and slices so the error is reported against the
+	// innermost offending field
+	switch {
+	case v.Kind() == reflect.Struct && !customDecoding(v.Type()):
+		raw := map[string]json.RawMessage{}
+		if json.Unmarshal(data, &raw) != nil {
+			break
+		}
+		v.Set(reflect.Zero(v.Type()))
+		for i := 0; i < v.NumField(); i++ {
+			name := jsonName(v.Type().Field(i))
+			if name == "" {
+				continue
+			}
+			if value, ok := raw[name]; ok {
+				decodeFields(value, v.Field(i), join(path, name), errs)
+			}
+		}
+		return
+
+	case v.Kind() == reflect.Slice:
+		raw := []json.RawMessage{}
+		if json.Unmarshal(data, &raw) != nil {
+			break
+		}
+		v.Set(reflect.MakeSlice(v.Type(), len(raw), len(raw)))
+		for i, value := range raw {
+			decodeFields(value, v.Index(i), path+"["+strconv.Itoa(i)+"]", errs)
+		}
+		return
+	}
+
+	v.Set(reflect.Zero(v.Type()))
+	*errs = append(*errs, FieldError{Field: path, Err: err})
+}
+
+func jsonName(f reflect.StructField) string {
+	if f.PkgPath != "" {
+		return ""
+	}
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+func join(path, name string) string {
+	if path == "" || name == "" {
+		return path + name
+	}
+	return path + "." + name
+}
+
+// sectionTypes caches, by the joined names of the sections, a struct type
+// holding only those fields of Response, so that encoding/json skips the
+// others without allocating for them
+var sectionTypes sync.Map
+
+type sectionType struct {
+	typ    reflect.Type
+	fields []int // the index in Response of each field of typ
+}
+
+func sectionTypeOf(sections []string) *sectionType {
+	key := strings.Join(sections, ",")
+	if v, ok := sectionTypes.Load(key); ok {
+		return v.(*sectionType)
+	}
+
+	responseType := reflect.TypeOf(Response{})
+	st := &sectionType{}
+	var fields []reflect.StructField
+	for i := 0; i < responseType.NumField(); i++ {
+		field := responseType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		for _, section := range sections {
+			if field.IsExported() && name == section {
+				fields = append(fields, reflect.StructField{Name: field.Name, Type: field.Type, Tag: field.Tag})
+				st.fields = append(st.fields, i)
+			}
+		}
+	}
+	st.typ = reflect.StructOf(fields)
+	v, _ := sectionTypes.LoadOrStore(key, st)
+	return v.(*sectionType)
+}
+
+// decodeSections decodes only the named sections of data into r.  An
+// answer that doesn't decode cleanly takes the path of decode, for its
+// per-field errors.
+func decodeSections(data []byte, r *Response, sections []string) error {
+	st := sectionTypeOf(sections)
+	v := reflect.New(st.typ)
+	if err := json.Unmarshal(data, v.Interface()); err != nil {
+		err = decode(data, r)
+		if e, ok := err.(DecodeError); ok {
+			if e.Fields = keepSections(r, sections, e.Fields); len(e.Fields) == 0 {
+				return nil
+			}
+			return e
+		}
+		keepSections(r, sections, nil)
+		return err
+	}
+
+	rv := reflect.ValueOf(r).Elem()
+	for i, index := range st.fields {
+		rv.Field(index).Set(v.Elem().Field(i))
+	}
+	return nil
+}
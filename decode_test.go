@@ -0,0 +1,98 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDecodeError(t *testing.T) {
+	Convey("Given a response with malformed fields", t, func() {
+		body := `{
+			"city":         {"confidence": "high", "geoname_id": 54321},
+			"country":      {"iso_code": "US"},
+			"subdivisions": [{"iso_code": "CA"}, {"iso_code": 42}],
+			"traits":       {"network": "not-a-network", "isp": "Linkem spa"}
+		}`
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(context.Context, *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		})
+
+		Convey("When I call #City", func() {
+			resp, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect a DecodeError listing each offending field", func() {
+				So(err, ShouldNotBeNil)
+				e, ok := err.(DecodeError)
+				So(ok, ShouldBeTrue)
+
+				fields := []string{}
+				for _, f := range e.Fields {
+					fields = append(fields, f.Field)
+				}
+				So(fields, ShouldResemble, []string{"city.confidence", "subdivisions[1].iso_code", "traits.network"})
+			})
+
+			Convey("I expect the remaining fields to be decoded", func() {
+				So(resp.City.GeoNameId, ShouldEqual, 54321)
+				So(resp.Country.IsoCode, ShouldEqual, "US")
+				So(len(resp.Subdivisions), ShouldEqual, 2)
+				So(resp.Subdivisions[0].IsoCode, ShouldEqual, "CA")
+				So(resp.Traits.Isp, ShouldEqual, "Linkem spa")
+				So(resp.DecodeErrors(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When I call #City leniently", func() {
+			resp, err := api.Clone(WithLenientDecoding()).City(nil, "1.2.3.4")
+
+			Convey("I expect the partial answer as a success, listing the offending fields", func() {
+				So(err, ShouldBeNil)
+				So(resp.Country.IsoCode, ShouldEqual, "US")
+				So(resp.Traits.Isp, ShouldEqual, "Linkem spa")
+
+				fields := []string{}
+				for _, f := range resp.DecodeErrors() {
+					fields = append(fields, f.Field)
+					So(f.Err, ShouldNotBeNil)
+				}
+				So(fields, ShouldResemble, []string{"city.confidence", "subdivisions[1].iso_code", "traits.network"})
+			})
+		})
+	})
+
+	Convey("Given a response that is not valid JSON", t, func() {
+		resp := Response{}
+		err := decode([]byte(`{"city": `), &resp)
+
+		Convey("I expect a syntax error", func() {
+			So(err, ShouldNotBeNil)
+			_, ok := err.(DecodeError)
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
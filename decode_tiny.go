@@ -0,0 +1,52 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build tinygo || geoip2_tiny
+// +build tinygo geoip2_tiny
+
+// The tinygo and geoip2_tiny tags build a reduced package for TinyGo and
+// other constrained targets.  Decoding makes a single encoding/json pass
+// without the per-field fallback, and the reflection and template based
+// helpers (Diff, Merge, Renderer, MapReport) and the bundled names table
+// are left out.
+
+package geoip2
+
+import "encoding/json"
+
+// decode unmarshals data into v, reporting a type mismatch as a DecodeError
+// for the first offending field.  encoding/json keeps decoding the remaining
+// fields after a type mismatch, so v still holds the rest of the response.
+func decode(data []byte, v interface{}) error {
+	err := json.Unmarshal(data, v)
+	if e, ok := err.(*json.UnmarshalTypeError); ok {
+		return DecodeError{Fields: []FieldError{{Field: e.Field, Err: err}}}
+	}
+	return err
+}
+
+// decodeSections decodes data whole into r and keeps the named sections;
+// it saves no allocation over decode, but answers look the same in either
+// build
+func decodeSections(data []byte, r *Response, sections []string) error {
+	err := decode(data, r)
+	if e, ok := err.(DecodeError); ok {
+		if e.Fields = keepSections(r, sections, e.Fields); len(e.Fields) == 0 {
+			return nil
+		}
+		return e
+	}
+	keepSections(r, sections, nil)
+	return err
+}
@@ -0,0 +1,39 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build tinygo || geoip2_tiny
+// +build tinygo geoip2_tiny
+
+package geoip2
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDecodeTiny(t *testing.T) {
+	Convey("Given a response with a malformed field", t, func() {
+		resp := Response{}
+		err := decode([]byte(`{"city": {"confidence": "high", "geoname_id": 54321}, "country": {"iso_code": "US"}}`), &resp)
+
+		Convey("I expect a DecodeError for the field and the rest decoded", func() {
+			e, ok := err.(DecodeError)
+			So(ok, ShouldBeTrue)
+			So(e.Fields[0].Field, ShouldEqual, "city.confidence")
+			So(resp.City.GeoNameId, ShouldEqual, 54321)
+			So(resp.Country.IsoCode, ShouldEqual, "US")
+		})
+	})
+}
@@ -0,0 +1,117 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync/atomic"
+)
+
+// ErrNoCredentials is returned by NewFromEnv, and so by the package level
+// lookups, when the environment holds no MaxMind credentials
+var ErrNoCredentials = errors.New("geoip2: MAXMIND_ACCOUNT_ID and MAXMIND_LICENSE_KEY must be set")
+
+var defaultApi atomic.Pointer[Api]
+
+type defaultKey struct{}
+
+// NewFromEnv returns an Api using the account in MAXMIND_ACCOUNT_ID and the
+// key in MAXMIND_LICENSE_KEY.  MAXMIND_USER_ID, as read by the command line
+// tools, is accepted in place of MAXMIND_ACCOUNT_ID.
+func NewFromEnv(opts ...Option) (*Api, error) {
+	userId := os.Getenv("MAXMIND_ACCOUNT_ID")
+	if userId == "" {
+		userId = os.Getenv("MAXMIND_USER_ID")
+	}
+	licenseKey := os.Getenv("MAXMIND_LICENSE_KEY")
+	if userId == "" || licenseKey == "" {
+		return nil, ErrNoCredentials
+	}
+	return New(userId, licenseKey, opts...), nil
+}
+
+// Default returns the client behind LookupCountry, LookupCity and
+// LookupInsights: the one given to SetDefault or, failing that, one made
+// with NewFromEnv on first use
+func Default() (*Api, error) {
+	if api := defaultApi.Load(); api != nil {
+		return api, nil
+	}
+	api, err := NewFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if defaultApi.CompareAndSwap(nil, api) {
+		return api, nil
+	}
+	return defaultApi.Load(), nil
+}
+
+// SetDefault replaces the default client, e.g. to add a cache or a
+// timeout; nil reverts to reading the environment
+func SetDefault(api *Api) {
+	defaultApi.Store(api)
+}
+
+// WithDefaultClient returns a context whose package level lookups use api
+// in place of the default, so that a tool or test can scope a client to a
+// call tree without touching global state
+func WithDefaultClient(ctx context.Context, api *Api) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, defaultKey{}, api)
+}
+
+func defaultFor(ctx context.Context) (*Api, error) {
+	if ctx != nil {
+		if api, _ := ctx.Value(defaultKey{}).(*Api); api != nil {
+			return api, nil
+		}
+	}
+	return Default()
+}
+
+// LookupCountry, LookupCity and LookupInsights look up the address with the
+// client from WithDefaultClient or Default, as http.Get does with
+// http.DefaultClient, for scripts and small tools that make a single
+// lookup:
+//
+//	resp, err := geoip2.LookupCity(ctx, "81.2.69.160")
+func LookupCountry(ctx context.Context, ipAddress string) (Response, error) {
+	api, err := defaultFor(ctx)
+	if err != nil {
+		return Response{}, err
+	}
+	return api.Country(ctx, ipAddress)
+}
+
+func LookupCity(ctx context.Context, ipAddress string) (Response, error) {
+	api, err := defaultFor(ctx)
+	if err != nil {
+		return Response{}, err
+	}
+	return api.City(ctx, ipAddress)
+}
+
+func LookupInsights(ctx context.Context, ipAddress string) (Response, error) {
+	api, err := defaultFor(ctx)
+	if err != nil {
+		return Response{}, err
+	}
+	return api.Insights(ctx, ipAddress)
+}
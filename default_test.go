@@ -0,0 +1,95 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDefault(t *testing.T) {
+	Convey("Given no credentials in the environment", t, func() {
+		t.Setenv("MAXMIND_ACCOUNT_ID", "")
+		t.Setenv("MAXMIND_USER_ID", "")
+		t.Setenv("MAXMIND_LICENSE_KEY", "")
+		SetDefault(nil)
+
+		Convey("I expect package level lookups to fail clearly", func() {
+			_, err := LookupCity(context.Background(), "1.2.3.4")
+			So(err, ShouldEqual, ErrNoCredentials)
+			_, err = Default()
+			So(err, ShouldEqual, ErrNoCredentials)
+		})
+	})
+
+	Convey("Given credentials in the environment", t, func() {
+		var user string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			user, _, _ = req.BasicAuth()
+			w.Write([]byte(sample))
+		}))
+		defer server.Close()
+
+		t.Setenv("MAXMIND_ACCOUNT_ID", "")
+		t.Setenv("MAXMIND_USER_ID", "blah-user-id")
+		t.Setenv("MAXMIND_LICENSE_KEY", "blah-license-key")
+		SetDefault(nil)
+		defer SetDefault(nil)
+
+		Convey("When I read them", func() {
+			api, err := NewFromEnv(WithBaseURL(server.URL))
+			So(err, ShouldBeNil)
+
+			Convey("I expect MAXMIND_USER_ID to stand in for the account", func() {
+				_, err := api.City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+				So(user, ShouldEqual, "blah-user-id")
+
+				t.Setenv("MAXMIND_ACCOUNT_ID", "blah-account-id")
+				api, err = NewFromEnv(WithBaseURL(server.URL))
+				So(err, ShouldBeNil)
+				_, err = api.City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+				So(user, ShouldEqual, "blah-account-id")
+			})
+		})
+
+		Convey("When I set a default", func() {
+			SetDefault(New("default-user-id", "blah-license-key", WithBaseURL(server.URL)))
+			resp, err := LookupCity(nil, "1.2.3.4")
+
+			Convey("I expect the package level lookups to use it", func() {
+				So(err, ShouldBeNil)
+				So(resp.City.Confidence, ShouldEqual, 25)
+				So(user, ShouldEqual, "default-user-id")
+			})
+
+			Convey("I expect a context scoped client to take precedence", func() {
+				ctx := WithDefaultClient(context.Background(), New("scoped-user-id", "blah-license-key", WithBaseURL(server.URL)))
+				_, err := LookupCountry(ctx, "1.2.3.4")
+				So(err, ShouldBeNil)
+				So(user, ShouldEqual, "scoped-user-id")
+
+				_, err = LookupInsights(context.Background(), "1.2.3.4")
+				So(err, ShouldBeNil)
+				So(user, ShouldEqual, "default-user-id")
+			})
+		})
+	})
+}
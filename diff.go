@@ -0,0 +1,159 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Change is a field that differs between two responses.  Field is the JSON
+// path of the value, e.g. "city.names.en" or "subdivisions[0].iso_code".
+type Change struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old,omitempty"`
+	New   interface{} `json:"new,omitempty"`
+}
+
+type diffConfig struct {
+	ignore map[string]bool
+}
+
+// DiffOption customises Diff and Equal
+type DiffOption func(*diffConfig)
+
+// IgnoreFields excludes fields from comparison.  A name containing a dot
+// matches that exact path, e.g. "location.accuracy_radius"; a bare name
+// such as "confidence" matches the field wherever it occurs.
+func IgnoreFields(fields ...string) DiffOption {
+	return func(c *diffConfig) {
+		for _, field := range fields {
+			c.ignore[field] = true
+		}
+	}
+}
+
+// IgnoreVolatile excludes fields that change between lookups without the
+// answer changing: confidences, the accuracy radius and the query balance
+func IgnoreVolatile() DiffOption {
+	return IgnoreFields("confidence", "location.accuracy_radius", "maxmind.queries_remaining")
+}
+
+func (c *diffConfig) ignored(path string) bool {
+	if c.ignore[path] {
+		return true
+	}
+	name := path[strings.LastIndex(path, ".")+1:]
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name = name[:i]
+	}
+	return c.ignore[name]
+}
+
+// Equal reports whether a and b have no differences according to Diff
+func Equal(a, b Response, opts ...DiffOption) bool {
+	return len(Diff(a, b, opts...)) == 0
+}
+
+// Diff returns the fields that differ between a and b in a stable order
+func Diff(a, b Response, opts ...DiffOption) []Change {
+	config := &diffConfig{ignore: map[string]bool{}}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	changes := []Change{}
+	diffValues(config, "", reflect.ValueOf(a), reflect.ValueOf(b), &changes)
+	return changes
+}
+
+func diffValues(config *diffConfig, path string, a, b reflect.Value, changes *[]Change) {
+	if path != "" && config.ignored(path) {
+		return
+	}
+
+	switch {
+	case !a.IsValid() || !b.IsValid():
+		// present on only one side: a map key or slice element
+		change := Change{Field: path}
+		if a.IsValid() {
+			change.Old = a.Interface()
+		}
+		if b.IsValid() {
+			change.New = b.Interface()
+		}
+		*changes = append(*changes, change)
+
+	case a.Kind() == reflect.Struct && !leaf(a.Type()):
+		for i := 0; i < a.NumField(); i++ {
+			name := jsonName(a.Type().Field(i))
+			if name == "" {
+				continue
+			}
+			diffValues(config, join(path, name), a.Field(i), b.Field(i), changes)
+		}
+
+	case a.Kind() == reflect.Map:
+		keys := map[string]bool{}
+		for _, k := range a.MapKeys() {
+			keys[k.String()] = true
+		}
+		for _, k := range b.MapKeys() {
+			keys[k.String()] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+
+		for _, k := range sorted {
+			key := reflect.ValueOf(k)
+			diffValues(config, join(path, k), a.MapIndex(key), b.MapIndex(key), changes)
+		}
+
+	case a.Kind() == reflect.Slice:
+		n := a.Len()
+		if b.Len() > n {
+			n = b.Len()
+		}
+		for i := 0; i < n; i++ {
+			var x, y reflect.Value
+			if i < a.Len() {
+				x = a.Index(i)
+			}
+			if i < b.Len() {
+				y = b.Index(i)
+			}
+			diffValues(config, path+"["+strconv.Itoa(i)+"]", x, y, changes)
+		}
+
+	default:
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			*changes = append(*changes, Change{Field: path, Old: a.Interface(), New: b.Interface()})
+		}
+	}
+}
+
+// leaf reports whether a struct is compared as a single value: types with
+// their own encoding, such as netip.Addr and Decimal
+func leaf(t reflect.Type) bool {
+	return customDecoding(t)
+}
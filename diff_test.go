@@ -0,0 +1,103 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"encoding/json"
+	"net/netip"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiff(t *testing.T) {
+	Convey("Given two copies of a complete maxmind response", t, func() {
+		a := Response{}
+		So(json.NewDecoder(strings.NewReader(sample)).Decode(&a), ShouldBeNil)
+		b := Response{}
+		So(json.NewDecoder(strings.NewReader(sample)).Decode(&b), ShouldBeNil)
+
+		Convey("I expect them to be equal", func() {
+			So(Equal(a, b), ShouldBeTrue)
+			So(Diff(a, b), ShouldBeEmpty)
+		})
+
+		Convey("When fields change", func() {
+			b.Country.IsoCode = "CA"
+			b.City.Names = map[string]string{"en": "Toronto", "xx": "Toronto"}
+			b.Subdivisions = append(b.Subdivisions, Subdivision{IsoCode: "ON"})
+			b.Traits.Network = netip.MustParsePrefix("1.2.0.0/16")
+
+			Convey("I expect each change with its path and values", func() {
+				changes := Diff(a, b)
+				fields := []string{}
+				for _, c := range changes {
+					fields = append(fields, c.Field)
+				}
+				So(fields, ShouldResemble, []string{
+					"city.names.de", "city.names.en", "city.names.es", "city.names.fr",
+					"city.names.ja", "city.names.pt-BR", "city.names.ru", "city.names.xx", "city.names.zh-CN",
+					"country.iso_code",
+					"subdivisions[1]",
+					"traits.network",
+				})
+				So(changes[1], ShouldResemble, Change{Field: "city.names.en", Old: "Los Angeles", New: "Toronto"})
+				So(changes[7], ShouldResemble, Change{Field: "city.names.xx", New: "Toronto"})
+				So(changes[9], ShouldResemble, Change{Field: "country.iso_code", Old: "US", New: "CA"})
+				So(changes[10].Old, ShouldBeNil)
+				So(Equal(a, b), ShouldBeFalse)
+			})
+		})
+
+		Convey("When a subdivision is dropped", func() {
+			b.Subdivisions = nil
+
+			Convey("I expect it reported as removed", func() {
+				changes := Diff(a, b)
+				So(changes, ShouldHaveLength, 1)
+				So(changes[0].Field, ShouldEqual, "subdivisions[0]")
+				So(changes[0].Old, ShouldResemble, a.Subdivisions[0])
+				So(changes[0].New, ShouldBeNil)
+			})
+		})
+
+		Convey("When only volatile fields change", func() {
+			b.City.Confidence = 99
+			b.Subdivisions[0].Confidence = 1
+			b.Location.AccuracyRadius = 500
+			b.MaxMind.QueriesRemaining = 1
+
+			Convey("I expect them to be ignored on request", func() {
+				So(len(Diff(a, b)), ShouldEqual, 4)
+				So(Equal(a, b, IgnoreVolatile()), ShouldBeTrue)
+			})
+		})
+
+		Convey("When I ignore specific fields", func() {
+			b.Traits.Isp = "other"
+			b.Country.IsoCode = "CA"
+
+			Convey("I expect only the other changes", func() {
+				changes := Diff(a, b, IgnoreFields("traits.isp"))
+				So(len(changes), ShouldEqual, 1)
+				So(changes[0].Field, ShouldEqual, "country.iso_code")
+			})
+		})
+	})
+}
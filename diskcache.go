@@ -0,0 +1,264 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DiskCache is a Cache that keeps each response in a file of its own under
+// a directory, so short-lived processes and restarts reuse earlier
+// lookups.  Like LRUCache it indexes responses by their network.  A file's
+// modification time is its expiry; once size responses are stored, those
+// closest to expiry are removed first.
+type DiskCache struct {
+	dir  string
+	size int
+
+	mutex   sync.Mutex
+	entries map[string]diskEntry   // file name -> entry
+	lengths map[string]map[int]int // service -> prefix length -> entries
+}
+
+type diskEntry struct {
+	service string
+	bits    int
+	expires time.Time
+}
+
+var _ Cache = (*DiskCache)(nil)
+
+// NewDiskCache returns a DiskCache holding up to size responses in dir,
+// which is created if need be.  Responses already in dir are reused and
+// expired ones removed.
+func NewDiskCache(dir string, size int) (*DiskCache, error) {
+	if size <= 0 {
+		size = 10000
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	c := &DiskCache{
+		dir:     dir,
+		size:    size,
+		entries: map[string]diskEntry{},
+		lengths: map[string]map[int]int{},
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, file := range files {
+		service, prefix, ok := parseDiskName(file.Name())
+		if !ok {
+			continue
+		}
+		info, err := file.Info()
+		if err != nil {
+			continue
+		}
+		if info.ModTime().Before(now) {
+			os.Remove(filepath.Join(dir, file.Name()))
+			continue
+		}
+		c.add(file.Name(), diskEntry{service: service, bits: prefix.Bits(), expires: info.ModTime()})
+	}
+	c.evict()
+	return c, nil
+}
+
+// diskName names the file for a service and network, e.g.
+// "city_01020300_24.json" for 1.2.3.0/24
+func diskName(service string, prefix netip.Prefix) string {
+	return service + "_" + hex.EncodeToString(prefix.Addr().AsSlice()) + "_" + strconv.Itoa(prefix.Bits()) + ".json"
+}
+
+func parseDiskName(name string) (string, netip.Prefix, bool) {
+	parts := strings.Split(strings.TrimSuffix(name, ".json"), "_")
+	if len(parts) != 3 || !strings.HasSuffix(name, ".json") {
+		return "", netip.Prefix{}, false
+	}
+	raw, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return "", netip.Prefix{}, false
+	}
+	addr, ok := netip.AddrFromSlice(raw)
+	if !ok {
+		return "", netip.Prefix{}, false
+	}
+	bits, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return "", netip.Prefix{}, false
+	}
+	prefix, err := addr.Prefix(bits)
+	if err != nil {
+		return "", netip.Prefix{}, false
+	}
+	return parts[0], prefix, true
+}
+
+func (c *DiskCache) Get(ctx context.Context, key string) (Response, bool) {
+	service, addr, ok := splitKey(key)
+	if !ok {
+		return Response{}, false
+	}
+
+	c.mutex.Lock()
+	bits := make([]int, 0, len(c.lengths[service]))
+	for n := range c.lengths[service] {
+		bits = append(bits, n)
+	}
+	c.mutex.Unlock()
+	sort.Sort(sort.Reverse(sort.IntSlice(bits)))
+
+	for _, n := range bits {
+		prefix, err := addr.Prefix(n)
+		if err != nil {
+			continue
+		}
+		if resp, ok := c.read(diskName(service, prefix)); ok {
+			resp.Traits.IpAddress = addr
+			return resp, true
+		}
+	}
+	return Response{}, false
+}
+
+// read returns the response in name unless it has expired or can't be read
+func (c *DiskCache) read(name string) (Response, bool) {
+	c.mutex.Lock()
+	entry, ok := c.entries[name]
+	if ok && time.Now().After(entry.expires) {
+		c.remove(name)
+		ok = false
+	}
+	c.mutex.Unlock()
+	if !ok {
+		return Response{}, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.dir, name))
+	if err != nil {
+		return Response{}, false
+	}
+	var resp Response
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return Response{}, false
+	}
+	return resp, true
+}
+
+func (c *DiskCache) Set(ctx context.Context, key string, resp Response, ttl time.Duration) {
+	service, addr, ok := splitKey(key)
+	if !ok {
+		return
+	}
+	prefix := netip.PrefixFrom(addr, addr.BitLen())
+	if resp.Traits.Network.IsValid() {
+		prefix = resp.Traits.Network.Masked()
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return
+	}
+
+	// write atomically so a reader never sees a partial response
+	name := diskName(service, prefix)
+	expires := time.Now().Add(ttl)
+	tmp, err := os.CreateTemp(c.dir, name+".*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	if err := os.Chtimes(tmp.Name(), expires, expires); err != nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err := os.Rename(tmp.Name(), filepath.Join(c.dir, name)); err != nil {
+		return
+	}
+	c.add(name, diskEntry{service: service, bits: prefix.Bits(), expires: expires})
+	c.evict()
+}
+
+// Len returns the number of cached responses, including expired ones not
+// yet removed
+func (c *DiskCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.entries)
+}
+
+func (c *DiskCache) add(name string, entry diskEntry) {
+	if _, ok := c.entries[name]; ok {
+		c.forget(name)
+	}
+	c.entries[name] = entry
+	if c.lengths[entry.service] == nil {
+		c.lengths[entry.service] = map[int]int{}
+	}
+	c.lengths[entry.service][entry.bits]++
+}
+
+// evict removes the entries closest to expiry until at most size remain
+func (c *DiskCache) evict() {
+	if len(c.entries) <= c.size {
+		return
+	}
+	names := make([]string, 0, len(c.entries))
+	for name := range c.entries {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return c.entries[names[i]].expires.Before(c.entries[names[j]].expires)
+	})
+	for _, name := range names[:len(names)-c.size] {
+		c.remove(name)
+	}
+}
+
+func (c *DiskCache) remove(name string) {
+	c.forget(name)
+	os.Remove(filepath.Join(c.dir, name))
+}
+
+func (c *DiskCache) forget(name string) {
+	entry := c.entries[name]
+	delete(c.entries, name)
+	if c.lengths[entry.service][entry.bits]--; c.lengths[entry.service][entry.bits] == 0 {
+		delete(c.lengths[entry.service], entry.bits)
+	}
+}
@@ -0,0 +1,89 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiskCache(t *testing.T) {
+	Convey("Given a DiskCache", t, func() {
+		dir := t.TempDir()
+		cache, err := NewDiskCache(dir, 2)
+		So(err, ShouldBeNil)
+
+		resp := MockResponse("1.2.3.4")
+		resp.Traits.Network = netip.MustParsePrefix("1.2.3.0/24")
+		cache.Set(nil, "city/1.2.3.4", resp, time.Hour)
+
+		Convey("I expect the response to answer for its whole network", func() {
+			got, ok := cache.Get(nil, "city/1.2.3.7")
+			So(ok, ShouldBeTrue)
+			So(got.Traits.IpAddress.String(), ShouldEqual, "1.2.3.7")
+			So(got.City.Name(), ShouldEqual, resp.City.Name())
+
+			_, ok = cache.Get(nil, "country/1.2.3.4")
+			So(ok, ShouldBeFalse)
+			_, ok = cache.Get(nil, "city/1.2.4.1")
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("I expect a new DiskCache on the same directory to reuse it", func() {
+			reopened, err := NewDiskCache(dir, 2)
+			So(err, ShouldBeNil)
+			So(reopened.Len(), ShouldEqual, 1)
+			_, ok := reopened.Get(nil, "city/1.2.3.99")
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey("I expect expired responses to be dropped", func() {
+			cache.Set(nil, "city/5.6.7.8", MockResponse("5.6.7.8"), -time.Second)
+			_, ok := cache.Get(nil, "city/5.6.7.8")
+			So(ok, ShouldBeFalse)
+			So(cache.Len(), ShouldEqual, 1)
+
+			cache.Set(nil, "city/5.6.7.9", MockResponse("5.6.7.9"), -time.Second)
+			reopened, err := NewDiskCache(dir, 2)
+			So(err, ShouldBeNil)
+			So(reopened.Len(), ShouldEqual, 1)
+		})
+
+		Convey("I expect the responses closest to expiry to be evicted past the size", func() {
+			cache.Set(nil, "city/5.6.7.8", MockResponse("5.6.7.8"), time.Minute)
+			cache.Set(nil, "city/9.9.9.9", MockResponse("9.9.9.9"), 2*time.Hour)
+			So(cache.Len(), ShouldEqual, 2)
+			_, ok := cache.Get(nil, "city/5.6.7.8")
+			So(ok, ShouldBeFalse)
+
+			files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+			So(len(files), ShouldEqual, 2)
+		})
+
+		Convey("I expect unrelated files to be left alone", func() {
+			os.WriteFile(filepath.Join(dir, "README"), []byte("hello"), 0o644)
+			reopened, err := NewDiskCache(dir, 2)
+			So(err, ShouldBeNil)
+			So(reopened.Len(), ShouldEqual, 1)
+			_, err = os.Stat(filepath.Join(dir, "README"))
+			So(err, ShouldBeNil)
+		})
+	})
+}
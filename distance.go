@@ -0,0 +1,80 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import "math"
+
+// DistanceKm returns the great-circle distance in kilometers between two
+// points given in degrees, using the haversine formula
+// http://www.movable-type.co.uk/scripts/latlong.html#distance
+func DistanceKm(lat1, lon1, lat2, lon2 float64) float64 {
+	lat1r := lat1 * math.Pi / 180
+	lat2r := lat2 * math.Pi / 180
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLon := (lon2 - lon1) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1r)*math.Cos(lat2r)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	// rounding can take antipodal points just past 1
+	a = math.Min(1, math.Max(0, a))
+	return 2 * earthRadiusKm * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// DistanceTo returns the distance in kilometers from the location to the
+// point at lat, lon; it is NaN when the location has no coordinates, so that
+// comparisons with it are false
+func (l Location) DistanceTo(lat, lon float64) float64 {
+	if !l.hasCoordinates() {
+		return math.NaN()
+	}
+	return DistanceKm(l.Latitude, l.Longitude, lat, lon)
+}
+
+// DistanceToLocation returns the distance in kilometers between two
+// locations; see DistanceTo
+func (l Location) DistanceToLocation(other Location) float64 {
+	if !other.hasCoordinates() {
+		return math.NaN()
+	}
+	return l.DistanceTo(other.Latitude, other.Longitude)
+}
+
+// DistanceTo returns the distance in kilometers from the response's location
+// to the point at lat, lon, measuring from its country's centroid when it
+// has no coordinates; see WithCentroidFallback
+func (r Response) DistanceTo(lat, lon float64) float64 {
+	r, _ = r.WithCentroidFallback()
+	return r.Location.DistanceTo(lat, lon)
+}
+
+// MayBeWithinKm is Location.MayBeWithinKm for the response's location,
+// falling back to its country's centroid and the centroid's wide accuracy
+// radius when it has no coordinates
+func (r Response) MayBeWithinKm(lat, lon, km float64) bool {
+	r, _ = r.WithCentroidFallback()
+	return r.Location.MayBeWithinKm(lat, lon, km)
+}
+
+// WithinKm reports whether the location's coordinates are within km of
+// lat, lon.  It ignores the accuracy radius; see MayBeWithinKm.
+func (l Location) WithinKm(lat, lon, km float64) bool {
+	return l.DistanceTo(lat, lon) <= km
+}
+
+// MayBeWithinKm reports whether any point within the accuracy radius is
+// within km of lat, lon, i.e. whether the visitor could be there.  Without
+// an accuracy radius it is WithinKm.
+func (l Location) MayBeWithinKm(lat, lon, km float64) bool {
+	return l.DistanceTo(lat, lon)-float64(l.AccuracyRadius) <= km
+}
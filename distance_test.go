@@ -0,0 +1,48 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDistance(t *testing.T) {
+	Convey("Given two cities", t, func() {
+		paris := Location{Latitude: 48.8566, Longitude: 2.3522, AccuracyRadius: 20}
+		london := Location{Latitude: 51.5074, Longitude: -0.1278}
+
+		Convey("I expect the great-circle distance between them", func() {
+			So(paris.DistanceToLocation(london), ShouldAlmostEqual, 343.5, 0.5)
+			So(DistanceKm(0, 0, 0, 180), ShouldAlmostEqual, math.Pi*earthRadiusKm, 1e-6)
+			So(DistanceKm(10, 20, 10, 20), ShouldEqual, 0)
+		})
+
+		Convey("I expect radius checks to honour the accuracy radius only when asked", func() {
+			So(paris.WithinKm(london.Latitude, london.Longitude, 350), ShouldBeTrue)
+			So(paris.WithinKm(london.Latitude, london.Longitude, 330), ShouldBeFalse)
+			So(paris.MayBeWithinKm(london.Latitude, london.Longitude, 330), ShouldBeTrue)
+			So(paris.MayBeWithinKm(london.Latitude, london.Longitude, 300), ShouldBeFalse)
+		})
+
+		Convey("I expect a location without coordinates to be nowhere", func() {
+			So(math.IsNaN(Location{}.DistanceTo(0, 0)), ShouldBeTrue)
+			So(Location{}.WithinKm(0, 0, 1000), ShouldBeFalse)
+			So(paris.DistanceToLocation(Location{}), ShouldNotEqual, paris.DistanceToLocation(Location{}))
+		})
+	})
+}
@@ -0,0 +1,113 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"net/netip"
+	"time"
+)
+
+// Enricher adds supplementary data about an IP address alongside the
+// MaxMind response.  The value returned by Enrich is keyed by Name.
+type Enricher interface {
+	Name() string
+	Enrich(ctx context.Context, ipAddress string, resp Response) (interface{}, error)
+}
+
+// Provenance records where a result came from and when.  Resolver is the
+// web service that answered, e.g. "insights", and Retrieved when it did;
+// both are unknown for responses that weren't looked up through an Api,
+// in which case Retrieved is the time of enrichment.
+type Provenance struct {
+	Resolver  string       `json:"resolver,omitempty"`
+	Cached    bool         `json:"cached,omitempty"`
+	Network   netip.Prefix `json:"network,omitzero"`
+	Retrieved time.Time    `json:"retrieved,omitzero"`
+	Enriched  time.Time    `json:"enriched,omitzero"`
+}
+
+// EnrichedResult is the envelope handed to downstream consumers: the
+// MaxMind response, the output of each enricher, the risk assessment and
+// the provenance of the answer.
+type EnrichedResult struct {
+	IpAddress   string                 `json:"ip_address,omitempty"`
+	Response    Response               `json:"response"`
+	Enrichments map[string]interface{} `json:"enrichments,omitempty"`
+	Errors      map[string]string      `json:"errors,omitempty"`
+	RiskScore   float64                `json:"risk_score,omitempty"`
+	Decision    string                 `json:"decision,omitempty"`
+	Provenance  Provenance             `json:"provenance"`
+}
+
+// riskScores places each RiskLevel on the 0 to 100 scale of RiskScore
+var riskScores = map[RiskLevel]float64{
+	RiskLow:    0,
+	RiskMedium: 50,
+	RiskHigh:   100,
+}
+
+// Enrichment returns the output of the named enricher
+func (e EnrichedResult) Enrichment(name string) (interface{}, bool) {
+	v, ok := e.Enrichments[name]
+	return v, ok
+}
+
+// Enrich runs each enricher against resp.  Enricher failures are recorded
+// in Errors rather than failing the whole result.  Insights responses are
+// also assessed under the default AssessPolicy: the risk level is scored
+// 0, 50 or 100 and the recommended action becomes the Decision.
+func Enrich(ctx context.Context, ipAddress string, resp Response, enrichers ...Enricher) EnrichedResult {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	result := EnrichedResult{
+		IpAddress:   ipAddress,
+		Response:    resp,
+		Enrichments: map[string]interface{}{},
+	}
+	for _, enricher := range enrichers {
+		v, err := enricher.Enrich(ctx, ipAddress, resp)
+		if err != nil {
+			if result.Errors == nil {
+				result.Errors = map[string]string{}
+			}
+			result.Errors[enricher.Name()] = err.Error()
+			continue
+		}
+		result.Enrichments[enricher.Name()] = v
+	}
+	meta := resp.Meta()
+	now := time.Now()
+	result.Provenance = Provenance{
+		Resolver:  meta.Service,
+		Cached:    meta.Cached,
+		Network:   resp.Traits.Network,
+		Retrieved: meta.Retrieved,
+		Enriched:  now,
+	}
+	if result.Provenance.Retrieved.IsZero() {
+		result.Provenance.Retrieved = now
+	}
+
+	if meta.Service == "insights" {
+		verdict := resp.Assess(AssessPolicy{})
+		result.RiskScore = riskScores[verdict.Risk]
+		result.Decision = verdict.Action.String()
+	}
+
+	return result
+}
@@ -0,0 +1,112 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type funcEnricher struct {
+	name string
+	fn   func(ipAddress string, resp Response) (interface{}, error)
+}
+
+func (f funcEnricher) Name() string {
+	return f.name
+}
+
+func (f funcEnricher) Enrich(ctx context.Context, ipAddress string, resp Response) (interface{}, error) {
+	return f.fn(ipAddress, resp)
+}
+
+func TestEnrich(t *testing.T) {
+	Convey("Given a response and several enrichers", t, func() {
+		resp := Response{
+			Country: Country{IsoCode: "US"},
+			Traits:  Traits{Network: netip.MustParsePrefix("1.2.3.0/24")},
+		}
+		ok := funcEnricher{name: "ok", fn: func(ipAddress string, resp Response) (interface{}, error) {
+			return resp.Country.IsoCode + ":" + ipAddress, nil
+		}}
+		broken := funcEnricher{name: "broken", fn: func(string, Response) (interface{}, error) {
+			return nil, errors.New("boom")
+		}}
+
+		Convey("When I enrich the response", func() {
+			result := Enrich(nil, "1.2.3.4", resp, ok, broken)
+
+			Convey("I expect outputs keyed by enricher name", func() {
+				v, found := result.Enrichment("ok")
+				So(found, ShouldBeTrue)
+				So(v, ShouldEqual, "US:1.2.3.4")
+				So(result.Response.Country.IsoCode, ShouldEqual, "US")
+				So(result.Provenance.Network.String(), ShouldEqual, "1.2.3.0/24")
+				So(result.Provenance.Enriched.IsZero(), ShouldBeFalse)
+			})
+
+			Convey("I expect provenance without a lookup to fall back to the clock", func() {
+				So(result.Provenance.Resolver, ShouldEqual, "")
+				So(result.Provenance.Retrieved, ShouldEqual, result.Provenance.Enriched)
+				So(result.RiskScore, ShouldEqual, 0)
+				So(result.Decision, ShouldEqual, "")
+			})
+
+			Convey("I expect failures to be recorded rather than returned", func() {
+				_, found := result.Enrichment("broken")
+				So(found, ShouldBeFalse)
+				So(result.Errors, ShouldResemble, map[string]string{"broken": "boom"})
+			})
+
+			Convey("I expect the envelope to encode as a single document", func() {
+				data, err := json.Marshal(result)
+				So(err, ShouldBeNil)
+				So(string(data), ShouldContainSubstring, `"enrichments":{"ok":"US:1.2.3.4"}`)
+			})
+		})
+	})
+
+	Convey("Given an Insights response from the web service", t, func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Write([]byte(`{"country":{"iso_code":"NL"},"traits":{"is_hosting_provider":true}}`))
+		}))
+		defer server.Close()
+
+		before := time.Now()
+		resp, err := New("blah-user-id", "blah-license-key", WithBaseURL(server.URL)).Insights(nil, "1.2.3.4")
+		So(err, ShouldBeNil)
+
+		Convey("When I enrich it", func() {
+			time.Sleep(5 * time.Millisecond)
+			result := Enrich(nil, "1.2.3.4", resp)
+
+			Convey("I expect its resolver, retrieval time and assessment recorded", func() {
+				So(result.Provenance.Resolver, ShouldEqual, "insights")
+				So(result.Provenance.Retrieved, ShouldHappenOnOrAfter, before)
+				So(result.Provenance.Retrieved, ShouldHappenBefore, result.Provenance.Enriched)
+				So(result.RiskScore, ShouldEqual, 50)
+				So(result.Decision, ShouldEqual, "review")
+			})
+		})
+	})
+}
@@ -0,0 +1,116 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+)
+
+// Codes returned by the web services in the body of an error response
+// https://dev.maxmind.com/geoip/docs/web-services/responses#errors
+const (
+	CodeIPAddressInvalid     = "IP_ADDRESS_INVALID"
+	CodeIPAddressRequired    = "IP_ADDRESS_REQUIRED"
+	CodeIPAddressReserved    = "IP_ADDRESS_RESERVED"
+	CodeIPAddressNotFound    = "IP_ADDRESS_NOT_FOUND"
+	CodeAccountIdRequired    = "ACCOUNT_ID_REQUIRED"
+	CodeAccountIdUnknown     = "ACCOUNT_ID_UNKNOWN"
+	CodeAuthorizationInvalid = "AUTHORIZATION_INVALID"
+	CodeLicenseKeyRequired   = "LICENSE_KEY_REQUIRED"
+	CodeInsufficientFunds    = "INSUFFICIENT_FUNDS"
+	CodeOutOfQueries         = "OUT_OF_QUERIES"
+	CodePermissionRequired   = "PERMISSION_REQUIRED"
+)
+
+// Sentinels for use with errors.Is, which matches any Error with the same
+// Code, e.g.
+//
+//	if errors.Is(err, geoip2.ErrOutOfQueries) { ... }
+var (
+	ErrIPAddressInvalid     = Error{Code: CodeIPAddressInvalid}
+	ErrIPAddressRequired    = Error{Code: CodeIPAddressRequired}
+	ErrIPAddressReserved    = Error{Code: CodeIPAddressReserved}
+	ErrIPAddressNotFound    = Error{Code: CodeIPAddressNotFound}
+	ErrAccountIdRequired    = Error{Code: CodeAccountIdRequired}
+	ErrAccountIdUnknown     = Error{Code: CodeAccountIdUnknown}
+	ErrAuthorizationInvalid = Error{Code: CodeAuthorizationInvalid}
+	ErrLicenseKeyRequired   = Error{Code: CodeLicenseKeyRequired}
+	ErrInsufficientFunds    = Error{Code: CodeInsufficientFunds}
+	ErrOutOfQueries         = Error{Code: CodeOutOfQueries}
+	ErrPermissionRequired   = Error{Code: CodePermissionRequired}
+)
+
+// Error is returned for error responses from the web services, and for
+// addresses rejected before a request is sent.  StatusCode, URL and Body are
+// set only for errors received from the web service; URL and Body are kept
+// for debugging and are scrubbed like the message.
+type Error struct {
+	Code string `json:"code,omitempty"`
+	Err  string `json:"error,omitempty"`
+
+	StatusCode int    `json:"-"`
+	URL        string `json:"-"`
+	Body       string `json:"-"`
+	RequestId  string `json:"-"`
+}
+
+func (e Error) Error() string {
+	if e.Code == "" && e.StatusCode != 0 {
+		return fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Err)
+}
+
+// Is reports whether target is an Error with the same Code, so errors.Is
+// matches the sentinels above whatever the message
+func (e Error) Is(target error) bool {
+	t, ok := target.(Error)
+	if !ok {
+		return false
+	}
+	if t.Err != "" && t.Err != e.Err {
+		return false
+	}
+	return t.Code != "" && t.Code == e.Code
+}
+
+// IsUnresolvable reports whether err means the address can never be
+// located, because MaxMind has no data for it or it is reserved, as
+// opposed to a failure worth retrying or alerting on
+func IsUnresolvable(err error) bool {
+	return errors.Is(err, ErrIPAddressNotFound) || errors.Is(err, ErrIPAddressReserved)
+}
+
+// WithNotFoundAsEmpty answers lookups of unresolvable addresses, see
+// IsUnresolvable, with an empty Response carrying only the address rather
+// than an error, so pipelines pass them through.  Response.Found tells them
+// apart.
+func WithNotFoundAsEmpty() Option {
+	return func(a *Api) {
+		a.notFoundAsEmpty = true
+	}
+}
+
+// notFound returns the empty answer for an unresolvable ipAddress
+func notFound(ipAddress string) Response {
+	resp := Response{notFound: true}
+	if addr, err := netip.ParseAddr(ipAddress); err == nil {
+		resp.Traits.IpAddress = addr.Unmap().WithZone("")
+	}
+	return resp
+}
@@ -0,0 +1,84 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestErrors(t *testing.T) {
+	Convey("Given errors from the web service", t, func() {
+		Convey("I expect unresolvable addresses told apart from failures", func() {
+			So(IsUnresolvable(Error{Code: CodeIPAddressNotFound, Err: "not in the database"}), ShouldBeTrue)
+			So(IsUnresolvable(fmt.Errorf("lookup: %w", Error{Code: CodeIPAddressReserved})), ShouldBeTrue)
+			So(IsUnresolvable(Error{Code: CodeOutOfQueries}), ShouldBeFalse)
+			So(IsUnresolvable(Error{StatusCode: http.StatusBadGateway}), ShouldBeFalse)
+			So(IsUnresolvable(context.DeadlineExceeded), ShouldBeFalse)
+		})
+	})
+
+	Convey("Given an Api that treats not-found as empty", t, func() {
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/8.8.8.8") {
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(sample))}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Header:     http.Header{"X-Request-Id": {"req-404"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"code":"IP_ADDRESS_NOT_FOUND","error":"not found"}`)),
+			}, nil
+		}).Clone(WithNotFoundAsEmpty(), WithRejectReserved())
+
+		Convey("When an address isn't in the database", func() {
+			ctx, meta := CaptureMeta(context.Background())
+			resp, err := api.City(ctx, "2A00:1450::1")
+
+			Convey("I expect an empty answer that wasn't found", func() {
+				So(err, ShouldBeNil)
+				So(resp.Found(), ShouldBeFalse)
+				So(resp.Traits.IpAddress.String(), ShouldEqual, "2a00:1450::1")
+				So(resp.City, ShouldResemble, City{})
+				So(meta.StatusCode, ShouldEqual, http.StatusNotFound)
+				So(meta.RequestId, ShouldEqual, "req-404")
+			})
+		})
+
+		Convey("When an address is reserved", func() {
+			resp, err := api.City(nil, "10.0.0.1")
+
+			Convey("I expect an empty answer too", func() {
+				So(err, ShouldBeNil)
+				So(resp.Found(), ShouldBeFalse)
+			})
+		})
+
+		Convey("When an address is found or invalid", func() {
+			resp, err := api.City(nil, "8.8.8.8")
+			So(err, ShouldBeNil)
+			So(resp.Found(), ShouldBeTrue)
+
+			_, err = api.City(nil, "nope")
+			So(errors.Is(err, ErrIPAddressInvalid), ShouldBeTrue)
+		})
+	})
+}
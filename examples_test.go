@@ -15,12 +15,12 @@
 package geoip2_test
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"time"
 
 	"github.com/savaki/geoip2"
-	"golang.org/x/net/context"
 )
 
 func ExampleApi_City() {
@@ -37,7 +37,8 @@ func ExampleApi_Country() {
 	licenseKey := os.Getenv("MAXMIND_LICENSE_KEY")
 	api := geoip2.New(userId, licenseKey)
 
-	ctx, _ := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
 	resp, _ := api.Country(ctx, "1.2.3.4")
 	json.NewEncoder(os.Stdout).Encode(resp)
 }
@@ -47,7 +48,21 @@ func ExampleApi_Insights() {
 	licenseKey := os.Getenv("MAXMIND_LICENSE_KEY")
 	api := geoip2.New(userId, licenseKey)
 
-	ctx, _ := context.WithTimeout(context.Background(), 3*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
 	resp, _ := api.Insights(ctx, "1.2.3.4")
 	json.NewEncoder(os.Stdout).Encode(resp)
 }
+
+func ExampleNew() {
+	userId := os.Getenv("MAXMIND_USER_ID")
+	licenseKey := os.Getenv("MAXMIND_LICENSE_KEY")
+	api := geoip2.New(userId, licenseKey,
+		geoip2.WithTimeout(3*time.Second),
+		geoip2.WithUserAgent("my-app/1.0"),
+		geoip2.WithLocales("pt-BR", "en"),
+	)
+
+	resp, _ := api.City(nil, "1.2.3.4")
+	json.NewEncoder(os.Stdout).Encode(resp)
+}
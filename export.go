@@ -0,0 +1,163 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"encoding/xml"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// mean earth radius
+// https://en.wikipedia.org/wiki/Earth_radius#Mean_radius
+const earthRadiusKm = 6371.0088
+
+func (l Location) hasCoordinates() bool {
+	return l.Latitude != 0 || l.Longitude != 0
+}
+
+// WKT returns the location as a Well-Known Text point, e.g.
+// "POINT(-122.1163 37.6293)", or "POINT EMPTY" without coordinates
+func (l Location) WKT() string {
+	if !l.hasCoordinates() {
+		return "POINT EMPTY"
+	}
+	return "POINT(" + formatCoordinate(l.Longitude) + " " + formatCoordinate(l.Latitude) + ")"
+}
+
+// WKTCircle returns the accuracy radius as a Well-Known Text polygon with
+// the given number of segments, since WKT has no circle primitive
+func (l Location) WKTCircle(segments int) string {
+	if !l.hasCoordinates() || l.AccuracyRadius <= 0 {
+		return "POLYGON EMPTY"
+	}
+	return "POLYGON((" + strings.Join(l.circle(segments), ", ") + "))"
+}
+
+// circle returns a closed ring of "lon lat" pairs approximating the
+// accuracy radius
+// http://www.movable-type.co.uk/scripts/latlong.html#destPoint
+func (l Location) circle(segments int) []string {
+	if segments < 3 {
+		segments = 3
+	}
+
+	lat := l.Latitude * math.Pi / 180
+	lon := l.Longitude * math.Pi / 180
+	d := float64(l.AccuracyRadius) / earthRadiusKm
+
+	ring := make([]string, 0, segments+1)
+	for i := 0; i <= segments; i++ {
+		bearing := 2 * math.Pi * float64(i%segments) / float64(segments)
+		lat2 := math.Asin(math.Sin(lat)*math.Cos(d) + math.Cos(lat)*math.Sin(d)*math.Cos(bearing))
+		lon2 := lon + math.Atan2(math.Sin(bearing)*math.Sin(d)*math.Cos(lat), math.Cos(d)-math.Sin(lat)*math.Sin(lat2))
+
+		// normalise to -180..+180
+		lon2 = math.Mod(lon2*180/math.Pi+540, 360) - 180
+		ring = append(ring, formatCoordinate(lon2)+" "+formatCoordinate(lat2*180/math.Pi))
+	}
+	return ring
+}
+
+func formatCoordinate(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e6)/1e6, 'f', -1, 64)
+}
+
+type kmlDocument struct {
+	XMLName    xml.Name       `xml:"kml"`
+	Namespace  string         `xml:"xmlns,attr"`
+	Placemarks []kmlPlacemark `xml:"Document>Placemark"`
+}
+
+type kmlPlacemark struct {
+	Name        string    `xml:"name"`
+	Description string    `xml:"description,omitempty"`
+	Data        []kmlData `xml:"ExtendedData>Data,omitempty"`
+	Coordinates string    `xml:"Point>coordinates"`
+}
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+// WriteKML writes a KML document with one placemark per response.
+// Responses without coordinates are skipped rather than placed at 0,0.
+// https://developers.google.com/kml/documentation/kmlreference#placemark
+func WriteKML(w io.Writer, responses []Response) error {
+	doc := kmlDocument{
+		Namespace: "http://www.opengis.net/kml/2.2",
+	}
+	for _, resp := range responses {
+		if !resp.Location.hasCoordinates() {
+			continue
+		}
+		doc.Placemarks = append(doc.Placemarks, kmlPlacemarkFor(resp))
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func kmlPlacemarkFor(resp Response) kmlPlacemark {
+	place := []string{}
+	for _, name := range []string{
+		localizedName(resp.City.Names),
+		mostSpecificSubdivisionName(resp),
+		localizedName(resp.Country.Names),
+	} {
+		if name != "" {
+			place = append(place, name)
+		}
+	}
+
+	placemark := kmlPlacemark{
+		Name:        resp.Traits.IpAddress.String(),
+		Description: strings.Join(place, ", "),
+		Coordinates: formatCoordinate(resp.Location.Longitude) + "," + formatCoordinate(resp.Location.Latitude),
+	}
+	if !resp.Traits.IpAddress.IsValid() {
+		placemark.Name = ""
+	}
+
+	data := []kmlData{
+		{Name: "country", Value: resp.Country.IsoCode},
+		{Name: "accuracy_radius_km", Value: strconv.Itoa(resp.Location.AccuracyRadius)},
+		{Name: "asn", Value: strconv.Itoa(resp.Traits.AutonomousSystemNumber)},
+	}
+	for _, d := range data {
+		if d.Value != "" && d.Value != "0" {
+			placemark.Data = append(placemark.Data, d)
+		}
+	}
+	return placemark
+}
+
+func mostSpecificSubdivisionName(resp Response) string {
+	if len(resp.Subdivisions) == 0 {
+		return ""
+	}
+	return localizedName(resp.Subdivisions[len(resp.Subdivisions)-1].Names)
+}
@@ -0,0 +1,65 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestExport(t *testing.T) {
+	Convey("Given a complete maxmind response", t, func() {
+		resp := Response{}
+		err := json.NewDecoder(strings.NewReader(sample)).Decode(&resp)
+		So(err, ShouldBeNil)
+
+		Convey("I expect a WKT point in lon/lat order", func() {
+			So(resp.Location.WKT(), ShouldEqual, "POINT(-122.1163 37.6293)")
+			So(Location{}.WKT(), ShouldEqual, "POINT EMPTY")
+		})
+
+		Convey("I expect a closed WKT polygon for the accuracy radius", func() {
+			wkt := resp.Location.WKTCircle(4)
+			So(wkt, ShouldStartWith, "POLYGON((")
+
+			ring := strings.Split(strings.TrimSuffix(strings.TrimPrefix(wkt, "POLYGON(("), "))"), ", ")
+			So(len(ring), ShouldEqual, 5)
+			So(ring[0], ShouldEqual, ring[4])
+
+			// due north by 20km is ~0.18 degrees of latitude
+			So(ring[0], ShouldEqual, "-122.1163 37.809164")
+			So(Location{}.WKTCircle(16), ShouldEqual, "POLYGON EMPTY")
+		})
+
+		Convey("When I write KML for a batch", func() {
+			buf := &bytes.Buffer{}
+			err := WriteKML(buf, []Response{resp, {}})
+			So(err, ShouldBeNil)
+			kml := buf.String()
+
+			Convey("I expect one placemark per located response", func() {
+				So(strings.Count(kml, "<Placemark>"), ShouldEqual, 1)
+				So(kml, ShouldContainSubstring, "<name>1.2.3.4</name>")
+				So(kml, ShouldContainSubstring, "<description>Los Angeles, California, United States</description>")
+				So(kml, ShouldContainSubstring, "<coordinates>-122.1163,37.6293</coordinates>")
+				So(kml, ShouldContainSubstring, `<Data name="country">`)
+			})
+		})
+	})
+}
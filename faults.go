@@ -0,0 +1,126 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Faults configures failures injected by WithFaults.  Each rate is the
+// fraction of requests, between 0 and 1, affected by that fault.
+type Faults struct {
+	// ErrorRate of requests are answered with one of StatusCodes, 503 if
+	// empty, and a MaxMind-style error body
+	ErrorRate   float64
+	StatusCodes []int
+
+	// LatencyRate of requests are delayed by Latency before being sent
+	LatencyRate float64
+	Latency     time.Duration
+
+	// DropRate of requests fail as though the connection had been dropped
+	DropRate float64
+
+	// Seed makes the sequence of faults reproducible; zero seeds randomly
+	Seed int64
+}
+
+// WithFaults injects faults into the transport, for testing how callers
+// behave when lookups degrade.  Faults wrap whatever transport is
+// configured, whether before or after this option, and sit inside any
+// WithRetries or WithCircuitBreaker so that those see the faults as they
+// would real failures.  It is not intended for production use.
+func WithFaults(faults Faults) Option {
+	seed := faults.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	injector := &faultInjector{
+		faults: faults,
+		random: rand.New(rand.NewSource(seed)),
+	}
+	return func(a *Api) {
+		a.faults = injector
+	}
+}
+
+type faultInjector struct {
+	faults Faults
+
+	mutex  sync.Mutex
+	random *rand.Rand
+}
+
+func (f *faultInjector) roll(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.random.Float64() < rate
+}
+
+func (f *faultInjector) statusCode() int {
+	if len(f.faults.StatusCodes) == 0 {
+		return http.StatusServiceUnavailable
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.faults.StatusCodes[f.random.Intn(len(f.faults.StatusCodes))]
+}
+
+func (f *faultInjector) wrap(doFunc DoFunc) DoFunc {
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		if f.roll(f.faults.LatencyRate) {
+			timer := time.NewTimer(f.faults.Latency)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-req.Context().Done():
+				timer.Stop()
+				return nil, req.Context().Err()
+			}
+		}
+
+		if f.roll(f.faults.DropRate) {
+			return nil, &url.Error{Op: req.Method, URL: req.URL.String(), Err: io.ErrUnexpectedEOF}
+		}
+
+		if f.roll(f.faults.ErrorRate) {
+			code := f.statusCode()
+			body := fmt.Sprintf(`{"code":"INJECTED_FAULT","error":"injected %d %s"}`, code, http.StatusText(code))
+			return &http.Response{
+				StatusCode: code,
+				Status:     fmt.Sprintf("%d %s", code, http.StatusText(code)),
+				Header:     http.Header{"Content-Type": {"application/vnd.maxmind.com-error+json; charset=UTF-8; version=2.1"}},
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+				Request:    req,
+			}, nil
+		}
+
+		return doFunc(ctx, req)
+	}
+}
@@ -0,0 +1,119 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFaults(t *testing.T) {
+	Convey("Given an Api with fault injection", t, func() {
+		calls := 0
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(context.Context, *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		})
+
+		Convey("When every request fails with a status code", func() {
+			api = api.Clone(WithFaults(Faults{ErrorRate: 1, StatusCodes: []int{502}}))
+			_, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect a MaxMind-style error without reaching the transport", func() {
+				e, ok := err.(Error)
+				So(ok, ShouldBeTrue)
+				So(e.Code, ShouldEqual, "INJECTED_FAULT")
+				So(e.Err, ShouldContainSubstring, "502")
+				So(calls, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When every connection is dropped", func() {
+			api = api.Clone(WithFaults(Faults{DropRate: 1}))
+			_, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect a transport error", func() {
+				e, ok := err.(*url.Error)
+				So(ok, ShouldBeTrue)
+				So(e.Err, ShouldEqual, io.ErrUnexpectedEOF)
+			})
+		})
+
+		Convey("When every request is delayed", func() {
+			api = api.Clone(WithFaults(Faults{LatencyRate: 1, Latency: 20 * time.Millisecond}))
+			started := time.Now()
+			_, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect the request to succeed late", func() {
+				So(err, ShouldBeNil)
+				So(time.Since(started), ShouldBeGreaterThanOrEqualTo, 20*time.Millisecond)
+				So(calls, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When faults are injected at a rate", func() {
+			api = api.Clone(WithFaults(Faults{ErrorRate: 0.5, Seed: 42}))
+			failures := 0
+			for i := 0; i < 200; i++ {
+				if _, err := api.Country(nil, "1.2.3.4"); err != nil {
+					failures++
+				}
+			}
+
+			Convey("I expect roughly that fraction to fail", func() {
+				So(failures, ShouldBeBetween, 70, 130)
+				So(calls, ShouldEqual, 200-failures)
+			})
+		})
+
+		Convey("When faults are given to New before the transport and retries", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				calls++
+				w.Write([]byte(sample))
+			}))
+			defer server.Close()
+
+			api := New("blah-user-id", "blah-license-key",
+				WithFaults(Faults{ErrorRate: 0.5, Seed: 42}),
+				WithRetries(RetryPolicy{MaxRetries: 10, BaseDelay: time.Microsecond}),
+				WithHTTPClient(server.Client()),
+				WithBaseURL(server.URL),
+			)
+			retries := 0
+			for i := 0; i < 20; i++ {
+				resp, err := api.Country(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+				retries += resp.Meta().Retries
+			}
+
+			Convey("I expect the faults to wrap the transport, inside the retries", func() {
+				So(calls, ShouldEqual, 20)
+				So(retries, ShouldBeGreaterThan, 0)
+			})
+		})
+	})
+}
@@ -0,0 +1,244 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Feed is a named list of IP addresses and CIDR ranges, such as a Tor exit
+// list or an internal blocklist.
+type Feed struct {
+	Name string
+	Open func(ctx context.Context) (io.ReadCloser, error)
+}
+
+// URLFeed returns a Feed downloaded from url with http.DefaultClient
+func URLFeed(name, url string) Feed {
+	return Feed{
+		Name: name,
+		Open: func(ctx context.Context) (io.ReadCloser, error) {
+			req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+			if err != nil {
+				return nil, err
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return nil, err
+			}
+			if resp.StatusCode != http.StatusOK {
+				resp.Body.Close()
+				return nil, fmt.Errorf("feed %s: %s returned status %d", name, url, resp.StatusCode)
+			}
+			return resp.Body, nil
+		},
+	}
+}
+
+// FileFeed returns a Feed read from the local file at path
+func FileFeed(name, path string) Feed {
+	return Feed{
+		Name: name,
+		Open: func(ctx context.Context) (io.ReadCloser, error) {
+			return os.Open(path)
+		},
+	}
+}
+
+// FeedEnricher is an Enricher that reports which feeds contain an address.
+// The enriched value is the sorted list of matching feed names.
+type FeedEnricher struct {
+	feeds []Feed
+
+	mutex sync.RWMutex
+	sets  map[string]*prefixSet
+}
+
+func NewFeedEnricher(feeds ...Feed) *FeedEnricher {
+	return &FeedEnricher{
+		feeds: feeds,
+		sets:  map[string]*prefixSet{},
+	}
+}
+
+func (f *FeedEnricher) Name() string {
+	return "feeds"
+}
+
+func (f *FeedEnricher) Enrich(ctx context.Context, ipAddress string, resp Response) (interface{}, error) {
+	return f.Lookup(ipAddress)
+}
+
+// Lookup returns the names of the feeds that contain ipAddress
+func (f *FeedEnricher) Lookup(ipAddress string) ([]string, error) {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr = addr.Unmap()
+
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	matches := []string{}
+	for name, set := range f.sets {
+		if set.contains(addr) {
+			matches = append(matches, name)
+		}
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// Refresh reloads every feed.  A feed that fails to load keeps its previous
+// contents; the first such error is returned once all feeds were attempted.
+func (f *FeedEnricher) Refresh(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var first error
+	for _, feed := range f.feeds {
+		set, err := loadFeed(ctx, feed)
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+			continue
+		}
+
+		f.mutex.Lock()
+		f.sets[feed.Name] = set
+		f.mutex.Unlock()
+	}
+	return first
+}
+
+// DefaultFeedInterval is how often Run refreshes the feeds when given no
+// interval
+const DefaultFeedInterval = time.Hour
+
+// Run refreshes the feeds immediately and then every interval, or
+// DefaultFeedInterval if it isn't positive, until ctx is done.  Refresh
+// errors are passed to onError, which may be nil.
+func (f *FeedEnricher) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	if interval <= 0 {
+		interval = DefaultFeedInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.Refresh(ctx); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func loadFeed(ctx context.Context, feed Feed) (*prefixSet, error) {
+	r, err := feed.Open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	set, err := readPrefixSet(r)
+	if err != nil {
+		return nil, fmt.Errorf("feed %s: %v", feed.Name, err)
+	}
+	return set, nil
+}
+
+// prefixSet answers containment queries for a collection of addresses and
+// CIDR ranges by probing once per distinct prefix length
+type prefixSet struct {
+	prefixes map[netip.Prefix]struct{}
+	lengths  []int
+}
+
+// readPrefixSet parses one address or CIDR per line.  Anything after the
+// first field is ignored so that annotated lists such as Spamhaus DROP
+// ("1.2.3.0/24 ; SBL123") parse as-is.
+func readPrefixSet(r io.Reader) (*prefixSet, error) {
+	set := &prefixSet{
+		prefixes: map[netip.Prefix]struct{}{},
+	}
+	seen := map[int]bool{}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' || text[0] == ';' {
+			continue
+		}
+		field := strings.Fields(text)[0]
+
+		var prefix netip.Prefix
+		if strings.Contains(field, "/") {
+			p, err := netip.ParsePrefix(field)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			prefix = p.Masked()
+		} else {
+			addr, err := netip.ParseAddr(field)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			addr = addr.Unmap()
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+
+		set.prefixes[prefix] = struct{}{}
+		if !seen[prefix.Bits()] {
+			seen[prefix.Bits()] = true
+			set.lengths = append(set.lengths, prefix.Bits())
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+func (s *prefixSet) contains(addr netip.Addr) bool {
+	for _, bits := range s.lengths {
+		prefix, err := addr.Prefix(bits)
+		if err != nil {
+			continue
+		}
+		if _, ok := s.prefixes[prefix]; ok {
+			return true
+		}
+	}
+	return false
+}
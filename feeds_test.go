@@ -0,0 +1,103 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func staticFeed(name string, content *string) Feed {
+	return Feed{
+		Name: name,
+		Open: func(ctx context.Context) (io.ReadCloser, error) {
+			if content == nil {
+				return nil, errors.New("unavailable")
+			}
+			return ioutil.NopCloser(strings.NewReader(*content)), nil
+		},
+	}
+}
+
+func TestFeedEnricher(t *testing.T) {
+	Convey("Given a FeedEnricher with several feeds", t, func() {
+		tor := "# exit nodes\n1.2.3.4\n5.6.7.8\n"
+		drop := "1.2.3.0/24 ; SBL123\n2001:db8::/32 ; SBL456\n"
+		enricher := NewFeedEnricher(staticFeed("tor", &tor), staticFeed("drop", &drop))
+		So(enricher.Refresh(nil), ShouldBeNil)
+
+		Convey("I expect every matching feed to be reported", func() {
+			v, err := enricher.Enrich(nil, "1.2.3.4", Response{})
+			So(err, ShouldBeNil)
+			So(v, ShouldResemble, []string{"drop", "tor"})
+		})
+
+		Convey("I expect CIDR membership to be honoured", func() {
+			matches, err := enricher.Lookup("1.2.3.77")
+			So(err, ShouldBeNil)
+			So(matches, ShouldResemble, []string{"drop"})
+
+			matches, err = enricher.Lookup("2001:db8::2")
+			So(err, ShouldBeNil)
+			So(matches, ShouldResemble, []string{"drop"})
+		})
+
+		Convey("I expect no matches for unlisted addresses", func() {
+			matches, err := enricher.Lookup("9.9.9.9")
+			So(err, ShouldBeNil)
+			So(matches, ShouldBeEmpty)
+		})
+
+		Convey("When I run it without an interval", func() {
+			tor = "9.9.9.9\n"
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				enricher.Run(ctx, 0, nil)
+			}()
+			for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+				if matches, _ := enricher.Lookup("9.9.9.9"); len(matches) > 0 {
+					break
+				}
+			}
+			cancel()
+			<-done
+
+			Convey("I expect it to refresh on the default interval rather than panic", func() {
+				matches, _ := enricher.Lookup("9.9.9.9")
+				So(matches, ShouldResemble, []string{"tor"})
+			})
+		})
+
+		Convey("When a feed fails to refresh", func() {
+			enricher.feeds[0] = staticFeed("tor", nil)
+			err := enricher.Refresh(nil)
+
+			Convey("I expect the error and the previous contents to be kept", func() {
+				So(err, ShouldNotBeNil)
+				matches, _ := enricher.Lookup("5.6.7.8")
+				So(matches, ShouldResemble, []string{"tor"})
+			})
+		})
+	})
+}
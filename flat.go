@@ -0,0 +1,185 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// FlatRecord is a response flattened into the stable columns most tables
+// want, one row per address.  Names are in the locales of the client that
+// fetched the response.  Coordinates and the static IP score are nil when
+// they are unknown, so that they load as NULL rather than as zero.
+//
+// The columns, in the order of FlatColumns, CSVRecord, Args and Dest, are
+// those of the json, csv, parquet and db tags.
+type FlatRecord struct {
+	IpAddress                    string   `json:"ip_address" csv:"ip_address" parquet:"ip_address" db:"ip_address"`
+	Network                      string   `json:"network" csv:"network" parquet:"network" db:"network"`
+	ContinentCode                string   `json:"continent_code" csv:"continent_code" parquet:"continent_code" db:"continent_code"`
+	CountryIsoCode               string   `json:"country_iso_code" csv:"country_iso_code" parquet:"country_iso_code" db:"country_iso_code"`
+	CountryName                  string   `json:"country_name" csv:"country_name" parquet:"country_name" db:"country_name"`
+	RegisteredCountryIsoCode     string   `json:"registered_country_iso_code" csv:"registered_country_iso_code" parquet:"registered_country_iso_code" db:"registered_country_iso_code"`
+	SubdivisionIsoCode           string   `json:"subdivision_iso_code" csv:"subdivision_iso_code" parquet:"subdivision_iso_code" db:"subdivision_iso_code"`
+	SubdivisionName              string   `json:"subdivision_name" csv:"subdivision_name" parquet:"subdivision_name" db:"subdivision_name"`
+	City                         string   `json:"city" csv:"city" parquet:"city" db:"city"`
+	PostalCode                   string   `json:"postal_code" csv:"postal_code" parquet:"postal_code" db:"postal_code"`
+	Latitude                     *float64 `json:"latitude" csv:"latitude" parquet:"latitude,optional" db:"latitude"`
+	Longitude                    *float64 `json:"longitude" csv:"longitude" parquet:"longitude,optional" db:"longitude"`
+	AccuracyRadius               int      `json:"accuracy_radius" csv:"accuracy_radius" parquet:"accuracy_radius" db:"accuracy_radius"`
+	TimeZone                     string   `json:"time_zone" csv:"time_zone" parquet:"time_zone" db:"time_zone"`
+	AutonomousSystemNumber       int      `json:"autonomous_system_number" csv:"autonomous_system_number" parquet:"autonomous_system_number" db:"autonomous_system_number"`
+	AutonomousSystemOrganization string   `json:"autonomous_system_organization" csv:"autonomous_system_organization" parquet:"autonomous_system_organization" db:"autonomous_system_organization"`
+	Isp                          string   `json:"isp" csv:"isp" parquet:"isp" db:"isp"`
+	Organization                 string   `json:"organization" csv:"organization" parquet:"organization" db:"organization"`
+	Domain                       string   `json:"domain" csv:"domain" parquet:"domain" db:"domain"`
+	UserType                     string   `json:"user_type" csv:"user_type" parquet:"user_type" db:"user_type"`
+	IsAnonymous                  bool     `json:"is_anonymous" csv:"is_anonymous" parquet:"is_anonymous" db:"is_anonymous"`
+	IsAnonymousVpn               bool     `json:"is_anonymous_vpn" csv:"is_anonymous_vpn" parquet:"is_anonymous_vpn" db:"is_anonymous_vpn"`
+	IsHostingProvider            bool     `json:"is_hosting_provider" csv:"is_hosting_provider" parquet:"is_hosting_provider" db:"is_hosting_provider"`
+	IsPublicProxy                bool     `json:"is_public_proxy" csv:"is_public_proxy" parquet:"is_public_proxy" db:"is_public_proxy"`
+	IsResidentialProxy           bool     `json:"is_residential_proxy" csv:"is_residential_proxy" parquet:"is_residential_proxy" db:"is_residential_proxy"`
+	IsTorExitNode                bool     `json:"is_tor_exit_node" csv:"is_tor_exit_node" parquet:"is_tor_exit_node" db:"is_tor_exit_node"`
+	StaticIpScore                *float64 `json:"static_ip_score" csv:"static_ip_score" parquet:"static_ip_score,optional" db:"static_ip_score"`
+}
+
+var flatColumns = []string{
+	"ip_address", "network", "continent_code", "country_iso_code", "country_name",
+	"registered_country_iso_code", "subdivision_iso_code", "subdivision_name", "city", "postal_code",
+	"latitude", "longitude", "accuracy_radius", "time_zone",
+	"autonomous_system_number", "autonomous_system_organization", "isp", "organization", "domain", "user_type",
+	"is_anonymous", "is_anonymous_vpn", "is_hosting_provider", "is_public_proxy", "is_residential_proxy", "is_tor_exit_node",
+	"static_ip_score",
+}
+
+// FlatColumns returns the column names of FlatRecord in order, e.g. for a
+// CSV header or an INSERT statement
+func FlatColumns() []string {
+	return append([]string(nil), flatColumns...)
+}
+
+// Flat flattens the response into a FlatRecord
+func (r Response) Flat() FlatRecord {
+	f := FlatRecord{
+		ContinentCode:                r.Continent.Code,
+		CountryIsoCode:               r.Country.IsoCode,
+		CountryName:                  r.CountryName(),
+		RegisteredCountryIsoCode:     r.RegisteredCountry.IsoCode,
+		SubdivisionName:              r.SubdivisionName(),
+		City:                         r.CityName(),
+		PostalCode:                   r.Postal.Code,
+		AccuracyRadius:               r.Location.AccuracyRadius,
+		TimeZone:                     r.Location.TimeZone,
+		AutonomousSystemNumber:       r.Traits.AutonomousSystemNumber,
+		AutonomousSystemOrganization: r.Traits.AutonomousSystemOrganization,
+		Isp:                          r.Traits.Isp,
+		Organization:                 r.Traits.Organization,
+		Domain:                       r.Traits.Domain,
+		UserType:                     r.Traits.UserType,
+		IsAnonymous:                  r.Traits.IsAnonymized(),
+		IsAnonymousVpn:               r.Traits.IsAnonymousVpn,
+		IsHostingProvider:            r.Traits.IsHostingProvider,
+		IsPublicProxy:                r.Traits.IsPublicProxy,
+		IsResidentialProxy:           r.Traits.IsResidentialProxy,
+		IsTorExitNode:                r.Traits.IsTorExitNode,
+	}
+	if r.Traits.IpAddress.IsValid() {
+		f.IpAddress = r.Traits.IpAddress.String()
+	}
+	if network := r.Network(); network.IsValid() {
+		f.Network = network.String()
+	}
+	if s, ok := r.MostSpecificSubdivision(); ok {
+		f.SubdivisionIsoCode = s.IsoCode
+	}
+	if r.Location.hasCoordinates() {
+		latitude, longitude := r.Location.Latitude, r.Location.Longitude
+		f.Latitude, f.Longitude = &latitude, &longitude
+	}
+	if !r.Traits.StaticIpScore.IsZero() {
+		score := r.Traits.StaticIpScore.Float64()
+		f.StaticIpScore = &score
+	}
+	return f
+}
+
+// CSVRecord returns the record as strings in column order, for
+// csv.Writer.Write.  Unknown coordinates and scores are empty.
+func (f FlatRecord) CSVRecord() []string {
+	optional := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*v, 'f', -1, 64)
+	}
+	return []string{
+		f.IpAddress, f.Network, f.ContinentCode, f.CountryIsoCode, f.CountryName,
+		f.RegisteredCountryIsoCode, f.SubdivisionIsoCode, f.SubdivisionName, f.City, f.PostalCode,
+		optional(f.Latitude), optional(f.Longitude), strconv.Itoa(f.AccuracyRadius), f.TimeZone,
+		strconv.Itoa(f.AutonomousSystemNumber), f.AutonomousSystemOrganization, f.Isp, f.Organization, f.Domain, f.UserType,
+		strconv.FormatBool(f.IsAnonymous), strconv.FormatBool(f.IsAnonymousVpn), strconv.FormatBool(f.IsHostingProvider),
+		strconv.FormatBool(f.IsPublicProxy), strconv.FormatBool(f.IsResidentialProxy), strconv.FormatBool(f.IsTorExitNode),
+		optional(f.StaticIpScore),
+	}
+}
+
+// Args returns the record's values in column order, as arguments to an
+// INSERT into the columns of FlatColumns
+func (f FlatRecord) Args() []any {
+	return []any{
+		f.IpAddress, f.Network, f.ContinentCode, f.CountryIsoCode, f.CountryName,
+		f.RegisteredCountryIsoCode, f.SubdivisionIsoCode, f.SubdivisionName, f.City, f.PostalCode,
+		f.Latitude, f.Longitude, f.AccuracyRadius, f.TimeZone,
+		f.AutonomousSystemNumber, f.AutonomousSystemOrganization, f.Isp, f.Organization, f.Domain, f.UserType,
+		f.IsAnonymous, f.IsAnonymousVpn, f.IsHostingProvider, f.IsPublicProxy, f.IsResidentialProxy, f.IsTorExitNode,
+		f.StaticIpScore,
+	}
+}
+
+// Dest returns pointers to the record's fields in column order, for
+// sql.Rows.Scan over a SELECT of the columns of FlatColumns
+func (f *FlatRecord) Dest() []any {
+	return []any{
+		&f.IpAddress, &f.Network, &f.ContinentCode, &f.CountryIsoCode, &f.CountryName,
+		&f.RegisteredCountryIsoCode, &f.SubdivisionIsoCode, &f.SubdivisionName, &f.City, &f.PostalCode,
+		&f.Latitude, &f.Longitude, &f.AccuracyRadius, &f.TimeZone,
+		&f.AutonomousSystemNumber, &f.AutonomousSystemOrganization, &f.Isp, &f.Organization, &f.Domain, &f.UserType,
+		&f.IsAnonymous, &f.IsAnonymousVpn, &f.IsHostingProvider, &f.IsPublicProxy, &f.IsResidentialProxy, &f.IsTorExitNode,
+		&f.StaticIpScore,
+	}
+}
+
+// Value stores the record in a single JSON column
+func (f FlatRecord) Value() (driver.Value, error) {
+	return json.Marshal(f)
+}
+
+// Scan reads a record stored by Value.  NULL scans as the zero record.
+func (f *FlatRecord) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*f = FlatRecord{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, f)
+	case string:
+		return json.Unmarshal([]byte(v), f)
+	default:
+		return fmt.Errorf("geoip2: cannot scan %T into FlatRecord", src)
+	}
+}
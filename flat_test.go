@@ -0,0 +1,109 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFlatRecord(t *testing.T) {
+	Convey("Given a complete maxmind response", t, func() {
+		resp := Response{}
+		So(json.NewDecoder(strings.NewReader(sample)).Decode(&resp), ShouldBeNil)
+
+		Convey("When I flatten it", func() {
+			f := resp.Flat()
+
+			Convey("I expect its columns filled", func() {
+				So(f.IpAddress, ShouldEqual, resp.Traits.IpAddress.String())
+				So(f.CountryIsoCode, ShouldEqual, "US")
+				So(f.City, ShouldEqual, "Los Angeles")
+				So(f.SubdivisionIsoCode, ShouldEqual, resp.Subdivisions[len(resp.Subdivisions)-1].IsoCode)
+				So(*f.Latitude, ShouldEqual, resp.Location.Latitude)
+				So(*f.Longitude, ShouldEqual, resp.Location.Longitude)
+				So(f.AutonomousSystemNumber, ShouldEqual, resp.Traits.AutonomousSystemNumber)
+			})
+
+			Convey("I expect every view to follow FlatColumns", func() {
+				columns := FlatColumns()
+				typ := reflect.TypeOf(f)
+				So(typ.NumField(), ShouldEqual, len(columns))
+				for i, column := range columns {
+					field := typ.Field(i)
+					So(field.Tag.Get("json"), ShouldEqual, column)
+					So(field.Tag.Get("csv"), ShouldEqual, column)
+					So(field.Tag.Get("db"), ShouldEqual, column)
+					So(strings.Split(field.Tag.Get("parquet"), ",")[0], ShouldEqual, column)
+				}
+				So(f.CSVRecord(), ShouldHaveLength, len(columns))
+				So(f.Args(), ShouldHaveLength, len(columns))
+				So(f.Dest(), ShouldHaveLength, len(columns))
+			})
+
+			Convey("I expect it written as CSV", func() {
+				buffer := &bytes.Buffer{}
+				w := csv.NewWriter(buffer)
+				So(w.Write(FlatColumns()), ShouldBeNil)
+				So(w.Write(f.CSVRecord()), ShouldBeNil)
+				w.Flush()
+
+				rows, err := csv.NewReader(buffer).ReadAll()
+				So(err, ShouldBeNil)
+				So(rows[1][3], ShouldEqual, "US")
+				So(rows[1][len(rows[1])-1], ShouldEqual, resp.Traits.StaticIpScore.String())
+			})
+
+			Convey("I expect it to round trip as a column value", func() {
+				value, err := f.Value()
+				So(err, ShouldBeNil)
+
+				var scanned FlatRecord
+				So(scanned.Scan(value), ShouldBeNil)
+				So(scanned, ShouldResemble, f)
+				So(scanned.Scan(string(value.([]byte))), ShouldBeNil)
+				So(scanned, ShouldResemble, f)
+				So(scanned.Scan(nil), ShouldBeNil)
+				So(scanned, ShouldResemble, FlatRecord{})
+				So(scanned.Scan(42), ShouldNotBeNil)
+			})
+
+			Convey("I expect Dest to fill the record", func() {
+				var scanned FlatRecord
+				for i, dest := range scanned.Dest() {
+					reflect.ValueOf(dest).Elem().Set(reflect.ValueOf(f.Args()[i]))
+				}
+				So(scanned, ShouldResemble, f)
+			})
+		})
+	})
+
+	Convey("Given an empty response", t, func() {
+		f := Response{}.Flat()
+
+		Convey("I expect unknown values to be nil", func() {
+			So(f.Latitude, ShouldBeNil)
+			So(f.Longitude, ShouldBeNil)
+			So(f.StaticIpScore, ShouldBeNil)
+			So(f.Network, ShouldEqual, "")
+		})
+	})
+}
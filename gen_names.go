@@ -0,0 +1,211 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build ignore
+// +build ignore
+
+// gen_names generates names_data.go from the ISO 3166 tables and
+// translations shipped by the iso-codes project
+// https://salsa.debian.org/iso-codes-team/iso-codes
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// locales maps MaxMind locale codes to gettext locale directories
+var locales = map[string]string{
+	"de":    "de",
+	"es":    "es",
+	"fr":    "fr",
+	"ja":    "ja",
+	"pt-BR": "pt_BR",
+	"ru":    "ru",
+	"zh-CN": "zh_CN",
+}
+
+type entry struct {
+	Code       string `json:"code"`
+	Alpha2     string `json:"alpha_2"`
+	Name       string `json:"name"`
+	CommonName string `json:"common_name"`
+}
+
+func main() {
+	dir := flag.String("dir", "/usr/share", "directory containing iso-codes/ and locale/")
+	out := flag.String("out", "names_data.go", "output file")
+	flag.Parse()
+
+	countries, err := load(*dir, "3166-1")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	subdivisions, err := load(*dir, "3166-2")
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintln(buf, "// Code generated by gen_names.go; DO NOT EDIT.")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "//go:build !tinygo && !geoip2_tiny")
+	fmt.Fprintln(buf, "// +build !tinygo,!geoip2_tiny")
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package geoip2")
+	fmt.Fprintln(buf)
+	write(buf, "countryNamesData", countries)
+	write(buf, "subdivisionNamesData", subdivisions)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// load returns names keyed by code and then locale; translations identical
+// to the English name are omitted since lookups fall back to English
+func load(dir, standard string) (map[string]map[string]string, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, "iso-codes", "json", "iso_"+standard+".json"))
+	if err != nil {
+		return nil, err
+	}
+	v := map[string][]entry{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	catalogs := map[string]map[string]string{}
+	for locale, gettext := range locales {
+		catalog, err := readMO(filepath.Join(dir, "locale", gettext, "LC_MESSAGES", "iso_"+standard+".mo"))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		catalogs[locale] = catalog
+	}
+
+	names := map[string]map[string]string{}
+	for _, e := range v[standard] {
+		code := e.Code
+		if code == "" {
+			code = e.Alpha2
+		}
+		english := e.Name
+		if e.CommonName != "" {
+			english = e.CommonName
+		}
+
+		names[code] = map[string]string{"en": english}
+		for locale, catalog := range catalogs {
+			if name, ok := catalog[english]; ok && name != english {
+				names[code][locale] = name
+			}
+		}
+	}
+	return names, nil
+}
+
+// write emits names as one string constant of lines "code\tlocale=name..."
+// rather than a map literal, whose initialisation is too large for some
+// targets such as js/wasm; names.go parses it on first use
+func write(buf *bytes.Buffer, name string, names map[string]map[string]string) {
+	codes := make([]string, 0, len(names))
+	for code := range names {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	fmt.Fprintf(buf, "const %s = \"\" +\n", name)
+	for _, code := range codes {
+		keys := make([]string, 0, len(names[code]))
+		for locale := range names[code] {
+			keys = append(keys, locale)
+		}
+		sort.Strings(keys)
+
+		fields := []string{code}
+		for _, locale := range keys {
+			fields = append(fields, locale+"="+names[code][locale])
+		}
+		fmt.Fprintf(buf, "\t%q +\n", strings.Join(fields, "\t")+"\n")
+	}
+	fmt.Fprintln(buf, "\t\"\"")
+	fmt.Fprintln(buf)
+}
+
+// readMO parses a GNU gettext message catalog
+// https://www.gnu.org/software/gettext/manual/html_node/MO-Files.html
+func readMO(path string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 20 {
+		return nil, errors.New(path + ": truncated catalog")
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if binary.BigEndian.Uint32(data) == 0x950412de {
+		order = binary.BigEndian
+	} else if order.Uint32(data) != 0x950412de {
+		return nil, errors.New(path + ": not a message catalog")
+	}
+
+	n := int(order.Uint32(data[8:]))
+	originals := int(order.Uint32(data[12:]))
+	translations := int(order.Uint32(data[16:]))
+
+	str := func(table, i int) (string, error) {
+		at := table + i*8
+		if at+8 > len(data) {
+			return "", errors.New(path + ": truncated catalog")
+		}
+		length := int(order.Uint32(data[at:]))
+		offset := int(order.Uint32(data[at+4:]))
+		if offset+length > len(data) {
+			return "", errors.New(path + ": truncated catalog")
+		}
+		return string(data[offset : offset+length]), nil
+	}
+
+	catalog := map[string]string{}
+	for i := 0; i < n; i++ {
+		original, err := str(originals, i)
+		if err != nil {
+			return nil, err
+		}
+		translation, err := str(translations, i)
+		if err != nil {
+			return nil, err
+		}
+		if original != "" && translation != "" {
+			catalog[original] = translation
+		}
+	}
+	return catalog, nil
+}
@@ -15,36 +15,204 @@
 package geoip2
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net"
 	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
 
-	"golang.org/x/net/context"
+	"golang.org/x/sync/singleflight"
 )
 
 type Api struct {
-	doFunc     func(ctx context.Context, req *http.Request) (*http.Response, error)
+	doFunc     DoFunc
 	userId     string
 	licenseKey string
+	monitor    *Monitor
+	timeout    time.Duration
+	auth       Authenticator
+	redactIPs  bool
+	baseURL    string
+	userAgent  string
+	header     http.Header
+	locales    []string
+	limiter    *rateLimiter
+	faults     *faultInjector
+	hedgeDelay time.Duration
+	retrier    *retrier
+	breaker    *breaker
+	logger     *lookupLogger
+	cache      Cache
+	ttlPolicy  TTLPolicy
+	onQuota    func(remaining int)
+
+	instrumentation Instrumentation
+	interceptors    []Interceptor
+	inflight        *singleflight.Group
+	quota           *QuotaGuard
+	resolver        *net.Resolver
+	tracer          Tracer
+
+	keepMapped      bool
+	lenient         bool
+	maxBodySize     int64
+	minConfidence   int
+	centroids       bool
+	notFoundAsEmpty bool
+	rejectReserved  bool
+	sections        []string
+	strict          bool
+}
+
+// Hosts serving the GeoIP2 web services.  The GeoLite host serves only the
+// Country and City services.
+// https://dev.maxmind.com/geoip/docs/web-services
+const (
+	DefaultHost = "geoip.maxmind.com"
+	GeoLiteHost = "geolite.info"
+	SandboxHost = "sandbox.maxmind.com"
+)
+
+// DefaultBaseURL is the root of MaxMind's GeoIP2 Precision web services
+const DefaultBaseURL = "https://" + DefaultHost + "/geoip/v2.1/"
+
+// Lookuper is implemented by every source of responses, whether the web
+// service Api, a local database Reader or another provider adapted in a
+// subpackage such as geoip2ipinfo, so call sites can switch between them.
+// Whatever the source, answers are Responses and failures are Errors with
+// MaxMind's codes.
+type Lookuper interface {
+	Country(ctx context.Context, ipAddress string) (Response, error)
+	City(ctx context.Context, ipAddress string) (Response, error)
+	Insights(ctx context.Context, ipAddress string) (Response, error)
+}
+
+var _ Lookuper = (*Api)(nil)
+
+// Option configures an Api
+type Option func(*Api)
+
+// WithTimeout bounds each lookup, including reading the response, to d
+func WithTimeout(d time.Duration) Option {
+	return func(a *Api) {
+		a.timeout = d
+	}
+}
+
+// WithCredentials replaces the account credentials used by the Api
+func WithCredentials(userId, licenseKey string) Option {
+	return func(a *Api) {
+		a.userId = userId
+		a.licenseKey = licenseKey
+	}
+}
+
+// WithHTTPClient sends requests with client instead of the default client
+func WithHTTPClient(client *http.Client) Option {
+	return func(a *Api) {
+		a.doFunc = wrap(client.Do)
+	}
+}
+
+// WithBaseURL sends requests to baseURL, e.g. a proxy or mock server, in
+// place of DefaultBaseURL.  The service name and address are appended, as in
+// {baseURL}city/1.2.3.4.
+func WithBaseURL(baseURL string) Option {
+	return func(a *Api) {
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		a.baseURL = baseURL
+	}
+}
+
+// WithHost sends requests to the web services at host, e.g. GeoLiteHost or
+// SandboxHost, over https
+func WithHost(host string) Option {
+	return WithBaseURL("https://" + host + "/geoip/v2.1/")
+}
+
+// DefaultUserAgent is sent in the User-Agent header unless WithUserAgent
+// names the application
+const DefaultUserAgent = "github.com/savaki/geoip2"
+
+// WithUserAgent identifies the application in the User-Agent header, as
+// MaxMind requests
+func WithUserAgent(userAgent string) Option {
+	return func(a *Api) {
+		a.userAgent = userAgent
+	}
 }
 
-func New(userId, licenseKey string) *Api {
+// WithHeader adds a header to every request, e.g. the credentials of an
+// egress proxy.  Values accumulate when the same key is given again, and
+// the request's own headers, such as Authorization, take precedence.
+func WithHeader(key, value string) Option {
+	return func(a *Api) {
+		header := a.header.Clone() // clones don't share the map
+		if header == nil {
+			header = http.Header{}
+		}
+		header.Add(key, value)
+		a.header = header
+	}
+}
+
+// WithLocales lists the preferred locales for names, most preferred first,
+// and sends them in the Accept-Language header
+func WithLocales(locales ...string) Option {
+	return func(a *Api) {
+		a.locales = append([]string(nil), locales...)
+	}
+}
+
+// New returns an Api for the given account.  Options are applied in order
+// after the defaults.
+func New(userId, licenseKey string, opts ...Option) *Api {
 	api := &Api{
 		userId:     userId,
 		licenseKey: licenseKey,
+		baseURL:    DefaultBaseURL,
+	}
+	api = WithClient(api, defaultClient())
+	for _, opt := range opts {
+		opt(api)
 	}
-	return WithClient(api, http.DefaultClient)
+	return api
 }
 
 func WithClient(api *Api, client *http.Client) *Api {
 	return WithClientFunc(api, wrap(client.Do))
 }
 
-func WithClientFunc(api *Api, ctxFunc func(context.Context, *http.Request) (*http.Response, error)) *Api {
-	return &Api{
-		doFunc:     ctxFunc,
-		userId:     api.userId,
-		licenseKey: api.licenseKey,
+func WithClientFunc(api *Api, ctxFunc DoFunc) *Api {
+	clone := *api
+	clone.doFunc = ctxFunc
+	return &clone
+}
+
+// Clone returns a copy of the Api with opts applied.  The copy shares the
+// original's transport and monitor, so it is cheap to derive clients with
+// different settings for different call paths.
+func (a *Api) Clone(opts ...Option) *Api {
+	clone := *a
+	for _, opt := range opts {
+		opt(&clone)
 	}
+	return &clone
+}
+
+// String and GoString have value receivers so that the license key is
+// redacted however the Api is formatted
+func (a Api) String() string {
+	return fmt.Sprintf("geoip2.Api{userId: %q, licenseKey: %s}", a.userId, redact(a.licenseKey))
+}
+
+func (a Api) GoString() string {
+	return a.String()
 }
 
 func wrap(doFunc func(*http.Request) (*http.Response, error)) func(context.Context, *http.Request) (*http.Response, error) {
@@ -54,52 +222,248 @@ func wrap(doFunc func(*http.Request) (*http.Response, error)) func(context.Conte
 }
 
 func (a *Api) Country(ctx context.Context, ipAddress string) (Response, error) {
-	return a.fetch(ctx, "https://geoip.maxmind.com/geoip/v2.1/country/", ipAddress)
+	return a.fetch(ctx, "country", ipAddress)
 }
 
 func (a *Api) City(ctx context.Context, ipAddress string) (Response, error) {
-	return a.fetch(ctx, "https://geoip.maxmind.com/geoip/v2.1/city/", ipAddress)
+	return a.fetch(ctx, "city", ipAddress)
 }
 
 func (a *Api) Insights(ctx context.Context, ipAddress string) (Response, error) {
-	return a.fetch(ctx, "https://geoip.maxmind.com/geoip/v2.1/insights/", ipAddress)
+	return a.fetch(ctx, "insights", ipAddress)
+}
+
+// CountryMe looks up the public address the request is sent from
+func (a *Api) CountryMe(ctx context.Context) (Response, error) {
+	return a.fetch(ctx, "country", me)
+}
+
+// CityMe looks up the public address the request is sent from
+func (a *Api) CityMe(ctx context.Context) (Response, error) {
+	return a.fetch(ctx, "city", me)
+}
+
+// InsightsMe looks up the public address the request is sent from
+func (a *Api) InsightsMe(ctx context.Context) (Response, error) {
+	return a.fetch(ctx, "insights", me)
+}
+
+// me asks the web service to look up the caller's own address
+// https://dev.maxmind.com/geoip/docs/web-services/requests
+const me = "me"
+
+// fetch serves the lookup from the cache when possible, and scrubs secrets
+// from any error it returns.  Lookups that share a flight each report their
+// own Meta to CaptureMeta.
+func (a *Api) fetch(ctx context.Context, service, ipAddress string) (response Response, err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	a = a.forRequest(ctx)
+	if a.tracer != nil {
+		var span LookupSpan
+		ctx, span = a.tracer.StartLookup(ctx, service, ipAddress)
+		defer func() { span.End(response, err) }()
+	}
+	if a.notFoundAsEmpty {
+		requested := ipAddress // normalize clears it for a reserved address
+		defer func() {
+			if IsUnresolvable(err) {
+				response, err = notFound(requested), nil
+			}
+		}()
+	}
+	// registered after the substitution above, so they run first and the
+	// log and captured Meta still describe an unresolvable address's answer
+	if a.logger != nil {
+		var log *lookupLog
+		ctx, log = a.startLog(ctx, service, ipAddress)
+		defer func() { log.lookup(ctx, response, err) }()
+	}
+	if holder, ok := ctx.Value(metaKey{}).(*Meta); ok {
+		defer func() { *holder = resultMeta(service, response, err) }()
+	}
+	if a.centroids {
+		defer func() {
+			if err == nil {
+				response = centroidFallback(response)
+			}
+		}()
+	}
+	if a.minConfidence > 0 {
+		defer func() {
+			if err == nil {
+				response = response.FilterByConfidence(a.minConfidence)
+			}
+		}()
+	}
+
+	ipAddress, err = a.normalize(ipAddress)
+	if err != nil {
+		return Response{}, a.redactor().Error(err)
+	}
+
+	// the caller's own address may change, so it's never cached
+	key := a.cacheKey(service, ipAddress)
+	cache := a.cache
+	if ipAddress == me {
+		cache = nil
+	}
+	if cache != nil {
+		started := time.Now()
+		response, ok := cache.Get(ctx, key)
+		if a.instrumentation != nil {
+			a.instrumentation.ObserveCache(service, ok)
+		}
+		if ok {
+			return fromCache(response, started, a.locales), nil
+		}
+	}
+
+	if a.quota != nil {
+		if err := a.quota.allow(); err != nil {
+			return Response{}, err
+		}
+	}
+	response, err = a.shared(ctx, key, func(ctx context.Context) (Response, error) {
+		response, err := a.lookup(ctx, service, ipAddress)
+		if err == nil && cache != nil {
+			if ttl := a.ttl(service, response); ttl > 0 {
+				cache.Set(ctx, key, response, ttl)
+			}
+		}
+		return response, err
+	})
+	return response, a.redactor().Error(err)
 }
 
-func (a *Api) fetch(ctx context.Context, prefix, ipAddress string) (Response, error) {
-	req, err := http.NewRequest("GET", prefix+ipAddress, nil)
+func (a *Api) lookup(ctx context.Context, service, ipAddress string) (Response, error) {
+	// the timeout covers the whole exchange, including reading the body, and
+	// cancelling ctx aborts the request in flight
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.timeout)
+		defer cancel()
+	}
+	var retries int32
+	ctx = countRetries(ctx, &retries)
+	ctx = withLimiter(ctx, a.limiter)
+
+	baseURL := a.baseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", baseURL+service+"/"+ipAddress, nil)
 	if err != nil {
 		return Response{}, err
 	}
+	for key, values := range a.header {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	userAgent := a.userAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	req.Header.Set("User-Agent", userAgent)
+	if len(a.locales) > 0 {
+		req.Header.Set("Accept-Language", strings.Join(a.locales, ", "))
+	}
+	req.Header.Set("Accept-Encoding", acceptEncoding)
 
 	// authorize the request
-	// http://dev.maxmind.com/geoip/geoip2/web-services/#Authorization
-	req.SetBasicAuth(a.userId, a.licenseKey)
+	if err := a.authenticator().Authenticate(req); err != nil {
+		return Response{}, err
+	}
 
 	// execute the request
-	if ctx == nil {
-		ctx = context.Background()
+	started := time.Now()
+	resp, err := a.do()(ctx, req)
+	if a.instrumentation != nil {
+		status := 0
+		if err == nil {
+			status = resp.StatusCode
+		}
+		a.instrumentation.ObserveRequest(service, status, time.Since(started), err)
 	}
-	resp, err := a.doFunc(ctx, req)
 	if err != nil {
 		return Response{}, err
 	}
 	defer resp.Body.Close()
+	if err := decompress(resp); err != nil {
+		return Response{}, err
+	}
 
 	// handle errors that may occur
 	// http://dev.maxmind.com/geoip/geoip2/web-services/#Response_Headers
 	if resp.StatusCode >= 400 && resp.StatusCode < 600 {
-		v := Error{}
-		err := json.NewDecoder(resp.Body).Decode(&v)
+		body, err := readBody(resp.Body, a.maxBodySize)
 		if err != nil {
 			return Response{}, err
 		}
 
+		// proxies and load balancers may answer with a body that isn't
+		// json; the status alone is still worth reporting
+		v := Error{}
+		json.Unmarshal(body, &v)
+		v.StatusCode = resp.StatusCode
+		v.URL = req.URL.String()
+		v.Body = string(body)
+		v.RequestId = requestId(resp.Header)
+		if a.quota != nil && (v.Code == CodeOutOfQueries || v.Code == CodeInsufficientFunds) {
+			a.quota.observe(0)
+		}
 		return Response{}, v
 	}
 
 	// parse the response body
 	// http://dev.maxmind.com/geoip/geoip2/web-services/#Response_Body
+	data, err := readBody(resp.Body, a.maxBodySize)
+	if err != nil {
+		return Response{}, err
+	}
+
+	// a DecodeError, or a strict decoding failure, still carries the fields
+	// that could be decoded.  The body is attached afterwards as decoding
+	// may reset the response when it falls back to field by field.
 	response := Response{}
-	err = json.NewDecoder(resp.Body).Decode(&response)
+	if len(a.sections) > 0 {
+		err = decodeSections(data, &response, a.sections)
+	} else {
+		err = decode(data, &response)
+	}
+	response.raw = data
+	if e, ok := err.(DecodeError); ok && a.lenient {
+		response.decodeErrors = e.Fields
+		err = nil
+	}
+	if err == nil && a.strict {
+		err = strictDecode(data)
+	}
+	response.meta = &Meta{
+		Service:     service,
+		StatusCode:  resp.StatusCode,
+		Header:      RedactHeader(resp.Header),
+		RequestId:   requestId(resp.Header),
+		ContentType: resp.Header.Get("Content-Type"),
+		Latency:     time.Since(started),
+		Retrieved:   time.Now().UTC(),
+		Retries:     int(atomic.LoadInt32(&retries)),
+		Locales:     a.locales,
+		Billable:    resp.StatusCode >= 200 && resp.StatusCode < 300,
+	}
+	if remaining, ok := response.QueriesRemaining(); ok {
+		if a.onQuota != nil {
+			a.onQuota(remaining)
+		}
+		if a.instrumentation != nil {
+			a.instrumentation.ObserveQueriesRemaining(remaining)
+		}
+		if a.quota != nil {
+			a.quota.observe(remaining)
+		}
+	}
 	return response, err
 }
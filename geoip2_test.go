@@ -15,7 +15,9 @@
 package geoip2
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"strings"
 	"testing"
@@ -25,7 +27,6 @@ import (
 	"fmt"
 
 	. "github.com/smartystreets/goconvey/convey"
-	"golang.org/x/net/context"
 )
 
 var sample = `
@@ -137,10 +138,15 @@ var sample = `
       "autonomous_system_organization": "Linkem IR WiMax Network",
       "domain":                        "example.com",
       "is_anonymous_proxy":            true,
+      "is_anycast":                    true,
       "is_satellite_provider":         true,
       "isp":                           "Linkem spa",
       "ip_address":                    "1.2.3.4",
+      "mobile_country_code":           "222",
+      "mobile_network_code":           "01",
+      "network":                       "1.2.3.0/24",
       "organization":                  "Linkem IR WiMax Network",
+      "static_ip_score":               1.3,
       "user_type":                     "traveler"
   },
   "maxmind": {
@@ -173,6 +179,7 @@ func TestApi(t *testing.T) {
 			doFunc := func(context.Context, *http.Request) (*http.Response, error) {
 				resp := &http.Response{
 					StatusCode: 200,
+					Header:     http.Header{"Content-Type": {"application/vnd.maxmind.com-city+json; charset=UTF-8; version=2.1"}},
 					Body:       ioutil.NopCloser(strings.NewReader(sample)),
 				}
 				return resp, nil
@@ -196,6 +203,14 @@ func TestApi(t *testing.T) {
 					So(err, ShouldBeNil)
 					So(resp.City.Confidence, ShouldEqual, 25)
 				})
+
+				Convey("I expect the request metadata", func() {
+					meta := resp.Meta()
+					So(meta.StatusCode, ShouldEqual, 200)
+					So(meta.Header.Get("Content-Type"), ShouldStartWith, "application/vnd.maxmind.com-city+json")
+					So(meta.Latency, ShouldBeGreaterThan, 0)
+					So(meta.Cached, ShouldBeFalse)
+				})
 			})
 
 			Convey("When I call #Insights", func() {
@@ -235,6 +250,36 @@ func TestApi(t *testing.T) {
 				So(e.Code, ShouldEqual, code)
 				So(e.Err, ShouldEqual, message)
 			})
+
+			Convey("I expect the error to match its sentinel and carry the response", func() {
+				So(errors.Is(err, ErrIPAddressRequired), ShouldBeTrue)
+				So(errors.Is(err, ErrIPAddressInvalid), ShouldBeFalse)
+
+				var e Error
+				So(errors.As(fmt.Errorf("wrapped: %w", err), &e), ShouldBeTrue)
+				So(e.StatusCode, ShouldEqual, 400)
+				So(e.URL, ShouldEqual, DefaultBaseURL+"city/1.2.3.4")
+				So(e.Body, ShouldContainSubstring, message)
+			})
+		})
+
+		Convey("When the error response isn't json", func() {
+			api = WithClientFunc(api, func(context.Context, *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: 502,
+					Body:       ioutil.NopCloser(strings.NewReader("<html>Bad Gateway</html>")),
+				}, nil
+			})
+			_, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect an Error reporting the status and body", func() {
+				e, ok := err.(Error)
+				So(ok, ShouldBeTrue)
+				So(e.Code, ShouldEqual, "")
+				So(e.StatusCode, ShouldEqual, 502)
+				So(e.Body, ShouldEqual, "<html>Bad Gateway</html>")
+				So(e.Error(), ShouldEqual, "502 Bad Gateway")
+			})
 		})
 	})
 }
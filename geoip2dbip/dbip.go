@@ -0,0 +1,225 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package geoip2dbip adapts the DB-IP API to geoip2.Lookuper, so it can
+// stand in for, or back up, the GeoIP2 web services.
+//
+//	lookuper := geoip2.NewChain(api, geoip2dbip.New(apiKey))
+//
+// DB-IP answers every lookup with the fields of the plan, so Country, City
+// and Insights differ only in name.  Its errors are reported as geoip2.Error
+// with the closest MaxMind code.
+// https://db-ip.com/api/doc.php
+package geoip2dbip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/savaki/geoip2"
+)
+
+// DefaultBaseURL is the root of the DB-IP API
+const DefaultBaseURL = "https://api.db-ip.com/v2/"
+
+// FreeKey is the API key of DB-IP's free, rate limited, tier
+const FreeKey = "free"
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sends requests with client instead of http.DefaultClient
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.client = client
+	}
+}
+
+// WithBaseURL sends requests to baseURL in place of DefaultBaseURL
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		c.baseURL = baseURL
+	}
+}
+
+// Client is a geoip2.Lookuper answering from DB-IP
+type Client struct {
+	apiKey  string
+	baseURL string
+	client  *http.Client
+}
+
+var _ geoip2.Lookuper = (*Client)(nil)
+
+// New returns a Client authenticating with apiKey, or FreeKey
+func New(apiKey string, opts ...Option) *Client {
+	c := &Client{
+		apiKey:  apiKey,
+		baseURL: DefaultBaseURL,
+		client:  http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Client) Country(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.lookup(ctx, ipAddress)
+}
+
+func (c *Client) City(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.lookup(ctx, ipAddress)
+}
+
+func (c *Client) Insights(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.lookup(ctx, ipAddress)
+}
+
+// response is the body DB-IP answers with
+type response struct {
+	IpAddress     string  `json:"ipAddress"`
+	ContinentCode string  `json:"continentCode"`
+	ContinentName string  `json:"continentName"`
+	CountryCode   string  `json:"countryCode"`
+	CountryName   string  `json:"countryName"`
+	IsEuMember    bool    `json:"isEuMember"`
+	StateProvCode string  `json:"stateProvCode"`
+	StateProv     string  `json:"stateProv"`
+	City          string  `json:"city"`
+	ZipCode       string  `json:"zipCode"`
+	Latitude      float64 `json:"latitude"`
+	Longitude     float64 `json:"longitude"`
+	TimeZone      string  `json:"timeZone"`
+	AsNumber      int     `json:"asNumber"`
+	AsName        string  `json:"asName"`
+	Isp           string  `json:"isp"`
+	Organization  string  `json:"organization"`
+	UsageType     string  `json:"usageType"`
+	ErrorCode     string  `json:"errorCode"`
+	Error         string  `json:"error"`
+}
+
+func (c *Client) lookup(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return geoip2.Response{}, geoip2.Error{
+			Code: geoip2.CodeIPAddressInvalid,
+			Err:  fmt.Sprintf("The value %q is not a valid IP address.", ipAddress),
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+url.PathEscape(c.apiKey)+"/"+url.PathEscape(addr.String()), nil)
+	if err != nil {
+		return geoip2.Response{}, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		// the API key is in the URL, so keep it out of the error
+		if e, ok := err.(*url.Error); ok {
+			err = e.Err
+		}
+		return geoip2.Response{}, fmt.Errorf("geoip2dbip: lookup failed: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return geoip2.Response{}, err
+	}
+
+	v := response{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return geoip2.Response{}, geoip2.Error{StatusCode: resp.StatusCode, Body: string(data)}
+	}
+	if v.Error != "" || resp.StatusCode >= 400 {
+		return geoip2.Response{}, geoip2.Error{Code: errorCode(v.ErrorCode), Err: v.Error, StatusCode: resp.StatusCode, Body: string(data)}
+	}
+	if v.CountryCode == "" || v.CountryCode == "ZZ" {
+		// DB-IP places reserved and unknown addresses in "ZZ"
+		code, message := geoip2.CodeIPAddressNotFound, fmt.Sprintf("The address %s is not in the database.", addr)
+		if geoip2.IsReserved(addr) {
+			code, message = geoip2.CodeIPAddressReserved, fmt.Sprintf("The IP address '%s' is a reserved IP address.", addr)
+		}
+		return geoip2.Response{}, geoip2.Error{Code: code, Err: message}
+	}
+	return v.toResponse(addr), nil
+}
+
+// errorCode maps DB-IP error codes to the closest MaxMind code
+func errorCode(code string) string {
+	switch code {
+	case "INVALID_ADDRESS":
+		return geoip2.CodeIPAddressInvalid
+	case "INVALID_KEY", "KEY_EXPIRED":
+		return geoip2.CodeAuthorizationInvalid
+	case "OVER_QUERY_LIMIT", "OVER_QUOTA":
+		return geoip2.CodeOutOfQueries
+	case "FEATURE_NOT_ALLOWED":
+		return geoip2.CodePermissionRequired
+	}
+	return ""
+}
+
+func (v response) toResponse(addr netip.Addr) geoip2.Response {
+	resp := geoip2.Response{
+		Continent: geoip2.Continent{Code: v.ContinentCode},
+		Country: geoip2.Country{
+			IsInEuropeanUnion: v.IsEuMember,
+			IsoCode:           v.CountryCode,
+		},
+		Location: geoip2.Location{
+			Latitude:  v.Latitude,
+			Longitude: v.Longitude,
+			TimeZone:  v.TimeZone,
+		},
+		Postal: geoip2.Postal{Code: v.ZipCode},
+		Traits: geoip2.Traits{
+			AutonomousSystemNumber:       v.AsNumber,
+			AutonomousSystemOrganization: v.AsName,
+			IpAddress:                    addr,
+			Isp:                          v.Isp,
+			Organization:                 v.Organization,
+			UserType:                     v.UsageType,
+		},
+	}
+	if v.ContinentName != "" {
+		resp.Continent.Names = map[string]string{"en": v.ContinentName}
+	}
+	if v.CountryName != "" {
+		resp.Country.Names = map[string]string{"en": v.CountryName}
+	}
+	if v.City != "" {
+		resp.City.Names = map[string]string{"en": v.City}
+	}
+	if v.StateProvCode != "" || v.StateProv != "" {
+		subdivision := geoip2.Subdivision{IsoCode: v.StateProvCode}
+		if v.StateProv != "" {
+			subdivision.Names = map[string]string{"en": v.StateProv}
+		}
+		resp.Subdivisions = []geoip2.Subdivision{subdivision}
+	}
+	return resp
+}
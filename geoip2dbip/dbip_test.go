@@ -0,0 +1,63 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2dbip
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/savaki/geoip2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClient(t *testing.T) {
+	Convey("Given a server standing in for DB-IP", t, func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/blah-key/8.8.8.8":
+				w.Write([]byte(`{"ipAddress":"8.8.8.8","continentCode":"NA","continentName":"North America","countryCode":"US","countryName":"United States","isEuMember":false,"stateProvCode":"CA","stateProv":"California","city":"Mountain View","latitude":37.4223,"longitude":-122.085,"timeZone":"America/Los_Angeles","asNumber":15169,"asName":"GOOGLE","isp":"Google LLC","usageType":"hosting"}`))
+			case "/blah-key/10.0.0.1":
+				w.Write([]byte(`{"ipAddress":"10.0.0.1","continentCode":"ZZ","continentName":"Unknown","countryCode":"ZZ","countryName":"Unknown","city":""}`))
+			default:
+				w.Write([]byte(`{"errorCode":"OVER_QUERY_LIMIT","error":"daily query limit exceeded"}`))
+			}
+		}))
+		defer server.Close()
+		client := New("blah-key", WithBaseURL(server.URL))
+		ctx := context.Background()
+
+		Convey("I expect the answer mapped onto a response", func() {
+			resp, err := client.City(ctx, "8.8.8.8")
+			So(err, ShouldBeNil)
+			So(resp.Country.IsoCode, ShouldEqual, "US")
+			So(resp.CityName(), ShouldEqual, "Mountain View")
+			So(resp.Subdivisions[0].IsoCode, ShouldEqual, "CA")
+			So(resp.Location.Longitude, ShouldEqual, -122.085)
+			So(resp.Traits.AutonomousSystemNumber, ShouldEqual, 15169)
+			So(resp.Traits.UserType, ShouldEqual, "hosting")
+		})
+
+		Convey("I expect errors reported with MaxMind codes", func() {
+			_, err := client.City(ctx, "10.0.0.1")
+			So(errors.Is(err, geoip2.ErrIPAddressReserved), ShouldBeTrue)
+
+			_, err = client.City(ctx, "1.1.1.1")
+			So(errors.Is(err, geoip2.ErrOutOfQueries), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,78 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2grpc
+
+import (
+	"context"
+
+	"github.com/savaki/geoip2"
+	"github.com/savaki/geoip2/geoip2grpc/geoip2pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Client is a geoip2.Lookuper calling a GeoIP2 gRPC service, so Go callers
+// can switch between it, the web service and a local database
+type Client struct {
+	client geoip2pb.GeoIP2Client
+}
+
+var _ geoip2.Lookuper = (*Client)(nil)
+
+// NewClient returns a Client calling the service on conn
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{client: geoip2pb.NewGeoIP2Client(conn)}
+}
+
+func (c *Client) Country(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.unary(ctx, c.client.Country, ipAddress)
+}
+
+func (c *Client) City(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.unary(ctx, c.client.City, ipAddress)
+}
+
+func (c *Client) Insights(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.unary(ctx, c.client.Insights, ipAddress)
+}
+
+type unaryFunc func(ctx context.Context, req *geoip2pb.LookupRequest, opts ...grpc.CallOption) (*geoip2pb.LookupResponse, error)
+
+func (c *Client) unary(ctx context.Context, fn unaryFunc, ipAddress string) (geoip2.Response, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	resp, err := fn(ctx, &geoip2pb.LookupRequest{IpAddress: ipAddress})
+	if err != nil {
+		return geoip2.Response{}, FromStatus(err)
+	}
+	return FromProto(resp.GetResponse()), nil
+}
+
+// FromStatus returns the geoip2.Error for a status carrying a MaxMind
+// ErrorInfo, so errors.Is matches the geoip2 sentinels, and err otherwise
+func FromStatus(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetDomain() == ErrorDomain {
+			return geoip2.Error{Code: info.GetReason(), Err: st.Message()}
+		}
+	}
+	return err
+}
@@ -0,0 +1,201 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2grpc
+
+import (
+	"net/netip"
+
+	"github.com/savaki/geoip2"
+	"github.com/savaki/geoip2/geoip2grpc/geoip2pb"
+)
+
+// ToProto converts a response to its protocol buffer form
+func ToProto(resp geoip2.Response) *geoip2pb.Response {
+	v := &geoip2pb.Response{
+		City: &geoip2pb.City{
+			Confidence: int32(resp.City.Confidence),
+			GeonameId:  int64(resp.City.GeoNameId),
+			Names:      resp.City.Names,
+		},
+		Continent: &geoip2pb.Continent{
+			Code:      resp.Continent.Code,
+			GeonameId: int64(resp.Continent.GeoNameId),
+			Names:     resp.Continent.Names,
+		},
+		Country: &geoip2pb.Country{
+			Confidence:        int32(resp.Country.Confidence),
+			GeonameId:         int64(resp.Country.GeoNameId),
+			IsInEuropeanUnion: resp.Country.IsInEuropeanUnion,
+			IsoCode:           resp.Country.IsoCode,
+			Names:             resp.Country.Names,
+		},
+		Location: &geoip2pb.Location{
+			AccuracyRadius:    int32(resp.Location.AccuracyRadius),
+			AverageIncome:     int32(resp.Location.AverageIncome),
+			Latitude:          resp.Location.Latitude,
+			Longitude:         resp.Location.Longitude,
+			MetroCode:         int32(resp.Location.MetroCode),
+			PopulationDensity: int32(resp.Location.PopulationDensity),
+			TimeZone:          resp.Location.TimeZone,
+		},
+		Postal: &geoip2pb.Postal{
+			Code:       resp.Postal.Code,
+			Confidence: int32(resp.Postal.Confidence),
+		},
+		RegisteredCountry: &geoip2pb.Country{
+			GeonameId:         int64(resp.RegisteredCountry.GeoNameId),
+			IsInEuropeanUnion: resp.RegisteredCountry.IsInEuropeanUnion,
+			IsoCode:           resp.RegisteredCountry.IsoCode,
+			Names:             resp.RegisteredCountry.Names,
+		},
+		RepresentedCountry: &geoip2pb.RepresentedCountry{
+			GeonameId:         int64(resp.RepresentedCountry.GeoNameId),
+			IsInEuropeanUnion: resp.RepresentedCountry.IsInEuropeanUnion,
+			IsoCode:           resp.RepresentedCountry.IsoCode,
+			Names:             resp.RepresentedCountry.Names,
+			Type:              resp.RepresentedCountry.Type,
+		},
+		Traits: &geoip2pb.Traits{
+			AutonomousSystemNumber:       int64(resp.Traits.AutonomousSystemNumber),
+			AutonomousSystemOrganization: resp.Traits.AutonomousSystemOrganization,
+			Domain:                       resp.Traits.Domain,
+			IsAnonymous:                  resp.Traits.IsAnonymous,
+			IsAnonymousProxy:             resp.Traits.IsAnonymousProxy,
+			IsAnonymousVpn:               resp.Traits.IsAnonymousVpn,
+			IsAnycast:                    resp.Traits.IsAnycast,
+			IsHostingProvider:            resp.Traits.IsHostingProvider,
+			IsPublicProxy:                resp.Traits.IsPublicProxy,
+			IsResidentialProxy:           resp.Traits.IsResidentialProxy,
+			IsSatelliteProvider:          resp.Traits.IsSatelliteProvider,
+			IsTorExitNode:                resp.Traits.IsTorExitNode,
+			Isp:                          resp.Traits.Isp,
+			MobileCountryCode:            resp.Traits.MobileCountryCode,
+			MobileNetworkCode:            resp.Traits.MobileNetworkCode,
+			Organization:                 resp.Traits.Organization,
+			UserType:                     resp.Traits.UserType,
+			ConnectionType:               resp.Traits.ConnectionType,
+		},
+		QueriesRemaining: int32(resp.MaxMind.QueriesRemaining),
+	}
+	if resp.Traits.IpAddress.IsValid() {
+		v.Traits.IpAddress = resp.Traits.IpAddress.String()
+	}
+	if resp.Traits.Network.IsValid() {
+		v.Traits.Network = resp.Traits.Network.String()
+	}
+	if !resp.Traits.StaticIpScore.IsZero() {
+		v.Traits.StaticIpScore = resp.Traits.StaticIpScore.String()
+	}
+	for _, subdivision := range resp.Subdivisions {
+		v.Subdivisions = append(v.Subdivisions, &geoip2pb.Subdivision{
+			Confidence: int32(subdivision.Confidence),
+			GeonameId:  int64(subdivision.GeoNameId),
+			IsoCode:    subdivision.IsoCode,
+			Names:      subdivision.Names,
+		})
+	}
+	return v
+}
+
+// FromProto converts a response from its protocol buffer form.  Missing
+// messages are treated as empty.
+func FromProto(v *geoip2pb.Response) geoip2.Response {
+	resp := geoip2.Response{
+		City: geoip2.City{
+			Confidence: int(v.GetCity().GetConfidence()),
+			GeoNameId:  int(v.GetCity().GetGeonameId()),
+			Names:      v.GetCity().GetNames(),
+		},
+		Continent: geoip2.Continent{
+			Code:      v.GetContinent().GetCode(),
+			GeoNameId: int(v.GetContinent().GetGeonameId()),
+			Names:     v.GetContinent().GetNames(),
+		},
+		Country: geoip2.Country{
+			Confidence:        int(v.GetCountry().GetConfidence()),
+			GeoNameId:         int(v.GetCountry().GetGeonameId()),
+			IsInEuropeanUnion: v.GetCountry().GetIsInEuropeanUnion(),
+			IsoCode:           v.GetCountry().GetIsoCode(),
+			Names:             v.GetCountry().GetNames(),
+		},
+		Location: geoip2.Location{
+			AccuracyRadius:    int(v.GetLocation().GetAccuracyRadius()),
+			AverageIncome:     int(v.GetLocation().GetAverageIncome()),
+			Latitude:          v.GetLocation().GetLatitude(),
+			Longitude:         v.GetLocation().GetLongitude(),
+			MetroCode:         int(v.GetLocation().GetMetroCode()),
+			PopulationDensity: int(v.GetLocation().GetPopulationDensity()),
+			TimeZone:          v.GetLocation().GetTimeZone(),
+		},
+		Postal: geoip2.Postal{
+			Code:       v.GetPostal().GetCode(),
+			Confidence: int(v.GetPostal().GetConfidence()),
+		},
+		RegisteredCountry: geoip2.RegisteredCountry{
+			GeoNameId:         int(v.GetRegisteredCountry().GetGeonameId()),
+			IsInEuropeanUnion: v.GetRegisteredCountry().GetIsInEuropeanUnion(),
+			IsoCode:           v.GetRegisteredCountry().GetIsoCode(),
+			Names:             v.GetRegisteredCountry().GetNames(),
+		},
+		RepresentedCountry: geoip2.RepresentedCountry{
+			GeoNameId:         int(v.GetRepresentedCountry().GetGeonameId()),
+			IsInEuropeanUnion: v.GetRepresentedCountry().GetIsInEuropeanUnion(),
+			IsoCode:           v.GetRepresentedCountry().GetIsoCode(),
+			Names:             v.GetRepresentedCountry().GetNames(),
+			Type:              v.GetRepresentedCountry().GetType(),
+		},
+		Traits: geoip2.Traits{
+			AutonomousSystemNumber:       int(v.GetTraits().GetAutonomousSystemNumber()),
+			AutonomousSystemOrganization: v.GetTraits().GetAutonomousSystemOrganization(),
+			Domain:                       v.GetTraits().GetDomain(),
+			IsAnonymous:                  v.GetTraits().GetIsAnonymous(),
+			IsAnonymousProxy:             v.GetTraits().GetIsAnonymousProxy(),
+			IsAnonymousVpn:               v.GetTraits().GetIsAnonymousVpn(),
+			IsAnycast:                    v.GetTraits().GetIsAnycast(),
+			IsHostingProvider:            v.GetTraits().GetIsHostingProvider(),
+			IsPublicProxy:                v.GetTraits().GetIsPublicProxy(),
+			IsResidentialProxy:           v.GetTraits().GetIsResidentialProxy(),
+			IsSatelliteProvider:          v.GetTraits().GetIsSatelliteProvider(),
+			IsTorExitNode:                v.GetTraits().GetIsTorExitNode(),
+			Isp:                          v.GetTraits().GetIsp(),
+			MobileCountryCode:            v.GetTraits().GetMobileCountryCode(),
+			MobileNetworkCode:            v.GetTraits().GetMobileNetworkCode(),
+			Organization:                 v.GetTraits().GetOrganization(),
+			UserType:                     v.GetTraits().GetUserType(),
+			ConnectionType:               v.GetTraits().GetConnectionType(),
+		},
+		MaxMind: geoip2.MaxMind{
+			QueriesRemaining: int(v.GetQueriesRemaining()),
+		},
+	}
+	if addr, err := netip.ParseAddr(v.GetTraits().GetIpAddress()); err == nil {
+		resp.Traits.IpAddress = addr
+	}
+	if prefix, err := netip.ParsePrefix(v.GetTraits().GetNetwork()); err == nil {
+		resp.Traits.Network = prefix
+	}
+	if score, err := geoip2.ParseDecimal(v.GetTraits().GetStaticIpScore()); err == nil {
+		resp.Traits.StaticIpScore = score
+	}
+	for _, subdivision := range v.GetSubdivisions() {
+		resp.Subdivisions = append(resp.Subdivisions, geoip2.Subdivision{
+			Confidence: int(subdivision.GetConfidence()),
+			GeoNameId:  int(subdivision.GetGeonameId()),
+			IsoCode:    subdivision.GetIsoCode(),
+			Names:      subdivision.GetNames(),
+		})
+	}
+	return resp
+}
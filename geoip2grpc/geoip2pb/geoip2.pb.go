@@ -0,0 +1,1840 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.28.3
+// source: geoip2.proto
+
+package geoip2pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Service int32
+
+const (
+	Service_SERVICE_UNSPECIFIED Service = 0
+	Service_SERVICE_COUNTRY     Service = 1
+	Service_SERVICE_CITY        Service = 2
+	Service_SERVICE_INSIGHTS    Service = 3
+)
+
+// Enum value maps for Service.
+var (
+	Service_name = map[int32]string{
+		0: "SERVICE_UNSPECIFIED",
+		1: "SERVICE_COUNTRY",
+		2: "SERVICE_CITY",
+		3: "SERVICE_INSIGHTS",
+	}
+	Service_value = map[string]int32{
+		"SERVICE_UNSPECIFIED": 0,
+		"SERVICE_COUNTRY":     1,
+		"SERVICE_CITY":        2,
+		"SERVICE_INSIGHTS":    3,
+	}
+)
+
+func (x Service) Enum() *Service {
+	p := new(Service)
+	*p = x
+	return p
+}
+
+func (x Service) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Service) Descriptor() protoreflect.EnumDescriptor {
+	return file_geoip2_proto_enumTypes[0].Descriptor()
+}
+
+func (Service) Type() protoreflect.EnumType {
+	return &file_geoip2_proto_enumTypes[0]
+}
+
+func (x Service) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Service.Descriptor instead.
+func (Service) EnumDescriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{0}
+}
+
+type LookupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IpAddress string `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+}
+
+func (x *LookupRequest) Reset() {
+	*x = LookupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupRequest) ProtoMessage() {}
+
+func (x *LookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupRequest.ProtoReflect.Descriptor instead.
+func (*LookupRequest) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LookupRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type LookupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response *Response `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (x *LookupResponse) Reset() {
+	*x = LookupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupResponse) ProtoMessage() {}
+
+func (x *LookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupResponse.ProtoReflect.Descriptor instead.
+func (*LookupResponse) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LookupResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type BatchLookupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service     Service  `protobuf:"varint,1,opt,name=service,proto3,enum=geoip2.v1.Service" json:"service,omitempty"`
+	IpAddresses []string `protobuf:"bytes,2,rep,name=ip_addresses,json=ipAddresses,proto3" json:"ip_addresses,omitempty"`
+}
+
+func (x *BatchLookupRequest) Reset() {
+	*x = BatchLookupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchLookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchLookupRequest) ProtoMessage() {}
+
+func (x *BatchLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchLookupRequest.ProtoReflect.Descriptor instead.
+func (*BatchLookupRequest) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchLookupRequest) GetService() Service {
+	if x != nil {
+		return x.Service
+	}
+	return Service_SERVICE_UNSPECIFIED
+}
+
+func (x *BatchLookupRequest) GetIpAddresses() []string {
+	if x != nil {
+		return x.IpAddresses
+	}
+	return nil
+}
+
+type BatchLookupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*LookupResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchLookupResponse) Reset() {
+	*x = BatchLookupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchLookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchLookupResponse) ProtoMessage() {}
+
+func (x *BatchLookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchLookupResponse.ProtoReflect.Descriptor instead.
+func (*BatchLookupResponse) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchLookupResponse) GetResults() []*LookupResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type StreamLookupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service   Service `protobuf:"varint,1,opt,name=service,proto3,enum=geoip2.v1.Service" json:"service,omitempty"`
+	IpAddress string  `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+}
+
+func (x *StreamLookupRequest) Reset() {
+	*x = StreamLookupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLookupRequest) ProtoMessage() {}
+
+func (x *StreamLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLookupRequest.ProtoReflect.Descriptor instead.
+func (*StreamLookupRequest) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamLookupRequest) GetService() Service {
+	if x != nil {
+		return x.Service
+	}
+	return Service_SERVICE_UNSPECIFIED
+}
+
+func (x *StreamLookupRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type LookupResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IpAddress string    `protobuf:"bytes,1,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Response  *Response `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Error     *Error    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *LookupResult) Reset() {
+	*x = LookupResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupResult) ProtoMessage() {}
+
+func (x *LookupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupResult.ProtoReflect.Descriptor instead.
+func (*LookupResult) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LookupResult) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *LookupResult) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *LookupResult) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code is the MaxMind error code, empty when the lookup failed for
+	// another reason
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Error) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Response mirrors the web service response
+// https://dev.maxmind.com/geoip/docs/web-services/responses
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	City               *City               `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Continent          *Continent          `protobuf:"bytes,2,opt,name=continent,proto3" json:"continent,omitempty"`
+	Country            *Country            `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	Location           *Location           `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Postal             *Postal             `protobuf:"bytes,5,opt,name=postal,proto3" json:"postal,omitempty"`
+	RegisteredCountry  *Country            `protobuf:"bytes,6,opt,name=registered_country,json=registeredCountry,proto3" json:"registered_country,omitempty"`
+	RepresentedCountry *RepresentedCountry `protobuf:"bytes,7,opt,name=represented_country,json=representedCountry,proto3" json:"represented_country,omitempty"`
+	Subdivisions       []*Subdivision      `protobuf:"bytes,8,rep,name=subdivisions,proto3" json:"subdivisions,omitempty"`
+	Traits             *Traits             `protobuf:"bytes,9,opt,name=traits,proto3" json:"traits,omitempty"`
+	QueriesRemaining   int32               `protobuf:"varint,10,opt,name=queries_remaining,json=queriesRemaining,proto3" json:"queries_remaining,omitempty"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Response) GetCity() *City {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+func (x *Response) GetContinent() *Continent {
+	if x != nil {
+		return x.Continent
+	}
+	return nil
+}
+
+func (x *Response) GetCountry() *Country {
+	if x != nil {
+		return x.Country
+	}
+	return nil
+}
+
+func (x *Response) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Response) GetPostal() *Postal {
+	if x != nil {
+		return x.Postal
+	}
+	return nil
+}
+
+func (x *Response) GetRegisteredCountry() *Country {
+	if x != nil {
+		return x.RegisteredCountry
+	}
+	return nil
+}
+
+func (x *Response) GetRepresentedCountry() *RepresentedCountry {
+	if x != nil {
+		return x.RepresentedCountry
+	}
+	return nil
+}
+
+func (x *Response) GetSubdivisions() []*Subdivision {
+	if x != nil {
+		return x.Subdivisions
+	}
+	return nil
+}
+
+func (x *Response) GetTraits() *Traits {
+	if x != nil {
+		return x.Traits
+	}
+	return nil
+}
+
+func (x *Response) GetQueriesRemaining() int32 {
+	if x != nil {
+		return x.QueriesRemaining
+	}
+	return 0
+}
+
+type City struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Confidence int32             `protobuf:"varint,1,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	GeonameId  int64             `protobuf:"varint,2,opt,name=geoname_id,json=geonameId,proto3" json:"geoname_id,omitempty"`
+	Names      map[string]string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *City) Reset() {
+	*x = City{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *City) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*City) ProtoMessage() {}
+
+func (x *City) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use City.ProtoReflect.Descriptor instead.
+func (*City) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *City) GetConfidence() int32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *City) GetGeonameId() int64 {
+	if x != nil {
+		return x.GeonameId
+	}
+	return 0
+}
+
+func (x *City) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type Continent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      string            `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	GeonameId int64             `protobuf:"varint,2,opt,name=geoname_id,json=geonameId,proto3" json:"geoname_id,omitempty"`
+	Names     map[string]string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Continent) Reset() {
+	*x = Continent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Continent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Continent) ProtoMessage() {}
+
+func (x *Continent) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Continent.ProtoReflect.Descriptor instead.
+func (*Continent) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Continent) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Continent) GetGeonameId() int64 {
+	if x != nil {
+		return x.GeonameId
+	}
+	return 0
+}
+
+func (x *Continent) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type Country struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Confidence        int32             `protobuf:"varint,1,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	GeonameId         int64             `protobuf:"varint,2,opt,name=geoname_id,json=geonameId,proto3" json:"geoname_id,omitempty"`
+	IsInEuropeanUnion bool              `protobuf:"varint,3,opt,name=is_in_european_union,json=isInEuropeanUnion,proto3" json:"is_in_european_union,omitempty"`
+	IsoCode           string            `protobuf:"bytes,4,opt,name=iso_code,json=isoCode,proto3" json:"iso_code,omitempty"`
+	Names             map[string]string `protobuf:"bytes,5,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Country) Reset() {
+	*x = Country{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Country) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Country) ProtoMessage() {}
+
+func (x *Country) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Country.ProtoReflect.Descriptor instead.
+func (*Country) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Country) GetConfidence() int32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *Country) GetGeonameId() int64 {
+	if x != nil {
+		return x.GeonameId
+	}
+	return 0
+}
+
+func (x *Country) GetIsInEuropeanUnion() bool {
+	if x != nil {
+		return x.IsInEuropeanUnion
+	}
+	return false
+}
+
+func (x *Country) GetIsoCode() string {
+	if x != nil {
+		return x.IsoCode
+	}
+	return ""
+}
+
+func (x *Country) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type RepresentedCountry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GeonameId         int64             `protobuf:"varint,1,opt,name=geoname_id,json=geonameId,proto3" json:"geoname_id,omitempty"`
+	IsInEuropeanUnion bool              `protobuf:"varint,2,opt,name=is_in_european_union,json=isInEuropeanUnion,proto3" json:"is_in_european_union,omitempty"`
+	IsoCode           string            `protobuf:"bytes,3,opt,name=iso_code,json=isoCode,proto3" json:"iso_code,omitempty"`
+	Names             map[string]string `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Type              string            `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *RepresentedCountry) Reset() {
+	*x = RepresentedCountry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepresentedCountry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepresentedCountry) ProtoMessage() {}
+
+func (x *RepresentedCountry) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepresentedCountry.ProtoReflect.Descriptor instead.
+func (*RepresentedCountry) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RepresentedCountry) GetGeonameId() int64 {
+	if x != nil {
+		return x.GeonameId
+	}
+	return 0
+}
+
+func (x *RepresentedCountry) GetIsInEuropeanUnion() bool {
+	if x != nil {
+		return x.IsInEuropeanUnion
+	}
+	return false
+}
+
+func (x *RepresentedCountry) GetIsoCode() string {
+	if x != nil {
+		return x.IsoCode
+	}
+	return ""
+}
+
+func (x *RepresentedCountry) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *RepresentedCountry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type Location struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccuracyRadius    int32   `protobuf:"varint,1,opt,name=accuracy_radius,json=accuracyRadius,proto3" json:"accuracy_radius,omitempty"`
+	AverageIncome     int32   `protobuf:"varint,2,opt,name=average_income,json=averageIncome,proto3" json:"average_income,omitempty"`
+	Latitude          float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude         float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	MetroCode         int32   `protobuf:"varint,5,opt,name=metro_code,json=metroCode,proto3" json:"metro_code,omitempty"`
+	PopulationDensity int32   `protobuf:"varint,6,opt,name=population_density,json=populationDensity,proto3" json:"population_density,omitempty"`
+	TimeZone          string  `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Location) GetAccuracyRadius() int32 {
+	if x != nil {
+		return x.AccuracyRadius
+	}
+	return 0
+}
+
+func (x *Location) GetAverageIncome() int32 {
+	if x != nil {
+		return x.AverageIncome
+	}
+	return 0
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Location) GetMetroCode() int32 {
+	if x != nil {
+		return x.MetroCode
+	}
+	return 0
+}
+
+func (x *Location) GetPopulationDensity() int32 {
+	if x != nil {
+		return x.PopulationDensity
+	}
+	return 0
+}
+
+func (x *Location) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type Postal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code       string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Confidence int32  `protobuf:"varint,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+}
+
+func (x *Postal) Reset() {
+	*x = Postal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Postal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Postal) ProtoMessage() {}
+
+func (x *Postal) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Postal.ProtoReflect.Descriptor instead.
+func (*Postal) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Postal) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Postal) GetConfidence() int32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+type Subdivision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Confidence int32             `protobuf:"varint,1,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	GeonameId  int64             `protobuf:"varint,2,opt,name=geoname_id,json=geonameId,proto3" json:"geoname_id,omitempty"`
+	IsoCode    string            `protobuf:"bytes,3,opt,name=iso_code,json=isoCode,proto3" json:"iso_code,omitempty"`
+	Names      map[string]string `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Subdivision) Reset() {
+	*x = Subdivision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subdivision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subdivision) ProtoMessage() {}
+
+func (x *Subdivision) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subdivision.ProtoReflect.Descriptor instead.
+func (*Subdivision) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Subdivision) GetConfidence() int32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *Subdivision) GetGeonameId() int64 {
+	if x != nil {
+		return x.GeonameId
+	}
+	return 0
+}
+
+func (x *Subdivision) GetIsoCode() string {
+	if x != nil {
+		return x.IsoCode
+	}
+	return ""
+}
+
+func (x *Subdivision) GetNames() map[string]string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type Traits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AutonomousSystemNumber       int64  `protobuf:"varint,1,opt,name=autonomous_system_number,json=autonomousSystemNumber,proto3" json:"autonomous_system_number,omitempty"`
+	AutonomousSystemOrganization string `protobuf:"bytes,2,opt,name=autonomous_system_organization,json=autonomousSystemOrganization,proto3" json:"autonomous_system_organization,omitempty"`
+	Domain                       string `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	IsAnonymous                  bool   `protobuf:"varint,4,opt,name=is_anonymous,json=isAnonymous,proto3" json:"is_anonymous,omitempty"`
+	IsAnonymousProxy             bool   `protobuf:"varint,5,opt,name=is_anonymous_proxy,json=isAnonymousProxy,proto3" json:"is_anonymous_proxy,omitempty"`
+	IsAnonymousVpn               bool   `protobuf:"varint,6,opt,name=is_anonymous_vpn,json=isAnonymousVpn,proto3" json:"is_anonymous_vpn,omitempty"`
+	IsAnycast                    bool   `protobuf:"varint,7,opt,name=is_anycast,json=isAnycast,proto3" json:"is_anycast,omitempty"`
+	IsHostingProvider            bool   `protobuf:"varint,8,opt,name=is_hosting_provider,json=isHostingProvider,proto3" json:"is_hosting_provider,omitempty"`
+	IsPublicProxy                bool   `protobuf:"varint,9,opt,name=is_public_proxy,json=isPublicProxy,proto3" json:"is_public_proxy,omitempty"`
+	IsResidentialProxy           bool   `protobuf:"varint,10,opt,name=is_residential_proxy,json=isResidentialProxy,proto3" json:"is_residential_proxy,omitempty"`
+	IsSatelliteProvider          bool   `protobuf:"varint,11,opt,name=is_satellite_provider,json=isSatelliteProvider,proto3" json:"is_satellite_provider,omitempty"`
+	IsTorExitNode                bool   `protobuf:"varint,12,opt,name=is_tor_exit_node,json=isTorExitNode,proto3" json:"is_tor_exit_node,omitempty"`
+	Isp                          string `protobuf:"bytes,13,opt,name=isp,proto3" json:"isp,omitempty"`
+	IpAddress                    string `protobuf:"bytes,14,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	MobileCountryCode            string `protobuf:"bytes,15,opt,name=mobile_country_code,json=mobileCountryCode,proto3" json:"mobile_country_code,omitempty"`
+	MobileNetworkCode            string `protobuf:"bytes,16,opt,name=mobile_network_code,json=mobileNetworkCode,proto3" json:"mobile_network_code,omitempty"`
+	Network                      string `protobuf:"bytes,17,opt,name=network,proto3" json:"network,omitempty"`
+	Organization                 string `protobuf:"bytes,18,opt,name=organization,proto3" json:"organization,omitempty"`
+	// static_ip_score is decimal, e.g. "1.23", to keep MaxMind's precision
+	StaticIpScore  string `protobuf:"bytes,19,opt,name=static_ip_score,json=staticIpScore,proto3" json:"static_ip_score,omitempty"`
+	UserType       string `protobuf:"bytes,20,opt,name=user_type,json=userType,proto3" json:"user_type,omitempty"`
+	ConnectionType string `protobuf:"bytes,21,opt,name=connection_type,json=connectionType,proto3" json:"connection_type,omitempty"`
+}
+
+func (x *Traits) Reset() {
+	*x = Traits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_geoip2_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Traits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Traits) ProtoMessage() {}
+
+func (x *Traits) ProtoReflect() protoreflect.Message {
+	mi := &file_geoip2_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Traits.ProtoReflect.Descriptor instead.
+func (*Traits) Descriptor() ([]byte, []int) {
+	return file_geoip2_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Traits) GetAutonomousSystemNumber() int64 {
+	if x != nil {
+		return x.AutonomousSystemNumber
+	}
+	return 0
+}
+
+func (x *Traits) GetAutonomousSystemOrganization() string {
+	if x != nil {
+		return x.AutonomousSystemOrganization
+	}
+	return ""
+}
+
+func (x *Traits) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Traits) GetIsAnonymous() bool {
+	if x != nil {
+		return x.IsAnonymous
+	}
+	return false
+}
+
+func (x *Traits) GetIsAnonymousProxy() bool {
+	if x != nil {
+		return x.IsAnonymousProxy
+	}
+	return false
+}
+
+func (x *Traits) GetIsAnonymousVpn() bool {
+	if x != nil {
+		return x.IsAnonymousVpn
+	}
+	return false
+}
+
+func (x *Traits) GetIsAnycast() bool {
+	if x != nil {
+		return x.IsAnycast
+	}
+	return false
+}
+
+func (x *Traits) GetIsHostingProvider() bool {
+	if x != nil {
+		return x.IsHostingProvider
+	}
+	return false
+}
+
+func (x *Traits) GetIsPublicProxy() bool {
+	if x != nil {
+		return x.IsPublicProxy
+	}
+	return false
+}
+
+func (x *Traits) GetIsResidentialProxy() bool {
+	if x != nil {
+		return x.IsResidentialProxy
+	}
+	return false
+}
+
+func (x *Traits) GetIsSatelliteProvider() bool {
+	if x != nil {
+		return x.IsSatelliteProvider
+	}
+	return false
+}
+
+func (x *Traits) GetIsTorExitNode() bool {
+	if x != nil {
+		return x.IsTorExitNode
+	}
+	return false
+}
+
+func (x *Traits) GetIsp() string {
+	if x != nil {
+		return x.Isp
+	}
+	return ""
+}
+
+func (x *Traits) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Traits) GetMobileCountryCode() string {
+	if x != nil {
+		return x.MobileCountryCode
+	}
+	return ""
+}
+
+func (x *Traits) GetMobileNetworkCode() string {
+	if x != nil {
+		return x.MobileNetworkCode
+	}
+	return ""
+}
+
+func (x *Traits) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Traits) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *Traits) GetStaticIpScore() string {
+	if x != nil {
+		return x.StaticIpScore
+	}
+	return ""
+}
+
+func (x *Traits) GetUserType() string {
+	if x != nil {
+		return x.UserType
+	}
+	return ""
+}
+
+func (x *Traits) GetConnectionType() string {
+	if x != nil {
+		return x.ConnectionType
+	}
+	return ""
+}
+
+var File_geoip2_proto protoreflect.FileDescriptor
+
+var file_geoip2_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+	0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x22, 0x2e, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x41, 0x0a, 0x0e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x12,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65,
+	0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x62, 0x0a,
+	0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x86, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x35, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x94, 0x04, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67,
+	0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x52, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70,
+	0x32, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x61,
+	0x6c, 0x12, 0x41, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x4e, 0x0a, 0x13, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x12, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x64, 0x69, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6f,
+	0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x64, 0x69, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x29, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x69, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x69, 0x74, 0x73, 0x52, 0x06, 0x74, 0x72, 0x61, 0x69, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x71,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xb1, 0x01, 0x0a, 0x04, 0x43, 0x69, 0x74,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x65, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x65, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x74, 0x79,
+	0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xaf, 0x01, 0x0a,
+	0x09, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x65, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x67, 0x65, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x35, 0x0a,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67,
+	0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x83,
+	0x02, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x65,
+	0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x67, 0x65, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x69, 0x73, 0x5f,
+	0x69, 0x6e, 0x5f, 0x65, 0x75, 0x72, 0x6f, 0x70, 0x65, 0x61, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x73, 0x49, 0x6e, 0x45, 0x75, 0x72,
+	0x6f, 0x70, 0x65, 0x61, 0x6e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73,
+	0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x73,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x8d, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x65, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x67, 0x65, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x69, 0x73,
+	0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x75, 0x72, 0x6f, 0x70, 0x65, 0x61, 0x6e, 0x5f, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x73, 0x49, 0x6e, 0x45, 0x75,
+	0x72, 0x6f, 0x70, 0x65, 0x61, 0x6e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69,
+	0x73, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69,
+	0x73, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x1a, 0x38, 0x0a, 0x0a, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xff, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x72, 0x61,
+	0x64, 0x69, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x75,
+	0x72, 0x61, 0x63, 0x79, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x65, 0x74, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x6d, 0x65, 0x74, 0x72, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x6f,
+	0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x3c, 0x0a, 0x06, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x0b, 0x53, 0x75, 0x62, 0x64, 0x69, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x65, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x65, 0x6f, 0x6e, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x6f, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x73, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x37,
+	0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x64, 0x69, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xde, 0x06, 0x0a, 0x06, 0x54, 0x72, 0x61, 0x69, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x18,
+	0x61, 0x75, 0x74, 0x6f, 0x6e, 0x6f, 0x6d, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x61, 0x75, 0x74, 0x6f, 0x6e, 0x6f, 0x6d, 0x6f, 0x75, 0x73, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x1e, 0x61, 0x75, 0x74, 0x6f, 0x6e, 0x6f,
+	0x6d, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c,
+	0x61, 0x75, 0x74, 0x6f, 0x6e, 0x6f, 0x6d, 0x6f, 0x75, 0x73, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
+	0x6d, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x73, 0x5f, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x6d, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x70, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x69, 0x73, 0x41, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x56, 0x70, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x63, 0x61, 0x73, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x41, 0x6e, 0x79, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x69, 0x73, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x73, 0x48,
+	0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x26,
+	0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x73,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x52, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x73, 0x5f, 0x73,
+	0x61, 0x74, 0x65, 0x6c, 0x6c, 0x69, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x73, 0x53, 0x61, 0x74, 0x65, 0x6c,
+	0x6c, 0x69, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x10,
+	0x69, 0x73, 0x5f, 0x74, 0x6f, 0x72, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x54, 0x6f, 0x72, 0x45, 0x78, 0x69,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x73, 0x70, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x69, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65,
+	0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x69,
+	0x70, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x63, 0x49, 0x70, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x2a, 0x5f, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a,
+	0x13, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43,
+	0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x52, 0x59, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x49, 0x54, 0x59, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x49, 0x47, 0x48, 0x54,
+	0x53, 0x10, 0x03, 0x32, 0xe1, 0x02, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x49, 0x50, 0x32, 0x12, 0x3e,
+	0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x2e, 0x67, 0x65, 0x6f, 0x69,
+	0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x12, 0x18, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x49,
+	0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0b,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x1d, 0x2e, 0x67, 0x65,
+	0x6f, 0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x65, 0x6f,
+	0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x1e, 0x2e, 0x67, 0x65, 0x6f,
+	0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x67, 0x65, 0x6f,
+	0x69, 0x70, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x28, 0x01, 0x30, 0x01, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x61, 0x76, 0x61, 0x6b, 0x69, 0x2f, 0x67, 0x65, 0x6f,
+	0x69, 0x70, 0x32, 0x2f, 0x67, 0x65, 0x6f, 0x69, 0x70, 0x32, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67,
+	0x65, 0x6f, 0x69, 0x70, 0x32, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_geoip2_proto_rawDescOnce sync.Once
+	file_geoip2_proto_rawDescData = file_geoip2_proto_rawDesc
+)
+
+func file_geoip2_proto_rawDescGZIP() []byte {
+	file_geoip2_proto_rawDescOnce.Do(func() {
+		file_geoip2_proto_rawDescData = protoimpl.X.CompressGZIP(file_geoip2_proto_rawDescData)
+	})
+	return file_geoip2_proto_rawDescData
+}
+
+var file_geoip2_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_geoip2_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_geoip2_proto_goTypes = []any{
+	(Service)(0),                // 0: geoip2.v1.Service
+	(*LookupRequest)(nil),       // 1: geoip2.v1.LookupRequest
+	(*LookupResponse)(nil),      // 2: geoip2.v1.LookupResponse
+	(*BatchLookupRequest)(nil),  // 3: geoip2.v1.BatchLookupRequest
+	(*BatchLookupResponse)(nil), // 4: geoip2.v1.BatchLookupResponse
+	(*StreamLookupRequest)(nil), // 5: geoip2.v1.StreamLookupRequest
+	(*LookupResult)(nil),        // 6: geoip2.v1.LookupResult
+	(*Error)(nil),               // 7: geoip2.v1.Error
+	(*Response)(nil),            // 8: geoip2.v1.Response
+	(*City)(nil),                // 9: geoip2.v1.City
+	(*Continent)(nil),           // 10: geoip2.v1.Continent
+	(*Country)(nil),             // 11: geoip2.v1.Country
+	(*RepresentedCountry)(nil),  // 12: geoip2.v1.RepresentedCountry
+	(*Location)(nil),            // 13: geoip2.v1.Location
+	(*Postal)(nil),              // 14: geoip2.v1.Postal
+	(*Subdivision)(nil),         // 15: geoip2.v1.Subdivision
+	(*Traits)(nil),              // 16: geoip2.v1.Traits
+	nil,                         // 17: geoip2.v1.City.NamesEntry
+	nil,                         // 18: geoip2.v1.Continent.NamesEntry
+	nil,                         // 19: geoip2.v1.Country.NamesEntry
+	nil,                         // 20: geoip2.v1.RepresentedCountry.NamesEntry
+	nil,                         // 21: geoip2.v1.Subdivision.NamesEntry
+}
+var file_geoip2_proto_depIdxs = []int32{
+	8,  // 0: geoip2.v1.LookupResponse.response:type_name -> geoip2.v1.Response
+	0,  // 1: geoip2.v1.BatchLookupRequest.service:type_name -> geoip2.v1.Service
+	6,  // 2: geoip2.v1.BatchLookupResponse.results:type_name -> geoip2.v1.LookupResult
+	0,  // 3: geoip2.v1.StreamLookupRequest.service:type_name -> geoip2.v1.Service
+	8,  // 4: geoip2.v1.LookupResult.response:type_name -> geoip2.v1.Response
+	7,  // 5: geoip2.v1.LookupResult.error:type_name -> geoip2.v1.Error
+	9,  // 6: geoip2.v1.Response.city:type_name -> geoip2.v1.City
+	10, // 7: geoip2.v1.Response.continent:type_name -> geoip2.v1.Continent
+	11, // 8: geoip2.v1.Response.country:type_name -> geoip2.v1.Country
+	13, // 9: geoip2.v1.Response.location:type_name -> geoip2.v1.Location
+	14, // 10: geoip2.v1.Response.postal:type_name -> geoip2.v1.Postal
+	11, // 11: geoip2.v1.Response.registered_country:type_name -> geoip2.v1.Country
+	12, // 12: geoip2.v1.Response.represented_country:type_name -> geoip2.v1.RepresentedCountry
+	15, // 13: geoip2.v1.Response.subdivisions:type_name -> geoip2.v1.Subdivision
+	16, // 14: geoip2.v1.Response.traits:type_name -> geoip2.v1.Traits
+	17, // 15: geoip2.v1.City.names:type_name -> geoip2.v1.City.NamesEntry
+	18, // 16: geoip2.v1.Continent.names:type_name -> geoip2.v1.Continent.NamesEntry
+	19, // 17: geoip2.v1.Country.names:type_name -> geoip2.v1.Country.NamesEntry
+	20, // 18: geoip2.v1.RepresentedCountry.names:type_name -> geoip2.v1.RepresentedCountry.NamesEntry
+	21, // 19: geoip2.v1.Subdivision.names:type_name -> geoip2.v1.Subdivision.NamesEntry
+	1,  // 20: geoip2.v1.GeoIP2.Country:input_type -> geoip2.v1.LookupRequest
+	1,  // 21: geoip2.v1.GeoIP2.City:input_type -> geoip2.v1.LookupRequest
+	1,  // 22: geoip2.v1.GeoIP2.Insights:input_type -> geoip2.v1.LookupRequest
+	3,  // 23: geoip2.v1.GeoIP2.BatchLookup:input_type -> geoip2.v1.BatchLookupRequest
+	5,  // 24: geoip2.v1.GeoIP2.StreamLookup:input_type -> geoip2.v1.StreamLookupRequest
+	2,  // 25: geoip2.v1.GeoIP2.Country:output_type -> geoip2.v1.LookupResponse
+	2,  // 26: geoip2.v1.GeoIP2.City:output_type -> geoip2.v1.LookupResponse
+	2,  // 27: geoip2.v1.GeoIP2.Insights:output_type -> geoip2.v1.LookupResponse
+	4,  // 28: geoip2.v1.GeoIP2.BatchLookup:output_type -> geoip2.v1.BatchLookupResponse
+	6,  // 29: geoip2.v1.GeoIP2.StreamLookup:output_type -> geoip2.v1.LookupResult
+	25, // [25:30] is the sub-list for method output_type
+	20, // [20:25] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_geoip2_proto_init() }
+func file_geoip2_proto_init() {
+	if File_geoip2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_geoip2_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*LookupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*LookupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchLookupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchLookupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamLookupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*LookupResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*City); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*Continent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Country); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*RepresentedCountry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Location); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Postal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*Subdivision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_geoip2_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*Traits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_geoip2_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_geoip2_proto_goTypes,
+		DependencyIndexes: file_geoip2_proto_depIdxs,
+		EnumInfos:         file_geoip2_proto_enumTypes,
+		MessageInfos:      file_geoip2_proto_msgTypes,
+	}.Build()
+	File_geoip2_proto = out.File
+	file_geoip2_proto_rawDesc = nil
+	file_geoip2_proto_goTypes = nil
+	file_geoip2_proto_depIdxs = nil
+}
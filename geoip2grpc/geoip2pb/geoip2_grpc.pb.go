@@ -0,0 +1,302 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: geoip2.proto
+
+package geoip2pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GeoIP2_Country_FullMethodName      = "/geoip2.v1.GeoIP2/Country"
+	GeoIP2_City_FullMethodName         = "/geoip2.v1.GeoIP2/City"
+	GeoIP2_Insights_FullMethodName     = "/geoip2.v1.GeoIP2/Insights"
+	GeoIP2_BatchLookup_FullMethodName  = "/geoip2.v1.GeoIP2/BatchLookup"
+	GeoIP2_StreamLookup_FullMethodName = "/geoip2.v1.GeoIP2/StreamLookup"
+)
+
+// GeoIP2Client is the client API for GeoIP2 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GeoIP2 looks up addresses with the GeoIP2 web services.  Failed lookups
+// are reported with the gRPC status for the MaxMind error, carrying an
+// ErrorInfo whose reason is the MaxMind error code, e.g.
+// IP_ADDRESS_NOT_FOUND, and whose domain is maxmind.com.
+type GeoIP2Client interface {
+	Country(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error)
+	City(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error)
+	Insights(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error)
+	// BatchLookup looks up every address, returning the results in request
+	// order.  Failed lookups are reported in each result rather than failing
+	// the call.
+	BatchLookup(ctx context.Context, in *BatchLookupRequest, opts ...grpc.CallOption) (*BatchLookupResponse, error)
+	// StreamLookup answers each request as its lookup completes, which may be
+	// out of order
+	StreamLookup(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamLookupRequest, LookupResult], error)
+}
+
+type geoIP2Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGeoIP2Client(cc grpc.ClientConnInterface) GeoIP2Client {
+	return &geoIP2Client{cc}
+}
+
+func (c *geoIP2Client) Country(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupResponse)
+	err := c.cc.Invoke(ctx, GeoIP2_Country_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoIP2Client) City(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupResponse)
+	err := c.cc.Invoke(ctx, GeoIP2_City_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoIP2Client) Insights(ctx context.Context, in *LookupRequest, opts ...grpc.CallOption) (*LookupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LookupResponse)
+	err := c.cc.Invoke(ctx, GeoIP2_Insights_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoIP2Client) BatchLookup(ctx context.Context, in *BatchLookupRequest, opts ...grpc.CallOption) (*BatchLookupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchLookupResponse)
+	err := c.cc.Invoke(ctx, GeoIP2_BatchLookup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoIP2Client) StreamLookup(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamLookupRequest, LookupResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GeoIP2_ServiceDesc.Streams[0], GeoIP2_StreamLookup_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamLookupRequest, LookupResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GeoIP2_StreamLookupClient = grpc.BidiStreamingClient[StreamLookupRequest, LookupResult]
+
+// GeoIP2Server is the server API for GeoIP2 service.
+// All implementations must embed UnimplementedGeoIP2Server
+// for forward compatibility.
+//
+// GeoIP2 looks up addresses with the GeoIP2 web services.  Failed lookups
+// are reported with the gRPC status for the MaxMind error, carrying an
+// ErrorInfo whose reason is the MaxMind error code, e.g.
+// IP_ADDRESS_NOT_FOUND, and whose domain is maxmind.com.
+type GeoIP2Server interface {
+	Country(context.Context, *LookupRequest) (*LookupResponse, error)
+	City(context.Context, *LookupRequest) (*LookupResponse, error)
+	Insights(context.Context, *LookupRequest) (*LookupResponse, error)
+	// BatchLookup looks up every address, returning the results in request
+	// order.  Failed lookups are reported in each result rather than failing
+	// the call.
+	BatchLookup(context.Context, *BatchLookupRequest) (*BatchLookupResponse, error)
+	// StreamLookup answers each request as its lookup completes, which may be
+	// out of order
+	StreamLookup(grpc.BidiStreamingServer[StreamLookupRequest, LookupResult]) error
+	mustEmbedUnimplementedGeoIP2Server()
+}
+
+// UnimplementedGeoIP2Server must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGeoIP2Server struct{}
+
+func (UnimplementedGeoIP2Server) Country(context.Context, *LookupRequest) (*LookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Country not implemented")
+}
+func (UnimplementedGeoIP2Server) City(context.Context, *LookupRequest) (*LookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method City not implemented")
+}
+func (UnimplementedGeoIP2Server) Insights(context.Context, *LookupRequest) (*LookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Insights not implemented")
+}
+func (UnimplementedGeoIP2Server) BatchLookup(context.Context, *BatchLookupRequest) (*BatchLookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchLookup not implemented")
+}
+func (UnimplementedGeoIP2Server) StreamLookup(grpc.BidiStreamingServer[StreamLookupRequest, LookupResult]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLookup not implemented")
+}
+func (UnimplementedGeoIP2Server) mustEmbedUnimplementedGeoIP2Server() {}
+func (UnimplementedGeoIP2Server) testEmbeddedByValue()                {}
+
+// UnsafeGeoIP2Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GeoIP2Server will
+// result in compilation errors.
+type UnsafeGeoIP2Server interface {
+	mustEmbedUnimplementedGeoIP2Server()
+}
+
+func RegisterGeoIP2Server(s grpc.ServiceRegistrar, srv GeoIP2Server) {
+	// If the following call pancis, it indicates UnimplementedGeoIP2Server was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GeoIP2_ServiceDesc, srv)
+}
+
+func _GeoIP2_Country_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoIP2Server).Country(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeoIP2_Country_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoIP2Server).Country(ctx, req.(*LookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoIP2_City_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoIP2Server).City(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeoIP2_City_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoIP2Server).City(ctx, req.(*LookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoIP2_Insights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoIP2Server).Insights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeoIP2_Insights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoIP2Server).Insights(ctx, req.(*LookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoIP2_BatchLookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchLookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoIP2Server).BatchLookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeoIP2_BatchLookup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoIP2Server).BatchLookup(ctx, req.(*BatchLookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeoIP2_StreamLookup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GeoIP2Server).StreamLookup(&grpc.GenericServerStream[StreamLookupRequest, LookupResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GeoIP2_StreamLookupServer = grpc.BidiStreamingServer[StreamLookupRequest, LookupResult]
+
+// GeoIP2_ServiceDesc is the grpc.ServiceDesc for GeoIP2 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GeoIP2_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "geoip2.v1.GeoIP2",
+	HandlerType: (*GeoIP2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Country",
+			Handler:    _GeoIP2_Country_Handler,
+		},
+		{
+			MethodName: "City",
+			Handler:    _GeoIP2_City_Handler,
+		},
+		{
+			MethodName: "Insights",
+			Handler:    _GeoIP2_Insights_Handler,
+		},
+		{
+			MethodName: "BatchLookup",
+			Handler:    _GeoIP2_BatchLookup_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLookup",
+			Handler:       _GeoIP2_StreamLookup_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "geoip2.proto",
+}
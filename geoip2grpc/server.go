@@ -0,0 +1,261 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package geoip2grpc serves lookups over gRPC, so services in any language
+// get geo enrichment without reimplementing MaxMind authentication and
+// error handling.  The service is defined in geoip2pb/geoip2.proto.
+//
+//	api := geoip2.New(userId, licenseKey, geoip2.WithCache(geoip2.NewLRUCache(100000)))
+//	server := grpc.NewServer()
+//	geoip2pb.RegisterGeoIP2Server(server, geoip2grpc.NewServer(api))
+//
+// Go callers can use the generated client, or NewClient for a
+// geoip2.Lookuper.
+package geoip2grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/savaki/geoip2"
+	"github.com/savaki/geoip2/geoip2grpc/geoip2pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the ErrorInfo attached to failed lookups
+const ErrorDomain = "maxmind.com"
+
+// Option configures a Server
+type Option func(*Server)
+
+// WithConcurrency bounds the lookups in flight for each batch or stream,
+// 4 by default
+func WithConcurrency(n int) Option {
+	return func(s *Server) {
+		s.concurrency = n
+	}
+}
+
+// WithMaxBatch bounds the addresses in a BatchLookup, 1000 by default
+func WithMaxBatch(n int) Option {
+	return func(s *Server) {
+		s.maxBatch = n
+	}
+}
+
+// Server implements geoip2pb.GeoIP2Server with a Lookuper
+type Server struct {
+	geoip2pb.UnimplementedGeoIP2Server
+
+	lookuper    geoip2.Lookuper
+	concurrency int
+	maxBatch    int
+}
+
+var _ geoip2pb.GeoIP2Server = (*Server)(nil)
+
+// NewServer returns a Server answering from lookuper
+func NewServer(lookuper geoip2.Lookuper, opts ...Option) *Server {
+	s := &Server{
+		lookuper:    lookuper,
+		concurrency: 4,
+		maxBatch:    1000,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.concurrency <= 0 {
+		s.concurrency = 1
+	}
+	return s
+}
+
+func (s *Server) Country(ctx context.Context, req *geoip2pb.LookupRequest) (*geoip2pb.LookupResponse, error) {
+	return s.unary(ctx, s.lookuper.Country, req)
+}
+
+func (s *Server) City(ctx context.Context, req *geoip2pb.LookupRequest) (*geoip2pb.LookupResponse, error) {
+	return s.unary(ctx, s.lookuper.City, req)
+}
+
+func (s *Server) Insights(ctx context.Context, req *geoip2pb.LookupRequest) (*geoip2pb.LookupResponse, error) {
+	return s.unary(ctx, s.lookuper.Insights, req)
+}
+
+func (s *Server) unary(ctx context.Context, lookup geoip2.LookupFunc, req *geoip2pb.LookupRequest) (*geoip2pb.LookupResponse, error) {
+	resp, err := lookup(ctx, req.GetIpAddress())
+	if err != nil {
+		return nil, Status(err).Err()
+	}
+	return &geoip2pb.LookupResponse{Response: ToProto(resp)}, nil
+}
+
+func (s *Server) BatchLookup(ctx context.Context, req *geoip2pb.BatchLookupRequest) (*geoip2pb.BatchLookupResponse, error) {
+	lookup, err := s.lookupFor(req.GetService())
+	if err != nil {
+		return nil, err
+	}
+	if s.maxBatch > 0 && len(req.GetIpAddresses()) > s.maxBatch {
+		return nil, status.Errorf(codes.InvalidArgument, "geoip2: batch of %d addresses exceeds the limit of %d", len(req.GetIpAddresses()), s.maxBatch)
+	}
+
+	results, err := geoip2.Batch(ctx, lookup, req.GetIpAddresses(), s.concurrency)
+	if err != nil {
+		return nil, Status(err).Err()
+	}
+	v := &geoip2pb.BatchLookupResponse{}
+	for _, result := range results {
+		v.Results = append(v.Results, toResult(result))
+	}
+	return v, nil
+}
+
+// StreamLookup reads requests while earlier lookups are in flight, up to
+// the concurrency of the Server, and ends once the client has closed its
+// side and every result has been sent
+func (s *Server) StreamLookup(stream grpc.BidiStreamingServer[geoip2pb.StreamLookupRequest, geoip2pb.LookupResult]) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		mutex   sync.Mutex
+		sendErr error
+		slots   = make(chan struct{}, s.concurrency)
+	)
+	send := func(result *geoip2pb.LookupResult) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if sendErr == nil {
+			if sendErr = stream.Send(result); sendErr != nil {
+				cancel()
+			}
+		}
+	}
+
+	var recvErr error
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				recvErr = err
+			}
+			break
+		}
+		lookup, err := s.lookupFor(req.GetService())
+		if err != nil {
+			recvErr = err
+			break
+		}
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(ipAddress string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			resp, err := lookup(ctx, ipAddress)
+			send(toResult(geoip2.Result{IpAddress: ipAddress, Response: resp, Err: err}))
+		}(req.GetIpAddress())
+	}
+	if recvErr != nil {
+		cancel()
+	}
+	wg.Wait()
+
+	switch {
+	case recvErr != nil:
+		return recvErr
+	case sendErr != nil:
+		return sendErr
+	}
+	return stream.Context().Err()
+}
+
+func (s *Server) lookupFor(service geoip2pb.Service) (geoip2.LookupFunc, error) {
+	switch service {
+	case geoip2pb.Service_SERVICE_COUNTRY:
+		return s.lookuper.Country, nil
+	case geoip2pb.Service_SERVICE_CITY:
+		return s.lookuper.City, nil
+	case geoip2pb.Service_SERVICE_INSIGHTS:
+		return s.lookuper.Insights, nil
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "geoip2: unknown service %v", service)
+}
+
+func toResult(result geoip2.Result) *geoip2pb.LookupResult {
+	v := &geoip2pb.LookupResult{IpAddress: result.IpAddress}
+	if result.Err != nil {
+		v.Error = &geoip2pb.Error{Message: result.Err.Error()}
+		var e geoip2.Error
+		if errors.As(result.Err, &e) {
+			v.Error.Code = e.Code
+		}
+		return v
+	}
+	v.Response = ToProto(result.Response)
+	return v
+}
+
+// statusCodes maps MaxMind error codes to the closest gRPC code
+var statusCodes = map[string]codes.Code{
+	geoip2.CodeIPAddressInvalid:     codes.InvalidArgument,
+	geoip2.CodeIPAddressRequired:    codes.InvalidArgument,
+	geoip2.CodeIPAddressReserved:    codes.InvalidArgument,
+	geoip2.CodeIPAddressNotFound:    codes.NotFound,
+	geoip2.CodeInsufficientFunds:    codes.ResourceExhausted,
+	geoip2.CodeOutOfQueries:         codes.ResourceExhausted,
+	geoip2.CodeAccountIdRequired:    codes.Internal,
+	geoip2.CodeAccountIdUnknown:     codes.Internal,
+	geoip2.CodeAuthorizationInvalid: codes.Internal,
+	geoip2.CodeLicenseKeyRequired:   codes.Internal,
+	geoip2.CodePermissionRequired:   codes.PermissionDenied,
+}
+
+// Status returns the gRPC status for a lookup error.  MaxMind errors carry
+// an ErrorInfo with the error code as its reason.  The credentials are the
+// server's own, so MaxMind refusing them is an internal error.
+func Status(err error) *status.Status {
+	var e geoip2.Error
+	switch {
+	case errors.As(err, &e) && e.Code != "":
+		code, ok := statusCodes[e.Code]
+		if !ok {
+			code = codes.Unknown
+		}
+		st := status.New(code, err.Error())
+		if detailed, derr := st.WithDetails(&errdetails.ErrorInfo{Reason: e.Code, Domain: ErrorDomain}); derr == nil {
+			return detailed
+		}
+		return st
+	case errors.Is(err, geoip2.ErrQuotaExhausted):
+		return status.New(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, geoip2.ErrCircuitOpen):
+		return status.New(codes.Unavailable, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err)
+	}
+	return status.New(codes.Unavailable, err.Error())
+}
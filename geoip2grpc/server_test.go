@@ -0,0 +1,111 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/savaki/geoip2"
+	"github.com/savaki/geoip2/geoip2grpc/geoip2pb"
+	"github.com/savaki/geoip2/geoip2test"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestServer(t *testing.T) {
+	Convey("Given a gRPC service in front of the web service", t, func() {
+		upstream := geoip2test.NewServer()
+		defer upstream.Close()
+
+		listener := bufconn.Listen(1 << 20)
+		server := grpc.NewServer()
+		geoip2pb.RegisterGeoIP2Server(server, NewServer(upstream.Api(), WithMaxBatch(3)))
+		go server.Serve(listener)
+		defer server.Stop()
+
+		conn, err := grpc.NewClient("passthrough:///bufconn",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		So(err, ShouldBeNil)
+		defer conn.Close()
+		client := NewClient(conn)
+		ctx := context.Background()
+
+		Convey("I expect lookups to round trip", func() {
+			resp, err := client.Insights(ctx, geoip2test.Addr)
+			So(err, ShouldBeNil)
+			expected := geoip2test.Response("insights")
+			So(resp.City, ShouldResemble, expected.City)
+			So(resp.Subdivisions, ShouldResemble, expected.Subdivisions)
+			So(resp.Traits, ShouldResemble, expected.Traits)
+			So(resp.Traits.StaticIpScore.String(), ShouldEqual, "13.08")
+		})
+
+		Convey("I expect MaxMind errors to keep their codes", func() {
+			_, err := client.City(ctx, "1.1.1.1")
+			So(errors.Is(err, geoip2.ErrIPAddressNotFound), ShouldBeTrue)
+
+			_, err = geoip2pb.NewGeoIP2Client(conn).City(ctx, &geoip2pb.LookupRequest{IpAddress: "10.0.0.1"})
+			So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("I expect a batch to report each result in order", func() {
+			resp, err := geoip2pb.NewGeoIP2Client(conn).BatchLookup(ctx, &geoip2pb.BatchLookupRequest{
+				Service:     geoip2pb.Service_SERVICE_COUNTRY,
+				IpAddresses: []string{geoip2test.Addr, "1.1.1.1"},
+			})
+			So(err, ShouldBeNil)
+			So(resp.GetResults(), ShouldHaveLength, 2)
+			So(resp.GetResults()[0].GetResponse().GetCountry().GetIsoCode(), ShouldEqual, "GB")
+			So(resp.GetResults()[1].GetError().GetCode(), ShouldEqual, geoip2.CodeIPAddressNotFound)
+
+			_, err = geoip2pb.NewGeoIP2Client(conn).BatchLookup(ctx, &geoip2pb.BatchLookupRequest{
+				Service:     geoip2pb.Service_SERVICE_COUNTRY,
+				IpAddresses: []string{"1.1.1.1", "1.1.1.2", "1.1.1.3", "1.1.1.4"},
+			})
+			So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("I expect a stream to answer every request", func() {
+			stream, err := geoip2pb.NewGeoIP2Client(conn).StreamLookup(ctx)
+			So(err, ShouldBeNil)
+			So(stream.Send(&geoip2pb.StreamLookupRequest{Service: geoip2pb.Service_SERVICE_CITY, IpAddress: geoip2test.Addr}), ShouldBeNil)
+			So(stream.Send(&geoip2pb.StreamLookupRequest{Service: geoip2pb.Service_SERVICE_COUNTRY, IpAddress: "1.1.1.1"}), ShouldBeNil)
+			So(stream.CloseSend(), ShouldBeNil)
+
+			results := map[string]*geoip2pb.LookupResult{}
+			for {
+				result, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				So(err, ShouldBeNil)
+				results[result.GetIpAddress()] = result
+			}
+			So(results, ShouldHaveLength, 2)
+			So(results[geoip2test.Addr].GetResponse().GetCity().GetNames()["en"], ShouldEqual, "London")
+			So(results["1.1.1.1"].GetError().GetCode(), ShouldEqual, geoip2.CodeIPAddressNotFound)
+		})
+	})
+}
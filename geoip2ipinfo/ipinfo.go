@@ -0,0 +1,203 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package geoip2ipinfo adapts the ipinfo.io API to geoip2.Lookuper, so it
+// can stand in for, or back up, the GeoIP2 web services.
+//
+//	lookuper := geoip2.NewChain(api, geoip2ipinfo.New(token))
+//
+// ipinfo.io answers every lookup with the same fields, so Country, City and
+// Insights differ only in name.  Its errors are reported as geoip2.Error
+// with the closest MaxMind code.
+// https://ipinfo.io/developers
+package geoip2ipinfo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/savaki/geoip2"
+)
+
+// DefaultBaseURL is the root of the ipinfo.io API
+const DefaultBaseURL = "https://ipinfo.io/"
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sends requests with client instead of http.DefaultClient
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.client = client
+	}
+}
+
+// WithBaseURL sends requests to baseURL in place of DefaultBaseURL
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		c.baseURL = baseURL
+	}
+}
+
+// Client is a geoip2.Lookuper answering from ipinfo.io
+type Client struct {
+	token   string
+	baseURL string
+	client  *http.Client
+}
+
+var _ geoip2.Lookuper = (*Client)(nil)
+
+// New returns a Client authenticating with token
+func New(token string, opts ...Option) *Client {
+	c := &Client{
+		token:   token,
+		baseURL: DefaultBaseURL,
+		client:  http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Client) Country(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.lookup(ctx, ipAddress)
+}
+
+func (c *Client) City(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.lookup(ctx, ipAddress)
+}
+
+func (c *Client) Insights(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.lookup(ctx, ipAddress)
+}
+
+// response is the body ipinfo.io answers with
+type response struct {
+	Ip       string `json:"ip"`
+	Bogon    bool   `json:"bogon"`
+	Hostname string `json:"hostname"`
+	City     string `json:"city"`
+	Region   string `json:"region"`
+	Country  string `json:"country"`
+	Loc      string `json:"loc"`
+	Org      string `json:"org"`
+	Postal   string `json:"postal"`
+	Timezone string `json:"timezone"`
+	Error    *struct {
+		Title   string `json:"title"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (c *Client) lookup(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return geoip2.Response{}, geoip2.Error{
+			Code: geoip2.CodeIPAddressInvalid,
+			Err:  fmt.Sprintf("The value %q is not a valid IP address.", ipAddress),
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+url.PathEscape(addr.String())+"/json", nil)
+	if err != nil {
+		return geoip2.Response{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return geoip2.Response{}, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return geoip2.Response{}, err
+	}
+
+	v := response{}
+	json.Unmarshal(data, &v)
+	if resp.StatusCode >= 400 {
+		e := geoip2.Error{Code: errorCode(resp.StatusCode), StatusCode: resp.StatusCode, Body: string(data)}
+		if v.Error != nil {
+			e.Err = v.Error.Message
+		}
+		return geoip2.Response{}, e
+	}
+	if v.Bogon {
+		return geoip2.Response{}, geoip2.Error{
+			Code: geoip2.CodeIPAddressReserved,
+			Err:  fmt.Sprintf("The IP address '%s' is a reserved IP address.", addr),
+		}
+	}
+	return v.toResponse(addr), nil
+}
+
+// errorCode maps ipinfo.io statuses to the closest MaxMind code
+func errorCode(statusCode int) string {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return geoip2.CodeIPAddressInvalid
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return geoip2.CodeAuthorizationInvalid
+	case http.StatusNotFound:
+		return geoip2.CodeIPAddressNotFound
+	case http.StatusTooManyRequests:
+		return geoip2.CodeOutOfQueries
+	}
+	return ""
+}
+
+func (v response) toResponse(addr netip.Addr) geoip2.Response {
+	resp := geoip2.Response{
+		Country:  geoip2.Country{IsoCode: v.Country},
+		Location: geoip2.Location{TimeZone: v.Timezone},
+		Postal:   geoip2.Postal{Code: v.Postal},
+		Traits:   geoip2.Traits{IpAddress: addr, Domain: v.Hostname},
+	}
+	if v.City != "" {
+		resp.City.Names = map[string]string{"en": v.City}
+	}
+	if v.Region != "" {
+		resp.Subdivisions = []geoip2.Subdivision{{Names: map[string]string{"en": v.Region}}}
+	}
+	if latitude, longitude, ok := strings.Cut(v.Loc, ","); ok {
+		resp.Location.Latitude, _ = strconv.ParseFloat(latitude, 64)
+		resp.Location.Longitude, _ = strconv.ParseFloat(longitude, 64)
+	}
+
+	// org is the autonomous system, e.g. "AS15169 Google LLC"
+	if asn, organization, ok := strings.Cut(v.Org, " "); ok && strings.HasPrefix(asn, "AS") {
+		resp.Traits.AutonomousSystemNumber, _ = strconv.Atoi(asn[2:])
+		resp.Traits.AutonomousSystemOrganization = organization
+	} else {
+		resp.Traits.AutonomousSystemOrganization = v.Org
+	}
+	return resp
+}
@@ -0,0 +1,77 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2ipinfo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/savaki/geoip2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClient(t *testing.T) {
+	Convey("Given a server standing in for ipinfo.io", t, func() {
+		var authorization string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			authorization = req.Header.Get("Authorization")
+			switch req.URL.Path {
+			case "/8.8.8.8/json":
+				w.Write([]byte(`{"ip":"8.8.8.8","hostname":"dns.google","city":"Mountain View","region":"California","country":"US","loc":"37.4056,-122.0775","org":"AS15169 Google LLC","postal":"94043","timezone":"America/Los_Angeles"}`))
+			case "/10.0.0.1/json":
+				w.Write([]byte(`{"ip":"10.0.0.1","bogon":true}`))
+			case "/1.1.1.1/json":
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(`{"error":{"title":"Rate limit exceeded","message":"Upgrade to increase your usage limits"}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":{"title":"Wrong ip","message":"Please provide a valid IP address"}}`))
+			}
+		}))
+		defer server.Close()
+		client := New("blah-token", WithBaseURL(server.URL))
+		ctx := context.Background()
+
+		Convey("I expect the answer mapped onto a response", func() {
+			resp, err := client.City(ctx, "8.8.8.8")
+			So(err, ShouldBeNil)
+			So(authorization, ShouldEqual, "Bearer blah-token")
+			So(resp.Country.IsoCode, ShouldEqual, "US")
+			So(resp.CityName(), ShouldEqual, "Mountain View")
+			So(resp.SubdivisionName(), ShouldEqual, "California")
+			So(resp.Location.Latitude, ShouldEqual, 37.4056)
+			So(resp.Location.Longitude, ShouldEqual, -122.0775)
+			So(resp.Location.TimeZone, ShouldEqual, "America/Los_Angeles")
+			So(resp.Postal.Code, ShouldEqual, "94043")
+			So(resp.Traits.AutonomousSystemNumber, ShouldEqual, 15169)
+			So(resp.Traits.AutonomousSystemOrganization, ShouldEqual, "Google LLC")
+			So(resp.Traits.IpAddress.String(), ShouldEqual, "8.8.8.8")
+		})
+
+		Convey("I expect errors reported with MaxMind codes", func() {
+			_, err := client.Country(ctx, "10.0.0.1")
+			So(errors.Is(err, geoip2.ErrIPAddressReserved), ShouldBeTrue)
+
+			_, err = client.Country(ctx, "1.1.1.1")
+			So(errors.Is(err, geoip2.ErrOutOfQueries), ShouldBeTrue)
+
+			_, err = client.Country(ctx, "nope")
+			So(errors.Is(err, geoip2.ErrIPAddressInvalid), ShouldBeTrue)
+		})
+	})
+}
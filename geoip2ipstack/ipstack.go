@@ -0,0 +1,237 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package geoip2ipstack adapts the ipstack API to geoip2.Lookuper, so it
+// can stand in for, or back up, the GeoIP2 web services.
+//
+//	lookuper := geoip2.NewChain(api, geoip2ipstack.New(accessKey))
+//
+// ipstack answers every lookup with the same fields, so Country, City and
+// Insights differ only in name.  Its errors are reported as geoip2.Error
+// with the closest MaxMind code.
+// https://ipstack.com/documentation
+package geoip2ipstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/savaki/geoip2"
+)
+
+// DefaultBaseURL is the root of the ipstack API
+const DefaultBaseURL = "https://api.ipstack.com/"
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sends requests with client instead of http.DefaultClient
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.client = client
+	}
+}
+
+// WithBaseURL sends requests to baseURL in place of DefaultBaseURL, e.g.
+// the http root on plans without https
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		c.baseURL = baseURL
+	}
+}
+
+// Client is a geoip2.Lookuper answering from ipstack
+type Client struct {
+	accessKey string
+	baseURL   string
+	client    *http.Client
+}
+
+var _ geoip2.Lookuper = (*Client)(nil)
+
+// New returns a Client authenticating with accessKey
+func New(accessKey string, opts ...Option) *Client {
+	c := &Client{
+		accessKey: accessKey,
+		baseURL:   DefaultBaseURL,
+		client:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Client) Country(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.lookup(ctx, ipAddress)
+}
+
+func (c *Client) City(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.lookup(ctx, ipAddress)
+}
+
+func (c *Client) Insights(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	return c.lookup(ctx, ipAddress)
+}
+
+// response is the body ipstack answers with.  Failures are answered with
+// status 200 and success false.
+type response struct {
+	Success       *bool   `json:"success"`
+	Ip            string  `json:"ip"`
+	ContinentCode string  `json:"continent_code"`
+	ContinentName string  `json:"continent_name"`
+	CountryCode   string  `json:"country_code"`
+	CountryName   string  `json:"country_name"`
+	RegionCode    string  `json:"region_code"`
+	RegionName    string  `json:"region_name"`
+	City          string  `json:"city"`
+	Zip           string  `json:"zip"`
+	Latitude      float64 `json:"latitude"`
+	Longitude     float64 `json:"longitude"`
+	Location      struct {
+		GeoNameId int  `json:"geoname_id"`
+		IsEu      bool `json:"is_eu"`
+	} `json:"location"`
+	TimeZone struct {
+		Id string `json:"id"`
+	} `json:"time_zone"`
+	Connection struct {
+		Asn int    `json:"asn"`
+		Isp string `json:"isp"`
+	} `json:"connection"`
+	Error *struct {
+		Code int    `json:"code"`
+		Type string `json:"type"`
+		Info string `json:"info"`
+	} `json:"error"`
+}
+
+func (c *Client) lookup(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return geoip2.Response{}, geoip2.Error{
+			Code: geoip2.CodeIPAddressInvalid,
+			Err:  fmt.Sprintf("The value %q is not a valid IP address.", ipAddress),
+		}
+	}
+
+	query := url.Values{"access_key": {c.accessKey}}
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+url.PathEscape(addr.String())+"?"+query.Encode(), nil)
+	if err != nil {
+		return geoip2.Response{}, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		// the access key is in the URL, so keep it out of the error
+		return geoip2.Response{}, fmt.Errorf("geoip2ipstack: lookup failed: %w", unwrapURLError(err))
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return geoip2.Response{}, err
+	}
+
+	v := response{}
+	if err := json.Unmarshal(data, &v); err != nil || resp.StatusCode >= 400 {
+		return geoip2.Response{}, geoip2.Error{StatusCode: resp.StatusCode, Body: string(data)}
+	}
+	if v.Error != nil {
+		return geoip2.Response{}, geoip2.Error{Code: errorCode(v.Error.Code), Err: v.Error.Info, StatusCode: resp.StatusCode, Body: string(data)}
+	}
+	if v.CountryCode == "" && v.Latitude == 0 && v.Longitude == 0 {
+		return geoip2.Response{}, geoip2.Error{
+			Code: geoip2.CodeIPAddressNotFound,
+			Err:  fmt.Sprintf("The address %s is not in the database.", addr),
+		}
+	}
+	return v.toResponse(addr), nil
+}
+
+// unwrapURLError drops the URL, and with it the access key, from err
+func unwrapURLError(err error) error {
+	if e, ok := err.(*url.Error); ok {
+		return e.Err
+	}
+	return err
+}
+
+// errorCode maps ipstack error codes to the closest MaxMind code
+// https://ipstack.com/documentation#errors
+func errorCode(code int) string {
+	switch code {
+	case 101:
+		return geoip2.CodeAuthorizationInvalid
+	case 102:
+		return geoip2.CodePermissionRequired
+	case 104:
+		return geoip2.CodeOutOfQueries
+	case 105:
+		return geoip2.CodePermissionRequired
+	case 106:
+		return geoip2.CodeIPAddressInvalid
+	}
+	return ""
+}
+
+func (v response) toResponse(addr netip.Addr) geoip2.Response {
+	resp := geoip2.Response{
+		Continent: geoip2.Continent{Code: v.ContinentCode},
+		Country: geoip2.Country{
+			IsInEuropeanUnion: v.Location.IsEu,
+			IsoCode:           v.CountryCode,
+		},
+		Location: geoip2.Location{
+			Latitude:  v.Latitude,
+			Longitude: v.Longitude,
+			TimeZone:  v.TimeZone.Id,
+		},
+		Postal: geoip2.Postal{Code: v.Zip},
+		Traits: geoip2.Traits{
+			AutonomousSystemNumber: v.Connection.Asn,
+			IpAddress:              addr,
+			Isp:                    v.Connection.Isp,
+		},
+	}
+	if v.ContinentName != "" {
+		resp.Continent.Names = map[string]string{"en": v.ContinentName}
+	}
+	if v.CountryName != "" {
+		resp.Country.Names = map[string]string{"en": v.CountryName}
+	}
+	if v.City != "" {
+		resp.City.GeoNameId = v.Location.GeoNameId
+		resp.City.Names = map[string]string{"en": v.City}
+	}
+	if v.RegionCode != "" || v.RegionName != "" {
+		subdivision := geoip2.Subdivision{IsoCode: v.RegionCode}
+		if v.RegionName != "" {
+			subdivision.Names = map[string]string{"en": v.RegionName}
+		}
+		resp.Subdivisions = []geoip2.Subdivision{subdivision}
+	}
+	return resp
+}
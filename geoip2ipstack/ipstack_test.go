@@ -0,0 +1,77 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2ipstack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/savaki/geoip2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClient(t *testing.T) {
+	Convey("Given a server standing in for ipstack", t, func() {
+		var accessKey string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			accessKey = req.URL.Query().Get("access_key")
+			switch req.URL.Path {
+			case "/134.201.250.155":
+				w.Write([]byte(`{"ip":"134.201.250.155","type":"ipv4","continent_code":"NA","continent_name":"North America","country_code":"US","country_name":"United States","region_code":"CA","region_name":"California","city":"Los Angeles","zip":"90013","latitude":34.0453,"longitude":-118.2413,"location":{"geoname_id":5368361,"is_eu":false},"time_zone":{"id":"America/Los_Angeles"},"connection":{"asn":25876,"isp":"Los Angeles Department of Water & Power"}}`))
+			case "/10.0.0.1":
+				w.Write([]byte(`{"ip":"10.0.0.1","type":"ipv4","continent_code":null,"country_code":null,"latitude":0,"longitude":0}`))
+			default:
+				w.Write([]byte(`{"success":false,"error":{"code":104,"type":"usage_limit_reached","info":"Your monthly usage limit has been reached."}}`))
+			}
+		}))
+		defer server.Close()
+		client := New("blah-access-key", WithBaseURL(server.URL))
+		ctx := context.Background()
+
+		Convey("I expect the answer mapped onto a response", func() {
+			resp, err := client.Insights(ctx, "134.201.250.155")
+			So(err, ShouldBeNil)
+			So(accessKey, ShouldEqual, "blah-access-key")
+			So(resp.Continent.Code, ShouldEqual, "NA")
+			So(resp.CountryName(), ShouldEqual, "United States")
+			So(resp.CityName(), ShouldEqual, "Los Angeles")
+			So(resp.Subdivisions[0].IsoCode, ShouldEqual, "CA")
+			So(resp.Location.Latitude, ShouldEqual, 34.0453)
+			So(resp.Location.TimeZone, ShouldEqual, "America/Los_Angeles")
+			So(resp.Traits.AutonomousSystemNumber, ShouldEqual, 25876)
+		})
+
+		Convey("I expect errors reported with MaxMind codes", func() {
+			_, err := client.City(ctx, "1.1.1.1")
+			So(errors.Is(err, geoip2.ErrOutOfQueries), ShouldBeTrue)
+
+			_, err = client.City(ctx, "10.0.0.1")
+			So(errors.Is(err, geoip2.ErrIPAddressNotFound), ShouldBeTrue)
+
+			_, err = client.City(ctx, "nope")
+			So(errors.Is(err, geoip2.ErrIPAddressInvalid), ShouldBeTrue)
+		})
+
+		Convey("I expect the access key kept out of transport errors", func() {
+			server.Close()
+			_, err := client.City(ctx, "1.1.1.1")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldNotContainSubstring, "blah-access-key")
+		})
+	})
+}
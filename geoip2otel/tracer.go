@@ -0,0 +1,109 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package geoip2otel traces geoip2 lookups with OpenTelemetry.
+//
+//	api := geoip2.New(userId, licenseKey, geoip2otel.WithTracerProvider(otel.GetTracerProvider()))
+//
+// Addresses are recorded as a truncated SHA-256 hash, so spans can be
+// correlated by client without storing the address itself.
+package geoip2otel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/savaki/geoip2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName identifies the instrumentation library in exported spans
+const ScopeName = "github.com/savaki/geoip2"
+
+// Attribute keys recorded on each span
+const (
+	EndpointKey   = attribute.Key("geoip2.endpoint")
+	IPHashKey     = attribute.Key("geoip2.ip_address.hash")
+	StatusCodeKey = attribute.Key("http.response.status_code")
+	RetriesKey    = attribute.Key("geoip2.retries")
+	CachedKey     = attribute.Key("geoip2.cached")
+	ErrorCodeKey  = attribute.Key("geoip2.error.code")
+)
+
+// WithTracerProvider traces every lookup with a tracer from tp
+func WithTracerProvider(tp trace.TracerProvider) geoip2.Option {
+	return geoip2.WithTracer(NewTracer(tp))
+}
+
+// NewTracer returns a geoip2.Tracer that starts a client span named after
+// the endpoint, e.g. "geoip2.city", for each lookup
+func NewTracer(tp trace.TracerProvider) geoip2.Tracer {
+	return tracer{tracer: tp.Tracer(ScopeName)}
+}
+
+type tracer struct {
+	tracer trace.Tracer
+}
+
+func (t tracer) StartLookup(ctx context.Context, endpoint, ipAddress string) (context.Context, geoip2.LookupSpan) {
+	ctx, span := t.tracer.Start(ctx, "geoip2."+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			EndpointKey.String(endpoint),
+			IPHashKey.String(hashIP(ipAddress)),
+		),
+	)
+	return ctx, lookupSpan{span: span}
+}
+
+// hashIP returns the first 8 bytes of the address's SHA-256 in hex
+func hashIP(ipAddress string) string {
+	sum := sha256.Sum256([]byte(ipAddress))
+	return hex.EncodeToString(sum[:8])
+}
+
+type lookupSpan struct {
+	span trace.Span
+}
+
+func (s lookupSpan) End(resp geoip2.Response, err error) {
+	defer s.span.End()
+
+	if err != nil {
+		var e geoip2.Error
+		if errors.As(err, &e) {
+			if e.StatusCode != 0 {
+				s.span.SetAttributes(StatusCodeKey.Int(e.StatusCode))
+			}
+			if e.Code != "" {
+				s.span.SetAttributes(ErrorCodeKey.String(e.Code))
+			}
+		}
+		// messages often quote the address
+		msg := geoip2.RedactIPs(err.Error())
+		s.span.RecordError(errors.New(msg))
+		s.span.SetStatus(codes.Error, msg)
+		return
+	}
+
+	meta := resp.Meta()
+	s.span.SetAttributes(CachedKey.Bool(meta.Cached), RetriesKey.Int(meta.Retries))
+	if meta.StatusCode != 0 {
+		s.span.SetAttributes(StatusCodeKey.Int(meta.StatusCode))
+	}
+}
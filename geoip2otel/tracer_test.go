@@ -0,0 +1,103 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2otel
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/savaki/geoip2"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	values := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		values[kv.Key] = kv.Value
+	}
+	return values
+}
+
+func TestTracer(t *testing.T) {
+	Convey("Given an Api traced with an OpenTelemetry provider", t, func() {
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		var parent trace.SpanContext
+		api := geoip2.WithClientFunc(geoip2.New("blah-user-id", "blah-license-key",
+			WithTracerProvider(provider),
+			geoip2.WithCache(geoip2.NewLRUCache(10)),
+		), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			parent = trace.SpanContextFromContext(req.Context())
+			if strings.HasSuffix(req.URL.Path, "/8.8.8.8") {
+				return &http.Response{
+					StatusCode: 404,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"code":"IP_ADDRESS_NOT_FOUND","error":"The address 8.8.8.8 is not in the database."}`)),
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"traits":{"ip_address":"1.2.3.4","network":"1.2.3.0/24"}}`)),
+			}, nil
+		})
+
+		Convey("When I make lookups", func() {
+			api.City(nil, "1.2.3.4")
+			api.City(nil, "1.2.3.4")
+			api.City(nil, "8.8.8.8")
+			spans := recorder.Ended()
+			So(len(spans), ShouldEqual, 3)
+
+			Convey("I expect a client span per lookup without the address", func() {
+				span := spans[0]
+				So(span.Name(), ShouldEqual, "geoip2.city")
+				So(span.SpanKind(), ShouldEqual, trace.SpanKindClient)
+				values := attributes(span)
+				So(values[EndpointKey].AsString(), ShouldEqual, "city")
+				So(values[IPHashKey].AsString(), ShouldEqual, hashIP("1.2.3.4"))
+				So(values[StatusCodeKey].AsInt64(), ShouldEqual, 200)
+				So(values[CachedKey].AsBool(), ShouldBeFalse)
+				for _, value := range values {
+					So(value.Emit(), ShouldNotContainSubstring, "1.2.3.4")
+				}
+			})
+
+			Convey("I expect the outbound request to carry the span", func() {
+				So(parent.SpanID(), ShouldEqual, spans[2].SpanContext().SpanID())
+			})
+
+			Convey("I expect cache hits to be marked", func() {
+				So(attributes(spans[1])[CachedKey].AsBool(), ShouldBeTrue)
+			})
+
+			Convey("I expect failures to be recorded and scrubbed", func() {
+				span := spans[2]
+				So(span.Status().Code, ShouldEqual, codes.Error)
+				So(span.Status().Description, ShouldNotContainSubstring, "8.8.8.8")
+				values := attributes(span)
+				So(values[StatusCodeKey].AsInt64(), ShouldEqual, 404)
+				So(values[ErrorCodeKey].AsString(), ShouldEqual, geoip2.CodeIPAddressNotFound)
+			})
+		})
+	})
+}
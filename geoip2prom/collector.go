@@ -0,0 +1,143 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package geoip2prom exports geoip2 lookup metrics to Prometheus.
+//
+//	collector := geoip2prom.NewCollector(geoip2prom.Opts{Namespace: "myapp"})
+//	prometheus.MustRegister(collector)
+//	api := geoip2.New(userId, licenseKey, geoip2.WithInstrumentation(collector))
+package geoip2prom
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/savaki/geoip2"
+)
+
+// Opts names the metrics.  ConstLabels are added to every metric, e.g. the
+// region the client runs in.
+type Opts struct {
+	Namespace   string
+	Subsystem   string // defaults to "geoip2"
+	ConstLabels prometheus.Labels
+
+	// Buckets for the latency histogram in seconds, defaulting to
+	// prometheus.DefBuckets
+	Buckets []float64
+}
+
+// Collector is both a prometheus.Collector and a geoip2.Instrumentation
+type Collector struct {
+	requests  *prometheus.CounterVec
+	errors    *prometheus.CounterVec
+	latency   *prometheus.HistogramVec
+	cache     *prometheus.CounterVec
+	remaining prometheus.Gauge
+}
+
+var (
+	_ geoip2.Instrumentation = (*Collector)(nil)
+	_ prometheus.Collector   = (*Collector)(nil)
+)
+
+// NewCollector returns a Collector exporting
+//
+//	geoip2_requests_total{endpoint,code}
+//	geoip2_errors_total{endpoint,code}
+//	geoip2_request_duration_seconds{endpoint}
+//	geoip2_cache_lookups_total{endpoint,result}
+//	geoip2_queries_remaining
+//
+// where code is the HTTP status, or "error" when no response was received,
+// and result is "hit" or "miss"
+func NewCollector(opts Opts) *Collector {
+	if opts.Subsystem == "" {
+		opts.Subsystem = "geoip2"
+	}
+	if opts.Buckets == nil {
+		opts.Buckets = prometheus.DefBuckets
+	}
+	counter := func(name, help string, labels ...string) *prometheus.CounterVec {
+		return prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        name,
+			Help:        help,
+			ConstLabels: opts.ConstLabels,
+		}, labels)
+	}
+
+	return &Collector{
+		requests: counter("requests_total", "Requests to the GeoIP2 web services.", "endpoint", "code"),
+		errors:   counter("errors_total", "Requests that failed or were answered with an error.", "endpoint", "code"),
+		cache:    counter("cache_lookups_total", "Lookups answered, or not, by the cache.", "endpoint", "result"),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "request_duration_seconds",
+			Help:        "Latency of requests to the GeoIP2 web services.",
+			ConstLabels: opts.ConstLabels,
+			Buckets:     opts.Buckets,
+		}, []string{"endpoint"}),
+		remaining: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "queries_remaining",
+			Help:        "Queries remaining on the account, as last reported.",
+			ConstLabels: opts.ConstLabels,
+		}),
+	}
+}
+
+func (c *Collector) ObserveRequest(endpoint string, status int, latency time.Duration, err error) {
+	code := "error"
+	if status != 0 {
+		code = strconv.Itoa(status)
+	}
+	c.requests.WithLabelValues(endpoint, code).Inc()
+	if err != nil || status >= 400 {
+		c.errors.WithLabelValues(endpoint, code).Inc()
+	}
+	c.latency.WithLabelValues(endpoint).Observe(latency.Seconds())
+}
+
+func (c *Collector) ObserveCache(endpoint string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	c.cache.WithLabelValues(endpoint, result).Inc()
+}
+
+func (c *Collector) ObserveQueriesRemaining(remaining int) {
+	c.remaining.Set(float64(remaining))
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.errors.Describe(ch)
+	c.latency.Describe(ch)
+	c.cache.Describe(ch)
+	c.remaining.Describe(ch)
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.errors.Collect(ch)
+	c.latency.Collect(ch)
+	c.cache.Collect(ch)
+	c.remaining.Collect(ch)
+}
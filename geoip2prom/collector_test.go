@@ -0,0 +1,83 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2prom
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/savaki/geoip2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCollector(t *testing.T) {
+	Convey("Given an Api instrumented with a Collector", t, func() {
+		collector := NewCollector(Opts{ConstLabels: prometheus.Labels{"region": "eu-west-1"}})
+		registry := prometheus.NewPedanticRegistry()
+		So(registry.Register(collector), ShouldBeNil)
+
+		api := geoip2.WithClientFunc(geoip2.New("blah-user-id", "blah-license-key",
+			geoip2.WithInstrumentation(collector),
+			geoip2.WithCache(geoip2.NewLRUCache(10)),
+		), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/8.8.8.8") {
+				return &http.Response{
+					StatusCode: 402,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"code":"OUT_OF_QUERIES","error":"out"}`)),
+				}, nil
+			}
+			header := http.Header{}
+			header.Set(geoip2.QueriesRemainingHeader, "42")
+			return &http.Response{
+				StatusCode: 200,
+				Header:     header,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"traits":{"ip_address":"1.2.3.4","network":"1.2.3.0/24"}}`)),
+			}, nil
+		})
+
+		Convey("When I make lookups", func() {
+			api.City(nil, "1.2.3.4")
+			api.City(nil, "1.2.3.4")
+			api.City(nil, "8.8.8.8")
+
+			Convey("I expect them to be counted", func() {
+				So(testutil.ToFloat64(collector.requests.WithLabelValues("city", "200")), ShouldEqual, 1)
+				So(testutil.ToFloat64(collector.errors.WithLabelValues("city", "402")), ShouldEqual, 1)
+				So(testutil.ToFloat64(collector.cache.WithLabelValues("city", "hit")), ShouldEqual, 1)
+				So(testutil.ToFloat64(collector.cache.WithLabelValues("city", "miss")), ShouldEqual, 2)
+				So(testutil.ToFloat64(collector.remaining), ShouldEqual, 42)
+				So(testutil.CollectAndCount(collector, "geoip2_request_duration_seconds"), ShouldEqual, 1)
+			})
+
+			Convey("I expect the registry to gather them with the const labels", func() {
+				families, err := registry.Gather()
+				So(err, ShouldBeNil)
+				So(len(families), ShouldEqual, 5)
+				for _, family := range families {
+					labels := map[string]string{}
+					for _, label := range family.Metric[0].Label {
+						labels[label.GetName()] = label.GetValue()
+					}
+					So(labels["region"], ShouldEqual, "eu-west-1")
+				}
+			})
+		})
+	})
+}
@@ -0,0 +1,199 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+
+	"github.com/savaki/geoip2"
+)
+
+// BulkPath is where WithBulk serves bulk lookups
+const BulkPath = "/bulk"
+
+// maxBulkBody bounds the body of a bulk request
+const maxBulkBody = 1 << 20
+
+// WithBulk serves POST {BulkPath}, which looks up a JSON array of addresses
+// and networks, e.g. ["81.2.69.142", "2001:db8::/126"], with the service
+// named by the service query parameter, city by default.  Results are
+// streamed back as they complete, one JSON object per line, each holding
+// the address and either its response or its error:
+//
+//	{"ip_address":"81.2.69.142","response":{...}}
+//	{"ip_address":"2001:db8::1","error":{"code":"IP_ADDRESS_NOT_FOUND","error":"..."}}
+//
+// Each client may send up to maxAddresses addresses, counting every address
+// of a network, in one request, and may have one bulk request in progress;
+// its lookups run concurrency at a time.
+func WithBulk(maxAddresses, concurrency int) Option {
+	return func(h *Handler) {
+		h.bulk = &bulkLimits{
+			maxAddresses: maxAddresses,
+			concurrency:  concurrency,
+			active:       map[string]bool{},
+		}
+	}
+}
+
+type bulkLimits struct {
+	maxAddresses int
+	concurrency  int
+
+	mutex  sync.Mutex
+	active map[string]bool
+}
+
+// acquire reports whether userId may start a bulk request
+func (b *bulkLimits) acquire(userId string) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.active[userId] {
+		return false
+	}
+	b.active[userId] = true
+	return true
+}
+
+func (b *bulkLimits) release(userId string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	delete(b.active, userId)
+}
+
+// bulkResult is a line of a bulk response
+type bulkResult struct {
+	IpAddress string           `json:"ip_address"`
+	Response  *geoip2.Response `json:"response,omitempty"`
+	Error     *geoip2.Error    `json:"error,omitempty"`
+}
+
+func (h *Handler) serveBulk(w http.ResponseWriter, req *http.Request, userId string) {
+	lookup, ok := h.lookupFunc(req.URL.Query().Get("service"))
+	if !ok {
+		writeError(w, http.StatusBadRequest, "", "The service must be country, city or insights.")
+		return
+	}
+
+	var items []string
+	if err := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxBulkBody)).Decode(&items); err != nil {
+		writeError(w, http.StatusBadRequest, "", "The body must be a JSON array of IP addresses and networks.")
+		return
+	}
+
+	// the whole request is checked against the limit before any lookup
+	var prefixes []netip.Prefix
+	count := 0
+	for _, item := range items {
+		n := 1
+		if strings.Contains(item, "/") {
+			prefix, err := netip.ParsePrefix(item)
+			if err == nil {
+				prefix = prefix.Masked()
+				n = prefixSize(prefix, h.bulk.maxAddresses+1)
+			}
+			prefixes = append(prefixes, prefix)
+		}
+		if count += n; count > h.bulk.maxAddresses {
+			writeError(w, http.StatusRequestEntityTooLarge, "", fmt.Sprintf("A bulk request may look up at most %d addresses.", h.bulk.maxAddresses))
+			return
+		}
+	}
+
+	if !h.bulk.acquire(userId) {
+		writeError(w, http.StatusTooManyRequests, "", "Only one bulk request may be in progress at a time.")
+		return
+	}
+	defer h.bulk.release(userId)
+
+	addresses := func(yield func(string) bool) {
+		next := 0
+		for _, item := range items {
+			if !strings.Contains(item, "/") {
+				if !yield(item) {
+					return
+				}
+				continue
+			}
+			prefix := prefixes[next]
+			next++
+			if !prefix.IsValid() {
+				if !yield(item) {
+					return
+				}
+				continue
+			}
+			for addr := prefix.Addr(); addr.IsValid() && prefix.Contains(addr); addr = addr.Next() {
+				if !yield(addr.String()) {
+					return
+				}
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+	for ipAddress, result := range geoip2.Stream(req.Context(), h.bulkLookup(lookup), addresses, h.bulk.concurrency) {
+		line := bulkResult{IpAddress: ipAddress}
+		if result.Err != nil {
+			if h.onError != nil {
+				h.onError(req, result.Err)
+			}
+			_, e := lookupError(result.Err)
+			line.Error = &e
+		} else {
+			line.Response = &result.Response
+		}
+		if err := encoder.Encode(line); err != nil {
+			return // the client has gone
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// bulkLookup refuses the addresses a bulk request may not look up: "me",
+// which would be the proxy's own, and networks that aren't valid
+func (h *Handler) bulkLookup(lookup geoip2.LookupFunc) geoip2.LookupFunc {
+	return func(ctx context.Context, ipAddress string) (geoip2.Response, error) {
+		if ipAddress == "me" || strings.Contains(ipAddress, "/") {
+			return geoip2.Response{}, geoip2.Error{
+				Code: geoip2.CodeIPAddressInvalid,
+				Err:  fmt.Sprintf("The value %q is not a valid IP address or network.", ipAddress),
+			}
+		}
+		return lookup(ctx, ipAddress)
+	}
+}
+
+// prefixSize returns the number of addresses in prefix, or limit if there are
+// more
+func prefixSize(prefix netip.Prefix, limit int) int {
+	bits := prefix.Addr().BitLen() - prefix.Bits()
+	if bits >= 31 || 1<<bits > limit {
+		return limit
+	}
+	return 1 << bits
+}
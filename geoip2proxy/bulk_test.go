@@ -0,0 +1,133 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2proxy
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/savaki/geoip2"
+	"github.com/savaki/geoip2/geoip2test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBulk(t *testing.T) {
+	Convey("Given a proxy serving bulk lookups", t, func() {
+		upstream := geoip2test.NewServer()
+		defer upstream.Close()
+
+		handler := New(upstream.Api(), WithAccounts(map[string]string{"billing": "s3cret"}), WithBulk(8, 2))
+		proxy := httptest.NewServer(handler)
+		defer proxy.Close()
+
+		post := func(query, body string) *http.Response {
+			req, err := http.NewRequest("POST", proxy.URL+BulkPath+query, strings.NewReader(body))
+			So(err, ShouldBeNil)
+			req.SetBasicAuth("billing", "s3cret")
+			resp, err := http.DefaultClient.Do(req)
+			So(err, ShouldBeNil)
+			return resp
+		}
+
+		Convey("When I post addresses and a network", func() {
+			resp := post("?service=country", `["`+geoip2test.Addr+`", "bad", "me", "81.2.69.160/30"]`)
+			defer resp.Body.Close()
+
+			results := map[string][]bulkResult{}
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				var result bulkResult
+				So(json.Unmarshal(scanner.Bytes(), &result), ShouldBeNil)
+				results[result.IpAddress] = append(results[result.IpAddress], result)
+			}
+
+			Convey("I expect a line for every address, with its response or error", func() {
+				So(resp.StatusCode, ShouldEqual, http.StatusOK)
+				So(resp.Header.Get("Content-Type"), ShouldEqual, "application/x-ndjson")
+				So(len(results), ShouldEqual, 6)
+
+				So(len(results[geoip2test.Addr]), ShouldEqual, 2)
+				for _, result := range results[geoip2test.Addr] {
+					So(result.Error, ShouldBeNil)
+					So(result.Response.Country.IsoCode, ShouldEqual, "GB")
+				}
+				So(results["bad"][0].Error.Code, ShouldEqual, geoip2.CodeIPAddressInvalid)
+				So(results["me"][0].Error.Code, ShouldEqual, geoip2.CodeIPAddressInvalid)
+				So(results["81.2.69.163"][0].Error.Code, ShouldEqual, geoip2.CodeIPAddressNotFound)
+			})
+		})
+
+		Convey("When I post more addresses than a client may look up", func() {
+			resp := post("", `["81.2.69.142", "10.0.0.0/8"]`)
+			resp.Body.Close()
+
+			Convey("I expect the request refused before any lookup", func() {
+				So(resp.StatusCode, ShouldEqual, http.StatusRequestEntityTooLarge)
+				So(upstream.Requests(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the client already has a bulk request in progress", func() {
+			So(handler.bulk.acquire("billing"), ShouldBeTrue)
+			resp := post("", `["81.2.69.142"]`)
+			resp.Body.Close()
+
+			Convey("I expect it to be told to wait", func() {
+				So(resp.StatusCode, ShouldEqual, http.StatusTooManyRequests)
+				So(upstream.Requests(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When the request is malformed", func() {
+			Convey("I expect it refused", func() {
+				resp := post("", `{"ip": "81.2.69.142"}`)
+				resp.Body.Close()
+				So(resp.StatusCode, ShouldEqual, http.StatusBadRequest)
+
+				resp = post("?service=asn", `["81.2.69.142"]`)
+				resp.Body.Close()
+				So(resp.StatusCode, ShouldEqual, http.StatusBadRequest)
+
+				resp, err := http.Get(proxy.URL + BulkPath)
+				So(err, ShouldBeNil)
+				resp.Body.Close()
+				So(resp.StatusCode, ShouldEqual, http.StatusMethodNotAllowed)
+
+				resp, err = http.Post(proxy.URL+BulkPath, "application/json", strings.NewReader(`["81.2.69.142"]`))
+				So(err, ShouldBeNil)
+				resp.Body.Close()
+				So(resp.StatusCode, ShouldEqual, http.StatusUnauthorized)
+			})
+		})
+	})
+
+	Convey("Given a proxy without bulk lookups", t, func() {
+		proxy := httptest.NewServer(New(geoip2.NewStaticLookuper(), WithAccounts(map[string]string{"billing": "s3cret"})))
+		defer proxy.Close()
+
+		Convey("I expect the path not to be served", func() {
+			req, _ := http.NewRequest("POST", proxy.URL+BulkPath, strings.NewReader(`["81.2.69.142"]`))
+			req.SetBasicAuth("billing", "s3cret")
+			resp, err := http.DefaultClient.Do(req)
+			So(err, ShouldBeNil)
+			resp.Body.Close()
+			So(resp.StatusCode, ShouldEqual, http.StatusNotFound)
+		})
+	})
+}
@@ -0,0 +1,257 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package geoip2proxy serves the GeoIP2 web service paths from a Lookuper,
+// so an organization can hold one set of MaxMind credentials in a proxy
+// instead of handing the license key to every service.  Caching, rate
+// limits and quota guarding are configured on the Api the proxy wraps, and
+// so are shared by every client.
+//
+//	api := geoip2.New(userId, licenseKey,
+//		geoip2.WithCache(geoip2.NewLRUCache(100000)),
+//		geoip2.WithSingleflight(),
+//		geoip2.WithQuotaGuard(&geoip2.QuotaGuard{HardStop: true, Reserve: 1000}),
+//	)
+//	handler := geoip2proxy.New(api, geoip2proxy.WithAccounts(map[string]string{"billing": "s3cret"}))
+//	http.ListenAndServe(":8080", handler)
+//
+// Clients point geoip2.WithBaseURL at the proxy and authenticate with the
+// internal account instead of a MaxMind one:
+//
+//	client := geoip2.New("billing", "s3cret", geoip2.WithBaseURL("http://geoip2-proxy:8080/geoip/v2.1/"))
+package geoip2proxy
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/savaki/geoip2"
+)
+
+// Path is the root the Handler serves lookups under, as MaxMind does
+const Path = "/geoip/v2.1/"
+
+// Option configures a Handler
+type Option func(*Handler)
+
+// WithAccounts accepts clients authenticating with one of the account IDs
+// and license keys given
+func WithAccounts(accounts map[string]string) Option {
+	accounts = maps.Clone(accounts)
+	return WithAuthorizer(func(userId, licenseKey string) bool {
+		expected, ok := accounts[userId]
+		return ok && subtle.ConstantTimeCompare([]byte(expected), []byte(licenseKey)) == 1
+	})
+}
+
+// WithAuthorizer accepts clients whose credentials fn approves, e.g. to
+// check them against a secret store
+func WithAuthorizer(fn func(userId, licenseKey string) bool) Option {
+	return func(h *Handler) {
+		h.authorize = fn
+	}
+}
+
+// WithTrustedProxies answers lookups of "me" for the address forwarded by
+// requests from prefixes, such as a load balancer, as resolved by
+// geoip2.ResolveClientAddr from X-Forwarded-For or X-Real-IP
+func WithTrustedProxies(prefixes ...netip.Prefix) Option {
+	return func(h *Handler) {
+		h.trusted = append(h.trusted, prefixes...)
+	}
+}
+
+// WithErrorHandler calls fn with each failed lookup, e.g. to log it
+func WithErrorHandler(fn func(req *http.Request, err error)) Option {
+	return func(h *Handler) {
+		h.onError = fn
+	}
+}
+
+// Handler answers requests for {Path}{service}/{ipAddress} from a Lookuper,
+// with the web service's response and error bodies
+type Handler struct {
+	lookuper  geoip2.Lookuper
+	authorize func(userId, licenseKey string) bool
+	trusted   []netip.Prefix
+	onError   func(req *http.Request, err error)
+	bulk      *bulkLimits
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+// New returns a Handler answering from lookuper.  Without WithAccounts or
+// WithAuthorizer every request is refused.
+func New(lookuper geoip2.Lookuper, opts ...Option) *Handler {
+	h := &Handler{lookuper: lookuper}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == BulkPath {
+		if h.bulk == nil {
+			http.NotFound(w, req)
+			return
+		}
+		if req.Method != http.MethodPost {
+			w.Header().Set("Allow", "POST")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		if userId, ok := h.authenticate(w, req); ok {
+			h.serveBulk(w, req, userId)
+		}
+		return
+	}
+
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if _, ok := h.authenticate(w, req); !ok {
+		return
+	}
+
+	service, ipAddress, ok := strings.Cut(strings.TrimPrefix(req.URL.Path, Path), "/")
+	if !ok || !strings.HasPrefix(req.URL.Path, Path) || strings.Contains(ipAddress, "/") {
+		http.NotFound(w, req)
+		return
+	}
+	if ipAddress == "" {
+		writeError(w, http.StatusBadRequest, geoip2.CodeIPAddressRequired, "You have not supplied an IP address, which is a required field.")
+		return
+	}
+	if ipAddress == "me" {
+		// the proxy's own address is of no interest to the client
+		addr, ok := geoip2.ResolveClientAddr(req, h.trusted...)
+		if !ok {
+			writeError(w, http.StatusBadRequest, geoip2.CodeIPAddressInvalid, "Unable to determine the address of the client.")
+			return
+		}
+		ipAddress = addr.String()
+	}
+
+	lookup, ok := h.lookupFunc(service)
+	if !ok || service == "" {
+		http.NotFound(w, req)
+		return
+	}
+
+	resp, err := lookup(req.Context(), ipAddress)
+	if err != nil {
+		if h.onError != nil {
+			h.onError(req, err)
+		}
+		h.writeLookupError(w, err)
+		return
+	}
+
+	// the response is encoded afresh, as the raw body of a cached response
+	// may describe a neighbouring address
+	data, err := json.Marshal(resp)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/vnd.maxmind.com-"+service+"+json; charset=UTF-8; version=2.1")
+	w.Write(data)
+}
+
+// authenticate checks the client's credentials, answering with the web
+// service's error when they are refused
+func (h *Handler) authenticate(w http.ResponseWriter, req *http.Request) (string, bool) {
+	userId, licenseKey, ok := req.BasicAuth()
+	switch {
+	case !ok || userId == "":
+		writeError(w, http.StatusUnauthorized, geoip2.CodeAccountIdRequired, "You have not supplied an account ID in the Authorization header.")
+		return "", false
+	case licenseKey == "":
+		writeError(w, http.StatusUnauthorized, geoip2.CodeLicenseKeyRequired, "You have not supplied a license key in the Authorization header.")
+		return "", false
+	case h.authorize == nil || !h.authorize(userId, licenseKey):
+		writeError(w, http.StatusUnauthorized, geoip2.CodeAuthorizationInvalid, "You have supplied an invalid account ID and/or license key in the Authorization header.")
+		return "", false
+	}
+	return userId, true
+}
+
+// lookupFunc returns the lookup for service, city when it is empty
+func (h *Handler) lookupFunc(service string) (geoip2.LookupFunc, bool) {
+	switch service {
+	case "country":
+		return h.lookuper.Country, true
+	case "city", "":
+		return h.lookuper.City, true
+	case "insights":
+		return h.lookuper.Insights, true
+	}
+	return nil, false
+}
+
+// writeLookupError answers with the error lookupError describes
+func (h *Handler) writeLookupError(w http.ResponseWriter, err error) {
+	status, e := lookupError(err)
+	writeError(w, status, e.Code, e.Err)
+}
+
+// lookupError returns the web service's error when the client is at fault,
+// and a gateway error when the proxy or MaxMind is
+func lookupError(err error) (int, geoip2.Error) {
+	var e geoip2.Error
+	switch {
+	case errors.Is(err, geoip2.ErrQuotaExhausted):
+		return http.StatusPaymentRequired, geoip2.Error{Code: geoip2.CodeOutOfQueries, Err: "The proxy has stopped lookups to preserve the remaining queries."}
+	case errors.Is(err, geoip2.ErrCircuitOpen):
+		return http.StatusServiceUnavailable, geoip2.Error{Err: err.Error()}
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, geoip2.Error{Err: err.Error()}
+	case errors.As(err, &e) && e.Code != "" && !credentialsError(e):
+		status := e.StatusCode
+		if status == 0 {
+			status = http.StatusBadRequest
+			if e.Code == geoip2.CodeIPAddressNotFound {
+				status = http.StatusNotFound
+			}
+		}
+		return status, geoip2.Error{Code: e.Code, Err: e.Err}
+	}
+	return http.StatusBadGateway, geoip2.Error{Err: err.Error()}
+}
+
+// credentialsError reports whether MaxMind refused the proxy's own
+// credentials, which the client can do nothing about
+func credentialsError(e geoip2.Error) bool {
+	switch e.Code {
+	case geoip2.CodeAccountIdRequired, geoip2.CodeAccountIdUnknown, geoip2.CodeAuthorizationInvalid, geoip2.CodeLicenseKeyRequired, geoip2.CodePermissionRequired:
+		return true
+	}
+	return false
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	data, _ := json.Marshal(geoip2.Error{Code: code, Err: message})
+	w.Header().Set("Content-Type", "application/vnd.maxmind.com-error+json; charset=UTF-8; version=2.0")
+	w.WriteHeader(status)
+	w.Write(data)
+}
@@ -0,0 +1,122 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2proxy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/savaki/geoip2"
+	"github.com/savaki/geoip2/geoip2test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHandler(t *testing.T) {
+	Convey("Given a proxy in front of the web service", t, func() {
+		upstream := geoip2test.NewServer()
+		defer upstream.Close()
+
+		guard := &geoip2.QuotaGuard{HardStop: true}
+		api := upstream.Api(geoip2.WithCache(geoip2.NewLRUCache(10)), geoip2.WithQuotaGuard(guard))
+		proxy := httptest.NewServer(New(api,
+			WithAccounts(map[string]string{"billing": "s3cret"}),
+			WithTrustedProxies(netip.MustParsePrefix("127.0.0.0/8")),
+		))
+		defer proxy.Close()
+
+		client := geoip2.New("billing", "s3cret", geoip2.WithBaseURL(proxy.URL+Path))
+		ctx := context.Background()
+
+		Convey("I expect lookups to be answered from the shared cache", func() {
+			for i := 0; i < 3; i++ {
+				resp, err := client.City(ctx, geoip2test.Addr)
+				So(err, ShouldBeNil)
+				So(resp.City.Names["en"], ShouldEqual, "London")
+				So(resp.Traits.IpAddress.String(), ShouldEqual, geoip2test.Addr)
+			}
+			So(upstream.Requests(), ShouldEqual, 1)
+		})
+
+		Convey("I expect the client's errors to be passed on", func() {
+			_, err := client.City(ctx, "1.1.1.1")
+			So(errors.Is(err, geoip2.ErrIPAddressNotFound), ShouldBeTrue)
+
+			var e geoip2.Error
+			So(errors.As(err, &e), ShouldBeTrue)
+			So(e.StatusCode, ShouldEqual, http.StatusNotFound)
+		})
+
+		Convey("I expect unknown clients to be refused", func() {
+			_, err := geoip2.New("billing", "guess", geoip2.WithBaseURL(proxy.URL+Path)).City(ctx, geoip2test.Addr)
+			So(errors.Is(err, geoip2.ErrAuthorizationInvalid), ShouldBeTrue)
+			So(upstream.Requests(), ShouldEqual, 0)
+		})
+
+		Convey("I expect me to be the client's address, not the proxy's", func() {
+			upstream.Set("country", "81.2.69.142", geoip2.Response{Country: geoip2.Country{IsoCode: "GB"}})
+			header := http.Header{"X-Forwarded-For": {"81.2.69.142"}}
+			forwarded := client.Clone(geoip2.WithInterceptor(func(next geoip2.DoFunc) geoip2.DoFunc {
+				return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+					for key, values := range header {
+						req.Header[key] = values
+					}
+					return next(ctx, req)
+				}
+			}))
+			resp, err := forwarded.CountryMe(ctx)
+			So(err, ShouldBeNil)
+			So(resp.Country.IsoCode, ShouldEqual, "GB")
+
+			// resolved as Middleware does, so X-Real-IP is honoured too
+			header = http.Header{"X-Real-IP": {"81.2.69.142"}}
+			resp, err = forwarded.CountryMe(ctx)
+			So(err, ShouldBeNil)
+			So(resp.Country.IsoCode, ShouldEqual, "GB")
+		})
+
+		Convey("I expect problems upstream to be gateway errors", func() {
+			upstream.SetOutOfQueries(true)
+			_, err := client.City(ctx, "81.2.69.1")
+			So(errors.Is(err, geoip2.ErrOutOfQueries), ShouldBeTrue)
+
+			// the guard has now seen the balance run out
+			_, err = client.City(ctx, "81.2.69.2")
+			So(errors.Is(err, geoip2.ErrOutOfQueries), ShouldBeTrue)
+			So(upstream.Requests(), ShouldEqual, 1)
+
+			misconfigured := upstream.Api(geoip2.WithCredentials(geoip2test.UserId, "expired"))
+			proxy := httptest.NewServer(New(misconfigured, WithAccounts(map[string]string{"billing": "s3cret"})))
+			defer proxy.Close()
+			_, err = geoip2.New("billing", "s3cret", geoip2.WithBaseURL(proxy.URL+Path)).City(ctx, geoip2test.Addr)
+			var e geoip2.Error
+			So(errors.As(err, &e), ShouldBeTrue)
+			So(e.StatusCode, ShouldEqual, http.StatusBadGateway)
+		})
+	})
+
+	Convey("Given a proxy without accounts", t, func() {
+		proxy := httptest.NewServer(New(geoip2.NewStaticLookuper()))
+		defer proxy.Close()
+
+		Convey("I expect every request to be refused", func() {
+			_, err := geoip2.New("anyone", "anything", geoip2.WithBaseURL(proxy.URL+Path)).City(nil, "1.2.3.4")
+			So(errors.Is(err, geoip2.ErrAuthorizationInvalid), ShouldBeTrue)
+		})
+	})
+}
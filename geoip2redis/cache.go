@@ -0,0 +1,249 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package geoip2redis provides a geoip2.Cache backed by Redis, so a fleet
+// of services can share lookups instead of paying for them per process.
+//
+//	client := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+//	api := geoip2.New(userId, licenseKey, geoip2.WithCache(geoip2redis.New(client)))
+package geoip2redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/savaki/geoip2"
+)
+
+// DefaultKeyPrefix begins every key written by the Cache
+const DefaultKeyPrefix = "geoip2:"
+
+// lengthsRefresh is how often the prefix lengths written by other
+// processes are read back
+const lengthsRefresh = time.Minute
+
+// Option configures a Cache
+type Option func(*Cache)
+
+// WithKeyPrefix replaces DefaultKeyPrefix, e.g. to share a Redis between
+// environments
+func WithKeyPrefix(prefix string) Option {
+	return func(c *Cache) {
+		c.prefix = prefix
+	}
+}
+
+// WithErrorHandler calls fn when Redis fails.  The failure is reported to
+// the Api as a miss either way, so the lookup goes to the web service.
+func WithErrorHandler(fn func(err error)) Option {
+	return func(c *Cache) {
+		c.onError = fn
+	}
+}
+
+// Cache is a geoip2.Cache storing responses as JSON with a Redis TTL.  Like
+// geoip2.LRUCache it indexes responses by their network, so a response for
+// 1.2.3.4 in 1.2.3.0/24 answers a later lookup for 1.2.3.7.  A lookup costs
+// one round trip, pipelining a GET per known prefix length; keys are
+// independent, so Redis Cluster is supported.
+type Cache struct {
+	client  redis.UniversalClient
+	prefix  string
+	onError func(error)
+
+	mutex   sync.Mutex
+	lengths map[string]*prefixLengths // service -> lengths
+}
+
+type prefixLengths struct {
+	bits      map[int]bool
+	refreshed time.Time
+}
+
+var _ geoip2.Cache = (*Cache)(nil)
+
+// New returns a Cache storing responses in client
+func New(client redis.UniversalClient, opts ...Option) *Cache {
+	c := &Cache{
+		client:  client,
+		prefix:  DefaultKeyPrefix,
+		lengths: map[string]*prefixLengths{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Cache) Get(ctx context.Context, key string) (geoip2.Response, bool) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	service, addr, ok := splitKey(key)
+	if !ok {
+		return geoip2.Response{}, false
+	}
+
+	var keys []string
+	for _, bits := range c.lengthsOf(ctx, service) {
+		if prefix, err := addr.Prefix(bits); err == nil {
+			keys = append(keys, c.key(service, prefix))
+		}
+	}
+	if len(keys) == 0 {
+		return geoip2.Response{}, false
+	}
+
+	cmds := make([]*redis.StringCmd, len(keys))
+	_, err := c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			cmds[i] = pipe.Get(ctx, key)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		c.fail(err)
+		return geoip2.Response{}, false
+	}
+
+	// the most specific network answers
+	for _, cmd := range cmds {
+		data, err := cmd.Bytes()
+		if err != nil {
+			continue
+		}
+		var resp geoip2.Response
+		if err := json.Unmarshal(data, &resp); err != nil {
+			c.fail(err)
+			continue
+		}
+		resp.Traits.IpAddress = addr
+		return resp, true
+	}
+	return geoip2.Response{}, false
+}
+
+func (c *Cache) Set(ctx context.Context, key string, resp geoip2.Response, ttl time.Duration) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	service, addr, ok := splitKey(key)
+	if !ok || ttl <= 0 {
+		return
+	}
+	prefix := netip.PrefixFrom(addr, addr.BitLen())
+	if resp.Traits.Network.IsValid() {
+		prefix = resp.Traits.Network.Masked()
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		c.fail(err)
+		return
+	}
+
+	_, err = c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, c.key(service, prefix), data, ttl)
+		pipe.SAdd(ctx, c.lengthsKey(service), prefix.Bits())
+		return nil
+	})
+	if err != nil {
+		c.fail(err)
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.lengthsFor(service).bits[prefix.Bits()] = true
+}
+
+// lengthsOf returns the prefix lengths stored for service, longest first,
+// reading back those added by other processes every lengthsRefresh
+func (c *Cache) lengthsOf(ctx context.Context, service string) []int {
+	c.mutex.Lock()
+	lengths := c.lengthsFor(service)
+	stale := time.Since(lengths.refreshed) > lengthsRefresh
+	if stale {
+		// other lookups carry on with what is known meanwhile
+		lengths.refreshed = time.Now()
+	}
+	c.mutex.Unlock()
+
+	if stale {
+		members, err := c.client.SMembers(ctx, c.lengthsKey(service)).Result()
+		if err != nil {
+			c.fail(err)
+		}
+		c.mutex.Lock()
+		for _, member := range members {
+			if bits, err := strconv.Atoi(member); err == nil {
+				lengths.bits[bits] = true
+			}
+		}
+		c.mutex.Unlock()
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	bits := make([]int, 0, len(lengths.bits))
+	for n := range lengths.bits {
+		bits = append(bits, n)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(bits)))
+	return bits
+}
+
+// lengthsFor must be called with the mutex held
+func (c *Cache) lengthsFor(service string) *prefixLengths {
+	lengths, ok := c.lengths[service]
+	if !ok {
+		lengths = &prefixLengths{bits: map[int]bool{}}
+		c.lengths[service] = lengths
+	}
+	return lengths
+}
+
+func (c *Cache) key(service string, prefix netip.Prefix) string {
+	return c.prefix + service + "/" + prefix.String()
+}
+
+func (c *Cache) lengthsKey(service string) string {
+	return c.prefix + "lengths:" + service
+}
+
+func (c *Cache) fail(err error) {
+	if c.onError != nil {
+		c.onError(err)
+	}
+}
+
+// splitKey separates a key such as "city/1.2.3.4" into service and address
+func splitKey(key string) (string, netip.Addr, bool) {
+	i := strings.IndexByte(key, '/')
+	if i < 0 {
+		return "", netip.Addr{}, false
+	}
+	addr, err := netip.ParseAddr(key[i+1:])
+	if err != nil {
+		return "", netip.Addr{}, false
+	}
+	return key[:i], addr.Unmap(), true
+}
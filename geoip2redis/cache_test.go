@@ -0,0 +1,102 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2redis
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/savaki/geoip2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCache(t *testing.T) {
+	Convey("Given two Caches sharing a Redis", t, func() {
+		server := miniredis.RunT(t)
+		first := New(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+		second := New(redis.NewClient(&redis.Options{Addr: server.Addr()}), WithKeyPrefix(DefaultKeyPrefix))
+
+		resp := geoip2.MockResponse("1.2.3.4")
+		resp.Traits.Network = netip.MustParsePrefix("1.2.3.0/24")
+		first.Set(nil, "city/1.2.3.4", resp, time.Hour)
+
+		Convey("I expect a response stored by one to answer the other for its network", func() {
+			got, ok := second.Get(nil, "city/1.2.3.7")
+			So(ok, ShouldBeTrue)
+			So(got.Traits.IpAddress.String(), ShouldEqual, "1.2.3.7")
+			So(got.City.Name(), ShouldEqual, resp.City.Name())
+			So(server.TTL("geoip2:city/1.2.3.0/24"), ShouldEqual, time.Hour)
+
+			_, ok = second.Get(nil, "country/1.2.3.7")
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("I expect the most specific network to answer", func() {
+			narrow := geoip2.MockResponse("1.2.3.200")
+			narrow.Traits.Network = netip.MustParsePrefix("1.2.3.128/25")
+			first.Set(nil, "city/1.2.3.200", narrow, time.Hour)
+
+			got, ok := first.Get(nil, "city/1.2.3.129")
+			So(ok, ShouldBeTrue)
+			So(got.City.Name(), ShouldEqual, narrow.City.Name())
+		})
+
+		Convey("I expect expired responses to miss", func() {
+			server.FastForward(2 * time.Hour)
+			_, ok := first.Get(nil, "city/1.2.3.4")
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given an Api cached in a Redis that goes away", t, func() {
+		server := miniredis.RunT(t)
+		var failures []error
+		cache := New(redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1}), WithErrorHandler(func(err error) {
+			failures = append(failures, err)
+		}))
+		calls := 0
+		api := geoip2.WithClientFunc(geoip2.New("blah-user-id", "blah-license-key", geoip2.WithCache(cache)), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"traits":{"ip_address":"1.2.3.4","network":"1.2.3.0/24"}}`)),
+			}, nil
+		})
+
+		_, err := api.City(nil, "1.2.3.4")
+		So(err, ShouldBeNil)
+		_, err = api.City(nil, "1.2.3.4")
+		So(err, ShouldBeNil)
+		So(calls, ShouldEqual, 1)
+
+		server.Close()
+
+		Convey("I expect lookups to carry on against the web service", func() {
+			_, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(calls, ShouldEqual, 2)
+			So(len(failures), ShouldBeGreaterThan, 0)
+			So(errors.Is(failures[0], redis.Nil), ShouldBeFalse)
+		})
+	})
+}
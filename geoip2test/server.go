@@ -0,0 +1,246 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package geoip2test serves canned GeoIP2 web service responses, so code
+// built on geoip2 can be tested without credentials or MaxMind JSON copied
+// into every repository.
+//
+//	server := geoip2test.NewServer()
+//	defer server.Close()
+//
+//	api := server.Api()
+//	resp, err := api.City(ctx, geoip2test.Addr)
+//
+// Addr, and "me", are answered with the fixtures for each service.  Other
+// addresses are answered with the errors the web services send: reserved
+// addresses with IP_ADDRESS_RESERVED, malformed ones with IP_ADDRESS_INVALID
+// and the rest with IP_ADDRESS_NOT_FOUND.
+package geoip2test
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/savaki/geoip2"
+)
+
+// Credentials accepted by the Server
+const (
+	UserId     = "42"
+	LicenseKey = "geoip2test-license-key"
+)
+
+// Addr is the address the fixtures describe, a corporate network in London
+// registered to a US company
+const Addr = "81.2.69.160"
+
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// Fixture returns the body served for Addr by service, one of "country",
+// "city" or "insights"
+func Fixture(service string) []byte {
+	data, err := fixtures.ReadFile("fixtures/" + service + ".json")
+	if err != nil {
+		panic(fmt.Sprintf("geoip2test: no fixture for service %q", service))
+	}
+	return data
+}
+
+// Response decodes the Fixture for service
+func Response(service string) geoip2.Response {
+	var resp geoip2.Response
+	if err := json.Unmarshal(Fixture(service), &resp); err != nil {
+		panic(fmt.Sprintf("geoip2test: unable to decode fixture for service %q: %v", service, err))
+	}
+	return resp
+}
+
+// statusCodes lists the status the web services answer each error code with
+// https://dev.maxmind.com/geoip/docs/web-services/responses#errors
+var statusCodes = map[string]int{
+	geoip2.CodeIPAddressInvalid:     http.StatusBadRequest,
+	geoip2.CodeIPAddressRequired:    http.StatusBadRequest,
+	geoip2.CodeIPAddressReserved:    http.StatusBadRequest,
+	geoip2.CodeIPAddressNotFound:    http.StatusNotFound,
+	geoip2.CodeAccountIdRequired:    http.StatusUnauthorized,
+	geoip2.CodeAccountIdUnknown:     http.StatusUnauthorized,
+	geoip2.CodeAuthorizationInvalid: http.StatusUnauthorized,
+	geoip2.CodeLicenseKeyRequired:   http.StatusUnauthorized,
+	geoip2.CodeInsufficientFunds:    http.StatusPaymentRequired,
+	geoip2.CodeOutOfQueries:         http.StatusPaymentRequired,
+	geoip2.CodePermissionRequired:   http.StatusForbidden,
+}
+
+// StatusCode returns the status the web services answer code with
+func StatusCode(code string) int {
+	if status, ok := statusCodes[code]; ok {
+		return status
+	}
+	return http.StatusBadRequest
+}
+
+// ErrorBody returns the body the web services send for an error
+func ErrorBody(code, message string) []byte {
+	data, _ := json.Marshal(geoip2.Error{Code: code, Err: message})
+	return data
+}
+
+// Server is an httptest.Server answering like the GeoIP2 web services
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	bodies       map[string][]byte
+	errors       map[string]geoip2.Error
+	outOfQueries bool
+	requests     int
+}
+
+// NewServer starts a Server with the fixtures for Addr.  The caller should
+// Close it when finished.
+func NewServer() *Server {
+	s := &Server{
+		bodies: map[string][]byte{},
+		errors: map[string]geoip2.Error{},
+	}
+	for _, service := range []string{"country", "city", "insights"} {
+		s.bodies[service+"/"+Addr] = Fixture(service)
+		s.bodies[service+"/me"] = Fixture(service)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// New starts a Server that is closed when tb completes, and returns an Api
+// pointed at it
+func New(tb testing.TB, opts ...geoip2.Option) *geoip2.Api {
+	s := NewServer()
+	tb.Cleanup(s.Close)
+	return s.Api(opts...)
+}
+
+// Api returns an Api with the Server's credentials pointed at the Server.
+// Options are applied after, so may replace either.
+func (s *Server) Api(opts ...geoip2.Option) *geoip2.Api {
+	opts = append([]geoip2.Option{geoip2.WithBaseURL(s.URL)}, opts...)
+	return geoip2.New(UserId, LicenseKey, opts...)
+}
+
+// Set answers lookups of ipAddress by service with resp
+func (s *Server) Set(service, ipAddress string, resp geoip2.Response) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		panic(fmt.Sprintf("geoip2test: unable to encode response: %v", err))
+	}
+	s.SetBody(service, ipAddress, data)
+}
+
+// SetBody answers lookups of ipAddress by service with data, as is
+func (s *Server) SetBody(service, ipAddress string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.bodies[service+"/"+ipAddress] = data
+}
+
+// Fail answers every lookup of ipAddress with the error code, e.g.
+// geoip2.CodePermissionRequired
+func (s *Server) Fail(ipAddress, code string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[ipAddress] = geoip2.Error{Code: code, Err: fmt.Sprintf("geoip2test: %s for %s", code, ipAddress)}
+}
+
+// SetOutOfQueries answers every lookup with OUT_OF_QUERIES while v is true
+func (s *Server) SetOutOfQueries(v bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.outOfQueries = v
+}
+
+// Requests returns the number of requests the Server has received
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	outOfQueries := s.outOfQueries
+	s.mu.Unlock()
+
+	userId, licenseKey, ok := req.BasicAuth()
+	switch {
+	case !ok || userId == "":
+		writeError(w, geoip2.CodeAccountIdRequired, "You have not supplied a MaxMind account ID in the Authorization header.")
+		return
+	case licenseKey == "":
+		writeError(w, geoip2.CodeLicenseKeyRequired, "You have not supplied a MaxMind license key in the Authorization header.")
+		return
+	case userId != UserId || licenseKey != LicenseKey:
+		writeError(w, geoip2.CodeAuthorizationInvalid, "You have supplied an invalid MaxMind account ID and/or license key in the Authorization header.")
+		return
+	case outOfQueries:
+		writeError(w, geoip2.CodeOutOfQueries, "The license key you have provided is out of queries. Please purchase more queries to use this service.")
+		return
+	}
+
+	// the path ends {service}/{ipAddress}, whatever the base URL
+	segments := strings.Split(strings.TrimSuffix(req.URL.Path, "/"), "/")
+	if len(segments) < 2 || segments[len(segments)-1] == "" {
+		writeError(w, geoip2.CodeIPAddressRequired, "You have not supplied an IP address, which is a required field.")
+		return
+	}
+	service, ipAddress := segments[len(segments)-2], segments[len(segments)-1]
+
+	s.mu.Lock()
+	failure, failed := s.errors[ipAddress]
+	body, found := s.bodies[service+"/"+ipAddress]
+	s.mu.Unlock()
+
+	if failed {
+		writeError(w, failure.Code, failure.Err)
+		return
+	}
+	if found {
+		w.Header().Set("Content-Type", "application/vnd.maxmind.com-"+service+"+json; charset=UTF-8; version=2.1")
+		w.Write(body)
+		return
+	}
+
+	addr, err := netip.ParseAddr(ipAddress)
+	switch {
+	case err != nil:
+		writeError(w, geoip2.CodeIPAddressInvalid, fmt.Sprintf("The value \"%s\" is not a valid IP address.", ipAddress))
+	case geoip2.IsReserved(addr):
+		writeError(w, geoip2.CodeIPAddressReserved, fmt.Sprintf("The IP address '%s' is a reserved IP address (private, multicast, etc.).", ipAddress))
+	default:
+		writeError(w, geoip2.CodeIPAddressNotFound, fmt.Sprintf("The address \"%s\" is not in our database.", ipAddress))
+	}
+}
+
+func writeError(w http.ResponseWriter, code, message string) {
+	w.Header().Set("Content-Type", "application/vnd.maxmind.com-error+json; charset=UTF-8; version=2.0")
+	w.WriteHeader(StatusCode(code))
+	w.Write(ErrorBody(code, message))
+}
@@ -0,0 +1,92 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/savaki/geoip2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestServer(t *testing.T) {
+	Convey("Given an Api pointed at a Server", t, func() {
+		server := NewServer()
+		defer server.Close()
+		api := server.Api()
+		ctx := context.Background()
+
+		Convey("I expect each service to answer with its fixture", func() {
+			country, err := api.Country(ctx, Addr)
+			So(err, ShouldBeNil)
+			So(country.Country.IsoCode, ShouldEqual, "GB")
+			So(country.City.Names, ShouldBeEmpty)
+
+			city, err := api.City(ctx, Addr)
+			So(err, ShouldBeNil)
+			So(city.City.Names["en"], ShouldEqual, "London")
+			So(city.Traits.Network.String(), ShouldEqual, "81.2.69.160/27")
+
+			insights, err := api.InsightsMe(ctx)
+			So(err, ShouldBeNil)
+			So(insights.Traits.UserType, ShouldEqual, "government")
+			So(insights.Traits, ShouldResemble, Response("insights").Traits)
+			So(server.Requests(), ShouldEqual, 3)
+		})
+
+		Convey("I expect other addresses to answer with the web service errors", func() {
+			_, err := api.City(ctx, "10.0.0.1")
+			So(errors.Is(err, geoip2.ErrIPAddressReserved), ShouldBeTrue)
+
+			_, err = api.City(ctx, "1.1.1.1")
+			So(errors.Is(err, geoip2.ErrIPAddressNotFound), ShouldBeTrue)
+
+			var v geoip2.Error
+			So(errors.As(err, &v), ShouldBeTrue)
+			So(v.StatusCode, ShouldEqual, 404)
+		})
+
+		Convey("I expect added responses and failures to be served", func() {
+			server.Set("city", "1.1.1.1", geoip2.MockResponse("1.1.1.1"))
+			resp, err := api.City(ctx, "1.1.1.1")
+			So(err, ShouldBeNil)
+			So(resp.Traits.IpAddress.String(), ShouldEqual, "1.1.1.1")
+
+			server.Fail(Addr, geoip2.CodePermissionRequired)
+			_, err = api.Insights(ctx, Addr)
+			So(errors.Is(err, geoip2.ErrPermissionRequired), ShouldBeTrue)
+		})
+
+		Convey("I expect bad credentials and exhausted queries to be refused", func() {
+			_, err := server.Api(geoip2.WithCredentials(UserId, "wrong")).City(ctx, Addr)
+			So(errors.Is(err, geoip2.ErrAuthorizationInvalid), ShouldBeTrue)
+
+			server.SetOutOfQueries(true)
+			_, err = api.City(ctx, Addr)
+			So(errors.Is(err, geoip2.ErrOutOfQueries), ShouldBeTrue)
+		})
+	})
+
+	Convey("Given an Api from New", t, func() {
+		resp, err := New(t).Country(context.Background(), Addr)
+
+		Convey("I expect the Server to answer", func() {
+			So(err, ShouldBeNil)
+			So(resp.RegisteredCountry.IsoCode, ShouldEqual, "US")
+		})
+	})
+}
@@ -0,0 +1,114 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// GeoJSONGeometry is a GeoJSON Point, with coordinates in longitude,
+// latitude order
+// https://datatracker.ietf.org/doc/html/rfc7946#section-3.1.2
+type GeoJSONGeometry struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// GeoJSONFeature is a GeoJSON Feature.  Geometry is nil, encoded as null,
+// for responses without coordinates.
+// https://datatracker.ietf.org/doc/html/rfc7946#section-3.2
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   *GeoJSONGeometry       `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// GeoJSONFeatureCollection is a GeoJSON FeatureCollection
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+// GeoJSON returns the response as a Feature located at its coordinates,
+// with flat properties such as "country", "city" and "asn" taken from the
+// response; empty values are left out
+func (r Response) GeoJSON() GeoJSONFeature {
+	feature := GeoJSONFeature{
+		Type:       "Feature",
+		Properties: map[string]interface{}{},
+	}
+	if r.Location.hasCoordinates() {
+		feature.Geometry = &GeoJSONGeometry{
+			Type:        "Point",
+			Coordinates: []float64{r.Location.Longitude, r.Location.Latitude},
+		}
+	}
+
+	set := func(key string, value interface{}) {
+		switch v := value.(type) {
+		case string:
+			if v == "" {
+				return
+			}
+		case int:
+			if v == 0 {
+				return
+			}
+		}
+		feature.Properties[key] = value
+	}
+	if r.Traits.IpAddress.IsValid() {
+		set("ip_address", r.Traits.IpAddress.String())
+	}
+	if r.Traits.Network.IsValid() {
+		set("network", r.Traits.Network.String())
+	}
+	set("continent", r.Continent.Code)
+	set("country", r.Country.IsoCode)
+	set("country_name", r.CountryName())
+	if len(r.Subdivisions) > 0 {
+		set("subdivision", r.Subdivisions[len(r.Subdivisions)-1].IsoCode)
+	}
+	set("subdivision_name", r.SubdivisionName())
+	set("city", r.CityName())
+	set("postal_code", r.Postal.Code)
+	set("time_zone", r.Location.TimeZone)
+	set("accuracy_radius_km", r.Location.AccuracyRadius)
+	set("asn", r.Traits.AutonomousSystemNumber)
+	set("as_organization", r.Traits.AutonomousSystemOrganization)
+	set("isp", r.Traits.Isp)
+	set("organization", r.Traits.Organization)
+	set("domain", r.Traits.Domain)
+	set("user_type", r.Traits.UserType)
+	return feature
+}
+
+// GeoJSONCollection gathers the responses into a FeatureCollection
+func GeoJSONCollection(responses []Response) GeoJSONFeatureCollection {
+	collection := GeoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]GeoJSONFeature, 0, len(responses)),
+	}
+	for _, resp := range responses {
+		collection.Features = append(collection.Features, resp.GeoJSON())
+	}
+	return collection
+}
+
+// WriteGeoJSON writes the responses as a FeatureCollection
+func WriteGeoJSON(w io.Writer, responses []Response) error {
+	return json.NewEncoder(w).Encode(GeoJSONCollection(responses))
+}
@@ -0,0 +1,64 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGeoJSON(t *testing.T) {
+	Convey("Given a complete maxmind response", t, func() {
+		resp := Response{}
+		err := json.NewDecoder(strings.NewReader(sample)).Decode(&resp)
+		So(err, ShouldBeNil)
+
+		Convey("I expect a Feature with a lon/lat Point and flat properties", func() {
+			feature := resp.GeoJSON()
+			So(feature.Type, ShouldEqual, "Feature")
+			So(feature.Geometry.Type, ShouldEqual, "Point")
+			So(feature.Geometry.Coordinates, ShouldResemble, []float64{-122.1163, 37.6293})
+			So(feature.Properties["ip_address"], ShouldEqual, "1.2.3.4")
+			So(feature.Properties["country"], ShouldEqual, resp.Country.IsoCode)
+			So(feature.Properties["city"], ShouldEqual, "Los Angeles")
+			So(feature.Properties["accuracy_radius_km"], ShouldEqual, resp.Location.AccuracyRadius)
+		})
+
+		Convey("When I write a collection including an unlocated response", func() {
+			buf := &bytes.Buffer{}
+			So(WriteGeoJSON(buf, []Response{resp, {}}), ShouldBeNil)
+
+			var v struct {
+				Type     string `json:"type"`
+				Features []struct {
+					Geometry   json.RawMessage        `json:"geometry"`
+					Properties map[string]interface{} `json:"properties"`
+				} `json:"features"`
+			}
+			So(json.Unmarshal(buf.Bytes(), &v), ShouldBeNil)
+
+			Convey("I expect null geometry and empty properties for it", func() {
+				So(v.Type, ShouldEqual, "FeatureCollection")
+				So(len(v.Features), ShouldEqual, 2)
+				So(string(v.Features[1].Geometry), ShouldEqual, "null")
+				So(v.Features[1].Properties, ShouldBeEmpty)
+			})
+		})
+	})
+}
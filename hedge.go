@@ -0,0 +1,138 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WithHedging sends a second, identical request when the first hasn't
+// completed within delay, e.g. the observed p95 latency.  Whichever returns
+// first is used and the other is cancelled.  Hedging wraps whatever
+// transport is configured, whether before or after this option, and each
+// retry of WithRetries is hedged in turn.
+//
+// Hedging heeds the deadline of the request, whether from WithTimeout or
+// the caller's context: the hedge is sent no later than halfway to it, so
+// that it has a fair chance of answering in time, and not at all once the
+// deadline has passed.  Under WithRateLimit the hedge is sent only when
+// capacity is free.
+func WithHedging(delay time.Duration) Option {
+	return func(a *Api) {
+		a.hedgeDelay = delay
+	}
+}
+
+type hedgeResult struct {
+	attempt int
+	resp    *http.Response
+	err     error
+}
+
+func hedge(delay time.Duration, doFunc DoFunc) DoFunc {
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		results := make(chan hedgeResult, 2)
+		cancels := []context.CancelFunc{}
+		launch := func() {
+			attemptCtx, cancel := context.WithCancel(ctx)
+			attempt := len(cancels)
+			cancels = append(cancels, cancel)
+			go func() {
+				resp, err := doFunc(attemptCtx, req.Clone(attemptCtx))
+				results <- hedgeResult{attempt: attempt, resp: resp, err: err}
+			}()
+		}
+
+		launch()
+		timer := time.NewTimer(hedgeDelay(ctx, delay))
+		defer timer.Stop()
+
+		var first error
+		for pending := 1; pending > 0; {
+			select {
+			case <-timer.C:
+				if ctx.Err() != nil {
+					continue
+				}
+				if limiter := limiterFrom(ctx); limiter != nil && !limiter.take() {
+					// a hedge is worth sending only within the rate limit
+					continue
+				}
+				launch()
+				pending++
+
+			case r := <-results:
+				pending--
+				if r.err != nil {
+					cancels[r.attempt]()
+					if first == nil {
+						first = r.err
+					}
+					if len(cancels) == 1 {
+						// the hedge hasn't been sent; a failure isn't a slow request
+						return nil, r.err
+					}
+					continue
+				}
+
+				// cancel the loser and release its response should it still arrive
+				for attempt, cancel := range cancels {
+					if attempt != r.attempt {
+						cancel()
+					}
+				}
+				if pending > 0 {
+					go func() {
+						if loser := <-results; loser.resp != nil {
+							loser.resp.Body.Close()
+						}
+					}()
+				}
+
+				// the winner's context must stay live until its body has been read
+				r.resp.Body = &cancelOnClose{ReadCloser: r.resp.Body, cancel: cancels[r.attempt]}
+				return r.resp, nil
+			}
+		}
+		return nil, first
+	}
+}
+
+// hedgeDelay brings delay forward to half the time left before the
+// deadline of ctx
+func hedgeDelay(ctx context.Context, delay time.Duration) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return delay
+	}
+	if half := time.Until(deadline) / 2; half < delay {
+		return max(half, 0)
+	}
+	return delay
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
@@ -0,0 +1,155 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHedging(t *testing.T) {
+	Convey("Given an Api with hedging enabled", t, func() {
+		var mutex sync.Mutex
+		calls := 0
+		cancelled := make(chan struct{}, 2)
+
+		// the first attempt hangs until cancelled, later attempts answer at once
+		var fail error
+		doFunc := func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			mutex.Lock()
+			calls++
+			n := calls
+			mutex.Unlock()
+
+			if n == 1 {
+				<-req.Context().Done()
+				cancelled <- struct{}{}
+				return nil, req.Context().Err()
+			}
+			if fail != nil {
+				return nil, fail
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		}
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), doFunc).Clone(WithHedging(10 * time.Millisecond))
+
+		Convey("When the first request is slow", func() {
+			resp, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect the hedged request to answer and the first to be cancelled", func() {
+				So(err, ShouldBeNil)
+				So(resp.City.Confidence, ShouldEqual, 25)
+				So(calls, ShouldEqual, 2)
+
+				select {
+				case <-cancelled:
+				case <-time.After(time.Second):
+					t.Fatal("slow attempt was not cancelled")
+				}
+			})
+		})
+
+		Convey("When the first fails quickly", func() {
+			calls = 1
+			fail = errors.New("boom")
+			_, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect the error without a hedge", func() {
+				So(err, ShouldEqual, fail)
+				So(calls, ShouldEqual, 2)
+			})
+		})
+	})
+
+	Convey("Given hedging given to New before the transport", t, func() {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				<-req.Context().Done()
+				return
+			}
+			w.Write([]byte(sample))
+		}))
+		defer server.Close()
+
+		api := New("blah-user-id", "blah-license-key",
+			WithHedging(10*time.Millisecond),
+			WithHTTPClient(server.Client()),
+			WithBaseURL(server.URL),
+		)
+		resp, err := api.City(nil, "1.2.3.4")
+
+		Convey("I expect the hedge to wrap the transport all the same", func() {
+			So(err, ShouldBeNil)
+			So(resp.City.Confidence, ShouldEqual, 25)
+			So(atomic.LoadInt32(&calls), ShouldEqual, 2)
+		})
+	})
+
+	Convey("Given a hedge delay longer than the time left", t, func() {
+		Convey("When the context has a deadline", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			delay := hedgeDelay(ctx, time.Second)
+
+			Convey("I expect the hedge brought forward to halfway there", func() {
+				So(delay, ShouldBeLessThanOrEqualTo, 50*time.Millisecond)
+				So(delay, ShouldBeGreaterThan, 0)
+				So(hedgeDelay(context.Background(), time.Second), ShouldEqual, time.Second)
+				So(hedgeDelay(ctx, time.Millisecond), ShouldEqual, time.Millisecond)
+			})
+		})
+
+		Convey("When the first request is slow under WithTimeout", func() {
+			var mutex sync.Mutex
+			calls := 0
+			doFunc := func(ctx context.Context, req *http.Request) (*http.Response, error) {
+				mutex.Lock()
+				calls++
+				n := calls
+				mutex.Unlock()
+
+				if n == 1 {
+					<-req.Context().Done()
+					return nil, req.Context().Err()
+				}
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(strings.NewReader(sample)),
+				}, nil
+			}
+			api := WithClientFunc(New("blah-user-id", "blah-license-key", WithTimeout(200*time.Millisecond)), doFunc).Clone(WithHedging(time.Hour))
+			_, err := api.City(nil, "1.2.3.4")
+
+			Convey("I expect the hedge sent in time to answer", func() {
+				So(err, ShouldBeNil)
+				So(calls, ShouldEqual, 2)
+			})
+		})
+	})
+}
@@ -0,0 +1,76 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"net"
+	"net/netip"
+)
+
+// WithResolver resolves hostnames for CountryHost, CityHost and InsightsHost
+// with resolver instead of net.DefaultResolver
+func WithResolver(resolver *net.Resolver) Option {
+	return func(a *Api) {
+		a.resolver = resolver
+	}
+}
+
+// CountryHost looks up every address hostname resolves to; see CityHost
+func (a *Api) CountryHost(ctx context.Context, hostname string) ([]Result, error) {
+	return a.host(ctx, hostname, a.Country)
+}
+
+// CityHost resolves hostname and looks up each of its addresses, returning
+// one Result per address in the resolver's order.  The error is non-nil
+// when the name cannot be resolved or ctx is done early; failed lookups are
+// reported in each Result's Err.
+func (a *Api) CityHost(ctx context.Context, hostname string) ([]Result, error) {
+	return a.host(ctx, hostname, a.City)
+}
+
+// InsightsHost looks up every address hostname resolves to; see CityHost
+func (a *Api) InsightsHost(ctx context.Context, hostname string) ([]Result, error) {
+	return a.host(ctx, hostname, a.Insights)
+}
+
+// hostConcurrency bounds the lookups in flight for one hostname
+const hostConcurrency = 4
+
+func (a *Api) host(ctx context.Context, hostname string, lookup LookupFunc) ([]Result, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	resolver := a.resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	addrs, err := resolver.LookupNetIP(ctx, "ip", hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[netip.Addr]bool{}
+	ipAddresses := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		addr = addr.Unmap().WithZone("")
+		if !seen[addr] {
+			seen[addr] = true
+			ipAddresses = append(ipAddresses, addr.String())
+		}
+	}
+	return Batch(ctx, lookup, ipAddresses, hostConcurrency)
+}
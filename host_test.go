@@ -0,0 +1,83 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestHost(t *testing.T) {
+	Convey("Given an Api with a resolver that only knows the hosts file", t, func() {
+		resolver := &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return nil, errors.New("no dns in tests")
+			},
+		}
+		var mutex sync.Mutex
+		var looked []string
+		api := WithClientFunc(New("blah-user-id", "blah-license-key", WithResolver(resolver)), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			mutex.Lock()
+			looked = append(looked, req.URL.Path)
+			mutex.Unlock()
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(sample))}, nil
+		})
+
+		Convey("When I look up a hostname", func() {
+			results, err := api.CityHost(nil, "localhost")
+
+			Convey("I expect a Result per resolved address", func() {
+				So(err, ShouldBeNil)
+				So(len(results), ShouldBeGreaterThan, 0)
+				So(results[0].Err, ShouldBeNil)
+				So(len(looked), ShouldEqual, len(results))
+				for _, result := range results {
+					So(result.IpAddress, ShouldBeIn, "127.0.0.1", "::1")
+				}
+				for _, path := range looked {
+					So(path, ShouldBeIn, "/geoip/v2.1/city/127.0.0.1", "/geoip/v2.1/city/::1")
+				}
+			})
+		})
+
+		Convey("When I pass an address", func() {
+			results, err := api.CountryHost(nil, "1.2.3.4")
+
+			Convey("I expect it to be looked up as is", func() {
+				So(err, ShouldBeNil)
+				So(len(results), ShouldEqual, 1)
+				So(looked, ShouldResemble, []string{"/geoip/v2.1/country/1.2.3.4"})
+			})
+		})
+
+		Convey("When the name can't be resolved", func() {
+			_, err := api.InsightsHost(nil, "nowhere.invalid")
+
+			Convey("I expect the resolver's error and no lookups", func() {
+				So(err, ShouldNotBeNil)
+				So(looked, ShouldBeEmpty)
+			})
+		})
+	})
+}
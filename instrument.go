@@ -0,0 +1,64 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"time"
+)
+
+// Instrumentation receives an observation for every lookup, so metrics
+// can be exported to any backend.  The geoip2prom package provides a
+// Prometheus collector.  Implementations must be safe for concurrent use.
+type Instrumentation interface {
+	// ObserveRequest is called once per lookup sent to the web service,
+	// after any retries.  status is 0 when no response was received.
+	ObserveRequest(endpoint string, status int, latency time.Duration, err error)
+
+	// ObserveCache is called for each lookup answered, or not, by the cache
+	ObserveCache(endpoint string, hit bool)
+
+	// ObserveQueriesRemaining is called when a response reports the
+	// account's remaining queries
+	ObserveQueriesRemaining(remaining int)
+}
+
+// WithInstrumentation reports every lookup to instrumentation
+func WithInstrumentation(instrumentation Instrumentation) Option {
+	return func(a *Api) {
+		a.instrumentation = instrumentation
+	}
+}
+
+// Tracer starts a span around each lookup, cached or not.  The geoip2otel
+// package provides an OpenTelemetry implementation.
+type Tracer interface {
+	// StartLookup returns the context for the lookup, which carries the
+	// span to the outbound request
+	StartLookup(ctx context.Context, endpoint, ipAddress string) (context.Context, LookupSpan)
+}
+
+// LookupSpan is ended with the outcome of the lookup.  resp.Meta() holds
+// the status, retries and whether the cache answered.
+type LookupSpan interface {
+	End(resp Response, err error)
+}
+
+// WithTracer traces every lookup with tracer
+func WithTracer(tracer Tracer) Option {
+	return func(a *Api) {
+		a.tracer = tracer
+	}
+}
@@ -0,0 +1,127 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type recordingInstrumentation struct {
+	mutex     sync.Mutex
+	events    []string
+	remaining int
+}
+
+func (r *recordingInstrumentation) ObserveRequest(endpoint string, status int, latency time.Duration, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.events = append(r.events, fmt.Sprintf("request %s %d %v", endpoint, status, err != nil))
+}
+
+func (r *recordingInstrumentation) ObserveCache(endpoint string, hit bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.events = append(r.events, fmt.Sprintf("cache %s %v", endpoint, hit))
+}
+
+func (r *recordingInstrumentation) ObserveQueriesRemaining(remaining int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.remaining = remaining
+}
+
+func TestInstrumentation(t *testing.T) {
+	Convey("Given an instrumented Api", t, func() {
+		recorder := &recordingInstrumentation{}
+		api := WithClientFunc(New("blah-user-id", "blah-license-key", WithInstrumentation(recorder)), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/8.8.8.8") {
+				return nil, errors.New("connection refused")
+			}
+			header := http.Header{}
+			header.Set(QueriesRemainingHeader, "7")
+			return &http.Response{
+				StatusCode: 200,
+				Header:     header,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		})
+
+		Convey("When I make lookups with and without a cache", func() {
+			cached := api.Clone(WithCache(NewLRUCache(10)))
+			cached.Country(nil, "1.2.3.4")
+			cached.Country(nil, "1.2.3.4")
+			api.Insights(nil, "8.8.8.8")
+
+			Convey("I expect every request, cache probe and quota to be observed", func() {
+				So(recorder.events, ShouldResemble, []string{
+					"cache country false",
+					"request country 200 false",
+					"cache country true",
+					"request insights 0 true",
+				})
+				So(recorder.remaining, ShouldEqual, 7)
+			})
+		})
+	})
+}
+
+type recordingTracer struct {
+	started []string
+	ended   []error
+}
+
+type recordingSpan struct {
+	tracer *recordingTracer
+}
+
+func (r *recordingTracer) StartLookup(ctx context.Context, endpoint, ipAddress string) (context.Context, LookupSpan) {
+	r.started = append(r.started, endpoint+"/"+ipAddress)
+	return ctx, recordingSpan{tracer: r}
+}
+
+func (s recordingSpan) End(resp Response, err error) {
+	s.tracer.ended = append(s.tracer.ended, err)
+}
+
+func TestTracer(t *testing.T) {
+	Convey("Given a traced Api", t, func() {
+		tracer := &recordingTracer{}
+		api := WithClientFunc(New("blah-user-id", "blah-license-key", WithTracer(tracer)), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(sample))}, nil
+		})
+
+		Convey("When I look up valid and invalid addresses", func() {
+			api.City(nil, "1.2.3.4")
+			api.City(nil, "bad")
+
+			Convey("I expect a span around each, ended with its outcome", func() {
+				So(tracer.started, ShouldResemble, []string{"city/1.2.3.4", "city/bad"})
+				So(len(tracer.ended), ShouldEqual, 2)
+				So(tracer.ended[0], ShouldBeNil)
+				So(errors.Is(tracer.ended[1], ErrIPAddressInvalid), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -0,0 +1,62 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"net/http"
+)
+
+// DoFunc sends a request to the web service
+type DoFunc func(ctx context.Context, req *http.Request) (*http.Response, error)
+
+// Interceptor wraps a DoFunc, e.g. to log, rewrite or retry requests
+type Interceptor func(next DoFunc) DoFunc
+
+// WithInterceptor adds interceptors around the transport, the first given
+// outermost.  Interceptors see each lookup once, outside any retries or
+// hedging installed with WithRetries or WithHedging, and apply whatever
+// transport is configured, whether before or after this option.
+func WithInterceptor(interceptors ...Interceptor) Option {
+	return func(a *Api) {
+		a.interceptors = append(append([]Interceptor(nil), a.interceptors...), interceptors...)
+	}
+}
+
+// do returns the transport wrapped by injected faults, hedging, retries,
+// the rate limit, the circuit breaker and the interceptors.  The limit sits
+// inside the breaker so that lookups failing fast spend no tokens.
+func (a *Api) do() DoFunc {
+	do := a.doFunc
+	if a.faults != nil {
+		do = a.faults.wrap(do)
+	}
+	if a.hedgeDelay > 0 {
+		do = hedge(a.hedgeDelay, do)
+	}
+	if a.retrier != nil {
+		do = a.retrier.wrap(do)
+	}
+	if a.limiter != nil {
+		do = a.limiter.wrap(do)
+	}
+	if a.breaker != nil {
+		do = a.breaker.wrap(do)
+	}
+	for i := len(a.interceptors) - 1; i >= 0; i-- {
+		do = a.interceptors[i](do)
+	}
+	return do
+}
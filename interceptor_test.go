@@ -0,0 +1,79 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestInterceptor(t *testing.T) {
+	Convey("Given an Api with interceptors", t, func() {
+		var order []string
+		var received *http.Request
+		trace := func(name string) Interceptor {
+			return func(next DoFunc) DoFunc {
+				return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+					order = append(order, name+" in")
+					defer func() { order = append(order, name+" out") }()
+					return next(ctx, req)
+				}
+			}
+		}
+		inject := func(next DoFunc) DoFunc {
+			return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+				req.Header.Set("X-Request-Id", "abc")
+				return next(ctx, req)
+			}
+		}
+
+		api := New("blah-user-id", "blah-license-key", WithInterceptor(trace("outer"), trace("inner")), WithInterceptor(inject))
+		api = WithClientFunc(api, func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			received = req
+			order = append(order, "transport")
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(sample))}, nil
+		})
+
+		Convey("When I make a lookup", func() {
+			_, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			Convey("I expect the interceptors to wrap the transport in order", func() {
+				So(order, ShouldResemble, []string{"outer in", "inner in", "transport", "inner out", "outer out"})
+				So(received.Header.Get("X-Request-Id"), ShouldEqual, "abc")
+			})
+		})
+
+		Convey("When an interceptor answers itself", func() {
+			clone := api.Clone(WithInterceptor(func(next DoFunc) DoFunc {
+				return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+					return &http.Response{StatusCode: 404, Body: ioutil.NopCloser(strings.NewReader(`{"code":"IP_ADDRESS_NOT_FOUND"}`))}, nil
+				}
+			}))
+			_, err := clone.City(nil, "1.2.3.4")
+
+			Convey("I expect the transport not to be called, nor the original Api changed", func() {
+				So(err, ShouldNotBeNil)
+				So(received, ShouldBeNil)
+				So(len(api.interceptors), ShouldEqual, 3)
+			})
+		})
+	})
+}
@@ -0,0 +1,285 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// InventoryEntry is an address to keep enriched, with caller-defined labels
+// such as an asset ID or owner
+type InventoryEntry struct {
+	IpAddress string            `json:"ip_address"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// LoadInventory reads a CSV inventory with a header row.  The ip_address
+// column is required; every other column becomes a label.
+func LoadInventory(r io.Reader) ([]InventoryEntry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("inventory: %v", err)
+	}
+	column := slices.Index(header, "ip_address")
+	if column < 0 {
+		return nil, errors.New("inventory: missing ip_address column")
+	}
+
+	var entries []InventoryEntry
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("inventory: %v", err)
+		}
+
+		entry := InventoryEntry{IpAddress: record[column]}
+		for i, value := range record {
+			if i != column && value != "" {
+				if entry.Labels == nil {
+					entry.Labels = map[string]string{}
+				}
+				entry.Labels[header[i]] = value
+			}
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// InventoryRecord is the latest enrichment of an InventoryEntry
+type InventoryRecord struct {
+	InventoryEntry
+	Response  Response  `json:"response"`
+	Retrieved time.Time `json:"retrieved"`
+}
+
+// InventoryChange is emitted when re-enrichment changes an entry's answer
+type InventoryChange struct {
+	Entry    InventoryEntry `json:"entry"`
+	Changes  []Change       `json:"changes"`
+	Previous Response       `json:"previous"`
+	Current  Response       `json:"current"`
+	Detected time.Time      `json:"detected"`
+}
+
+// Reenricher keeps the enrichment of an inventory fresh, persisting the
+// latest record for every address to a JSON file so that changes are
+// detected across restarts.
+type Reenricher struct {
+	lookup      LookupFunc
+	path        string
+	concurrency int
+	opts        []DiffOption
+
+	mutex   sync.Mutex
+	records map[string]InventoryRecord
+}
+
+// NewReenricher returns a Reenricher that performs lookup, e.g. api.City,
+// with up to concurrency lookups in flight and persists state to path.
+// Existing state at path is loaded.  opts control which differences count
+// as changes; IgnoreVolatile is a sensible default.
+func NewReenricher(lookup LookupFunc, path string, concurrency int, opts ...DiffOption) (*Reenricher, error) {
+	r := &Reenricher{
+		lookup:      lookup,
+		path:        path,
+		concurrency: concurrency,
+		opts:        opts,
+		records:     map[string]InventoryRecord{},
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var records []InventoryRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("inventory: %s: %v", path, err)
+	}
+	for _, record := range records {
+		r.records[record.IpAddress] = record
+	}
+	return r, nil
+}
+
+// Records returns the latest record for every address, sorted by address
+func (r *Reenricher) Records() []InventoryRecord {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	records := make([]InventoryRecord, 0, len(r.records))
+	for _, record := range r.records {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].IpAddress < records[j].IpAddress
+	})
+	return records
+}
+
+// Refresh re-enriches entries, persists the results and returns the changes
+// since the previous enrichment.  Addresses no longer in entries are
+// dropped.  Failed lookups keep their previous record and are reported
+// together in the returned error.
+func (r *Reenricher) Refresh(ctx context.Context, entries []InventoryEntry) ([]InventoryChange, error) {
+	byAddress := map[string]InventoryEntry{}
+	addresses := func(yield func(string) bool) {
+		for _, entry := range entries {
+			if _, ok := byAddress[entry.IpAddress]; ok {
+				continue
+			}
+			byAddress[entry.IpAddress] = entry
+			if !yield(entry.IpAddress) {
+				return
+			}
+		}
+	}
+
+	r.mutex.Lock()
+	previous := r.records
+	r.mutex.Unlock()
+
+	now := time.Now()
+	records := map[string]InventoryRecord{}
+	var changes []InventoryChange
+	var errs []error
+	for ipAddress, result := range Stream(ctx, r.lookup, addresses, r.concurrency) {
+		entry := byAddress[ipAddress]
+		old, existed := previous[ipAddress]
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ipAddress, result.Err))
+			if existed {
+				old.InventoryEntry = entry
+				records[ipAddress] = old
+			}
+			continue
+		}
+
+		records[ipAddress] = InventoryRecord{InventoryEntry: entry, Response: result.Response, Retrieved: now}
+		if existed {
+			if diff := Diff(old.Response, result.Response, r.opts...); len(diff) > 0 {
+				changes = append(changes, InventoryChange{
+					Entry:    entry,
+					Changes:  diff,
+					Previous: old.Response,
+					Current:  result.Response,
+					Detected: now,
+				})
+			}
+		}
+	}
+	if ctx != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Entry.IpAddress < changes[j].Entry.IpAddress
+	})
+
+	r.mutex.Lock()
+	r.records = records
+	r.mutex.Unlock()
+
+	if err := r.save(); err != nil {
+		errs = append(errs, err)
+	}
+	return changes, errors.Join(errs...)
+}
+
+// Run reloads the inventory and refreshes it every interval until ctx is
+// done, passing each change to onChange.  Errors are passed to onError if
+// it is not nil.
+func (r *Reenricher) Run(ctx context.Context, inventory Feed, interval time.Duration, onChange func(InventoryChange), onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		changes, err := r.refreshFeed(ctx, inventory)
+		if err != nil && onError != nil {
+			onError(err)
+		}
+		if onChange != nil {
+			for _, change := range changes {
+				onChange(change)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Reenricher) refreshFeed(ctx context.Context, inventory Feed) ([]InventoryChange, error) {
+	rc, err := inventory.Open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	entries, err := LoadInventory(rc)
+	if err != nil {
+		return nil, err
+	}
+	return r.Refresh(ctx, entries)
+}
+
+// save writes the records atomically so a crash never leaves partial state
+func (r *Reenricher) save() error {
+	data, err := json.Marshal(r.Records())
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(r.path), filepath.Base(r.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), r.path)
+}
@@ -0,0 +1,125 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLoadInventory(t *testing.T) {
+	Convey("Given a CSV inventory", t, func() {
+		entries, err := LoadInventory(strings.NewReader("asset,ip_address,owner\nweb-1,1.2.3.4,ops\nweb-2,5.6.7.8,\n"))
+
+		Convey("I expect the other columns to become labels", func() {
+			So(err, ShouldBeNil)
+			So(entries, ShouldResemble, []InventoryEntry{
+				{IpAddress: "1.2.3.4", Labels: map[string]string{"asset": "web-1", "owner": "ops"}},
+				{IpAddress: "5.6.7.8", Labels: map[string]string{"asset": "web-2"}},
+			})
+		})
+	})
+
+	Convey("Given a CSV without addresses", t, func() {
+		_, err := LoadInventory(strings.NewReader("asset,owner\nweb-1,ops\n"))
+
+		Convey("I expect an error", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestReenricher(t *testing.T) {
+	Convey("Given a Reenricher over a mutable lookup", t, func() {
+		var mutex sync.Mutex
+		countries := map[string]string{"1.2.3.4": "US", "5.6.7.8": "DE"}
+		failure := errors.New("boom")
+		lookup := func(ctx context.Context, ipAddress string) (Response, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			if countries[ipAddress] == "" {
+				return Response{}, failure
+			}
+			return Response{Country: Country{IsoCode: countries[ipAddress]}, Location: Location{AccuracyRadius: len(countries)}}, nil
+		}
+		path := filepath.Join(t.TempDir(), "state.json")
+		entries := []InventoryEntry{{IpAddress: "1.2.3.4", Labels: map[string]string{"asset": "web-1"}}, {IpAddress: "5.6.7.8"}}
+
+		r, err := NewReenricher(lookup, path, 2, IgnoreVolatile())
+		So(err, ShouldBeNil)
+		changes, err := r.Refresh(nil, entries)
+		So(err, ShouldBeNil)
+		So(changes, ShouldBeEmpty)
+		So(len(r.Records()), ShouldEqual, 2)
+
+		Convey("When an answer changes after a restart", func() {
+			countries["1.2.3.4"] = "CA"
+			countries["9.9.9.9"] = "FR"
+			restarted, err := NewReenricher(lookup, path, 2, IgnoreVolatile())
+			So(err, ShouldBeNil)
+			changes, err := restarted.Refresh(nil, entries)
+
+			Convey("I expect a change event from the persisted state", func() {
+				So(err, ShouldBeNil)
+				So(len(changes), ShouldEqual, 1)
+				So(changes[0].Entry.Labels["asset"], ShouldEqual, "web-1")
+				So(changes[0].Changes, ShouldResemble, []Change{{Field: "country.iso_code", Old: "US", New: "CA"}})
+			})
+		})
+
+		Convey("When a lookup fails", func() {
+			delete(countries, "5.6.7.8")
+			changes, err := r.Refresh(nil, entries)
+
+			Convey("I expect the previous record to be kept and the error reported", func() {
+				So(changes, ShouldBeEmpty)
+				So(errors.Is(err, failure), ShouldBeTrue)
+				So(err.Error(), ShouldContainSubstring, "5.6.7.8")
+				So(r.Records()[1].Response.Country.IsoCode, ShouldEqual, "DE")
+			})
+		})
+
+		Convey("When it runs against an inventory file", func() {
+			inventory := filepath.Join(t.TempDir(), "inventory.csv")
+			So(os.WriteFile(inventory, []byte("ip_address\n1.2.3.4\n"), 0644), ShouldBeNil)
+			countries["1.2.3.4"] = "MX"
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			var received []InventoryChange
+			r.Run(ctx, FileFeed("inventory", inventory), time.Hour, func(change InventoryChange) {
+				received = append(received, change)
+				cancel()
+			}, nil)
+
+			Convey("I expect changes to be emitted and removed addresses dropped", func() {
+				So(len(received), ShouldEqual, 1)
+				So(received[0].Current.Country.IsoCode, ShouldEqual, "MX")
+				So(len(r.Records()), ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,85 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+// countryNameFallback names countries whose response carries no names; the
+// bundled names replace it where they are compiled in
+var countryNameFallback = func(isoCode string, locales ...string) (string, bool) {
+	return "", false
+}
+
+// Name returns the name in the first of locales available, falling back to
+// English.  The Name methods of the other records behave the same way.
+func (c City) Name(locales ...string) string {
+	return localizedName(c.Names, locales...)
+}
+
+func (c Continent) Name(locales ...string) string {
+	return localizedName(c.Names, locales...)
+}
+
+// Name falls back to the bundled country names when the response has
+// none in the requested locales, e.g. from a source that returns only
+// ISO codes
+func (c Country) Name(locales ...string) string {
+	return countryName(c.Names, c.IsoCode, locales)
+}
+
+func (c RegisteredCountry) Name(locales ...string) string {
+	return countryName(c.Names, c.IsoCode, locales)
+}
+
+func (c RepresentedCountry) Name(locales ...string) string {
+	return countryName(c.Names, c.IsoCode, locales)
+}
+
+func (s Subdivision) Name(locales ...string) string {
+	return localizedName(s.Names, locales...)
+}
+
+func countryName(names map[string]string, isoCode string, locales []string) string {
+	if len(locales) == 0 {
+		locales = []string{"en"}
+	}
+	for _, locale := range locales {
+		if name, ok := names[locale]; ok {
+			return name
+		}
+	}
+	if name, ok := countryNameFallback(isoCode, locales...); ok && name != "" {
+		return name
+	}
+	return localizedName(names, locales...)
+}
+
+// CityName, CountryName and SubdivisionName name the most specific
+// places in the response in the locales of the client that fetched it, as
+// set with WithLocales
+func (r Response) CityName() string {
+	return r.City.Name(r.Meta().Locales...)
+}
+
+func (r Response) CountryName() string {
+	return r.Country.Name(r.Meta().Locales...)
+}
+
+// SubdivisionName names the most specific subdivision
+func (r Response) SubdivisionName() string {
+	s, ok := r.MostSpecificSubdivision()
+	if !ok {
+		return ""
+	}
+	return s.Name(r.Meta().Locales...)
+}
@@ -0,0 +1,72 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLocales(t *testing.T) {
+	Convey("Given localized names", t, func() {
+		city := City{Names: map[string]string{"en": "Munich", "de": "München", "pt-BR": "Munique"}}
+
+		Convey("I expect the first available locale to win", func() {
+			So(city.Name("fr", "de"), ShouldEqual, "München")
+			So(city.Name(), ShouldEqual, "Munich")
+			So(city.Name("ja"), ShouldEqual, "Munich")
+		})
+
+		Convey("I expect a locale in the same language to be used", func() {
+			So(city.Name("pt-PT"), ShouldEqual, "Munique")
+			So(city.Name("PT"), ShouldEqual, "Munique")
+		})
+	})
+
+	Convey("Given an Api preferring Brazilian Portuguese", t, func() {
+		var language string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			language = req.Header.Get("Accept-Language")
+			w.Write([]byte(sample))
+		}))
+		defer server.Close()
+
+		api := New("blah-user-id", "blah-license-key",
+			WithHTTPClient(server.Client()),
+			WithBaseURL(server.URL),
+			WithLocales("pt-BR", "en"),
+			WithCache(NewLRUCache(10)),
+		)
+		resp, err := api.City(nil, "1.2.3.4")
+		So(err, ShouldBeNil)
+
+		Convey("I expect the response names to follow the client's locales", func() {
+			So(language, ShouldEqual, "pt-BR, en")
+			So(resp.CityName(), ShouldEqual, resp.City.Names["pt-BR"])
+			So(resp.CountryName(), ShouldEqual, resp.Country.Names["pt-BR"])
+			So(resp.SubdivisionName(), ShouldEqual, resp.Subdivisions[len(resp.Subdivisions)-1].Name("pt-BR", "en"))
+		})
+
+		Convey("I expect cached responses to follow the locales of the client reading them", func() {
+			japanese, err := api.Clone(WithLocales("ja")).City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(japanese.Meta().Cached, ShouldBeTrue)
+			So(japanese.CityName(), ShouldEqual, resp.City.Names["ja"])
+		})
+	})
+}
@@ -0,0 +1,213 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net/netip"
+	"time"
+)
+
+// Logger receives a record of each lookup and retry.  *slog.Logger
+// implements it.
+type Logger interface {
+	Log(ctx context.Context, level slog.Level, msg string, args ...any)
+}
+
+// IPLogMode chooses how looked up addresses appear in logs
+type IPLogMode int
+
+const (
+	// LogIPTruncated logs the /24 of an IPv4 address or the /48 of an IPv6
+	// address, enough to tell networks apart but not people
+	LogIPTruncated IPLogMode = iota
+
+	// LogIPHashed logs a keyed hash of the address, so that lookups of one
+	// address can be correlated without recording it
+	LogIPHashed
+
+	// LogIPFull logs addresses as they are
+	LogIPFull
+
+	// LogIPOmitted leaves addresses out
+	LogIPOmitted
+)
+
+// LogOptions configures WithLogger
+type LogOptions struct {
+	// IPs chooses how addresses are logged, truncated by default
+	IPs IPLogMode
+
+	// HashKey keys the hashes of LogIPHashed.  Without one a random key is
+	// drawn, so hashes only correlate within a process; share a key, kept
+	// secret, to correlate across processes.
+	HashKey []byte
+}
+
+func (o LogOptions) withDefaults() LogOptions {
+	if o.IPs == LogIPHashed && len(o.HashKey) == 0 {
+		o.HashKey = make([]byte, 32)
+		rand.Read(o.HashKey)
+	}
+	return o
+}
+
+// WithLogger records each lookup, at debug level when it succeeds and warn
+// when it fails, and each retry at info level.  Records carry the service,
+// address, status and MaxMind's request id, and for successes the latency,
+// retries and whether the cache answered; errors are scrubbed of
+// credentials, and of addresses unless options log them in full.
+//
+//	api := geoip2.New(userId, licenseKey, geoip2.WithLogger(slog.Default(), geoip2.LogOptions{IPs: geoip2.LogIPHashed}))
+func WithLogger(logger Logger, options LogOptions) Option {
+	l := &lookupLogger{logger: logger, options: options.withDefaults()}
+	return func(a *Api) {
+		a.logger = l
+	}
+}
+
+type lookupLogger struct {
+	logger  Logger
+	options LogOptions
+}
+
+// lookupLog is carried in the context of a lookup so that retries, which
+// happen below the Api, are logged with it
+type lookupLog struct {
+	logger   *lookupLogger
+	redactor redactor
+	service  string
+	ip       string
+}
+
+type logKey struct{}
+
+func (a *Api) startLog(ctx context.Context, service, ipAddress string) (context.Context, *lookupLog) {
+	log := &lookupLog{
+		logger:   a.logger,
+		redactor: a.redactor(),
+		service:  service,
+		ip:       a.logger.ip(ipAddress),
+	}
+	return context.WithValue(ctx, logKey{}, log), log
+}
+
+// ip formats ipAddress for the log as the options ask
+func (l *lookupLogger) ip(ipAddress string) string {
+	if ipAddress == me {
+		return me
+	}
+	switch l.options.IPs {
+	case LogIPFull:
+		return ipAddress
+	case LogIPOmitted:
+		return ""
+	case LogIPHashed:
+		if normalized, ok := NormalizeIP(ipAddress); ok {
+			ipAddress = normalized
+		}
+		mac := hmac.New(sha256.New, l.options.HashKey)
+		mac.Write([]byte(ipAddress))
+		return hex.EncodeToString(mac.Sum(nil)[:8])
+	}
+
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return RedactedIP
+	}
+	addr = addr.Unmap().WithZone("")
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+	prefix, _ := addr.Prefix(bits)
+	return prefix.String()
+}
+
+func (l *lookupLog) attrs(args ...any) []any {
+	attrs := []any{slog.String("service", l.service)}
+	if l.ip != "" {
+		attrs = append(attrs, slog.String("ip", l.ip))
+	}
+	return append(attrs, args...)
+}
+
+// scrub returns the message of err without credentials, or addresses
+// unless they are logged in full
+func (l *lookupLog) scrub(err error) string {
+	msg := l.redactor.String(err.Error())
+	if l.logger.options.IPs != LogIPFull {
+		msg = RedactIPs(msg)
+	}
+	return msg
+}
+
+func (l *lookupLog) lookup(ctx context.Context, response Response, err error) {
+	if err == nil {
+		meta := response.Meta()
+		args := []any{
+			slog.Int("status", meta.StatusCode),
+			slog.Duration("latency", meta.Latency),
+			slog.Int("retries", meta.Retries),
+			slog.Bool("cached", meta.Cached),
+		}
+		if meta.RequestId != "" {
+			args = append(args, slog.String("request_id", meta.RequestId))
+		}
+		l.logger.logger.Log(ctx, slog.LevelDebug, "geoip2 lookup", l.attrs(args...)...)
+		return
+	}
+
+	// a failure carries only what the Error holds; retries were logged as
+	// they happened
+	var args []any
+	var v Error
+	if errors.As(err, &v) {
+		if v.StatusCode != 0 {
+			args = append(args, slog.Int("status", v.StatusCode))
+		}
+		if v.Code != "" {
+			args = append(args, slog.String("code", v.Code))
+		}
+		if v.RequestId != "" {
+			args = append(args, slog.String("request_id", v.RequestId))
+		}
+	}
+	args = append(args, slog.String("error", l.scrub(err)))
+	l.logger.logger.Log(ctx, slog.LevelWarn, "geoip2 lookup failed", l.attrs(args...)...)
+}
+
+// logRetry records a retry of the request in ctx, if it is logged
+func logRetry(ctx context.Context, attempt int, delay time.Duration, status int, err error) {
+	l, ok := ctx.Value(logKey{}).(*lookupLog)
+	if !ok {
+		return
+	}
+	args := []any{
+		slog.Int("attempt", attempt),
+		slog.Duration("delay", delay),
+		slog.Int("status", status),
+	}
+	if err != nil {
+		args = append(args, slog.String("error", l.scrub(err)))
+	}
+	l.logger.logger.Log(ctx, slog.LevelInfo, "geoip2 retry", l.attrs(args...)...)
+}
@@ -0,0 +1,133 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLogger(t *testing.T) {
+	Convey("Given an Api that logs as JSON", t, func() {
+		status := http.StatusOK
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("X-Request-Id", "req-7")
+			if status != http.StatusOK {
+				w.WriteHeader(status)
+				w.Write([]byte(`{"code":"AUTHORIZATION_INVALID","error":"key tLIC0 is invalid for 81.2.69.160"}`))
+				return
+			}
+			w.Write([]byte(sample))
+		}))
+		defer server.Close()
+
+		buf := &bytes.Buffer{}
+		logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		records := func() []map[string]interface{} {
+			var records []map[string]interface{}
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				record := map[string]interface{}{}
+				json.Unmarshal([]byte(line), &record)
+				records = append(records, record)
+			}
+			return records
+		}
+		api := func(options LogOptions) *Api {
+			return New("blah-user-id", "tLIC0", WithBaseURL(server.URL), WithLogger(logger, options))
+		}
+
+		Convey("When a lookup succeeds", func() {
+			api(LogOptions{}).City(context.Background(), "81.2.69.160")
+
+			Convey("I expect a debug record with a truncated address", func() {
+				r := records()[0]
+				So(r["level"], ShouldEqual, "DEBUG")
+				So(r["msg"], ShouldEqual, "geoip2 lookup")
+				So(r["service"], ShouldEqual, "city")
+				So(r["ip"], ShouldEqual, "81.2.69.0/24")
+				So(r["status"], ShouldEqual, 200)
+				So(r["request_id"], ShouldEqual, "req-7")
+				So(r["cached"], ShouldEqual, false)
+			})
+		})
+
+		Convey("When a lookup fails", func() {
+			status = http.StatusUnauthorized
+			api(LogOptions{IPs: LogIPOmitted}).Insights(context.Background(), "81.2.69.160")
+
+			Convey("I expect a warning with secrets and addresses scrubbed", func() {
+				r := records()[0]
+				So(r["level"], ShouldEqual, "WARN")
+				So(r["code"], ShouldEqual, CodeAuthorizationInvalid)
+				So(r["status"], ShouldEqual, 401)
+				So(r, ShouldNotContainKey, "ip")
+				So(buf.String(), ShouldNotContainSubstring, "tLIC0")
+				So(buf.String(), ShouldNotContainSubstring, "81.2.69.160")
+			})
+		})
+
+		Convey("When addresses are hashed", func() {
+			logged := api(LogOptions{IPs: LogIPHashed, HashKey: []byte("k")})
+			logged.Country(context.Background(), "2a02:DB8::1")
+			logged.Country(context.Background(), "2a02:db8::1")
+			other := api(LogOptions{IPs: LogIPHashed, HashKey: []byte("other")})
+			other.Country(context.Background(), "2a02:db8::1")
+
+			Convey("I expect equal addresses to hash alike under one key", func() {
+				r := records()
+				So(r[0]["ip"], ShouldEqual, r[1]["ip"])
+				So(r[0]["ip"], ShouldNotEqual, r[2]["ip"])
+				So(r[0]["ip"], ShouldHaveLength, 16)
+			})
+		})
+
+		Convey("When a request is retried", func() {
+			status = http.StatusServiceUnavailable
+			retrying := api(LogOptions{IPs: LogIPFull}).Clone(WithRetries(RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}))
+			retrying.Country(context.Background(), "81.2.69.160")
+
+			Convey("I expect the retry logged before the failure", func() {
+				r := records()
+				So(len(r), ShouldEqual, 2)
+				So(r[0]["msg"], ShouldEqual, "geoip2 retry")
+				So(r[0]["attempt"], ShouldEqual, 1)
+				So(r[0]["status"], ShouldEqual, 503)
+				So(r[0]["ip"], ShouldEqual, "81.2.69.160")
+				So(r[1]["msg"], ShouldEqual, "geoip2 lookup failed")
+				So(r[1]["status"], ShouldEqual, 503)
+			})
+		})
+	})
+
+	Convey("Given addresses to truncate", t, func() {
+		l := &lookupLogger{}
+
+		Convey("I expect their networks", func() {
+			So(l.ip("::ffff:192.0.2.77"), ShouldEqual, "192.0.2.0/24")
+			So(l.ip("2001:db8:abcd:12::1"), ShouldEqual, "2001:db8:abcd::/48")
+			So(l.ip("me"), ShouldEqual, "me")
+			So(l.ip("not an address"), ShouldEqual, RedactedIP)
+		})
+	})
+}
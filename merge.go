@@ -0,0 +1,108 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import "reflect"
+
+// Precedence decides which response wins when both carry a value
+type Precedence int
+
+const (
+	// PreferOverlay takes the overlay's values, filling gaps from the base
+	PreferOverlay Precedence = iota
+
+	// PreferBase keeps the base's values, filling gaps from the overlay
+	PreferBase
+)
+
+// MergeRules sets the precedence per field group, keyed by the group's JSON
+// name, e.g. "traits" or "subdivisions".  Groups without a rule use
+// PreferOverlay.
+type MergeRules map[string]Precedence
+
+// Merge combines r with overlay, e.g. a web service answer over a local
+// database answer.  Fields are merged individually within each group:
+// the preferred side's non-empty values win, names maps are combined key
+// by key, and subdivisions are taken whole from whichever side has them.
+func (r Response) Merge(overlay Response, rules MergeRules) Response {
+	merged := Response{}
+
+	base := reflect.ValueOf(r)
+	over := reflect.ValueOf(overlay)
+	out := reflect.ValueOf(&merged).Elem()
+	for i := 0; i < out.NumField(); i++ {
+		name := jsonName(out.Type().Field(i))
+		if name == "" {
+			continue
+		}
+
+		preferred, fallback := over.Field(i), base.Field(i)
+		if rules[name] == PreferBase {
+			preferred, fallback = fallback, preferred
+		}
+		out.Field(i).Set(mergeValues(preferred, fallback))
+	}
+
+	merged.meta = overlay.meta
+	if merged.meta == nil {
+		merged.meta = r.meta
+	}
+	return merged
+}
+
+func mergeValues(preferred, fallback reflect.Value) reflect.Value {
+	switch {
+	case preferred.Kind() == reflect.Struct && !leaf(preferred.Type()):
+		out := reflect.New(preferred.Type()).Elem()
+		for i := 0; i < out.NumField(); i++ {
+			if jsonName(out.Type().Field(i)) == "" {
+				continue
+			}
+			out.Field(i).Set(mergeValues(preferred.Field(i), fallback.Field(i)))
+		}
+		return out
+
+	case preferred.Kind() == reflect.Map:
+		if preferred.Len() == 0 {
+			return fallback
+		}
+		if fallback.Len() == 0 {
+			return preferred
+		}
+		out := reflect.MakeMap(preferred.Type())
+		for _, k := range fallback.MapKeys() {
+			out.SetMapIndex(k, fallback.MapIndex(k))
+		}
+		for _, k := range preferred.MapKeys() {
+			out.SetMapIndex(k, preferred.MapIndex(k))
+		}
+		return out
+
+	case preferred.Kind() == reflect.Slice:
+		if preferred.Len() == 0 {
+			return fallback
+		}
+		return preferred
+
+	default:
+		if preferred.IsZero() {
+			return fallback
+		}
+		return preferred
+	}
+}
@@ -0,0 +1,77 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMerge(t *testing.T) {
+	Convey("Given a local and a web service response", t, func() {
+		local := Response{
+			City:     City{GeoNameId: 5368361, Names: map[string]string{"en": "Los Angeles", "de": "Los Angeles"}},
+			Country:  Country{IsoCode: "US"},
+			Location: Location{Latitude: 34.05, Longitude: -118.24, AccuracyRadius: 100},
+			Traits:   Traits{AutonomousSystemNumber: 1239, Isp: "Local ISP"},
+		}
+		web := Response{
+			City:              City{Confidence: 25, Names: map[string]string{"en": "LA", "ja": "ロサンゼルス市"}},
+			Location:          Location{AccuracyRadius: 20},
+			Subdivisions:      []Subdivision{{IsoCode: "CA"}},
+			Traits:            Traits{Isp: "Web ISP", IsAnonymousProxy: true},
+			MaxMind:           MaxMind{QueriesRemaining: 54321},
+			RegisteredCountry: RegisteredCountry{IsoCode: "US"},
+		}
+
+		Convey("When I overlay the web response", func() {
+			merged := local.Merge(web, nil)
+
+			Convey("I expect the web values to win and gaps to be filled", func() {
+				So(merged.City.Confidence, ShouldEqual, 25)
+				So(merged.City.GeoNameId, ShouldEqual, 5368361)
+				So(merged.City.Names, ShouldResemble, map[string]string{"en": "LA", "de": "Los Angeles", "ja": "ロサンゼルス市"})
+				So(merged.Country.IsoCode, ShouldEqual, "US")
+				So(merged.Location, ShouldResemble, Location{Latitude: 34.05, Longitude: -118.24, AccuracyRadius: 20})
+				So(merged.Subdivisions, ShouldResemble, []Subdivision{{IsoCode: "CA"}})
+				So(merged.Traits.Isp, ShouldEqual, "Web ISP")
+				So(merged.Traits.AutonomousSystemNumber, ShouldEqual, 1239)
+				So(merged.Traits.IsAnonymousProxy, ShouldBeTrue)
+				So(merged.MaxMind.QueriesRemaining, ShouldEqual, 54321)
+			})
+
+			Convey("I expect the inputs to be left untouched", func() {
+				So(local.City.Names, ShouldResemble, map[string]string{"en": "Los Angeles", "de": "Los Angeles"})
+				So(web.City.Names["en"], ShouldEqual, "LA")
+			})
+		})
+
+		Convey("When the local database takes precedence for some groups", func() {
+			merged := local.Merge(web, MergeRules{"location": PreferBase, "traits": PreferBase})
+
+			Convey("I expect those groups to keep the local values", func() {
+				So(merged.Location.AccuracyRadius, ShouldEqual, 100)
+				So(merged.Traits.Isp, ShouldEqual, "Local ISP")
+				So(merged.Traits.IsAnonymousProxy, ShouldBeTrue)
+				So(merged.City.Confidence, ShouldEqual, 25)
+				So(merged.City.Names["en"], ShouldEqual, "LA")
+			})
+		})
+	})
+}
@@ -0,0 +1,73 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+type metaKey struct{}
+
+// CaptureMeta returns a context that records the Meta of the lookup made
+// with it, for call sites that only see the error, such as a failed
+// lookup whose request id belongs in a support ticket, or that reach the
+// Api through a Lookuper.  The Meta is filled in when the lookup returns
+// and describes the most recent lookup made with the context; it isn't
+// safe to share the context between concurrent lookups.
+//
+//	ctx, meta := geoip2.CaptureMeta(ctx)
+//	if _, err := lookuper.City(ctx, ip); err != nil {
+//		log.Printf("lookup failed (request %s): %v", meta.RequestId, err)
+//	}
+func CaptureMeta(ctx context.Context) (context.Context, *Meta) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	meta := &Meta{}
+	return context.WithValue(ctx, metaKey{}, meta), meta
+}
+
+// resultMeta describes the outcome of a lookup of service.  Errors carry
+// only the status and request id of the answer, if there was one.
+func resultMeta(service string, response Response, err error) Meta {
+	if err == nil {
+		meta := response.Meta()
+		meta.Service = service
+		return meta
+	}
+	meta := Meta{Service: service}
+	var v Error
+	if errors.As(err, &v) {
+		meta.StatusCode = v.StatusCode
+		meta.RequestId = v.RequestId
+	}
+	return meta
+}
+
+// requestIdHeaders name the headers identifying a request to MaxMind, in
+// order of preference; the web service sits behind Cloudflare, whose ray id
+// is the fallback
+var requestIdHeaders = []string{"X-Request-Id", "Cf-Ray"}
+
+func requestId(header http.Header) string {
+	for _, name := range requestIdHeaders {
+		if id := header.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
@@ -0,0 +1,95 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCaptureMeta(t *testing.T) {
+	Convey("Given an Api whose answers carry a request id", t, func() {
+		status := http.StatusOK
+		body := sample
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: status,
+				Header: http.Header{
+					"Content-Type": {"application/vnd.maxmind.com-city+json; charset=UTF-8; version=2.1"},
+					"X-Request-Id": {"req-1234"},
+				},
+				Body: ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		})
+
+		Convey("When a lookup succeeds", func() {
+			ctx, meta := CaptureMeta(context.Background())
+			resp, err := api.City(ctx, "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			Convey("I expect a billable answer with its request id", func() {
+				So(*meta, ShouldResemble, resp.Meta())
+				So(meta.Service, ShouldEqual, "city")
+				So(meta.StatusCode, ShouldEqual, http.StatusOK)
+				So(meta.RequestId, ShouldEqual, "req-1234")
+				So(meta.ContentType, ShouldStartWith, "application/vnd.maxmind.com-city+json")
+				So(meta.Latency, ShouldBeGreaterThan, 0)
+				So(meta.Billable, ShouldBeTrue)
+			})
+		})
+
+		Convey("When a lookup fails", func() {
+			status = http.StatusNotFound
+			body = `{"code":"IP_ADDRESS_NOT_FOUND","error":"not found"}`
+			ctx, meta := CaptureMeta(context.Background())
+			_, err := api.Country(ctx, "1.2.3.4")
+			So(errors.Is(err, ErrIPAddressNotFound), ShouldBeTrue)
+
+			Convey("I expect the status and request id, and no charge", func() {
+				So(meta.Service, ShouldEqual, "country")
+				So(meta.StatusCode, ShouldEqual, http.StatusNotFound)
+				So(meta.RequestId, ShouldEqual, "req-1234")
+				So(meta.Billable, ShouldBeFalse)
+
+				var v Error
+				So(errors.As(err, &v), ShouldBeTrue)
+				So(v.RequestId, ShouldEqual, "req-1234")
+			})
+		})
+
+		Convey("When the address is rejected before any request", func() {
+			ctx, meta := CaptureMeta(context.Background())
+			_, err := api.City(ctx, "not-an-address")
+			So(err, ShouldNotBeNil)
+
+			Convey("I expect only the service", func() {
+				So(*meta, ShouldResemble, Meta{Service: "city"})
+			})
+		})
+	})
+
+	Convey("Given headers without a request id", t, func() {
+		Convey("I expect the Cloudflare ray id as the fallback", func() {
+			So(requestId(http.Header{"Cf-Ray": {"8a1b2c3d4e5f-LHR"}}), ShouldEqual, "8a1b2c3d4e5f-LHR")
+			So(requestId(http.Header{}), ShouldEqual, "")
+		})
+	})
+}
@@ -0,0 +1,200 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// MiddlewareOption configures Middleware
+type MiddlewareOption func(*middleware)
+
+type middleware struct {
+	lookup   LookupFunc
+	proxies  []netip.Prefix
+	internal []netip.Prefix
+	onError  func(*http.Request, error)
+}
+
+// WithTrustedProxies honours X-Forwarded-For and X-Real-IP on requests
+// received from the given ranges, e.g. the load balancers in front of the
+// service.  Without trusted proxies the headers are ignored, since any
+// client can set them.
+func WithTrustedProxies(prefixes ...netip.Prefix) MiddlewareOption {
+	return func(m *middleware) {
+		m.proxies = append(m.proxies, prefixes...)
+	}
+}
+
+// WithInternalRanges skips the lookup for clients in the given ranges, e.g.
+// health checkers and office networks, and marks their requests as internal
+func WithInternalRanges(prefixes ...netip.Prefix) MiddlewareOption {
+	return func(m *middleware) {
+		m.internal = append(m.internal, prefixes...)
+	}
+}
+
+// WithLookupErrors calls fn when a lookup fails.  The request is served
+// either way, without a Response in its context.
+func WithLookupErrors(fn func(req *http.Request, err error)) MiddlewareOption {
+	return func(m *middleware) {
+		m.onError = fn
+	}
+}
+
+// Middleware looks up the client address of each request and stores the
+// result in the request context, for handlers to read with FromContext.
+// lookup is typically the City or Country method of an Api configured
+// WithCache, so repeat visitors don't cost a query each.  Clients in
+// private or reserved ranges are not looked up.
+func Middleware(lookup LookupFunc, opts ...MiddlewareOption) func(http.Handler) http.Handler {
+	m := &middleware{lookup: lookup}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			next.ServeHTTP(w, req.WithContext(m.enrich(req)))
+		})
+	}
+}
+
+func (m *middleware) enrich(req *http.Request) context.Context {
+	ctx := req.Context()
+	addr, ok := ResolveClientAddr(req, m.proxies...)
+	if !ok {
+		return ctx
+	}
+
+	v := &visitor{addr: addr}
+	switch {
+	case containsAddr(m.internal, addr):
+		v.internal = true
+	case !IsReserved(addr):
+		resp, err := m.lookup(ctx, addr.String())
+		if err != nil {
+			if m.onError != nil {
+				m.onError(req, err)
+			}
+			break
+		}
+		v.response, v.ok = resp, true
+	}
+	return context.WithValue(ctx, visitorKey{}, v)
+}
+
+// ResolveClientAddr returns the address of the peer of req, or, when the
+// peer is in one of the trusted proxy ranges, the nearest untrusted address
+// in X-Forwarded-For, falling back to X-Real-IP.  It is the resolution
+// Middleware uses with WithTrustedProxies, for handlers that need the
+// client address without a lookup.
+func ResolveClientAddr(req *http.Request, proxies ...netip.Prefix) (netip.Addr, bool) {
+	addr, ok := parseAddr(req.RemoteAddr)
+	if !ok || !containsAddr(proxies, addr) {
+		return addr, ok
+	}
+
+	// each proxy appends the address it received from, so the first
+	// untrusted address from the right is the one that can't be forged
+	var hops []string
+	for _, header := range req.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, ok := parseAddr(hops[i])
+		if !ok {
+			return addr, true
+		}
+		addr = hop
+		if !containsAddr(proxies, hop) {
+			return addr, true
+		}
+	}
+	if len(hops) == 0 {
+		if realIP, ok := parseAddr(req.Header.Get("X-Real-IP")); ok {
+			return realIP, true
+		}
+	}
+	return addr, true
+}
+
+// parseAddr accepts a bare address or host:port, as in RemoteAddr
+func parseAddr(s string) (netip.Addr, bool) {
+	s = strings.TrimSpace(s)
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap().WithZone(""), true
+}
+
+func containsAddr(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+type visitorKey struct{}
+
+type visitor struct {
+	addr     netip.Addr
+	internal bool
+	response Response
+	ok       bool
+}
+
+// NewContext returns a copy of ctx carrying resp as the client's Response,
+// e.g. to test handlers that expect Middleware in front of them
+func NewContext(ctx context.Context, resp Response) context.Context {
+	return context.WithValue(ctx, visitorKey{}, &visitor{addr: resp.Traits.IpAddress, response: resp, ok: true})
+}
+
+// FromContext returns the Response Middleware looked up for the client.  ok
+// is false when there was no lookup: the client was internal or reserved,
+// or the lookup failed.
+func FromContext(ctx context.Context) (resp Response, ok bool) {
+	v, _ := ctx.Value(visitorKey{}).(*visitor)
+	if v == nil {
+		return Response{}, false
+	}
+	return v.response, v.ok
+}
+
+// ClientAddr returns the client address Middleware resolved for the request
+func ClientAddr(ctx context.Context) (netip.Addr, bool) {
+	v, _ := ctx.Value(visitorKey{}).(*visitor)
+	if v == nil {
+		return netip.Addr{}, false
+	}
+	return v.addr, true
+}
+
+// IsInternal reports whether the client is in one of the ranges given to
+// WithInternalRanges
+func IsInternal(ctx context.Context) bool {
+	v, _ := ctx.Value(visitorKey{}).(*visitor)
+	return v != nil && v.internal
+}
@@ -0,0 +1,127 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMiddleware(t *testing.T) {
+	Convey("Given a handler behind the Middleware", t, func() {
+		var looked []string
+		lookup := func(ctx context.Context, ipAddress string) (Response, error) {
+			looked = append(looked, ipAddress)
+			if ipAddress == "9.9.9.9" {
+				return Response{}, errors.New("boom")
+			}
+			return MockResponse(ipAddress), nil
+		}
+
+		var failed error
+		handler := Middleware(lookup,
+			WithTrustedProxies(netip.MustParsePrefix("10.0.0.0/8")),
+			WithInternalRanges(netip.MustParsePrefix("81.2.69.0/24")),
+			WithLookupErrors(func(req *http.Request, err error) { failed = err }),
+		)
+
+		var ctx context.Context
+		serve := func(remoteAddr string, header http.Header) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = remoteAddr
+			for key, values := range header {
+				req.Header[key] = values
+			}
+			handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				ctx = req.Context()
+			})).ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		Convey("When a client connects directly", func() {
+			serve("1.2.3.4:5678", http.Header{"X-Forwarded-For": {"5.6.7.8"}})
+
+			Convey("I expect the peer to be looked up and forwarded headers ignored", func() {
+				resp, ok := FromContext(ctx)
+				So(ok, ShouldBeTrue)
+				So(resp.Traits.IpAddress.String(), ShouldEqual, "1.2.3.4")
+				So(looked, ShouldResemble, []string{"1.2.3.4"})
+				addr, _ := ClientAddr(ctx)
+				So(addr.String(), ShouldEqual, "1.2.3.4")
+			})
+		})
+
+		Convey("When a client connects through trusted proxies", func() {
+			serve("10.0.0.1:5678", http.Header{"X-Forwarded-For": {"6.6.6.6, 5.6.7.8", "10.0.0.2"}})
+
+			Convey("I expect the nearest untrusted hop to be the client", func() {
+				So(looked, ShouldResemble, []string{"5.6.7.8"})
+			})
+		})
+
+		Convey("When a trusted proxy sends X-Real-IP", func() {
+			serve("10.0.0.1:5678", http.Header{"X-Real-Ip": {"::ffff:5.6.7.8"}})
+
+			Convey("I expect it to be used", func() {
+				So(looked, ShouldResemble, []string{"5.6.7.8"})
+			})
+		})
+
+		Convey("When an internal client connects", func() {
+			serve("81.2.69.142:5678", nil)
+
+			Convey("I expect it to be tagged without a lookup", func() {
+				So(IsInternal(ctx), ShouldBeTrue)
+				_, ok := FromContext(ctx)
+				So(ok, ShouldBeFalse)
+				So(looked, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a client has a private address", func() {
+			serve("192.168.1.1:5678", nil)
+
+			Convey("I expect no lookup", func() {
+				So(looked, ShouldBeEmpty)
+				So(IsInternal(ctx), ShouldBeFalse)
+			})
+		})
+
+		Convey("When the lookup fails", func() {
+			serve("9.9.9.9:5678", nil)
+
+			Convey("I expect the request to be served without a Response", func() {
+				So(failed, ShouldNotBeNil)
+				_, ok := FromContext(ctx)
+				So(ok, ShouldBeFalse)
+			})
+		})
+	})
+
+	Convey("Given a context from NewContext", t, func() {
+		ctx := NewContext(context.Background(), MockResponse("1.2.3.4"))
+
+		Convey("I expect FromContext to return the Response", func() {
+			resp, ok := FromContext(ctx)
+			So(ok, ShouldBeTrue)
+			So(resp.Traits.IpAddress.String(), ShouldEqual, "1.2.3.4")
+		})
+	})
+}
@@ -0,0 +1,429 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// ErrDatabaseStale is returned by CheckAge for a database built too long
+// ago
+var ErrDatabaseStale = errors.New("geoip2: database is out of date")
+
+// Reader looks up addresses in a local GeoIP2 or GeoLite2 database.  It
+// returns the same errors as the web service for invalid and unknown
+// addresses, so it can stand in for an Api.  The database may be replaced
+// while in use with Reload.
+type Reader struct {
+	mutex sync.RWMutex
+	db    *maxminddb.Reader
+
+	mode      LoadMode
+	loaded    loadedFile
+	maxAge    time.Duration
+	warnStale func(error)
+}
+
+var _ Lookuper = (*Reader)(nil)
+
+// ReaderOption configures a Reader
+type ReaderOption func(*Reader)
+
+// LoadMode is how NewFromFile and Reload load a database
+type LoadMode int
+
+const (
+	// LoadMmap maps the file into memory, so that its pages are read on
+	// demand and may be evicted, as suits containers with tight memory
+	// limits.  It is the default.  The file must not be modified in place
+	// while mapped; Updater replaces it atomically instead.
+	LoadMmap LoadMode = iota
+
+	// LoadHeap reads the whole file into memory, so that no lookup waits on
+	// the disk and the file may be changed freely once loaded
+	LoadHeap
+)
+
+// WithLoadMode loads the database as mode rather than LoadMmap.  Databases
+// given to NewFromBytes are already in memory.
+func WithLoadMode(mode LoadMode) ReaderOption {
+	return func(r *Reader) {
+		r.mode = mode
+	}
+}
+
+// WithStaleWarning passes the error of CheckAge(maxAge) to warn, e.g. a
+// logger, each time a database older than maxAge is opened or reloaded
+func WithStaleWarning(maxAge time.Duration, warn func(error)) ReaderOption {
+	return func(r *Reader) {
+		r.maxAge = maxAge
+		r.warnStale = warn
+	}
+}
+
+// NewFromFile opens the .mmdb database at path
+func NewFromFile(path string, opts ...ReaderOption) (*Reader, error) {
+	r := &Reader{}
+	for _, opt := range opts {
+		opt(r)
+	}
+	info, _ := os.Stat(path)
+	db, err := r.open(path)
+	if err != nil {
+		return nil, err
+	}
+	r.db = db
+	r.loaded = loadedFile{path: path, info: info}
+	r.checkStale()
+	return r, nil
+}
+
+// NewFromBytes reads a database already held in memory
+func NewFromBytes(data []byte, opts ...ReaderOption) (*Reader, error) {
+	db, err := maxminddb.FromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	return newReader(db, opts), nil
+}
+
+func newReader(db *maxminddb.Reader, opts []ReaderOption) *Reader {
+	r := &Reader{db: db}
+	for _, opt := range opts {
+		opt(r)
+	}
+	r.checkStale()
+	return r
+}
+
+// loadedFile is the file a database was loaded from, as it was then
+type loadedFile struct {
+	path string
+	info os.FileInfo
+}
+
+// open loads the database at path as the Reader's mode asks
+func (r *Reader) open(path string) (*maxminddb.Reader, error) {
+	if r.mode == LoadHeap {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return maxminddb.FromBytes(data)
+	}
+	return maxminddb.Open(path)
+}
+
+// checkStale warns of a stale database when WithStaleWarning asks for it
+func (r *Reader) checkStale() {
+	if r.warnStale == nil {
+		return
+	}
+	if err := r.CheckAge(r.maxAge); err != nil {
+		r.warnStale(err)
+	}
+}
+
+// Close releases the database
+func (r *Reader) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.db.Close()
+}
+
+// Reload replaces the database with the one at path, e.g. once an Updater
+// has downloaded a new edition.  Lookups in progress finish with the old
+// database, which is then closed.  On error the old database is kept.  The
+// new database is loaded in the Reader's LoadMode.
+func (r *Reader) Reload(path string) error {
+	info, _ := os.Stat(path)
+	db, err := r.open(path)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	old := r.db
+	r.db = db
+	r.loaded = loadedFile{path: path, info: info}
+	r.mutex.Unlock()
+	r.checkStale()
+	return old.Close()
+}
+
+// DefaultWatchInterval is how often WatchFile checks the file when given no
+// interval
+const DefaultWatchInterval = time.Minute
+
+// WatchFile reloads the database from path whenever the file changes, e.g.
+// when a cron job or a sidecar replaces it, checking its modification time
+// and size every interval, or DefaultWatchInterval if it isn't positive,
+// until ctx is done.  Reload errors, such as a file still being written,
+// are passed to onError, which may be nil, and the file is tried again once
+// it changes further.  Databases installed by an Updater are reloaded by
+// WithReloadReaders without watching.
+func (r *Reader) WatchFile(ctx context.Context, path string, interval time.Duration, onError func(error)) {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// changes since the database was loaded count too
+	r.mutex.RLock()
+	loaded := r.loaded
+	r.mutex.RUnlock()
+	last := loaded.info
+	if loaded.path != path || last == nil {
+		last, _ = os.Stat(path)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			if onError != nil && last != nil {
+				onError(err)
+			}
+			last = nil
+			continue
+		}
+		if last != nil && info.ModTime().Equal(last.ModTime()) && info.Size() == last.Size() {
+			continue
+		}
+		last = info
+		if err := r.Reload(path); err != nil && onError != nil {
+			onError(err)
+		}
+	}
+}
+
+// DatabaseMetadata describes a database, as recorded in the file
+type DatabaseMetadata struct {
+	DatabaseType string // e.g. "GeoIP2-City" or "GeoLite2-ASN"
+	Description  map[string]string
+	Languages    []string // the locales of the names in the records
+	IPVersion    int      // 4 for IPv4 only, 6 for IPv4 and IPv6
+	RecordSize   int      // the size in bits of a search tree record
+	NodeCount    int
+	BuildTime    time.Time
+}
+
+// Metadata describes the database
+func (r *Reader) Metadata() DatabaseMetadata {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	m := r.db.Metadata
+	return DatabaseMetadata{
+		DatabaseType: m.DatabaseType,
+		Description:  m.Description,
+		Languages:    m.Languages,
+		IPVersion:    int(m.IPVersion),
+		RecordSize:   int(m.RecordSize),
+		NodeCount:    int(m.NodeCount),
+		BuildTime:    time.Unix(int64(m.BuildEpoch), 0).UTC(),
+	}
+}
+
+// BuildTime returns when the database was built
+func (r *Reader) BuildTime() time.Time {
+	return r.Metadata().BuildTime
+}
+
+// CheckAge returns an error wrapping ErrDatabaseStale when the database was
+// built more than maxAge ago, e.g. for a readiness probe.  MaxMind builds
+// most databases twice a week, so an updated database is rarely more than a
+// week old.
+func (r *Reader) CheckAge(maxAge time.Duration) error {
+	metadata := r.Metadata()
+	if age := time.Since(metadata.BuildTime); age > maxAge {
+		return fmt.Errorf("%w: %s was built %s ago", ErrDatabaseStale, metadata.DatabaseType, age.Truncate(time.Hour))
+	}
+	return nil
+}
+
+// Country returns only the country-level fields of the record, as the
+// Country web service does
+func (r *Reader) Country(ctx context.Context, ipAddress string) (Response, error) {
+	resp, err := r.lookup(ipAddress)
+	resp.City = City{}
+	resp.Location = Location{}
+	resp.Postal = Postal{}
+	resp.Subdivisions = nil
+	return resp, err
+}
+
+func (r *Reader) City(ctx context.Context, ipAddress string) (Response, error) {
+	return r.lookup(ipAddress)
+}
+
+// Insights returns every field in the database; which fields are present
+// depends on the database type.  The records of the Anonymous IP, ISP,
+// ASN, Domain and Connection Type databases are returned as the Response's
+// traits.
+func (r *Reader) Insights(ctx context.Context, ipAddress string) (Response, error) {
+	return r.lookup(ipAddress)
+}
+
+// Lookup decodes the record for ipAddress into v, a pointer to a struct of
+// the caller's own, e.g. for a custom database with fields Response lacks.
+// Fields are matched by their json tags, as for the web service, and v is
+// decoded as leniently: a field that doesn't fit is left zero and reported
+// in a DecodeError, and the rest are kept.
+func (r *Reader) Lookup(ipAddress string, v interface{}) error {
+	var record interface{}
+	if _, _, err := r.record(ipAddress, &record); err != nil {
+		return err
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return decode(data, v)
+}
+
+func (r *Reader) lookup(ipAddress string) (Response, error) {
+	// the records of databases such as Anonymous IP are the traits alone
+	response := Response{}
+	var result interface{} = &response
+	if traitsDatabase(r.Metadata().DatabaseType) {
+		result = &response.Traits
+	}
+	addr, network, err := r.record(ipAddress, result)
+	if err != nil {
+		return Response{}, err
+	}
+	response.Traits.IpAddress = addr
+	response.Traits.Network = network
+	return response, nil
+}
+
+// record decodes the record for ipAddress into result, returning the
+// address and the network holding it
+func (r *Reader) record(ipAddress string, result interface{}) (netip.Addr, netip.Prefix, error) {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return netip.Addr{}, netip.Prefix{}, Error{
+			Code: CodeIPAddressInvalid,
+			Err:  fmt.Sprintf("The value %q is not a valid IP address.", ipAddress),
+		}
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	network, ok, err := r.db.LookupNetwork(net.IP(addr.AsSlice()), result)
+	if err != nil {
+		return netip.Addr{}, netip.Prefix{}, err
+	}
+	if !ok {
+		return netip.Addr{}, netip.Prefix{}, Error{
+			Code: CodeIPAddressNotFound,
+			Err:  fmt.Sprintf("The address %s is not in the database.", addr),
+		}
+	}
+	return addr, prefixOf(network), nil
+}
+
+// Networks iterates over every network in the database with its record, in
+// address order, e.g. for offline analysis of the networks in a country.
+// Each Response has Traits.Network set; IPv4 networks are reported once, as
+// IPv4, however many places an IPv6 database maps them to.  An error ends
+// the iteration.  Reload waits for iterations in progress to finish.
+func (r *Reader) Networks() iter.Seq2[Response, error] {
+	return r.networks(func(db *maxminddb.Reader) *maxminddb.Networks {
+		return db.Networks(maxminddb.SkipAliasedNetworks)
+	})
+}
+
+// NetworksWithin is Networks for the networks within prefix, e.g. to audit
+// the locations of an organization's own allocations.  A prefix within a
+// single network of the database yields that network.  IPv4-mapped IPv6
+// prefixes, such as ::ffff:192.0.2.0/120, are taken as the IPv4 prefix they
+// map.
+func (r *Reader) NetworksWithin(prefix netip.Prefix) iter.Seq2[Response, error] {
+	addr, bits := prefix.Addr(), prefix.Bits()
+	if addr.Is4In6() && bits >= 96 {
+		addr, bits = addr.Unmap(), bits-96
+	}
+	if !prefix.IsValid() {
+		return func(yield func(Response, error) bool) {
+			yield(Response{}, Error{
+				Code: CodeIPAddressInvalid,
+				Err:  fmt.Sprintf("The value %q is not a valid network.", prefix),
+			})
+		}
+	}
+
+	network := &net.IPNet{
+		IP:   net.IP(addr.AsSlice()),
+		Mask: net.CIDRMask(bits, addr.BitLen()),
+	}
+	return r.networks(func(db *maxminddb.Reader) *maxminddb.Networks {
+		return db.NetworksWithin(network, maxminddb.SkipAliasedNetworks)
+	})
+}
+
+func (r *Reader) networks(open func(db *maxminddb.Reader) *maxminddb.Networks) iter.Seq2[Response, error] {
+	return func(yield func(Response, error) bool) {
+		r.mutex.RLock()
+		defer r.mutex.RUnlock()
+
+		networks := open(r.db)
+		for networks.Next() {
+			response := Response{}
+			network, err := networks.Network(&response)
+			if err != nil {
+				yield(Response{}, err)
+				return
+			}
+			response.Traits.Network = prefixOf(network)
+			if !yield(response, nil) {
+				return
+			}
+		}
+		if err := networks.Err(); err != nil {
+			yield(Response{}, err)
+		}
+	}
+}
+
+// prefixOf converts network to a netip.Prefix, IPv4 networks as IPv4
+func prefixOf(network *net.IPNet) netip.Prefix {
+	addr, _ := netip.AddrFromSlice(network.IP)
+	bits, _ := network.Mask.Size()
+	if addr.Is4In6() && bits >= 96 {
+		addr, bits = addr.Unmap(), bits-96
+	}
+	return netip.PrefixFrom(addr, bits)
+}
@@ -0,0 +1,178 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// AnonymousIP is a record of the GeoIP2 Anonymous IP database
+type AnonymousIP struct {
+	IsAnonymous        bool         `json:"is_anonymous,omitempty" maxminddb:"is_anonymous"`
+	IsAnonymousVpn     bool         `json:"is_anonymous_vpn,omitempty" maxminddb:"is_anonymous_vpn"`
+	IsHostingProvider  bool         `json:"is_hosting_provider,omitempty" maxminddb:"is_hosting_provider"`
+	IsPublicProxy      bool         `json:"is_public_proxy,omitempty" maxminddb:"is_public_proxy"`
+	IsResidentialProxy bool         `json:"is_residential_proxy,omitempty" maxminddb:"is_residential_proxy"`
+	IsTorExitNode      bool         `json:"is_tor_exit_node,omitempty" maxminddb:"is_tor_exit_node"`
+	IpAddress          netip.Addr   `json:"ip_address,omitzero"`
+	Network            netip.Prefix `json:"network,omitzero"`
+}
+
+// Traits returns the flags as the Insights web service reports them, so
+// that Traits.IsAnonymized and Assess treat both sources alike
+func (a AnonymousIP) Traits() Traits {
+	return Traits{
+		IsAnonymous:        a.IsAnonymous,
+		IsAnonymousVpn:     a.IsAnonymousVpn,
+		IsHostingProvider:  a.IsHostingProvider,
+		IsPublicProxy:      a.IsPublicProxy,
+		IsResidentialProxy: a.IsResidentialProxy,
+		IsTorExitNode:      a.IsTorExitNode,
+		IpAddress:          a.IpAddress,
+		Network:            a.Network,
+	}
+}
+
+// AnonymousIP looks up ipAddress in an Anonymous IP database.  An address
+// the database doesn't list is IP_ADDRESS_NOT_FOUND, and is not known to
+// be anonymized.
+func (r *Reader) AnonymousIP(ipAddress string) (AnonymousIP, error) {
+	record := AnonymousIP{}
+	addr, network, err := r.record(ipAddress, &record)
+	if err != nil {
+		return AnonymousIP{}, err
+	}
+	record.IpAddress, record.Network = addr, network
+	return record, nil
+}
+
+// Merge sets the flags on the traits of response, e.g. one looked up in a
+// City database
+func (a AnonymousIP) Merge(response *Response) {
+	t := &response.Traits
+	t.IsAnonymous = t.IsAnonymous || a.IsAnonymous
+	t.IsAnonymousVpn = t.IsAnonymousVpn || a.IsAnonymousVpn
+	t.IsHostingProvider = t.IsHostingProvider || a.IsHostingProvider
+	t.IsPublicProxy = t.IsPublicProxy || a.IsPublicProxy
+	t.IsResidentialProxy = t.IsResidentialProxy || a.IsResidentialProxy
+	t.IsTorExitNode = t.IsTorExitNode || a.IsTorExitNode
+}
+
+// ISP is a record of the GeoIP2 ISP or GeoLite2 ASN database; the latter
+// has the autonomous system alone
+type ISP struct {
+	AutonomousSystemNumber       int          `json:"autonomous_system_number,omitempty" maxminddb:"autonomous_system_number"`
+	AutonomousSystemOrganization string       `json:"autonomous_system_organization,omitempty" maxminddb:"autonomous_system_organization"`
+	Isp                          string       `json:"isp,omitempty" maxminddb:"isp"`
+	MobileCountryCode            string       `json:"mobile_country_code,omitempty" maxminddb:"mobile_country_code"`
+	MobileNetworkCode            string       `json:"mobile_network_code,omitempty" maxminddb:"mobile_network_code"`
+	Organization                 string       `json:"organization,omitempty" maxminddb:"organization"`
+	IpAddress                    netip.Addr   `json:"ip_address,omitzero"`
+	Network                      netip.Prefix `json:"network,omitzero"`
+}
+
+// ISP looks up ipAddress in an ISP or ASN database
+func (r *Reader) ISP(ipAddress string) (ISP, error) {
+	record := ISP{}
+	addr, network, err := r.record(ipAddress, &record)
+	if err != nil {
+		return ISP{}, err
+	}
+	record.IpAddress, record.Network = addr, network
+	return record, nil
+}
+
+// Merge sets the fields present in the record on the traits of response
+func (i ISP) Merge(response *Response) {
+	t := &response.Traits
+	if i.AutonomousSystemNumber != 0 {
+		t.AutonomousSystemNumber = i.AutonomousSystemNumber
+	}
+	mergeString(&t.AutonomousSystemOrganization, i.AutonomousSystemOrganization)
+	mergeString(&t.Isp, i.Isp)
+	mergeString(&t.MobileCountryCode, i.MobileCountryCode)
+	mergeString(&t.MobileNetworkCode, i.MobileNetworkCode)
+	mergeString(&t.Organization, i.Organization)
+}
+
+// Domain is a record of the GeoIP2 Domain database
+type Domain struct {
+	Domain    string       `json:"domain,omitempty" maxminddb:"domain"`
+	IpAddress netip.Addr   `json:"ip_address,omitzero"`
+	Network   netip.Prefix `json:"network,omitzero"`
+}
+
+// Domain looks up ipAddress in a Domain database
+func (r *Reader) Domain(ipAddress string) (Domain, error) {
+	record := Domain{}
+	addr, network, err := r.record(ipAddress, &record)
+	if err != nil {
+		return Domain{}, err
+	}
+	record.IpAddress, record.Network = addr, network
+	return record, nil
+}
+
+// Merge sets the domain on the traits of response
+func (d Domain) Merge(response *Response) {
+	mergeString(&response.Traits.Domain, d.Domain)
+}
+
+// ConnectionType is a record of the GeoIP2 Connection Type database, one
+// of Dialup, Cable/DSL, Corporate, Cellular or Satellite
+type ConnectionType struct {
+	ConnectionType string       `json:"connection_type,omitempty" maxminddb:"connection_type"`
+	IpAddress      netip.Addr   `json:"ip_address,omitzero"`
+	Network        netip.Prefix `json:"network,omitzero"`
+}
+
+// ConnectionType looks up ipAddress in a Connection Type database
+func (r *Reader) ConnectionType(ipAddress string) (ConnectionType, error) {
+	record := ConnectionType{}
+	addr, network, err := r.record(ipAddress, &record)
+	if err != nil {
+		return ConnectionType{}, err
+	}
+	record.IpAddress, record.Network = addr, network
+	return record, nil
+}
+
+// Merge sets the connection type on the traits of response
+func (c ConnectionType) Merge(response *Response) {
+	mergeString(&response.Traits.ConnectionType, c.ConnectionType)
+}
+
+func mergeString(dst *string, value string) {
+	if value != "" {
+		*dst = value
+	}
+}
+
+// traitsDatabases are the database types whose records hold only traits,
+// at the top level rather than under "traits"
+var traitsDatabases = []string{"-Anonymous-IP", "-ISP", "-ASN", "-Domain", "-Connection-Type"}
+
+func traitsDatabase(databaseType string) bool {
+	for _, suffix := range traitsDatabases {
+		if strings.HasSuffix(databaseType, suffix) {
+			return true
+		}
+	}
+	return false
+}
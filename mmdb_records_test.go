@@ -0,0 +1,151 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"testing"
+
+	"github.com/maxmind/mmdbwriter"
+	"github.com/maxmind/mmdbwriter/mmdbtype"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAnonymousIP(t *testing.T) {
+	Convey("Given an Anonymous IP database", t, func() {
+		path := writeDatabase(t, mmdbwriter.Options{DatabaseType: "GeoIP2-Anonymous-IP"}, map[string]mmdbtype.Map{
+			"1.2.3.0/24": {
+				"is_anonymous":     mmdbtype.Bool(true),
+				"is_anonymous_vpn": mmdbtype.Bool(true),
+			},
+			"5.6.7.8/32": {
+				"is_anonymous":        mmdbtype.Bool(true),
+				"is_tor_exit_node":    mmdbtype.Bool(true),
+				"is_hosting_provider": mmdbtype.Bool(true),
+			},
+		})
+		reader, err := NewFromFile(path)
+		So(err, ShouldBeNil)
+		defer reader.Close()
+
+		Convey("I expect the flags of a listed address", func() {
+			record, err := reader.AnonymousIP("5.6.7.8")
+			So(err, ShouldBeNil)
+			So(record.IsAnonymous, ShouldBeTrue)
+			So(record.IsTorExitNode, ShouldBeTrue)
+			So(record.IsHostingProvider, ShouldBeTrue)
+			So(record.IsAnonymousVpn, ShouldBeFalse)
+			So(record.Network.String(), ShouldEqual, "5.6.7.8/32")
+			So(record.Traits().IsAnonymized(), ShouldBeTrue)
+		})
+
+		Convey("I expect Insights to report them as traits, as the web service does", func() {
+			resp, err := reader.Insights(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.Traits.IsAnonymousVpn, ShouldBeTrue)
+			So(resp.Traits.IsAnonymized(), ShouldBeTrue)
+			So(resp.Traits.Network.String(), ShouldEqual, "1.2.3.0/24")
+
+			record, _ := reader.AnonymousIP("1.2.3.4")
+			So(record.Traits(), ShouldResemble, resp.Traits)
+		})
+
+		Convey("I expect an unlisted address to be not found", func() {
+			_, err := reader.AnonymousIP("9.9.9.9")
+			So(err.(Error).Code, ShouldEqual, CodeIPAddressNotFound)
+		})
+	})
+}
+
+func TestTraitsDatabases(t *testing.T) {
+	Convey("Given ISP, Domain and Connection Type databases", t, func() {
+		open := func(databaseType string, record mmdbtype.Map) *Reader {
+			path := writeDatabase(t, mmdbwriter.Options{DatabaseType: databaseType}, map[string]mmdbtype.Map{"1.2.3.0/24": record})
+			reader, err := NewFromFile(path)
+			So(err, ShouldBeNil)
+			Reset(func() { reader.Close() })
+			return reader
+		}
+		isp := open("GeoIP2-ISP", mmdbtype.Map{
+			"autonomous_system_number":       mmdbtype.Uint32(15169),
+			"autonomous_system_organization": mmdbtype.String("Google LLC"),
+			"isp":                            mmdbtype.String("Google"),
+			"organization":                   mmdbtype.String("Google Cloud"),
+			"mobile_country_code":            mmdbtype.String("310"),
+			"mobile_network_code":            mmdbtype.String("004"),
+		})
+		domain := open("GeoIP2-Domain", mmdbtype.Map{"domain": mmdbtype.String("example.com")})
+		connection := open("GeoIP2-Connection-Type", mmdbtype.Map{"connection_type": mmdbtype.String("Cable/DSL")})
+		city, err := NewFromFile(writeTestDatabase(t))
+		So(err, ShouldBeNil)
+		defer city.Close()
+
+		Convey("I expect each to return its typed record", func() {
+			i, err := isp.ISP("1.2.3.4")
+			So(err, ShouldBeNil)
+			So(i.AutonomousSystemNumber, ShouldEqual, 15169)
+			So(i.Isp, ShouldEqual, "Google")
+			So(i.MobileNetworkCode, ShouldEqual, "004")
+			So(i.Network.String(), ShouldEqual, "1.2.3.0/24")
+
+			d, err := domain.Domain("1.2.3.4")
+			So(err, ShouldBeNil)
+			So(d.Domain, ShouldEqual, "example.com")
+
+			c, err := connection.ConnectionType("1.2.3.4")
+			So(err, ShouldBeNil)
+			So(c.ConnectionType, ShouldEqual, "Cable/DSL")
+			So(c.IpAddress.String(), ShouldEqual, "1.2.3.4")
+		})
+
+		Convey("I expect Insights to return the fields as traits", func() {
+			resp, err := isp.Insights(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.Traits.Organization, ShouldEqual, "Google Cloud")
+			So(resp.Traits.IsMobile(), ShouldBeTrue)
+
+			resp, err = connection.Insights(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.Traits.ConnectionType, ShouldEqual, "Cable/DSL")
+		})
+
+		Convey("When I merge them into a City lookup", func() {
+			resp, err := city.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			i, _ := isp.ISP("1.2.3.4")
+			d, _ := domain.Domain("1.2.3.4")
+			c, _ := connection.ConnectionType("1.2.3.4")
+			i.Merge(&resp)
+			d.Merge(&resp)
+			c.Merge(&resp)
+
+			Convey("I expect one combined result", func() {
+				So(resp.City.Names["en"], ShouldEqual, "Mountain View")
+				So(resp.Traits.AutonomousSystemOrganization, ShouldEqual, "Google LLC")
+				So(resp.Traits.Domain, ShouldEqual, "example.com")
+				So(resp.Traits.ConnectionType, ShouldEqual, "Cable/DSL")
+				So(resp.Traits.Network.String(), ShouldEqual, "1.2.3.0/24")
+			})
+		})
+
+		Convey("I expect an empty record to leave the traits alone", func() {
+			resp := Response{Traits: Traits{Domain: "example.org"}}
+			Domain{}.Merge(&resp)
+			So(resp.Traits.Domain, ShouldEqual, "example.org")
+		})
+	})
+}
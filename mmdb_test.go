@@ -0,0 +1,388 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/maxmind/mmdbwriter"
+	"github.com/maxmind/mmdbwriter/mmdbtype"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// writeTestDatabase builds a small City database covering 1.2.3.0/24
+func writeTestDatabase(t *testing.T) string {
+	return writeCityDatabase(t, "Mountain View")
+}
+
+// writeCityDatabase builds a City database placing 1.2.3.0/24 in city
+func writeCityDatabase(t *testing.T, city string) string {
+	return writeDatabase(t, mmdbwriter.Options{DatabaseType: "GeoIP2-City"}, map[string]mmdbtype.Map{"1.2.3.0/24": cityRecord(city)})
+}
+
+// writeDatabase builds a database holding records by network
+func writeDatabase(t *testing.T, opts mmdbwriter.Options, records map[string]mmdbtype.Map) string {
+	if opts.Description == nil {
+		opts.Description = map[string]string{"en": "geoip2 test database"}
+	}
+	if opts.RecordSize == 0 {
+		opts.RecordSize = 24
+	}
+	writer, err := mmdbwriter.New(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for cidr, record := range records {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := writer.Insert(network, record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buffer := &bytes.Buffer{}
+	if _, err := writer.WriteTo(buffer); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "db.mmdb")
+	if err := os.WriteFile(path, buffer.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func cityRecord(city string) mmdbtype.Map {
+	return mmdbtype.Map{
+		"city":    mmdbtype.Map{"geoname_id": mmdbtype.Uint32(5375480), "names": mmdbtype.Map{"en": mmdbtype.String(city)}},
+		"country": mmdbtype.Map{"iso_code": mmdbtype.String("US"), "names": mmdbtype.Map{"en": mmdbtype.String("United States")}},
+		"location": mmdbtype.Map{
+			"accuracy_radius": mmdbtype.Uint16(20),
+			"latitude":        mmdbtype.Float64(37.386),
+			"longitude":       mmdbtype.Float64(-122.0838),
+			"time_zone":       mmdbtype.String("America/Los_Angeles"),
+		},
+		"subdivisions": mmdbtype.Slice{mmdbtype.Map{"iso_code": mmdbtype.String("CA")}},
+		"traits":       mmdbtype.Map{"is_anycast": mmdbtype.Bool(true)},
+	}
+}
+
+func TestReader(t *testing.T) {
+	Convey("Given a local City database", t, func() {
+		reader, err := NewFromFile(writeTestDatabase(t))
+		So(err, ShouldBeNil)
+		defer reader.Close()
+
+		Convey("When I look up an address in the database", func() {
+			resp, err := reader.City(nil, "1.2.3.4")
+
+			Convey("I expect the record and network to be decoded", func() {
+				So(err, ShouldBeNil)
+				So(resp.City.Names["en"], ShouldEqual, "Mountain View")
+				So(resp.Country.IsoCode, ShouldEqual, "US")
+				So(resp.Location.Latitude, ShouldEqual, 37.386)
+				So(resp.Location.AccuracyRadius, ShouldEqual, 20)
+				So(resp.Subdivisions[0].IsoCode, ShouldEqual, "CA")
+				So(resp.Traits.IsAnycast, ShouldBeTrue)
+				So(resp.Traits.IpAddress.String(), ShouldEqual, "1.2.3.4")
+				So(resp.Traits.Network.String(), ShouldEqual, "1.2.3.0/24")
+			})
+		})
+
+		Convey("When I look up the country", func() {
+			resp, err := reader.Country(nil, "1.2.3.4")
+
+			Convey("I expect only the country-level fields", func() {
+				So(err, ShouldBeNil)
+				So(resp.Country.IsoCode, ShouldEqual, "US")
+				So(resp.City.Names, ShouldBeNil)
+				So(resp.Subdivisions, ShouldBeNil)
+			})
+		})
+
+		Convey("I expect the web service errors for unknown and invalid addresses", func() {
+			var lookuper Lookuper = reader
+			_, err := lookuper.City(nil, "8.8.8.8")
+			So(err.(Error).Code, ShouldEqual, "IP_ADDRESS_NOT_FOUND")
+
+			_, err = lookuper.City(nil, "not-an-ip")
+			So(err.(Error).Code, ShouldEqual, "IP_ADDRESS_INVALID")
+		})
+	})
+}
+
+func TestReaderMetadata(t *testing.T) {
+	Convey("Given a database built ten days ago", t, func() {
+		built := time.Now().Add(-10 * 24 * time.Hour).Truncate(time.Second)
+		path := writeDatabase(t, mmdbwriter.Options{DatabaseType: "GeoIP2-City", BuildEpoch: built.Unix(), Languages: []string{"en", "de"}}, map[string]mmdbtype.Map{"1.2.3.0/24": cityRecord("Mountain View")})
+
+		var warnings []error
+		reader, err := NewFromFile(path, WithStaleWarning(7*24*time.Hour, func(err error) { warnings = append(warnings, err) }))
+		So(err, ShouldBeNil)
+		defer reader.Close()
+
+		Convey("I expect its metadata", func() {
+			metadata := reader.Metadata()
+			So(metadata.DatabaseType, ShouldEqual, "GeoIP2-City")
+			So(metadata.Languages, ShouldResemble, []string{"en", "de"})
+			So(metadata.IPVersion, ShouldEqual, 6)
+			So(metadata.RecordSize, ShouldEqual, 24)
+			So(metadata.NodeCount, ShouldBeGreaterThan, 0)
+			So(metadata.BuildTime.Equal(built), ShouldBeTrue)
+			So(reader.BuildTime().Equal(built), ShouldBeTrue)
+		})
+
+		Convey("I expect it to be reported stale against a week, but not a month", func() {
+			So(errors.Is(reader.CheckAge(7*24*time.Hour), ErrDatabaseStale), ShouldBeTrue)
+			So(reader.CheckAge(30*24*time.Hour), ShouldBeNil)
+		})
+
+		Convey("I expect a warning on opening, and on reloading only while it stays stale", func() {
+			So(len(warnings), ShouldEqual, 1)
+			So(errors.Is(warnings[0], ErrDatabaseStale), ShouldBeTrue)
+
+			So(reader.Reload(path), ShouldBeNil)
+			So(len(warnings), ShouldEqual, 2)
+
+			So(reader.Reload(writeTestDatabase(t)), ShouldBeNil)
+			So(len(warnings), ShouldEqual, 2)
+		})
+	})
+}
+
+func TestReaderNetworks(t *testing.T) {
+	Convey("Given a database of several networks", t, func() {
+		path := writeDatabase(t, mmdbwriter.Options{DatabaseType: "GeoIP2-City"}, map[string]mmdbtype.Map{
+			"1.2.3.0/24":     cityRecord("Mountain View"),
+			"5.6.0.0/16":     cityRecord("Berlin"),
+			"2400:4000::/22": cityRecord("Tokyo"),
+		})
+		reader, err := NewFromFile(path)
+		So(err, ShouldBeNil)
+		defer reader.Close()
+
+		Convey("I expect every network once, with its record", func() {
+			var networks, cities []string
+			for resp, err := range reader.Networks() {
+				So(err, ShouldBeNil)
+				networks = append(networks, resp.Traits.Network.String())
+				cities = append(cities, resp.City.Names["en"])
+			}
+			So(networks, ShouldResemble, []string{"1.2.3.0/24", "5.6.0.0/16", "2400:4000::/22"})
+			So(cities, ShouldResemble, []string{"Mountain View", "Berlin", "Tokyo"})
+		})
+
+		within := func(prefix string) []string {
+			var networks []string
+			for resp, err := range reader.NetworksWithin(netip.MustParsePrefix(prefix)) {
+				So(err, ShouldBeNil)
+				networks = append(networks, resp.Traits.Network.String())
+			}
+			return networks
+		}
+
+		Convey("I expect the networks within a prefix", func() {
+			So(within("5.0.0.0/8"), ShouldResemble, []string{"5.6.0.0/16"})
+			So(within("2400::/12"), ShouldResemble, []string{"2400:4000::/22"})
+			So(within("9.0.0.0/8"), ShouldBeEmpty)
+		})
+
+		Convey("I expect the network holding a narrower prefix", func() {
+			So(within("1.2.3.128/25"), ShouldResemble, []string{"1.2.3.0/24"})
+		})
+
+		Convey("I expect IPv4-mapped prefixes taken as IPv4", func() {
+			So(within("::ffff:1.2.3.0/120"), ShouldResemble, []string{"1.2.3.0/24"})
+			So(within("::ffff:1.2.0.0/112"), ShouldResemble, []string{"1.2.3.0/24"})
+			So(within("::ffff:0.0.0.0/96"), ShouldResemble, []string{"1.2.3.0/24", "5.6.0.0/16"})
+		})
+
+		Convey("I expect an error for an invalid prefix", func() {
+			for _, err := range reader.NetworksWithin(netip.Prefix{}) {
+				So(err.(Error).Code, ShouldEqual, CodeIPAddressInvalid)
+			}
+		})
+
+		Convey("I expect to be able to stop early", func() {
+			count := 0
+			for range reader.Networks() {
+				count++
+				break
+			}
+			So(count, ShouldEqual, 1)
+			So(reader.Reload(path), ShouldBeNil)
+		})
+	})
+}
+
+func TestReaderLookup(t *testing.T) {
+	Convey("Given a custom database", t, func() {
+		path := writeDatabase(t, mmdbwriter.Options{DatabaseType: "Acme-Sites"}, map[string]mmdbtype.Map{
+			"1.2.3.0/24": {
+				"site":    mmdbtype.String("hq"),
+				"floors":  mmdbtype.Uint16(12),
+				"tags":    mmdbtype.Slice{mmdbtype.String("office"), mmdbtype.String("vpn")},
+				"country": mmdbtype.Map{"iso_code": mmdbtype.String("US")},
+				"owner":   mmdbtype.Map{"team": mmdbtype.String("netops")},
+			},
+		})
+		reader, err := NewFromFile(path)
+		So(err, ShouldBeNil)
+		defer reader.Close()
+
+		type site struct {
+			Site    string   `json:"site"`
+			Floors  int      `json:"floors"`
+			Tags    []string `json:"tags"`
+			Country Country  `json:"country"`
+			Owner   string   `json:"owner"`
+		}
+
+		Convey("I expect the record decoded into my own struct, the misfit field reported", func() {
+			var v site
+			err := reader.Lookup("1.2.3.4", &v)
+			So(v.Site, ShouldEqual, "hq")
+			So(v.Floors, ShouldEqual, 12)
+			So(v.Tags, ShouldResemble, []string{"office", "vpn"})
+			So(v.Country.IsoCode, ShouldEqual, "US")
+
+			decodeErr, ok := err.(DecodeError)
+			So(ok, ShouldBeTrue)
+			So(len(decodeErr.Fields), ShouldEqual, 1)
+			So(decodeErr.Fields[0].Field, ShouldEqual, "owner")
+		})
+
+		Convey("I expect the web service errors for unknown and invalid addresses", func() {
+			var v site
+			So(reader.Lookup("8.8.8.8", &v).(Error).Code, ShouldEqual, CodeIPAddressNotFound)
+			So(reader.Lookup("bad", &v).(Error).Code, ShouldEqual, CodeIPAddressInvalid)
+		})
+	})
+}
+
+func TestReaderLoadMode(t *testing.T) {
+	Convey("Given a database loaded onto the heap", t, func() {
+		path := writeTestDatabase(t)
+		reader, err := NewFromFile(path, WithLoadMode(LoadHeap))
+		So(err, ShouldBeNil)
+		defer reader.Close()
+
+		Convey("I expect lookups to need nothing more from the file", func() {
+			So(os.Truncate(path, 0), ShouldBeNil)
+			resp, err := reader.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.City.Names["en"], ShouldEqual, "Mountain View")
+		})
+
+		Convey("I expect reloads onto the heap too", func() {
+			next := writeCityDatabase(t, "Sunnyvale")
+			So(reader.Reload(next), ShouldBeNil)
+			So(os.Truncate(next, 0), ShouldBeNil)
+			resp, err := reader.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.City.Names["en"], ShouldEqual, "Sunnyvale")
+		})
+
+		Convey("I expect a missing file to fail either way", func() {
+			_, err := NewFromFile(filepath.Join(t.TempDir(), "missing.mmdb"), WithLoadMode(LoadHeap))
+			So(err, ShouldNotBeNil)
+			_, err = NewFromFile(filepath.Join(t.TempDir(), "missing.mmdb"), WithLoadMode(LoadMmap))
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a database mapped into memory", t, func() {
+		reader, err := NewFromFile(writeTestDatabase(t), WithLoadMode(LoadMmap))
+		So(err, ShouldBeNil)
+		defer reader.Close()
+
+		Convey("I expect the same answers", func() {
+			resp, err := reader.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.City.Names["en"], ShouldEqual, "Mountain View")
+		})
+	})
+}
+
+func TestReaderWatchFile(t *testing.T) {
+	Convey("Given a Reader watching its file", t, func() {
+		path := writeTestDatabase(t)
+		reader, err := NewFromFile(path, WithLoadMode(LoadHeap))
+		So(err, ShouldBeNil)
+		defer reader.Close()
+
+		errs := make(chan error, 10)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			reader.WatchFile(ctx, path, 5*time.Millisecond, func(err error) { errs <- err })
+		}()
+		defer func() { cancel(); <-done }()
+
+		// replace the file as an updater would, with a distinct mtime
+		replace := func(data []byte, modified time.Time) {
+			tmp := path + ".tmp"
+			So(os.WriteFile(tmp, data, 0644), ShouldBeNil)
+			So(os.Chtimes(tmp, modified, modified), ShouldBeNil)
+			So(os.Rename(tmp, path), ShouldBeNil)
+		}
+		city := func() string {
+			resp, _ := reader.City(nil, "1.2.3.4")
+			return resp.City.Names["en"]
+		}
+		eventually := func(cond func() bool) bool {
+			for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+				if cond() {
+					return true
+				}
+			}
+			return false
+		}
+
+		Convey("When the file is replaced", func() {
+			data, err := os.ReadFile(writeCityDatabase(t, "Sunnyvale"))
+			So(err, ShouldBeNil)
+			replace(data, time.Now().Add(time.Hour))
+
+			Convey("I expect the new database to be loaded", func() {
+				So(eventually(func() bool { return city() == "Sunnyvale" }), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the file is replaced with a broken one", func() {
+			replace([]byte("not a database"), time.Now().Add(time.Hour))
+
+			Convey("I expect the error once, and the old database kept", func() {
+				So(eventually(func() bool { return len(errs) > 0 }), ShouldBeTrue)
+				time.Sleep(30 * time.Millisecond)
+				So(len(errs), ShouldEqual, 1)
+				So(city(), ShouldEqual, "Mountain View")
+			})
+		})
+	})
+}
@@ -0,0 +1,63 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"strconv"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMockResponse(t *testing.T) {
+	Convey("Given mock responses", t, func() {
+		Convey("I expect the same address to produce the same response", func() {
+			So(MockResponse("1.2.3.4"), ShouldResemble, MockResponse("1.2.3.4"))
+		})
+
+		Convey("I expect addresses in the same network to share a location", func() {
+			a, b := MockResponse("1.2.3.4"), MockResponse("1.2.3.200")
+			So(a.City, ShouldResemble, b.City)
+			So(a.Traits.Network, ShouldEqual, b.Traits.Network)
+			So(a.Traits.Network.String(), ShouldEqual, "1.2.3.0/24")
+			So(a.Traits.IpAddress.String(), ShouldEqual, "1.2.3.4")
+		})
+
+		Convey("I expect a realistic, internally consistent response", func() {
+			resp := MockResponse("2001:db8::1")
+			So(resp.Traits.Network.String(), ShouldEqual, "2001:db8::/48")
+			So(resp.Country.IsoCode, ShouldNotBeEmpty)
+			So(resp.RegisteredCountry.IsoCode, ShouldEqual, resp.Country.IsoCode)
+			So(resp.Location.hasCoordinates(), ShouldBeTrue)
+			So(resp.Traits.AutonomousSystemNumber, ShouldBeBetweenOrEqual, 64500, 64504)
+		})
+
+		Convey("I expect different networks to vary", func() {
+			countries := map[string]bool{}
+			for i := 0; i < 64; i++ {
+				countries[MockResponse(mockAddress(i)).Country.IsoCode] = true
+			}
+			So(len(countries), ShouldBeGreaterThan, 3)
+		})
+
+		Convey("I expect an invalid address to produce an empty response", func() {
+			So(MockResponse("nope"), ShouldResemble, Response{})
+		})
+	})
+}
+
+func mockAddress(i int) string {
+	return "10." + strconv.Itoa(i) + ".0.1"
+}
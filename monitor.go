@@ -0,0 +1,169 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ewmaWeight is the weight given to each new latency sample
+const ewmaWeight = 0.1
+
+// EndpointStats summarises the most recent requests to one endpoint.
+// Errors counts transport failures and 5xx responses.
+type EndpointStats struct {
+	Requests  int           `json:"requests"`
+	Errors    int           `json:"errors"`
+	ErrorRate float64       `json:"error_rate"`
+	P50       time.Duration `json:"p50"`
+	P90       time.Duration `json:"p90"`
+	P95       time.Duration `json:"p95"`
+	P99       time.Duration `json:"p99"`
+	EWMA      time.Duration `json:"ewma"`
+}
+
+// Monitor keeps rolling latency and error statistics per endpoint over the
+// last window requests, so a client can tell whether MaxMind is slow right now.
+type Monitor struct {
+	window int
+
+	mutex     sync.Mutex
+	endpoints map[string]*endpointWindow
+}
+
+type endpointWindow struct {
+	latencies []time.Duration
+	failed    []bool
+	next      int
+	ewma      float64
+}
+
+// NewMonitor returns a Monitor over the last window requests per endpoint
+func NewMonitor(window int) *Monitor {
+	if window <= 0 {
+		window = 1000
+	}
+	return &Monitor{
+		window:    window,
+		endpoints: map[string]*endpointWindow{},
+	}
+}
+
+// WithMonitor returns a copy of api that records every request in monitor
+func WithMonitor(api *Api, monitor *Monitor) *Api {
+	clone := WithClientFunc(api, monitor.wrap(api.doFunc))
+	clone.monitor = monitor
+	return clone
+}
+
+// Stats returns the rolling statistics of the api's Monitor, or nil when
+// the api isn't monitored
+func (a *Api) Stats() map[string]EndpointStats {
+	if a.monitor == nil {
+		return nil
+	}
+	return a.monitor.Stats()
+}
+
+func (m *Monitor) wrap(doFunc func(context.Context, *http.Request) (*http.Response, error)) func(context.Context, *http.Request) (*http.Response, error) {
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		started := time.Now()
+		resp, err := doFunc(ctx, req)
+		m.Record(endpoint(req), time.Since(started), err != nil || resp.StatusCode >= 500)
+		return resp, err
+	}
+}
+
+// endpoint extracts the service from paths like /geoip/v2.1/city/1.2.3.4
+func endpoint(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(segments) < 2 {
+		return RedactIPs(req.URL.Path)
+	}
+	return segments[len(segments)-2]
+}
+
+// Record adds a request to the statistics for name
+func (m *Monitor) Record(name string, latency time.Duration, failed bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	w, ok := m.endpoints[name]
+	if !ok {
+		w = &endpointWindow{ewma: float64(latency)}
+		m.endpoints[name] = w
+	}
+
+	if len(w.latencies) < m.window {
+		w.latencies = append(w.latencies, latency)
+		w.failed = append(w.failed, failed)
+	} else {
+		w.latencies[w.next] = latency
+		w.failed[w.next] = failed
+	}
+	w.next = (w.next + 1) % m.window
+	w.ewma += ewmaWeight * (float64(latency) - w.ewma)
+}
+
+// Stats returns the current statistics keyed by endpoint, e.g. "city"
+func (m *Monitor) Stats() map[string]EndpointStats {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	stats := map[string]EndpointStats{}
+	for name, w := range m.endpoints {
+		sorted := append([]time.Duration(nil), w.latencies...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+		s := EndpointStats{
+			Requests: len(sorted),
+			P50:      percentile(sorted, 0.50),
+			P90:      percentile(sorted, 0.90),
+			P95:      percentile(sorted, 0.95),
+			P99:      percentile(sorted, 0.99),
+			EWMA:     time.Duration(w.ewma),
+		}
+		for _, failed := range w.failed {
+			if failed {
+				s.Errors++
+			}
+		}
+		if s.Requests > 0 {
+			s.ErrorRate = float64(s.Errors) / float64(s.Requests)
+		}
+		stats[name] = s
+	}
+	return stats
+}
+
+// percentile uses the nearest-rank method on sorted samples
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
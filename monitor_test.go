@@ -0,0 +1,91 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMonitor(t *testing.T) {
+	Convey("Given a Monitor", t, func() {
+		monitor := NewMonitor(100)
+
+		Convey("When I record a spread of latencies", func() {
+			for i := 1; i <= 100; i++ {
+				monitor.Record("city", time.Duration(i)*time.Millisecond, i%10 == 0)
+			}
+			stats := monitor.Stats()["city"]
+
+			Convey("I expect rolling percentiles and error rates", func() {
+				So(stats.Requests, ShouldEqual, 100)
+				So(stats.P50, ShouldEqual, 50*time.Millisecond)
+				So(stats.P95, ShouldEqual, 95*time.Millisecond)
+				So(stats.P99, ShouldEqual, 99*time.Millisecond)
+				So(stats.Errors, ShouldEqual, 10)
+				So(stats.ErrorRate, ShouldAlmostEqual, 0.1)
+				So(stats.EWMA, ShouldBeBetween, 80*time.Millisecond, 100*time.Millisecond)
+			})
+
+			Convey("And then more than the window holds", func() {
+				for i := 0; i < 100; i++ {
+					monitor.Record("city", time.Second, false)
+				}
+				stats := monitor.Stats()["city"]
+
+				Convey("I expect old samples to roll off", func() {
+					So(stats.Requests, ShouldEqual, 100)
+					So(stats.P50, ShouldEqual, time.Second)
+					So(stats.Errors, ShouldEqual, 0)
+				})
+			})
+		})
+
+		Convey("When an Api is monitored", func() {
+			failing := false
+			api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(context.Context, *http.Request) (*http.Response, error) {
+				if failing {
+					return nil, errors.New("boom")
+				}
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(strings.NewReader(sample)),
+				}, nil
+			})
+			So(api.Stats(), ShouldBeNil)
+
+			api = WithMonitor(api, monitor)
+			api.City(nil, "1.2.3.4")
+			api.City(nil, "1.2.3.5")
+			failing = true
+			api.Insights(nil, "1.2.3.4")
+
+			Convey("I expect statistics per endpoint", func() {
+				stats := api.Stats()
+				So(stats["city"].Requests, ShouldEqual, 2)
+				So(stats["city"].Errors, ShouldEqual, 0)
+				So(stats["insights"].Requests, ShouldEqual, 1)
+				So(stats["insights"].Errors, ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,77 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"strings"
+	"sync"
+)
+
+//go:generate go run gen_names.go -dir /usr/share
+
+func init() {
+	countryNameFallback = CountryName
+}
+
+// CountryName returns the display name of the ISO 3166-1 alpha-2 country
+// code in the first of locales available, falling back to English.  It
+// covers sources that return only ISO codes without a names map.
+func CountryName(isoCode string, locales ...string) (string, bool) {
+	loadNames.Do(parseNames)
+	return bundledName(countryNames[strings.ToUpper(isoCode)], locales)
+}
+
+// SubdivisionName is CountryName for ISO 3166-2 subdivisions, e.g. ("US", "CA")
+func SubdivisionName(countryCode, isoCode string, locales ...string) (string, bool) {
+	loadNames.Do(parseNames)
+	return bundledName(subdivisionNames[strings.ToUpper(countryCode+"-"+isoCode)], locales)
+}
+
+func bundledName(names map[string]string, locales []string) (string, bool) {
+	if names == nil {
+		return "", false
+	}
+	return localizedName(names, locales...), true
+}
+
+var (
+	loadNames        sync.Once
+	countryNames     map[string]map[string]string
+	subdivisionNames map[string]map[string]string
+)
+
+func parseNames() {
+	countryNames = parseNamesData(countryNamesData)
+	subdivisionNames = parseNamesData(subdivisionNamesData)
+}
+
+// parseNamesData reads the "code\tlocale=name..." lines of names_data.go
+func parseNamesData(data string) map[string]map[string]string {
+	names := map[string]map[string]string{}
+	for _, line := range strings.Split(strings.TrimSuffix(data, "\n"), "\n") {
+		fields := strings.Split(line, "\t")
+		localized := make(map[string]string, len(fields)-1)
+		for _, field := range fields[1:] {
+			if i := strings.IndexByte(field, '='); i > 0 {
+				localized[field[:i]] = field[i+1:]
+			}
+		}
+		names[fields[0]] = localized
+	}
+	return names
+}
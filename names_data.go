@@ -0,0 +1,5388 @@
+// Code generated by gen_names.go; DO NOT EDIT.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+const countryNamesData = "" +
+	"AD\ten=Andorra\tfr=Andorre\tja=アンドラ\tru=Андорра\tzh-CN=安道尔\n" +
+	"AE\tde=Vereinigte Arabische Emirate\ten=United Arab Emirates\tes=Emiratos Árabes Unidos\tfr=Émirats arabes unis\tja=アラブ首長国連邦\tpt-BR=Emirados Árabes Unidos\tru=Объединённые Арабские Эмираты\tzh-CN=阿联酋\n" +
+	"AF\ten=Afghanistan\tes=Afganistán\tja=アフガニスタン\tpt-BR=Afeganistão\tru=Афганистан\tzh-CN=阿富汗\n" +
+	"AG\tde=Antigua und Barbuda\ten=Antigua and Barbuda\tes=Antigua y Barbuda\tfr=Antigua-et-Barbuda\tja=アンティグア・バーブーダ\tpt-BR=Antígua e Barbuda\tru=Антигуа и Барбуда\tzh-CN=安提瓜和巴布达\n" +
+	"AI\ten=Anguilla\tes=Anguila\tja=アングイラ\tpt-BR=Anguila\tru=Ангвилла\tzh-CN=安圭拉\n" +
+	"AL\tde=Albanien\ten=Albania\tfr=Albanie\tja=アルバニア\tpt-BR=Albânia\tru=Албания\tzh-CN=阿尔巴尼亚\n" +
+	"AM\tde=Armenien\ten=Armenia\tfr=Arménie\tja=アルメニア\tpt-BR=Armênia\tru=Армения\tzh-CN=亚美尼亚\n" +
+	"AO\ten=Angola\tja=アンゴラ\tru=Ангола\tzh-CN=安哥拉\n" +
+	"AQ\tde=Antarktis\ten=Antarctica\tes=Antártida\tfr=Antarctique\tja=南極大陸\tpt-BR=Antártida\tru=Антарктика\tzh-CN=南极洲\n" +
+	"AR\tde=Argentinien\ten=Argentina\tfr=Argentine\tja=アルゼンチン\tru=Аргентина\tzh-CN=阿根廷\n" +
+	"AS\tde=Amerikanisch-Samoa\ten=American Samoa\tes=Samoa Estadounidense\tfr=Samoa américaines\tja=米領サモア\tpt-BR=Samoa Americana\tru=Американские Самоа\tzh-CN=美属萨摩亚\n" +
+	"AT\tde=Österreich\ten=Austria\tfr=Autriche\tja=オーストリア\tpt-BR=Áustria\tru=Австрия\tzh-CN=奥地利\n" +
+	"AU\tde=Australien\ten=Australia\tfr=Australie\tja=オーストラリア連邦\tpt-BR=Austrália\tru=Австралия\tzh-CN=澳大利亚\n" +
+	"AW\ten=Aruba\tja=アルーバ\tru=Аруба\tzh-CN=阿鲁巴\n" +
+	"AX\tde=Åland-Inseln\ten=Åland Islands\tes=Islas Äland\tfr=Åland, Îles\tja=オーランド諸島\tpt-BR=Ilhas Åland\tru=Аландские острова\tzh-CN=奥兰群岛\n" +
+	"AZ\tde=Aserbaidschan\ten=Azerbaijan\tes=Azerbaiyán\tfr=Azerbaïdjan\tja=アゼルバイジャン\tpt-BR=Azerbaidjão\tru=Азербайджан\tzh-CN=阿塞拜疆\n" +
+	"BA\tde=Bosnien und Herzegowina\ten=Bosnia and Herzegovina\tes=Bosnia y Herzegovina\tfr=Bosnie-Herzégovine\tja=ボスニア・ヘルツェゴビナ\tpt-BR=Bósnia-Herzegóvina\tru=Босния и Герцеговина\tzh-CN=波斯尼亚和黑塞哥维那\n" +
+	"BB\ten=Barbados\tfr=Barbade\tja=バルバドス\tru=Барбадос\tzh-CN=巴巴多斯\n" +
+	"BD\tde=Bangladesch\ten=Bangladesh\tes=Bangladés\tja=バングラデシュ\tru=Бангладеш\tzh-CN=孟加拉\n" +
+	"BE\tde=Belgien\ten=Belgium\tes=Bélgica\tfr=Belgique\tja=ベルギー\tpt-BR=Bélgica\tru=Бельгия\tzh-CN=比利时\n" +
+	"BF\ten=Burkina Faso\tes=Burquina Faso\tja=ブルキナファソ\tpt-BR=Burquina\tru=Буркина-Фасо\tzh-CN=布基纳法索\n" +
+	"BG\tde=Bulgarien\ten=Bulgaria\tfr=Bulgarie\tja=ブルガリア\tpt-BR=Bulgária\tru=Болгария\tzh-CN=保加利亚\n" +
+	"BH\ten=Bahrain\tes=Baréin\tfr=Bahreïn\tja=バーレーン\tpt-BR=Barein\tru=Бахрейн\tzh-CN=巴林\n" +
+	"BI\ten=Burundi\tja=ブルンジ\tru=Бурунди\tzh-CN=布隆迪\n" +
+	"BJ\ten=Benin\tes=Benín\tfr=Bénin\tja=ベナン\tru=Бенин\tzh-CN=贝宁\n" +
+	"BL\tde=Saint-Barthélemy\ten=Saint Barthélemy\tes=San Bartolomé\tfr=Saint-Barthélemy\tja=サンバルテルミ\tpt-BR=São Bartolomeu\tru=Сен-Бартельми\tzh-CN=圣巴泰勒米岛\n" +
+	"BM\ten=Bermuda\tes=Islas Bermudas\tfr=Bermudes\tja=バーミューダ\tru=Бермуды\tzh-CN=百慕大\n" +
+	"BN\ten=Brunei Darussalam\tfr=Brunéi Darussalam\tja=ブルネイ・ダルサラーム国\tpt-BR=Brunei\tru=Бруней Даруссалам\tzh-CN=文莱\n" +
+	"BO\tde=Bolivien\ten=Bolivia\tfr=Bolivie\tja=ボリビア\tpt-BR=Bolívia\tru=Боливия\tzh-CN=波利维亚\n" +
+	"BQ\tde=Bonaire, Sint Eustatius und Saba\ten=Bonaire, Sint Eustatius and Saba\tes=Islas BES (Caribe Neerlandés)\tfr=Bonaire, Saint-Eustache et Saba\tja=ボネール、シントユースタティウス及びサバ\tpt-BR=Bonaire, Saba e Santo Eustáquio\tru=Бонайре, Синт-Эстатиус и Саба\tzh-CN=博奈尔、圣尤斯特歇斯岛和萨巴\n" +
+	"BR\tde=Brasilien\ten=Brazil\tes=Brasil\tfr=Brésil\tja=ブラジル\tpt-BR=Brasil\tru=Бразилия\tzh-CN=巴西\n" +
+	"BS\ten=Bahamas\tja=バハマ\tru=Багамы\tzh-CN=巴哈马\n" +
+	"BT\ten=Bhutan\tes=Bután\tfr=Bhoutan\tja=ブータン\tpt-BR=Butão\tru=Бутан\tzh-CN=不丹\n" +
+	"BV\tde=Bouvet-Insel\ten=Bouvet Island\tes=Isla Bouvet\tfr=île Bouvet\tja=ブーベ島\tpt-BR=Ilha Bouvet\tru=Остров Буве\tzh-CN=布维群岛\n" +
+	"BW\tde=Botsuana\ten=Botswana\tes=Botsuana\tja=ボツワナ\tpt-BR=Botsuana\tru=Ботсвана\tzh-CN=博兹瓦那\n" +
+	"BY\ten=Belarus\tes=Bielorrusia\tfr=Bélarus\tja=ベラルーシ\tpt-BR=Bielo-Rússia\tru=Беларусь\tzh-CN=白俄罗斯\n" +
+	"BZ\ten=Belize\tes=Belice\tja=ベリーズ\tru=Белиз\tzh-CN=伯利兹\n" +
+	"CA\tde=Kanada\ten=Canada\tes=Canadá\tja=カナダ\tpt-BR=Canadá\tru=Канада\tzh-CN=加拿大\n" +
+	"CC\tde=Kokos-(Keeling-)Inseln\ten=Cocos (Keeling) Islands\tes=Islas Cocos (Keeling)\tfr=Cocos (Keeling), Îles\tja=ココス (キーリング) 諸島\tpt-BR=Ilhas Cocos\tru=Кокосовые острова\tzh-CN=科科斯群岛\n" +
+	"CD\tde=Demokratische Republik Kongo\ten=Congo, The Democratic Republic of the\tes=Congo, República Democrática del\tfr=République démocratique du Congo\tja=コンゴ民主共和国\tpt-BR=Congo, República Democrática do\tru=Демократическая Республика Конго\tzh-CN=刚果民主共和国\n" +
+	"CF\tde=Zentralafrikanische Republik\ten=Central African Republic\tes=República Centroafricana\tfr=République centrafricaine\tja=中央アフリカ共和国\tpt-BR=República Centro-Africana\tru=Центрально-африканская республика\tzh-CN=中非\n" +
+	"CG\tde=Kongo\ten=Congo\tfr=République du Congo\tja=コンゴ\tru=Конго\tzh-CN=刚果\n" +
+	"CH\tde=Schweiz\ten=Switzerland\tes=Suiza\tfr=Suisse\tja=スイス\tpt-BR=Suíça\tru=Швейцария\tzh-CN=瑞士\n" +
+	"CI\ten=Côte d'Ivoire\tes=Costa de Marfíl\tja=コートジボワール\tpt-BR=Costa do Marfim\tru=Кот-д'Ивуар\tzh-CN=科特迪瓦\n" +
+	"CK\tde=Cookinseln\ten=Cook Islands\tes=Islas Cook\tfr=îles Cook\tja=クック諸島\tpt-BR=Ilhas Cook\tru=Острова Кука\tzh-CN=库克群岛\n" +
+	"CL\ten=Chile\tfr=Chili\tja=チリ\tru=Чили\tzh-CN=智利\n" +
+	"CM\tde=Kamerun\ten=Cameroon\tes=Camerún\tfr=Cameroun\tja=カメルーン\tpt-BR=Camarões\tru=Камерун\tzh-CN=喀麦隆\n" +
+	"CN\ten=China\tfr=Chine\tja=中国\tru=Китай\tzh-CN=中国\n" +
+	"CO\tde=Kolumbien\ten=Colombia\tfr=Colombie\tja=コロンビア\tpt-BR=Colômbia\tru=Колумбия\tzh-CN=哥伦比亚\n" +
+	"CR\ten=Costa Rica\tja=コスタリカ\tru=Коста-Рика\tzh-CN=哥斯达黎加\n" +
+	"CU\tde=Kuba\ten=Cuba\tja=キューバ\tru=Куба\tzh-CN=古巴\n" +
+	"CV\tde=Kap Verde\ten=Cabo Verde\tfr=Cap-Vert\tja=カーボヴェルデ\tru=Кабо-Верде\tzh-CN=佛得角\n" +
+	"CW\ten=Curaçao\tes=Curazao\tja=キュラソー\tru=Кюрасао\tzh-CN=库拉索\n" +
+	"CX\tde=Weihnachtsinseln\ten=Christmas Island\tes=Isla de Navidad\tfr=Christmas, Île\tja=クリスマス島\tpt-BR=Ilha Christmas\tru=Остров Рождества\tzh-CN=圣诞岛\n" +
+	"CY\tde=Zypern\ten=Cyprus\tes=Chipre\tfr=Chypre\tja=キプロス\tpt-BR=Chipre\tru=Кипр\tzh-CN=塞浦路斯\n" +
+	"CZ\tde=Tschechien\ten=Czechia\tes=Chequia\tfr=Tchéquie\tpt-BR=Chéquia\tru=Чехия\tzh-CN=捷克\n" +
+	"DE\tde=Deutschland\ten=Germany\tes=Alemania\tfr=Allemagne\tja=ドイツ\tpt-BR=Alemanha\tru=Германия\tzh-CN=德国\n" +
+	"DJ\tde=Dschibuti\ten=Djibouti\tes=Yibuti\tja=ジブチ\tpt-BR=Djibuti\tru=Джибути\tzh-CN=吉布提\n" +
+	"DK\tde=Dänemark\ten=Denmark\tes=Dinamarca\tfr=Danemark\tja=デンマーク\tpt-BR=Dinamarca\tru=Дания\tzh-CN=丹麦\n" +
+	"DM\ten=Dominica\tfr=Dominique\tja=ドミニカ\tpt-BR=Domínica\tru=Доминика\tzh-CN=多米尼克\n" +
+	"DO\tde=Dominikanische Republik\ten=Dominican Republic\tes=República Dominicana\tfr=République dominicaine\tja=ドミニカ共和国\tpt-BR=República Dominicana\tru=Доминиканская республика\tzh-CN=多米尼加共和国\n" +
+	"DZ\tde=Algerien\ten=Algeria\tfr=Algérie\tja=アルジェリア\tpt-BR=Argélia\tru=Алжир\tzh-CN=阿尔及利亚\n" +
+	"EC\ten=Ecuador\tfr=Équateur\tja=エクアドル\tpt-BR=Equador\tru=Эквадор\tzh-CN=厄瓜多尔\n" +
+	"EE\tde=Estland\ten=Estonia\tfr=Estonie\tja=エストニア\tpt-BR=Estônia\tru=Эстония\tzh-CN=爱沙尼亚\n" +
+	"EG\tde=Ägypten\ten=Egypt\tes=Egipto\tfr=Égypte\tja=エジプト\tpt-BR=Egito\tru=Египет\tzh-CN=埃及\n" +
+	"EH\tde=Westsahara\ten=Western Sahara\tes=Sahara Occidental\tfr=Sahara occidental\tja=西サハラ\tpt-BR=Saara Ocidental\tru=Западная Сахара\tzh-CN=西撒哈拉\n" +
+	"ER\ten=Eritrea\tfr=Érythrée\tja=エリトリア国\tpt-BR=Eritréia\tru=Эритрея\tzh-CN=厄立特里亚\n" +
+	"ES\tde=Spanien\ten=Spain\tes=España\tfr=Espagne\tja=スペイン\tpt-BR=Espanha\tru=Испания\tzh-CN=西班牙\n" +
+	"ET\tde=Äthiopien\ten=Ethiopia\tes=Etiopía\tfr=Éthiopie\tja=エチオピア\tpt-BR=Etiópia\tru=Эфиопия\tzh-CN=埃塞俄比亚\n" +
+	"FI\tde=Finnland\ten=Finland\tes=Finlandia\tfr=Finlande\tja=フィンランド\tpt-BR=Finlândia\tru=Финляндия\tzh-CN=芬兰\n" +
+	"FJ\tde=Fidschi\ten=Fiji\tes=Fiyi\tfr=Fidji\tja=フィジー\tru=Фиджи\tzh-CN=斐济\n" +
+	"FK\tde=Falklandinseln (Malwinen)\ten=Falkland Islands (Malvinas)\tes=Islas Falkland (Malvinas)\tfr=Malouines, Îles (Falkland)\tja=フォークランド諸島 (マルビナス)\tpt-BR=Ilhas Malvinas (Falkland)\tru=Фолклендские (Мальвинские) острова\tzh-CN=福克兰群岛(马尔维纳斯)\n" +
+	"FM\tde=Mikronesien, Föderierte Staaten von\ten=Micronesia, Federated States of\tes=Micronesia, Estados Federados de\tfr=Micronésie, États fédérés de\tja=ミクロネシア連邦\tpt-BR=Micronésia, Estados Federados da\tru=Федеративные Штаты Микронезии\tzh-CN=密克罗尼西亚\n" +
+	"FO\tde=Färöer-Inseln\ten=Faroe Islands\tes=Islas Feroe\tfr=îles Féroé\tja=フェロー諸島\tpt-BR=Ilhas Faroe\tru=Фарерские острова\tzh-CN=法罗群岛\n" +
+	"FR\tde=Frankreich\ten=France\tes=Francia\tja=フランス\tpt-BR=França\tru=Франция\tzh-CN=法国\n" +
+	"GA\tde=Gabun\ten=Gabon\tes=Gabón\tja=ガボン\tpt-BR=Gabão\tru=Габон\tzh-CN=加蓬\n" +
+	"GB\tde=Vereinigtes Königreich\ten=United Kingdom\tes=Reino Unido\tfr=Royaume-Uni\tja=英国\tpt-BR=Reino Unido\tru=Соединённое Королевство\tzh-CN=英国\n" +
+	"GD\ten=Grenada\tes=Granada\tfr=Grenade\tja=グレナダ\tpt-BR=Granada\tru=Гренада\tzh-CN=格林纳达\n" +
+	"GE\tde=Georgien\ten=Georgia\tfr=Géorgie\tja=グルジア\tpt-BR=Geórgia\tru=Грузия\tzh-CN=格鲁吉亚\n" +
+	"GF\tde=Französisch-Guyana\ten=French Guiana\tes=Guayana Francesa\tfr=Guyane française\tja=仏領ギアナ\tpt-BR=Guiana Francesa\tru=Французская Гвиана\tzh-CN=法属圭亚那\n" +
+	"GG\ten=Guernsey\tfr=Guernesey\tja=ガーンジー\tru=Гернси\tzh-CN=根西岛\n" +
+	"GH\ten=Ghana\tja=ガーナ\tpt-BR=Gana\tru=Гана\tzh-CN=加纳\n" +
+	"GI\ten=Gibraltar\tja=ジブラルタル\tru=Гибралтар\tzh-CN=直布罗陀\n" +
+	"GL\tde=Grönland\ten=Greenland\tes=Groenlandia\tfr=Groënland\tja=グリーンランド\tpt-BR=Groenlândia\tru=Гренландия\tzh-CN=格陵兰\n" +
+	"GM\ten=Gambia\tfr=Gambie\tja=ガンビア\tpt-BR=Gâmbia\tru=Гамбия\tzh-CN=冈比亚\n" +
+	"GN\ten=Guinea\tfr=Guinée\tja=ギニア\tpt-BR=Guiné\tru=Гвинея\tzh-CN=几内亚\n" +
+	"GP\ten=Guadeloupe\tes=Guadalupe\tja=グアドループ\tpt-BR=Guadalupe\tru=Гваделупа\tzh-CN=瓜德罗普\n" +
+	"GQ\tde=Äquatorialguinea\ten=Equatorial Guinea\tes=Guinea Ecuatorial\tfr=Guinée Équatoriale\tja=赤道ギニア\tpt-BR=Guiné Equatorial\tru=Экваториальная Гвинея\tzh-CN=赤道几内亚\n" +
+	"GR\tde=Griechenland\ten=Greece\tes=Grecia\tfr=Grèce\tja=ギリシャ\tpt-BR=Grécia\tru=Греция\tzh-CN=希腊\n" +
+	"GS\tde=South Georgia und die Südlichen Sandwichinseln\ten=South Georgia and the South Sandwich Islands\tes=Islas Georgias del Sur y Sándwich del Sur\tfr=Géorgie du Sud et les îles Sandwich du Sud\tja=サウスジョージア及びサウスサンドウィッチ諸島\tpt-BR=Geórgia do Sul e Ilhas Sandwich do Sul\tru=Южная Джорджия и Южные Сандвичевы острова\tzh-CN=南乔治亚岛和南桑德韦奇岛\n" +
+	"GT\ten=Guatemala\tja=グアテマラ\tru=Гватемала\tzh-CN=瓜地马拉\n" +
+	"GU\ten=Guam\tja=グアム\tru=Гуам\tzh-CN=关岛\n" +
+	"GW\ten=Guinea-Bissau\tes=Guinea-Bisáu\tfr=Guinée-Bissau\tja=ギニアビサウ\tpt-BR=Guiné-Bissau\tru=Гвинея-Бисау\tzh-CN=几内亚比绍\n" +
+	"GY\ten=Guyana\tja=ガイアナ\tpt-BR=Guiana\tru=Гайана\tzh-CN=圭亚那\n" +
+	"HK\tde=Hongkong\ten=Hong Kong\tja=香港\tru=Гонконг\tzh-CN=香港\n" +
+	"HM\tde=Heard und McDonaldinseln\ten=Heard Island and McDonald Islands\tes=Islas Heard y McDonald\tfr=îles Heard-et-MacDonald\tja=ハード島及びマクドナルド諸島\tpt-BR=Ilha Heard e Ilhas McDonald\tru=Остров Херд и острова МакДональд\tzh-CN=赫德岛与麦克唐纳群岛\n" +
+	"HN\ten=Honduras\tja=ホンジュラス\tru=Гондурас\tzh-CN=洪都拉斯\n" +
+	"HR\tde=Kroatien\ten=Croatia\tes=Croacia\tfr=Croatie\tja=クロアチア\tpt-BR=Croácia\tru=Хорватия\tzh-CN=克罗地亚\n" +
+	"HT\ten=Haiti\tes=Haití\tfr=Haïti\tja=ハイチ\tru=Гаити\tzh-CN=海地\n" +
+	"HU\tde=Ungarn\ten=Hungary\tes=Hungría\tfr=Hongrie\tja=ハンガリー\tpt-BR=Hungria\tru=Венгрия\tzh-CN=匈牙利\n" +
+	"ID\tde=Indonesien\ten=Indonesia\tfr=Indonésie\tja=インドネシア\tpt-BR=Indonésia\tru=Индонезия\tzh-CN=印度尼西亚\n" +
+	"IE\tde=Irland\ten=Ireland\tes=Irlanda\tfr=Irlande\tja=アイルランド\tpt-BR=Irlanda\tru=Ирландия\tzh-CN=爱尔兰\n" +
+	"IL\ten=Israel\tfr=Israël\tja=イスラエル\tru=Израиль\tzh-CN=以色列\n" +
+	"IM\tde=Insel Man\ten=Isle of Man\tes=Isla de Man\tfr=Île de Man\tja=マン島\tpt-BR=Ilha de Man\tru=Остров Мэн\tzh-CN=曼岛\n" +
+	"IN\tde=Indien\ten=India\tfr=Inde\tja=インド\tpt-BR=Índia\tru=Индия\tzh-CN=印度\n" +
+	"IO\tde=Britisches Territorium im Indischen Ozean\ten=British Indian Ocean Territory\tes=Territorio Británico del Océano Índico\tfr=Territoire britannique de l'océan Indien\tja=英国インド洋領土\tpt-BR=Território Britânico do Oceano Índico\tru=Британская территория Индийского океана\tzh-CN=英属印度洋领地\n" +
+	"IQ\tde=Irak\ten=Iraq\tes=Irak\tfr=Irak\tja=イラク\tpt-BR=Iraque\tru=Ирак\tzh-CN=伊拉克\n" +
+	"IR\ten=Iran\tzh-CN=伊朗\n" +
+	"IS\tde=Island\ten=Iceland\tes=Islandia\tfr=Islande\tja=アイスランド\tpt-BR=Islândia\tru=Исландия\tzh-CN=冰岛\n" +
+	"IT\tde=Italien\ten=Italy\tes=Italia\tfr=Italie\tja=イタリア\tpt-BR=Itália\tru=Италия\tzh-CN=意大利\n" +
+	"JE\ten=Jersey\tja=ジャージー\tru=Джерси\tzh-CN=泽西岛\n" +
+	"JM\tde=Jamaika\ten=Jamaica\tfr=Jamaïque\tja=ジャマイカ\tru=Ямайка\tzh-CN=牙买加\n" +
+	"JO\tde=Jordanien\ten=Jordan\tes=Jordania\tfr=Jordanie\tja=ヨルダン\tpt-BR=Jordânia\tru=Иордания\tzh-CN=约旦\n" +
+	"JP\ten=Japan\tes=Japón\tfr=Japon\tja=日本\tpt-BR=Japão\tru=Япония\tzh-CN=日本\n" +
+	"KE\tde=Kenia\ten=Kenya\tes=Kenia\tja=ケニア\tpt-BR=Quênia\tru=Кения\tzh-CN=肯尼亚\n" +
+	"KG\tde=Kirgisistan\ten=Kyrgyzstan\tes=Kirguistán\tfr=Kirghizistan\tja=キルギスタン\tpt-BR=Quirguistão\tru=Киргизия\tzh-CN=吉尔吉斯坦\n" +
+	"KH\tde=Kambodscha\ten=Cambodia\tes=Camboya\tfr=Cambodge\tja=カンボジア\tpt-BR=Camboja\tru=Камбоджа\tzh-CN=柬埔塞\n" +
+	"KI\ten=Kiribati\tja=キリバス\tru=Кирибати\tzh-CN=基里巴斯\n" +
+	"KM\tde=Komoren\ten=Comoros\tes=Comores, Islas\tfr=Comores\tja=コモロ\tpt-BR=Comores\tru=Коморы\tzh-CN=科摩罗\n" +
+	"KN\tde=St. Kitts und Nevis\ten=Saint Kitts and Nevis\tes=San Cristóbal y Nieves\tfr=Saint-Christophe-et-Niévès\tja=セントクリストファー・ネーヴィス\tpt-BR=São Cristóvão e Névis\tru=Сент-Китс и Невис\tzh-CN=圣基茨和尼维斯\n" +
+	"KP\tde=Nordkorea\ten=North Korea\tfr=Corée du Nord\tpt-BR=Coreia do Norte\tru=Северная Корея\tzh-CN=朝鲜\n" +
+	"KR\tde=Südkorea\ten=South Korea\tfr=Corée du Sud\tpt-BR=Coreia do Sul\tru=Южная Корея\tzh-CN=韩国\n" +
+	"KW\ten=Kuwait\tfr=Koweït\tja=クウェート\tru=Кувейт\tzh-CN=科威特\n" +
+	"KY\tde=Cayman-Inseln\ten=Cayman Islands\tes=Islas Caimán\tfr=îles Caïmans\tja=ケイマン諸島\tpt-BR=Ilhas Cayman\tru=Каймановы острова\tzh-CN=开曼群岛\n" +
+	"KZ\tde=Kasachstan\ten=Kazakhstan\tes=Kazajistán\tja=カザフスタン\tpt-BR=Cazaquistão\tru=Казахстан\tzh-CN=哈萨克斯坦\n" +
+	"LA\ten=Laos\tzh-CN=老挝\n" +
+	"LB\tde=Libanon\ten=Lebanon\tes=Líbano\tfr=Liban\tja=レバノン\tpt-BR=Líbano\tru=Ливан\tzh-CN=黎巴嫩\n" +
+	"LC\tde=St. Lucia\ten=Saint Lucia\tes=Santa Lucía\tfr=Sainte-Lucie\tja=セントルシア\tpt-BR=Santa Lúcia\tru=Сент-Люсия\tzh-CN=圣路西亚\n" +
+	"LI\ten=Liechtenstein\tja=リヒテンシュタイン\tru=Лихтенштейн\tzh-CN=列支敦士登\n" +
+	"LK\ten=Sri Lanka\tja=スリランカ\tru=Шри-Ланка\tzh-CN=斯里兰卡\n" +
+	"LR\ten=Liberia\tfr=Libéria\tja=リベリア\tpt-BR=Libéria\tru=Либерия\tzh-CN=利比里亚\n" +
+	"LS\ten=Lesotho\tes=Lesoto\tja=レソト\tpt-BR=Lesoto\tru=Лесото\tzh-CN=莱索托\n" +
+	"LT\tde=Litauen\ten=Lithuania\tes=Lituania\tfr=Lituanie\tja=リトアニア\tpt-BR=Lituânia\tru=Литва\tzh-CN=立陶宛\n" +
+	"LU\tde=Luxemburg\ten=Luxembourg\tes=Luxemburgo\tja=ルクセンブルク\tpt-BR=Luxemburgo\tru=Люксембург\tzh-CN=卢森堡\n" +
+	"LV\tde=Lettland\ten=Latvia\tes=Letonia\tfr=Lettonie\tja=ラトビア\tpt-BR=Letônia\tru=Латвия\tzh-CN=拉脱维亚\n" +
+	"LY\tde=Libyen\ten=Libya\tes=Libia\tfr=Libye\tja=リビア\tpt-BR=Líbia\tru=Ливия\tzh-CN=利比亚\n" +
+	"MA\tde=Marokko\ten=Morocco\tes=Marruecos\tfr=Maroc\tja=モロッコ\tpt-BR=Marrocos\tru=Марокко\tzh-CN=摩洛哥\n" +
+	"MC\ten=Monaco\tes=Mónaco\tja=モナコ\tpt-BR=Mônaco\tru=Монако\tzh-CN=摩纳哥\n" +
+	"MD\tde=Moldau\ten=Moldova\tes=Moldavia\tfr=Moldavie\tja=モルドバ\tpt-BR=Moldávia\tru=Молдавия\tzh-CN=摩尔多瓦\n" +
+	"ME\ten=Montenegro\tfr=Monténégro\tja=モンテネグロ\tru=Черногория\tzh-CN=黑山\n" +
+	"MF\tde=Saint Martin (Französischer Teil)\ten=Saint Martin (French part)\tes=San Martín (zona francesa)\tfr=Saint-Martin (partie française)\tja=サンマルタン (仏領)\tpt-BR=São Martim (parte francesa)\tru=Сен-Мартен (Франция)\tzh-CN=法属圣马丁\n" +
+	"MG\tde=Madagaskar\ten=Madagascar\tja=マダガスカル\tru=Мадагаскар\tzh-CN=马达加斯加\n" +
+	"MH\tde=Marshallinseln\ten=Marshall Islands\tes=Islas Marshall\tfr=Îles Marshall\tja=マーシャル諸島\tpt-BR=Ilhas Marshall\tru=Маршалловы острова\tzh-CN=马绍尔群岛\n" +
+	"MK\tde=Nordmazedonien\ten=North Macedonia\tes=Macedonia del Norte\tfr=Macédoine du Nord\tpt-BR=Macedônia do Norte\tru=Северная Македония\tzh-CN=北马其顿\n" +
+	"ML\ten=Mali\tes=Malí\tja=マリ\tru=Мали\tzh-CN=马里\n" +
+	"MM\ten=Myanmar\tes=Birmania\tfr=Birmanie\tja=ミャンマー\tru=Мьянма\tzh-CN=缅甸\n" +
+	"MN\tde=Mongolei\ten=Mongolia\tfr=Mongolie\tja=モンゴル国\tpt-BR=Mongólia\tru=Монголия\tzh-CN=蒙古\n" +
+	"MO\ten=Macao\tfr=Macau\tja=マカオ\tpt-BR=Macau\tru=Макао\tzh-CN=澳门\n" +
+	"MP\tde=Nördliche Marianen\ten=Northern Mariana Islands\tes=Islas Marianas del Norte\tfr=Îles Mariannes du Nord\tja=北マリアナ諸島\tpt-BR=Ilhas Marianas do Norte\tru=Острова северной Марианы\tzh-CN=北马里亚纳群岛\n" +
+	"MQ\ten=Martinique\tes=Martinica\tja=マルティニーク\tpt-BR=Martinica\tru=Мартиника\tzh-CN=马提尼克\n" +
+	"MR\tde=Mauretanien\ten=Mauritania\tfr=Mauritanie\tja=モーリタニア\tpt-BR=Mauritânia\tru=Мавритания\tzh-CN=毛里塔尼亚\n" +
+	"MS\ten=Montserrat\tja=モントセラト\tru=Монтсеррат\tzh-CN=蒙塞拉特岛\n" +
+	"MT\ten=Malta\tfr=Malte\tja=マルタ\tru=Мальта\tzh-CN=马尔他\n" +
+	"MU\ten=Mauritius\tes=Mauricio\tfr=Maurice\tja=モーリシャス\tpt-BR=Maurício\tru=Маврикий\tzh-CN=毛里求斯\n" +
+	"MV\tde=Malediven\ten=Maldives\tes=Islas Maldivas\tja=モルディブ\tpt-BR=Maldivas\tru=Мальдивы\tzh-CN=马尔代夫\n" +
+	"MW\ten=Malawi\tes=Malaui\tja=マラウイ\tpt-BR=Malaui\tru=Малави\tzh-CN=马拉维\n" +
+	"MX\tde=Mexiko\ten=Mexico\tes=México\tfr=Mexique\tja=メキシコ\tpt-BR=México\tru=Мексика\tzh-CN=墨西哥\n" +
+	"MY\ten=Malaysia\tes=Malasia\tfr=Malaisie\tja=マレーシア\tpt-BR=Malásia\tru=Малайзия\tzh-CN=马来西亚\n" +
+	"MZ\tde=Mosambik\ten=Mozambique\tja=モザンビーク\tpt-BR=Moçambique\tru=Мозамбик\tzh-CN=莫桑比克\n" +
+	"NA\ten=Namibia\tfr=Namibie\tja=ナミビア\tpt-BR=Namíbia\tru=Намибия\tzh-CN=纳米比亚\n" +
+	"NC\tde=Neukaledonien\ten=New Caledonia\tes=Nueva Caledonia\tfr=Nouvelle-Calédonie\tja=ニューカレドニア\tpt-BR=Nova Caledônia\tru=Новая Каледония\tzh-CN=新喀里多尼亚\n" +
+	"NE\ten=Niger\tja=ニジェール\tpt-BR=Níger\tru=Нигер\tzh-CN=尼日尔\n" +
+	"NF\tde=Norfolkinsel\ten=Norfolk Island\tes=Isla Norfolk\tfr=île Norfolk\tja=ノーフォーク島\tpt-BR=Ilha Norfolk\tru=Остров Норфолк\tzh-CN=诺福克岛\n" +
+	"NG\ten=Nigeria\tja=ナイジェリア\tpt-BR=Nigéria\tru=Нигерия\tzh-CN=尼日利亚\n" +
+	"NI\ten=Nicaragua\tja=ニカラグア\tpt-BR=Nicarágua\tru=Никарагуа\tzh-CN=尼加拉瓜\n" +
+	"NL\tde=Niederlande\ten=Netherlands\tes=Países Bajos\tfr=Pays-Bas\tja=オランダ\tpt-BR=Países Baixos\tru=Нидерланды\tzh-CN=荷兰\n" +
+	"NO\tde=Norwegen\ten=Norway\tes=Noruega\tfr=Norvège\tja=ノルウェー\tpt-BR=Noruega\tru=Норвегия\tzh-CN=挪威\n" +
+	"NP\ten=Nepal\tfr=Népal\tja=ネパール\tru=Непал\tzh-CN=尼泊尔\n" +
+	"NR\ten=Nauru\tja=ナウル\tru=Науру\tzh-CN=瑙鲁\n" +
+	"NU\ten=Niue\tfr=Nioue\tja=ニウエ\tru=Ниуэ\tzh-CN=纽埃\n" +
+	"NZ\tde=Neuseeland\ten=New Zealand\tes=Nueva Zelanda\tfr=Nouvelle-Zélande\tja=ニュージーランド\tpt-BR=Nova Zelândia\tru=Новая Зеландия\tzh-CN=新西兰\n" +
+	"OM\ten=Oman\tes=Omán\tja=オマーン\tpt-BR=Omã\tru=Оман\tzh-CN=阿曼\n" +
+	"PA\ten=Panama\tes=Panamá\tja=パナマ\tpt-BR=Panamá\tru=Панама\tzh-CN=巴拿马\n" +
+	"PE\ten=Peru\tes=Perú\tfr=Pérou\tja=ペルー\tru=Перу\tzh-CN=秘鲁\n" +
+	"PF\tde=Französisch-Polynesien\ten=French Polynesia\tes=Polinesia Francesa\tfr=Polynésie française\tja=仏領ポリネシア\tpt-BR=Polinésia Francesa\tru=Французская Полинезия\tzh-CN=法属玻利尼西亚\n" +
+	"PG\tde=Papua-Neuguinea\ten=Papua New Guinea\tes=Papúa Nueva Guinea\tfr=Papouasie-Nouvelle-Guinée\tja=パプアニューギニア\tpt-BR=Papua-Nova Guiné\tru=Папуа — Новая Гвинея\tzh-CN=巴布亚新几内亚\n" +
+	"PH\tde=Philippinen\ten=Philippines\tes=Filipinas\tja=フィリピン\tpt-BR=Filipinas\tru=Филиппины\tzh-CN=菲律宾\n" +
+	"PK\ten=Pakistan\tes=Pakistán\tja=パキスタン\tpt-BR=Paquistão\tru=Пакистан\tzh-CN=巴基斯坦\n" +
+	"PL\tde=Polen\ten=Poland\tes=Polonia\tfr=Pologne\tja=ポーランド\tpt-BR=Polônia\tru=Польша\tzh-CN=波兰\n" +
+	"PM\tde=St. Pierre und Miquelon\ten=Saint Pierre and Miquelon\tes=San Pedro y Miquelon\tfr=Saint-Pierre-et-Miquelon\tja=サンピエール及びミクロン\tpt-BR=São Pedro e Miquelon\tru=Сен-Пьер и Микелон\tzh-CN=圣皮埃尔和密克隆\n" +
+	"PN\ten=Pitcairn\tfr=Îles Pitcairn\tja=ピトケアン\tru=Питкэрн\tzh-CN=皮特克恩\n" +
+	"PR\ten=Puerto Rico\tfr=Porto Rico\tja=プエルトリコ\tpt-BR=Porto Rico\tru=Пуэрто-Рико\tzh-CN=波多黎各\n" +
+	"PS\tde=Palästina, Staat\ten=Palestine, State of\tes=Palestina, Estado de\tfr=Palestine, État de\tja=パレスチナ\tpt-BR=Palestina, Estado da\tru=Палестина\tzh-CN=巴勒斯坦\n" +
+	"PT\ten=Portugal\tja=ポルトガル\tru=Португалия\tzh-CN=葡萄牙\n" +
+	"PW\ten=Palau\tes=Palaos\tfr=Palaos\tja=パラオ\tru=Палау\tzh-CN=帕劳\n" +
+	"PY\ten=Paraguay\tja=パラグアイ\tpt-BR=Paraguai\tru=Парагвай\tzh-CN=巴拉圭\n" +
+	"QA\tde=Katar\ten=Qatar\tes=Catar\tja=カタール\tpt-BR=Catar\tru=Катар\tzh-CN=卡塔尔\n" +
+	"RE\ten=Réunion\tes=Reunión\tfr=Réunion, Île de la\tja=レユニオン\tpt-BR=Reunião\tru=Реюньон\tzh-CN=留尼汪\n" +
+	"RO\tde=Rumänien\ten=Romania\tes=Rumanía\tfr=Roumanie\tja=ルーマニア\tpt-BR=Romênia\tru=Румыния\tzh-CN=罗马尼亚\n" +
+	"RS\tde=Serbien\ten=Serbia\tfr=Serbie\tja=セルビア\tpt-BR=Sérvia\tru=Сербия\tzh-CN=塞尔维亚\n" +
+	"RU\tde=Russische Föderation\ten=Russian Federation\tes=Federación Rusa\tfr=Russie, Fédération de\tja=ロシア連邦\tpt-BR=Federação Russa\tru=Российская Федерация\tzh-CN=俄罗斯\n" +
+	"RW\tde=Ruanda\ten=Rwanda\tes=Ruanda\tja=ルワンダ\tpt-BR=Ruanda\tru=Руанда\tzh-CN=卢旺达\n" +
+	"SA\tde=Saudi-Arabien\ten=Saudi Arabia\tes=Arabia Saudí\tfr=Arabie saoudite\tja=サウジアラビア\tpt-BR=Arábia Saudita\tru=Саудовская Аравия\tzh-CN=沙特阿拉伯\n" +
+	"SB\tde=Salomoninseln\ten=Solomon Islands\tes=Islas Salomón\tfr=Salomon, Îles\tja=ソロモン諸島\tpt-BR=Ilhas Salomão\tru=Соломоновы Острова\tzh-CN=所罗门群岛\n" +
+	"SC\tde=Seychellen\ten=Seychelles\tja=セーシェル\tru=Сейшелы\tzh-CN=塞舌尔\n" +
+	"SD\ten=Sudan\tes=Sudán\tfr=Soudan\tja=スーダン\tpt-BR=Sudão\tru=Судан\tzh-CN=苏丹\n" +
+	"SE\tde=Schweden\ten=Sweden\tes=Suecia\tfr=Suède\tja=スウェーデン\tpt-BR=Suécia\tru=Швеция\tzh-CN=瑞典\n" +
+	"SG\tde=Singapur\ten=Singapore\tes=Singapur\tfr=Singapour\tja=シンガポール\tpt-BR=Cingapura\tru=Сингапур\tzh-CN=新加坡\n" +
+	"SH\tde=St. Helena, Ascension und Tristan da Cunha\ten=Saint Helena, Ascension and Tristan da Cunha\tes=Santa Elena, Ascensión y Tristán de Acuña\tfr=Sainte-Hélène, Ascension et Tristan da Cunha\tja=セントヘレナ、アセンション及びトリスタン・ダ・クーニャ\tpt-BR=Santa Helena, Ascensão e Tristão da Cunha\tru=Остров Святой Елены, Остров Вознесения и Тристан-да-Кунья\tzh-CN=圣赫勒拿-阿森松-特里斯坦达库尼亚\n" +
+	"SI\tde=Slowenien\ten=Slovenia\tes=Eslovenia\tfr=Slovénie\tja=スロベニア\tpt-BR=Eslovênia\tru=Словения\tzh-CN=斯洛文尼亚\n" +
+	"SJ\tde=Svalbard und Jan Mayen\ten=Svalbard and Jan Mayen\tes=Svalbard y Jan Mayen\tfr=Svalbard et île Jan Mayen\tja=スヴァールバル及びヤンマイエン\tpt-BR=Svalbard e a Ilha de Jan Mayen\tru=Шпицберген и Ян-Майен\tzh-CN=斯瓦尔巴特和扬马延岛\n" +
+	"SK\tde=Slowakei\ten=Slovakia\tes=Eslovaquia\tfr=Slovaquie\tja=スロバキア\tpt-BR=Eslováquia\tru=Словакия\tzh-CN=斯洛伐克\n" +
+	"SL\ten=Sierra Leone\tes=Sierra Leona\tja=シエラレオネ\tpt-BR=Serra Leoa\tru=Сьерра-Леоне\tzh-CN=塞拉利昂\n" +
+	"SM\ten=San Marino\tfr=Saint-Marin\tja=サンマリノ\tpt-BR=São Marino\tru=Сан-Марино\tzh-CN=圣马力诺市\n" +
+	"SN\ten=Senegal\tfr=Sénégal\tja=セネガル\tru=Сенегал\tzh-CN=塞内加尔\n" +
+	"SO\ten=Somalia\tfr=Somalie\tja=ソマリア\tpt-BR=Somália\tru=Сомали\tzh-CN=索马里\n" +
+	"SR\ten=Suriname\tes=Surinám\tfr=Surinam\tja=スリナム\tru=Суринам\tzh-CN=苏里南\n" +
+	"SS\tde=Südsudan\ten=South Sudan\tes=Sudán del Sur\tfr=Soudan du Sud\tja=南スーダン\tpt-BR=Sudão do Sul\tru=Южный Судан\tzh-CN=南苏丹\n" +
+	"ST\tde=São Tomé und Príncipe\ten=Sao Tome and Principe\tes=Santo Tomé y Príncipe\tfr=Sao Tomé-et-Principe\tja=サントメ・プリンシペ\tpt-BR=São Tomé e Príncipe\tru=Сан-Томе и Принсипи\tzh-CN=圣多美和普林西比\n" +
+	"SV\ten=El Salvador\tfr=Salvador\tja=エルサルバドル\tru=Сальвадор\tzh-CN=萨尔瓦多\n" +
+	"SX\tde=Saint-Martin (Niederländischer Teil)\ten=Sint Maarten (Dutch part)\tes=Isla de San Martín (zona holandsea)\tfr=Saint-Martin (partie néerlandaise)\tja=サンマルタン (オランダ領)\tpt-BR=São Martim (parte holandesa)\tru=Синт-Мартен (голландская часть)\tzh-CN=荷属圣马丁\n" +
+	"SY\tde=Syrien\ten=Syria\tzh-CN=叙利亚\n" +
+	"SZ\ten=Eswatini\tes=Esuatini\tpt-BR=Suazilândia\tru=Эсватини\tzh-CN=斯威士兰\n" +
+	"TC\tde=Turks- und Caicosinseln\ten=Turks and Caicos Islands\tes=Islas Turcas y Caicos\tfr=îles Turques-et-Caïques\tja=タークス及びカイコス諸島\tpt-BR=Ilhas Turks e Caicos\tru=Острова Туркс и Каикос\tzh-CN=特克斯和凯科斯群岛\n" +
+	"TD\tde=Tschad\ten=Chad\tfr=Tchad\tja=チャド\tpt-BR=Chade\tru=Чад\tzh-CN=乍得\n" +
+	"TF\tde=Französische Süd- und Antarktisgebiete\ten=French Southern Territories\tes=Territorios Franceses del Sur\tfr=Terres australes françaises\tja=フランス南方領土\tpt-BR=Territórios Franceses do Sul\tru=Французские южные территории\tzh-CN=法属南半球领地\n" +
+	"TG\ten=Togo\tja=トーゴ\tru=Того\tzh-CN=多哥\n" +
+	"TH\ten=Thailand\tes=Tailandia\tfr=Thaïlande\tja=タイ\tpt-BR=Tailândia\tru=Таиланд\tzh-CN=泰国\n" +
+	"TJ\tde=Tadschikistan\ten=Tajikistan\tes=Tayikistán\tfr=Tadjikistan\tja=タジキスタン\tpt-BR=Tadjiquistão\tru=Таджикистан\tzh-CN=塔吉克斯坦\n" +
+	"TK\ten=Tokelau\tja=トケラウ\tpt-BR=Toquelau\tru=Токелау\tzh-CN=托克劳\n" +
+	"TL\ten=Timor-Leste\tes=Timor Oriental\tfr=Timor oriental\tja=東ティモール\tpt-BR=Timor Leste\tru=Восточный Тимор\tzh-CN=东帝汶\n" +
+	"TM\ten=Turkmenistan\tes=Turkmenistán\tfr=Turkménistan\tja=トルクメニスタン\tpt-BR=Turcomenistão\tru=Туркменистан\tzh-CN=土库曼斯坦\n" +
+	"TN\tde=Tunesien\ten=Tunisia\tes=Tunez\tfr=Tunisie\tja=チュニジア\tpt-BR=Tunísia\tru=Тунис\tzh-CN=突尼斯\n" +
+	"TO\ten=Tonga\tja=トンガ\tru=Тонга\tzh-CN=汤加\n" +
+	"TR\tde=Türkei\ten=Türkiye\tpt-BR=Turquia\tzh-CN=土耳其\n" +
+	"TT\tde=Trinidad und Tobago\ten=Trinidad and Tobago\tes=Trinidad y Tobago\tfr=Trinité-et-Tobago\tja=トリニダード・トバゴ\tpt-BR=Trinidade e Tobago\tru=Тринидад и Тобаго\tzh-CN=特里尼达和多巴哥\n" +
+	"TV\ten=Tuvalu\tja=ツバル\tru=Тувалу\tzh-CN=图瓦卢\n" +
+	"TW\ten=Taiwan\tes=Taiwán\tfr=Taïwan\tja=台湾\tru=Тайвань\tzh-CN=台湾\n" +
+	"TZ\tde=Tansania\ten=Tanzania\tfr=Tanzanie\tja=タンザニア\tpt-BR=Tanzânia\tru=Танзания\tzh-CN=坦桑尼亚\n" +
+	"UA\ten=Ukraine\tes=Ucrania\tja=ウクライナ\tpt-BR=Ucrânia\tru=Украина\tzh-CN=乌克兰\n" +
+	"UG\ten=Uganda\tfr=Ouganda\tja=ウガンダ\tru=Уганда\tzh-CN=乌干达\n" +
+	"UM\ten=United States Minor Outlying Islands\tes=Islas Ultramarinas Menores de Estados Unidos\tfr=Îles mineures éloignées des États-Unis\tja=アメリカ合衆国外諸島\tpt-BR=Ilhas Menores Distantes dos Estados Unidos\tru=Соединенные штаты Малых Удаленных островов\tzh-CN=美国本土外小岛屿\n" +
+	"US\tde=Vereinigte Staaten\ten=United States\tes=Estados Unidos\tfr=États-Unis\tja=米国\tpt-BR=Estados Unidos\tru=Соединённые штаты\tzh-CN=美国\n" +
+	"UY\ten=Uruguay\tja=ウルグアイ\tpt-BR=Uruguai\tru=Уругвай\tzh-CN=乌拉圭\n" +
+	"UZ\tde=Usbekistan\ten=Uzbekistan\tes=Uzbekistán\tfr=Ouzbékistan\tja=ウズベキスタン\tpt-BR=Uzbequistão\tru=Узбекистан\tzh-CN=乌兹别克斯坦\n" +
+	"VA\tde=Heiliger Stuhl (Staat Vatikanstadt)\ten=Holy See (Vatican City State)\tes=Santa Sede (Ciudad Estado del Vaticano)\tfr=Saint-Siège (état de la cité du Vatican)\tja=聖庁 (バチカン市国)\tpt-BR=Santa Sé (Cidade-Estado do Vaticano)\tru=Государство-город Ватикан\tzh-CN=梵地冈\n" +
+	"VC\tde=St. Vincent und die Grenadinen\ten=Saint Vincent and the Grenadines\tes=San Vicente y las Granadinas\tfr=Saint-Vincent-et-les-Grenadines\tja=セントビンセント及びグレナディーン諸島\tpt-BR=São Vicente e Granadinas\tru=Сент-Винсент и Гренадины\tzh-CN=圣文森特和格林纳丁斯\n" +
+	"VE\ten=Venezuela\tfr=Vénézuela\tja=ベネズエラ\tru=Венесуэла\tzh-CN=委内瑞拉\n" +
+	"VG\tde=Britische Jungferninseln\ten=Virgin Islands, British\tes=Islas Vírgenes, Británicas\tfr=Îles Vierges britanniques\tja=英領ヴァージン諸島\tpt-BR=Ilhas Virgens Britânicas\tru=Виргинские острова (Британия)\tzh-CN=英属维尔京群岛\n" +
+	"VI\tde=Amerikanische Jungferninseln\ten=Virgin Islands, U.S.\tes=Islas Vírgenes, de EEUU\tfr=Îles Vierges, États-Unis\tja=米領ヴァージン諸島\tpt-BR=Ilhas Virgens dos Estados Unidos\tru=Виргинские острова (США)\tzh-CN=美属维尔京群岛\n" +
+	"VN\ten=Vietnam\tfr=Viêt Nam\tja=ベトナム\tpt-BR=Vietnã\tru=Вьетнам\tzh-CN=越南\n" +
+	"VU\ten=Vanuatu\tja=バヌアツ\tru=Вануату\tzh-CN=瓦努阿图\n" +
+	"WF\tde=Wallis und Futuna\ten=Wallis and Futuna\tes=Wallis y Futuna\tfr=Wallis et Futuna\tja=ワリー及びフテュナ\tpt-BR=Wallis e Futuna\tru=Уоллес и Футана\tzh-CN=瓦利斯和富图纳\n" +
+	"WS\ten=Samoa\tja=サモア\tru=Самоа\tzh-CN=萨摩亚\n" +
+	"YE\tde=Jemen\ten=Yemen\tfr=Yémen\tja=イエメン\tpt-BR=Iêmen\tru=Йемен\tzh-CN=也门\n" +
+	"YT\ten=Mayotte\tja=マヨット\tpt-BR=Maiote\tru=Майот\tzh-CN=马约特\n" +
+	"ZA\tde=Südafrika\ten=South Africa\tes=Sudáfrica\tfr=Afrique du Sud\tja=南アフリカ\tpt-BR=África do Sul\tru=Южная Африка\tzh-CN=南非\n" +
+	"ZM\tde=Sambia\ten=Zambia\tfr=Zambie\tja=ザンビア\tpt-BR=Zâmbia\tru=Замбия\tzh-CN=赞比亚\n" +
+	"ZW\tde=Simbabwe\ten=Zimbabwe\tes=Zimbabue\tja=ジンバブエ\tpt-BR=Zimbábue\tru=Зимбабве\tzh-CN=津巴布韦\n" +
+	""
+
+const subdivisionNamesData = "" +
+	"AD-02\ten=Canillo\tru=Канильо\tzh-CN=卡尼略\n" +
+	"AD-03\ten=Encamp\tru=Энкамп\tzh-CN=恩坎普\n" +
+	"AD-04\ten=La Massana\tes=La Masaña\tru=Ла-Массана\tzh-CN=马萨纳\n" +
+	"AD-05\ten=Ordino\tru=Ордино\tzh-CN=奥尔迪诺\n" +
+	"AD-06\ten=Sant Julià de Lòria\tes=San Julián de Loria\tfr=Saint Julià de Lòria\tru=Сан-Жулиа-де-Лория\tzh-CN=圣胡利娅-德洛里亚\n" +
+	"AD-07\ten=Andorra la Vella\tes=Andorra la Vieja\tfr=Andorre la Vieille\tja=アンドララベリャ\tru=Андорра-ла-Велья\tzh-CN=安道尔城\n" +
+	"AD-08\ten=Escaldes-Engordany\tes=Las Escaldas-Engordany\tru=Эскальдес-Энгордань\tzh-CN=莱塞斯卡尔德-恩戈尔达\n" +
+	"AE-AJ\ten=‘Ajmān\tru=Аджман\tzh-CN=阿吉曼\n" +
+	"AE-AZ\tde=Abu Dhabi\ten=Abū Z̧aby\tru=Абу-Даби\tzh-CN=阿布扎比\n" +
+	"AE-DU\tde=Dubai\ten=Dubayy\tes=Dubái\tfr=Dubaï\tja=ドバイ\tru=Дубай\tzh-CN=迪拜\n" +
+	"AE-FU\tde=Fudschaira\ten=Al Fujayrah\tes=Fuyaira\tfr=Fujaïrah\tja=フジャイラ\tru=Эль-Фуджайра\tzh-CN=富吉拉\n" +
+	"AE-RK\tde=Ra’s al-Chaima\ten=Ra’s al Khaymah\tes=Ras al-Khaimah\tfr=Ras el Khaïmah\tja=ラスアルカイマ\tru=Рас эль-Хайма\tzh-CN=拉斯海玛\n" +
+	"AE-SH\tde=Schārdscha\ten=Ash Shāriqah\tfr=Ash Shariqah\tja=シャルジャ\tru=Шарджа\tzh-CN=夏尔迦\n" +
+	"AE-UQ\tde=Umm al-Qaiwain\ten=Umm al Qaywayn\tfr=Oumm al Qaïwaïn\tru=Умм эль-Кайвайн\tzh-CN=欧姆古温\n" +
+	"AF-BAL\tde=Balch\ten=Balkh\tja=バルフ\tru=Балх\tzh-CN=巴尔赫省\n" +
+	"AF-BAM\tde=Bamiyan\ten=Bāmyān\tfr=Bamyan\tja=バーミヤン\tru=Бамиан\tzh-CN=巴米扬省\n" +
+	"AF-BDG\ten=Bādghīs\tfr=Badghis\tja=バドギース\tru=Бадгис\tzh-CN=巴德吉斯省\n" +
+	"AF-BDS\tde=Badakschān\ten=Badakhshān\tfr=Badakhchan\tja=バダフシャーン\tru=Бадахшан\tzh-CN=巴达赫尚省\n" +
+	"AF-BGL\tde=Baglān\ten=Baghlān\tfr=Baghlan\tja=バグラーン\tru=Баглан\tzh-CN=巴格兰省\n" +
+	"AF-DAY\tde=Dāikondī\ten=Dāykundī\tfr=Daykundi\tja=ダイクンディ\tru=Дайкунди\tzh-CN=代孔迪省\n" +
+	"AF-FRA\ten=Farāh\tfr=Farah\tja=ファラー\tru=Фарах\tzh-CN=法拉省\n" +
+	"AF-FYB\ten=Fāryāb\tfr=Faryab\tja=ファリヤーブ\tru=Фарьяб\tzh-CN=法利亚布省\n" +
+	"AF-GHA\ten=Ghaznī\tfr=Ghazni\tja=ガズニ\tru=Газни\tzh-CN=加兹尼省\n" +
+	"AF-GHO\ten=Ghōr\tfr=Ghor\tja=ゴール\tru=Гор\tzh-CN=古尔省\n" +
+	"AF-HEL\ten=Helmand\tja=ヘルマンド\tru=Гильменд\tzh-CN=赫尔曼德省\n" +
+	"AF-HER\ten=Herāt\tfr=Hérat\tja=ヘラート\tru=Герат\tzh-CN=赫拉特省\n" +
+	"AF-JOW\tde=Juzjān\ten=Jowzjān\tfr=Jowzjan\tja=ジョウズジャーン\tru=Джаузджан\tzh-CN=朱兹詹省\n" +
+	"AF-KAB\ten=Kābul\tes=Kabul\tfr=Kaboul\tja=カブール\tru=Кабул\tzh-CN=喀布尔省\n" +
+	"AF-KAN\ten=Kandahār\tes=Kandahar\tfr=Kandahar\tja=カンダハール\tru=Кандагар\tzh-CN=坎大哈省\n" +
+	"AF-KAP\ten=Kāpīsā\tfr=Kapisa\tja=カピサ\tru=Каписа\tzh-CN=卡比萨省\n" +
+	"AF-KDZ\tde=Kundus\ten=Kunduz\tja=クンドゥーズ\tru=Кундуз\tzh-CN=昆都士省\n" +
+	"AF-KHO\tde=Chost\ten=Khōst\tja=ホースト\tru=Хост\tzh-CN=霍斯特省\n" +
+	"AF-KNR\tde=Kunar\ten=Kunaṟ\tru=Кунар\tzh-CN=库纳尔\n" +
+	"AF-LAG\ten=Laghmān\tfr=Laghman\tja=ラグマーン\tru=Лагман\tzh-CN=拉格曼省\n" +
+	"AF-LOG\tde=Lugar\ten=Lōgar\tfr=Logar\tja=ローガル\tru=Логар\tzh-CN=洛加尔省\n" +
+	"AF-NAN\ten=Nangarhār\tes=Nangarjar\tfr=Nangarhar\tja=ナンガルハール\tru=Нангархар\tzh-CN=楠格哈尔省\n" +
+	"AF-NIM\tde=Nīmrūs\ten=Nīmrōz\tes=Nimruz\tfr=Nimruz\tja=ニームローズ\tru=Нимроз\tzh-CN=尼姆鲁兹省\n" +
+	"AF-NUR\ten=Nūristān\tes=Nurestán\tfr=Nourestan\tja=ヌーリスタン\tru=Нуристан\tzh-CN=努尔斯坦省\n" +
+	"AF-PAN\tde=Panjshīr\ten=Panjshayr\tes=Panjshir\tfr=Panchir\tru=Панджшер\tzh-CN=潘杰希尔省\n" +
+	"AF-PAR\ten=Parwān\tes=Paruán\tfr=Parwan\tja=パルワーン\tru=Парван\tzh-CN=帕尔旺省\n" +
+	"AF-PIA\tde=Paktīā\ten=Paktiyā\tes=Paktia\tfr=Paktia\tja=パクティア\tru=Пактия\tzh-CN=帕克蒂亚省\n" +
+	"AF-PKA\ten=Paktīkā\tes=Paktiká\tfr=Paktika\tja=パクティカ\tru=Пактика\tzh-CN=帕克蒂卡省\n" +
+	"AF-SAM\ten=Samangān\tes=Samangan\tfr=Samangan\tja=サマンガーン\tru=Саманган\tzh-CN=萨曼甘省\n" +
+	"AF-SAR\tde=Sar-i Pul\ten=Sar-e Pul\tfr=Sar-é Pol\tja=サリプル\tru=Сари-Пуль\tzh-CN=萨尔普勒省\n" +
+	"AF-TAK\ten=Takhār\tes=Tajar\tfr=Takhar\tja=タハール\tru=Тахар\tzh-CN=塔哈尔省\n" +
+	"AF-URU\ten=Uruzgān\tes=Uruzgán\tfr=Ourouzgan\tja=ウルズガン\tru=Урузган\tzh-CN=乌鲁兹甘省\n" +
+	"AF-WAR\ten=Wardak\tes=Vardak\tfr=Wardag\tja=ワルダック\tru=Вардак\tzh-CN=瓦尔达克省\n" +
+	"AF-ZAB\ten=Zābul\tfr=Zaboul\tja=ザーボル\tru=Забуль\tzh-CN=扎布尔省\n" +
+	"AG-03\ten=Saint George\tfr=Saint-George\tja=セントジョージ\tru=Сент-Джордж\tzh-CN=圣乔治\n" +
+	"AG-04\ten=Saint John\tfr=Saint-John\tja=セントジョン\tru=Сент-Джон\tzh-CN=圣约翰\n" +
+	"AG-05\ten=Saint Mary\tfr=Saint-Mary\tja=セントメアリー\tru=Сент-Мэри\tzh-CN=圣玛丽\n" +
+	"AG-06\ten=Saint Paul\tfr=Saint-Paul\tja=セントポール\tru=Сент-Пол\tzh-CN=圣保罗\n" +
+	"AG-07\ten=Saint Peter\tfr=Saint-Peter\tja=セントピーター\tru=Сент-Питер\tzh-CN=圣彼得\n" +
+	"AG-08\ten=Saint Philip\tfr=Saint-Philip\tja=セントフィリップ\tru=Сент-Филип\tzh-CN=圣飞利浦\n" +
+	"AG-10\ten=Barbuda\tfr=Barbade\tja=バーブーダ\tru=Барбуда\tzh-CN=巴布达\n" +
+	"AG-11\ten=Redonda\tru=Редонда\tzh-CN=雷东达岛\n" +
+	"AL-01\ten=Berat\tru=Берат\tzh-CN=培拉特区\n" +
+	"AL-02\ten=Durrës\tja=ドゥラス\tru=Дуррес\tzh-CN=都拉斯区\n" +
+	"AL-03\ten=Elbasan\tfr=elbasan\tja=エルバサン\tru=Эльбасан\tzh-CN=爱尔巴桑区\n" +
+	"AL-04\ten=Fier\tja=フィエル\tru=Фиери\tzh-CN=非夏尔区\n" +
+	"AL-05\tde=Gjirokastra\ten=Gjirokastër\tru=Гирокастра\tzh-CN=吉罗卡斯特区\n" +
+	"AL-06\tde=Korça\ten=Korçë\tja=コルチャ\tru=Корча\n" +
+	"AL-07\ten=Kukës\tja=クカス\tru=Кукес\tzh-CN=库克斯区\n" +
+	"AL-08\tde=Lezha\ten=Lezhë\tru=Лежа\tzh-CN=莱什区\n" +
+	"AL-09\tde=Dibra\ten=Dibër\tru=Дибра\tzh-CN=迪勃拉区\n" +
+	"AL-10\tde=Shkodra\ten=Shkodër\tja=シュコダル\tru=Шкодер\tzh-CN=斯库台区\n" +
+	"AL-11\tde=Tirana\ten=Tiranë\tfr=Tirana\tja=ティラナ\tru=Тирана\tzh-CN=地拉那区\n" +
+	"AL-12\tde=Vlora\ten=Vlorë\tja=ヴローラ\tru=Влёра\tzh-CN=发罗拉区\n" +
+	"AM-AG\ten=Aragac̣otn\tru=Арагацотнская область\n" +
+	"AM-AR\ten=Ararat\tja=アララト\tru=Араратская область\tzh-CN=阿拉拉特省\n" +
+	"AM-AV\tde=Armawir\ten=Armavir\tja=アルマヴィル\tru=Армавирская область\tzh-CN=阿尔马维尔省\n" +
+	"AM-ER\tde=Jerewan\ten=Erevan\tfr=Yerevan\tja=エレバン\tru=Ереван\tzh-CN=埃里温\n" +
+	"AM-GR\ten=Geġark'unik'\tru=Гехаркуникская область\n" +
+	"AM-KT\tde=Kotajk'\ten=Kotayk'\tfr=Kotayk\tru=Котайкская область\tzh-CN=科泰克省\n" +
+	"AM-LO\ten=Loṙi\tfr=Lori\tzh-CN=洛里省\n" +
+	"AM-SH\ten=Širak\tru=Ширакская область\n" +
+	"AM-SU\tde=Sjunik'\ten=Syunik'\tfr=Syunik\tru=Сюникская область\tzh-CN=休尼克省\n" +
+	"AM-TV\ten=Tavuš\tru=Тавушская область\n" +
+	"AM-VD\ten=Vayoć Jor\tfr=Vayots’ Dzor\tru=Вайоцдзорская область\n" +
+	"AO-BGO\ten=Bengo\tja=ベンゴ\tru=Бенго\tzh-CN=本哥省\n" +
+	"AO-BGU\ten=Benguela\tja=ベンゲラ\tru=Бенгела\tzh-CN=本吉拉省\n" +
+	"AO-BIE\ten=Bié\tja=ビエ\tru=Бие\tzh-CN=比耶省\n" +
+	"AO-CAB\ten=Cabinda\tja=カビンダ\tru=Кабинда\tzh-CN=喀丙达省\n" +
+	"AO-CCU\ten=Cuando Cubango\tru=Квандо-Кубанго\n" +
+	"AO-CNN\ten=Cunene\tja=クネネ\tru=Кунене\tzh-CN=库内纳省\n" +
+	"AO-CNO\ten=Cuanza-Norte\tfr=Cuanza-Nord\tru=Северная Кванза\n" +
+	"AO-CUS\ten=Cuanza-Sul\tfr=Cuanza-Sud\tru=Южная Кванза\n" +
+	"AO-HUA\ten=Huambo\tja=ワンボー\tru=Уамбо\tzh-CN=世博省\n" +
+	"AO-HUI\ten=Huíla\tfr=Huila\tja=ウイラ\tru=Уила\tzh-CN=威拉省\n" +
+	"AO-LNO\ten=Lunda-Norte\tfr=Lunda-Nord\tru=Северная Лунда\n" +
+	"AO-LSU\ten=Lunda-Sul\tfr=Lunda-Sud\tru=Южная Лунда\n" +
+	"AO-LUA\ten=Luanda\tja=ルアンダ\tru=Луанда\tzh-CN=罗安达省\n" +
+	"AO-MAL\tde=Malanje\ten=Malange\tfr=Malanje\tja=マランジェ\tru=Маланже\tzh-CN=马兰哲省\n" +
+	"AO-MOX\ten=Moxico\tja=モシコ\tru=Мошико\tzh-CN=莫希科省\n" +
+	"AO-NAM\ten=Namibe\tja=ナミベ\tru=Намибе\tzh-CN=纳米贝省\n" +
+	"AO-UIG\ten=Uíge\tfr=Uige\tja=ウイジェ\tru=Уиже\tzh-CN=威热省\n" +
+	"AO-ZAI\ten=Zaire\tja=ザイール\tru=Заире\tzh-CN=扎伊尔省\n" +
+	"AR-A\ten=Salta\tja=サルタ\tru=Сальта\tzh-CN=萨尔塔省\n" +
+	"AR-B\ten=Buenos Aires\tja=ブエノスアイレス\tru=Буэнос-Айрес\tzh-CN=布宜诺斯艾利斯省\n" +
+	"AR-C\tde=Autonome Stadt Buenos Aires\ten=Ciudad Autónoma de Buenos Aires\tfr=Ville autonome de Buenos Aires\tru=Автономный город Буэнос-Айрес\n" +
+	"AR-D\ten=San Luis\tja=サンルイス\tru=Сан-Луис\tzh-CN=圣路易斯省\n" +
+	"AR-E\ten=Entre Ríos\tfr=Entre-Ríos\tru=Энтре-Риос\n" +
+	"AR-F\ten=La Rioja\tja=ラリオハ\tru=Риоха\tzh-CN=拉里奥哈\n" +
+	"AR-G\ten=Santiago del Estero\tja=サンティアゴデルエストロ\tru=Сантьяго-дель-Эстеро\tzh-CN=圣地亚哥-德尔埃斯特罗省\n" +
+	"AR-H\ten=Chaco\tja=チャコ\tru=Чако\tzh-CN=查科省\n" +
+	"AR-J\ten=San Juan\tja=サンファン\tru=Сан-Хуан\tzh-CN=圣胡安省\n" +
+	"AR-K\ten=Catamarca\tja=カタマルカ\tru=Катамарка\tzh-CN=卡塔马卡省\n" +
+	"AR-L\ten=La Pampa\tja=ラパンパ\tru=Ла-Пампа\tzh-CN=拉潘帕省\n" +
+	"AR-M\ten=Mendoza\tja=メンドサ\tru=Мендоса\tzh-CN=门多萨省\n" +
+	"AR-N\ten=Misiones\tja=ミシオネス\tru=Мисьонес\tzh-CN=米西奥内斯省\n" +
+	"AR-P\ten=Formosa\tja=フォルモサ\tru=Формоса\tzh-CN=福尔摩沙省\n" +
+	"AR-Q\ten=Neuquén\tru=Неукен\n" +
+	"AR-R\ten=Río Negro\tja=リオネグロ\tru=Рио-Негро\n" +
+	"AR-S\ten=Santa Fe\tja=サンタフェ\tru=Санта-Фе\tzh-CN=圣菲省\n" +
+	"AR-T\ten=Tucumán\tru=Тукуман\n" +
+	"AR-U\ten=Chubut\tja=チュブト\tru=Чубут\tzh-CN=丘布特省\n" +
+	"AR-V\ten=Tierra del Fuego\tfr=Tierra del Fuego, Antártida, e Islas del Atlántico Sur\tja=ティエラデルフエゴ\tru=Огненная Земля\tzh-CN=火地岛省\n" +
+	"AR-W\ten=Corrientes\tja=コリエンテス\tru=Корриентес\tzh-CN=科连特斯省\n" +
+	"AR-X\ten=Córdoba\tfr=Cordoba\tja=コルドバ\tru=Кордова\tzh-CN=科尔多瓦省\n" +
+	"AR-Y\ten=Jujuy\tja=フフイ\tru=Жужуй\tzh-CN=胡胡伊省\n" +
+	"AR-Z\ten=Santa Cruz\tja=サンタクルス\tru=Санта-Крус\tzh-CN=圣克鲁斯省\n" +
+	"AT-1\ten=Burgenland\tja=ブルケンラント\tru=Бургенланд\tzh-CN=布尔根兰州\n" +
+	"AT-2\ten=Kärnten\tfr=Carinthie\tja=ケルンテン\tru=Каринтия\tzh-CN=凯恩藤州\n" +
+	"AT-3\ten=Niederösterreich\tfr=Basse-Autriche\tja=ニーダーエスターライヒ\tru=Нижняя Австрия\tzh-CN=下奥地利州\n" +
+	"AT-4\ten=Oberösterreich\tfr=Haute-Autriche\tja=オーバーエスターライヒ\tru=Верхняя Австрия\tzh-CN=上奥地利州\n" +
+	"AT-5\ten=Salzburg\tfr=Salzbourg\tja=ザルツブルク\tru=Зальцбург\tzh-CN=萨尔茨堡州\n" +
+	"AT-6\ten=Steiermark\tfr=Styrie\tja=シュタイアーマルク\tru=Штирия\tzh-CN=施泰尔马克州\n" +
+	"AT-7\ten=Tirol\tfr=Tyrol\tja=ティロル\tru=Тироль\tzh-CN=蒂罗尔州\n" +
+	"AT-8\ten=Vorarlberg\tja=フォーラールベルク\tru=Форарльберг\tzh-CN=福拉尔贝格州\n" +
+	"AT-9\ten=Wien\tfr=Vienne\tja=ウィーン\tru=Вена\tzh-CN=维也纳州\n" +
+	"AU-ACT\ten=Australian Capital Territory\tfr=Territoire de la capitale australienne\tja=オーストラリアキャピタルテリトリー\tru=Австралийская столичная территория\tzh-CN=澳大利亚首都特区\n" +
+	"AU-NSW\ten=New South Wales\tfr=Nouvelle-Galles-du-Sud\tja=ニューサウスウェールズ\tru=Новый Южный Уэльс\tzh-CN=新南威尔士\n" +
+	"AU-NT\ten=Northern Territory\tfr=Territoire du Nord\tja=ノーザンテリトリー\tru=Северная территория\tzh-CN=北领地\n" +
+	"AU-QLD\ten=Queensland\tja=クインズランド\tru=Квинсленд\tzh-CN=昆士兰州\n" +
+	"AU-SA\ten=South Australia\tfr=Australie-Méridionale\tja=サウスオーストラリア\tru=Южная Австралия\tzh-CN=南澳大利亚州\n" +
+	"AU-TAS\tde=Tasmanien\ten=Tasmania\tfr=Tasmanie\tja=タスマニア\tru=Тасмания\tzh-CN=塔斯曼尼亚\n" +
+	"AU-VIC\ten=Victoria\tja=ビクトリア\tru=Виктория\tzh-CN=维多利亚州\n" +
+	"AU-WA\ten=Western Australia\tfr=Australie-Occidentale\tja=ウェスタンオーストラリア\tru=Западная Австралия\tzh-CN=西澳大利亚州\n" +
+	"AZ-ABS\ten=Abşeron\tfr=Absheron\tru=Абшеронский район\tzh-CN=阿布歇隆区\n" +
+	"AZ-AGA\ten=Ağstafa\tfr=Agstafa\tru=Акстафинский район\tzh-CN=阿克斯塔法区\n" +
+	"AZ-AGC\ten=Ağcabədi\tfr=Agjabadi\tru=Агджабединский район\tzh-CN=阿格贾贝迪区\n" +
+	"AZ-AGM\ten=Ağdam\tfr=Agdam\tru=Агдамский район\tzh-CN=阿格达姆区\n" +
+	"AZ-AGS\ten=Ağdaş\tfr=Agdas\tru=Агдашаский район\tzh-CN=阿格达什区\n" +
+	"AZ-AGU\ten=Ağsu\tfr=Agsu\tru=Ахсуйский район\tzh-CN=阿赫苏区\n" +
+	"AZ-AST\ten=Astara\tru=Астаринский район\tzh-CN=阿斯塔拉区\n" +
+	"AZ-BA\ten=Bakı\tfr=Bakou\tja=バクー\tru=Баку\tzh-CN=巴库\n" +
+	"AZ-BAB\ten=Babək\tfr=Babak\tru=Бабекский район\tzh-CN=巴贝克区\n" +
+	"AZ-BAL\ten=Balakən\tfr=Balakan\tru=Белоканский район\tzh-CN=巴拉肯区\n" +
+	"AZ-BAR\ten=Bərdə\tfr=Barda\tru=Бардинский район\n" +
+	"AZ-BEY\ten=Beyləqan\tfr=Beylaqan\tru=Бейлаганский район\n" +
+	"AZ-BIL\ten=Biləsuvar\tfr=Bilasuvar\tru=Билясуварский район\n" +
+	"AZ-CAB\ten=Cəbrayıl\tfr=Jabrayil\tru=Джебраильский район\n" +
+	"AZ-CAL\ten=Cəlilabad\tru=Джалилабадский район\n" +
+	"AZ-CUL\tde=Julfa\ten=Culfa\tfr=Julfa\tru=Джульфинский район\n" +
+	"AZ-DAS\ten=Daşkəsən\tfr=Daskasan\tru=Дашкесанский район\n" +
+	"AZ-FUZ\ten=Füzuli\tru=Фузулинский район\n" +
+	"AZ-GA\ten=Gəncə\tfr=Gandja\tru=Гянджа\n" +
+	"AZ-GAD\ten=Gədəbəy\tfr=Gadabay\tru=Кедабекский район\n" +
+	"AZ-GOR\ten=Goranboy\tru=Геранбойский район\n" +
+	"AZ-GOY\ten=Göyçay\tfr=Göycay\tru=Геокчайский район\n" +
+	"AZ-GYG\ten=Göygöl\tru=Гёйгёльский район\n" +
+	"AZ-HAC\ten=Hacıqabul\tfr=Hajigabul\tru=Аджикабульский район\n" +
+	"AZ-IMI\ten=İmişli\tfr=Imisli\tru=Имишлинский район\n" +
+	"AZ-ISM\ten=İsmayıllı\tfr=Ismayilli\tru=Исмаиллинский район\n" +
+	"AZ-KAL\ten=Kəlbəcər\tfr=Kalbacar\tru=Кельбаджарский район\n" +
+	"AZ-KAN\tde=Kengerli\ten=Kǝngǝrli\tfr=Kangarli\tru=Кенгерлинский район\n" +
+	"AZ-KUR\ten=Kürdəmir\tfr=Kürdamir\tru=Кюрдамирский район\n" +
+	"AZ-LA\ten=Lənkəran\tfr=Lankaran\tru=Ленкорань\n" +
+	"AZ-LAC\ten=Laçın\tfr=Lacın\tru=Лачинский район\n" +
+	"AZ-LAN\ten=Lənkəran\tfr=Lankaran\tru=Ленкорань\n" +
+	"AZ-LER\ten=Lerik\tru=Лерикский район\n" +
+	"AZ-MAS\ten=Masallı\tfr=Masalli\tru=Масаллинский район\n" +
+	"AZ-MI\ten=Mingəçevir\tfr=Mingachevir\tru=Мингечаур\n" +
+	"AZ-NA\ten=Naftalan\tru=Нафталан\n" +
+	"AZ-NEF\ten=Neftçala\tfr=Neftchala\tru=Нефтечалинский район\n" +
+	"AZ-NV\tde=Nachitschewan\ten=Naxçıvan\tfr=Nakhitchevan\tru=Нахичевань\tzh-CN=那克赤凡\n" +
+	"AZ-NX\tde=Nachitschewan\ten=Naxçıvan\tfr=Nakhitchevan\tru=Нахичевань\tzh-CN=那克赤凡\n" +
+	"AZ-OGU\ten=Oğuz\tfr=Oguz\tru=Огузский район\n" +
+	"AZ-ORD\ten=Ordubad\tru=Ордубадский район\n" +
+	"AZ-QAB\ten=Qəbələ\tfr=Qabala\tru=Габалинский район\n" +
+	"AZ-QAX\ten=Qax\tfr=Qakh\tru=Кахский район\n" +
+	"AZ-QAZ\ten=Qazax\tes=Gazaj\tfr=Qazakh\tru=Казахский район\tzh-CN=卡扎赫\n" +
+	"AZ-QBA\ten=Quba\tja=クバ\tru=Губинский район\n" +
+	"AZ-QBI\ten=Qubadlı\tfr=Qubadli\tru=Кубатлинский район\n" +
+	"AZ-QOB\ten=Qobustan\tru=Гобустанский район\n" +
+	"AZ-QUS\ten=Qusar\tru=Кусарский район\n" +
+	"AZ-SA\ten=Şəki\tfr=Chaki\tja=シェキ\tru=Шекинский район\n" +
+	"AZ-SAB\ten=Sabirabad\tru=Сабирабадский район\n" +
+	"AZ-SAD\ten=Sədərək\tfr=Sadarak\tru=Садаракский район\n" +
+	"AZ-SAH\ten=Şahbuz\tfr=Shakhbuz\tru=Шахбузский район\n" +
+	"AZ-SAK\ten=Şəki\tfr=Chaki\tja=シェキ\tru=Шекинский район\n" +
+	"AZ-SAL\ten=Salyan\tja=サルヤン\tru=Сальянский район\tzh-CN=萨利亚内\n" +
+	"AZ-SAR\ten=Şərur\tfr=Sharur\tru=Шарурский район\n" +
+	"AZ-SAT\ten=Saatlı\tfr=Saatli\tru=Саатлинский район\n" +
+	"AZ-SBN\ten=Şabran\tfr=Chabran\tru=Шабранский район\n" +
+	"AZ-SIY\ten=Siyəzən\tfr=Siyazan\tru=Сиазанский район\n" +
+	"AZ-SKR\ten=Şəmkir\tfr=Shemkir\tru=Шамкирский район\n" +
+	"AZ-SM\ten=Sumqayıt\tfr=Sumqayit\tja=スムカイト\tru=Сумгаит\tzh-CN=苏姆盖特\n" +
+	"AZ-SMI\ten=Şamaxı\tfr=Shamaxi\tru=Шемахинский район\n" +
+	"AZ-SMX\ten=Samux\tfr=Samukh\tru=Самухский район\n" +
+	"AZ-SR\ten=Şirvan\tfr=Chirvan\tru=Ширван\n" +
+	"AZ-SUS\ten=Şuşa\tfr=Susa\tru=Шушинский район\n" +
+	"AZ-TAR\ten=Tərtər\tfr=Tartar\tru=Тертерский район\n" +
+	"AZ-TOV\ten=Tovuz\tru=Товузский район\n" +
+	"AZ-UCA\ten=Ucar\tfr=Ujar\tru=Уджарский район\n" +
+	"AZ-XA\ten=Xankəndi\tfr=Xankandi\tru=Ханкенди\n" +
+	"AZ-XAC\ten=Xaçmaz\tfr=Khachmaz\tru=Хачмазский район\n" +
+	"AZ-XCI\ten=Xocalı\tfr=Khojali\tru=Ходжалинский район\n" +
+	"AZ-XIZ\ten=Xızı\tfr=Xizi\tru=Хызынский район\n" +
+	"AZ-XVD\ten=Xocavənd\tfr=Khojavend\tru=Ходжаведский район\n" +
+	"AZ-YAR\ten=Yardımlı\tfr=Yardymli\tru=Ярдымлинский район\n" +
+	"AZ-YE\ten=Yevlax\tfr=Yevlakh\tru=Евлахский район\n" +
+	"AZ-YEV\ten=Yevlax\tfr=Yevlakh\tru=Евлахский район\n" +
+	"AZ-ZAN\ten=Zəngilan\tfr=Zangilan\tru=Зангиланский район\n" +
+	"AZ-ZAQ\ten=Zaqatala\tru=Закаталинский район\n" +
+	"AZ-ZAR\ten=Zərdab\tfr=Zardab\tru=Зардабский район\n" +
+	"BA-BIH\tde=Föderation Bosnien und Herzegowina\ten=Federacija Bosne i Hercegovine\tfr=Fédération de Bosnie et Herzégovine\tja=ボスニアヘルツェゴビナ連邦\tru=Федерация Боснии и Герцеговины\n" +
+	"BA-BRC\tde=Brčko-Distrikt\ten=Brčko distrikt\tfr=District de Brčko\tru=Округ Брчко\n" +
+	"BA-SRP\tde=Serbische Republik\ten=Republika Srpska\tfr=République serbe de Bosnie\tja=スルプスカ共和国\tru=Республика Сербская\tzh-CN=塞族共和国\n" +
+	"BB-01\ten=Christ Church\tru=Крайст Чёрч\n" +
+	"BB-02\ten=Saint Andrew\tfr=Saint-Andrew\tru=Сент Эндрю\tzh-CN=圣安德鲁斯\n" +
+	"BB-03\ten=Saint George\tfr=Saint-George\tja=セントジョージ\tru=Сент-Джордж\tzh-CN=圣乔治\n" +
+	"BB-04\ten=Saint James\tfr=Saint-James\tru=Сент Джеймс\tzh-CN=圣詹姆斯\n" +
+	"BB-05\ten=Saint John\tfr=Saint-John\tja=セントジョン\tru=Сент-Джон\tzh-CN=圣约翰\n" +
+	"BB-06\ten=Saint Joseph\tfr=Saint-Joseph\tru=Сент Джозеф\tzh-CN=圣约瑟\n" +
+	"BB-07\ten=Saint Lucy\tfr=Saint-Lucy\tru=Сент Лючи\tzh-CN=圣露西\n" +
+	"BB-08\ten=Saint Michael\tfr=Saint-Michael\tru=Сент Мишель\tzh-CN=圣麦可\n" +
+	"BB-09\ten=Saint Peter\tfr=Saint-Peter\tja=セントピーター\tru=Сент-Питер\tzh-CN=圣彼得\n" +
+	"BB-10\ten=Saint Philip\tfr=Saint-Philip\tja=セントフィリップ\tru=Сент-Филип\tzh-CN=圣飞利浦\n" +
+	"BB-11\ten=Saint Thomas\tfr=Saint-Thomas\tru=Сент Томас\tzh-CN=圣托马斯\n" +
+	"BD-01\ten=Bandarban\tja=バンドルボン\tru=Бандарбан\n" +
+	"BD-02\ten=Barguna\tfr=Laguna\tru=Баргуна\n" +
+	"BD-03\ten=Bogura\tru=Богра\n" +
+	"BD-04\ten=Brahmanbaria\tru=Брахманбария\n" +
+	"BD-05\ten=Bagerhat\tja=バゲルハット\tru=Багерхат\n" +
+	"BD-06\ten=Barishal\tfr=Barisal\tru=Барисал\n" +
+	"BD-07\ten=Bhola\tru=Бхола\n" +
+	"BD-08\ten=Cumilla\tfr=Comilla\tru=Комилла\n" +
+	"BD-09\ten=Chandpur\tru=Чандпур\n" +
+	"BD-10\ten=Chattogram\tfr=Chittagong\tru=Читтагонг\n" +
+	"BD-11\ten=Cox's Bazar\tja=コックスバザール\tru=Кокс-Базар\n" +
+	"BD-12\ten=Chuadanga\tja=チュアダンガ\tru=Чуаданга\n" +
+	"BD-13\ten=Dhaka\tfr=Dacca\tja=ダッカ\tru=Дакка\n" +
+	"BD-14\ten=Dinajpur\tja=ディナジプル\tru=Динаджпур\n" +
+	"BD-15\ten=Faridpur\tja=ファリドプル\tru=Фаридпур\n" +
+	"BD-16\ten=Feni\tru=Фени\n" +
+	"BD-17\ten=Gopalganj\tru=Гопалгандж\n" +
+	"BD-18\ten=Gazipur\tru=Газипур\n" +
+	"BD-19\ten=Gaibandha\tru=Гайбандха\n" +
+	"BD-20\ten=Habiganj\tru=Хабигандж\n" +
+	"BD-21\ten=Jamalpur\tja=ジャマールプル\tru=Джамалпур\n" +
+	"BD-22\ten=Jashore\tfr=Jessore\tru=Джессор\n" +
+	"BD-23\ten=Jhenaidah\tru=Дженайда\n" +
+	"BD-24\ten=Joypurhat\tfr=Jaipurhat\tru=Джайпурхат\n" +
+	"BD-25\ten=Jhalakathi\tfr=Jhalakati\tru=Джалокати\n" +
+	"BD-26\ten=Kishoreganj\tru=Кишоргандж\n" +
+	"BD-27\ten=Khulna\tja=クールナ\tru=Кхулна\n" +
+	"BD-28\ten=Kurigram\tru=Куриграм\n" +
+	"BD-29\ten=Khagrachhari\tfr=Khagrachari\tru=Кхаграчхари\n" +
+	"BD-30\ten=Kushtia\tja=クシュティア\tru=Куштия\n" +
+	"BD-31\ten=Lakshmipur\tru=Лакшмипур\n" +
+	"BD-32\ten=Lalmonirhat\tru=Лалмонирхат\n" +
+	"BD-33\ten=Manikganj\tru=Маникгандж\n" +
+	"BD-34\ten=Mymensingh\tru=Маймансингх\n" +
+	"BD-35\ten=Munshiganj\tru=Муншигандж\n" +
+	"BD-36\ten=Madaripur\tru=Мадарипур\n" +
+	"BD-37\ten=Magura\tfr=Fgura\tru=Магура\n" +
+	"BD-38\ten=Moulvibazar\tru=Маулвибазар\n" +
+	"BD-39\ten=Meherpur\tru=Мехерпур\n" +
+	"BD-40\ten=Narayanganj\tru=Нараянгандж\n" +
+	"BD-41\ten=Netrakona\tru=Нетрокона\n" +
+	"BD-42\ten=Narsingdi\tru=Нарсингди\n" +
+	"BD-43\ten=Narail\tfr=Nara\tru=Нарайл\n" +
+	"BD-44\ten=Natore\tru=Натор\n" +
+	"BD-45\ten=Chapai Nawabganj\tfr=Nawabganj\tru=Навабгандж\n" +
+	"BD-46\ten=Nilphamari\tru=Нилпхамари\n" +
+	"BD-47\ten=Noakhali\tja=ノアカリ\tru=Ноакхали\n" +
+	"BD-48\ten=Naogaon\tru=Наогаон\n" +
+	"BD-49\ten=Pabna\tru=Пабна\n" +
+	"BD-50\ten=Pirojpur\tru=Пироджпур\n" +
+	"BD-51\ten=Patuakhali\tja=パトゥアカリ\tru=Патуакхали\n" +
+	"BD-52\ten=Panchagarh\tru=Панчагарх\n" +
+	"BD-53\ten=Rajbari\tru=Раджбари\n" +
+	"BD-54\ten=Rajshahi\tja=ラージシャヒ\tru=Раджшахи\n" +
+	"BD-55\ten=Rangpur\tja=ラングプル\tru=Рангпур\n" +
+	"BD-56\ten=Rangamati\tru=Рангамати\n" +
+	"BD-57\ten=Sherpur\tru=Шерпур\n" +
+	"BD-58\ten=Satkhira\tru=Саткхира\n" +
+	"BD-59\ten=Sirajganj\tru=Сираджгандж\n" +
+	"BD-60\ten=Sylhet\tja=シルヘット\tru=Силхет\n" +
+	"BD-61\ten=Sunamganj\tru=Сунамгандж\n" +
+	"BD-62\ten=Shariatpur\tru=Шариатпур\n" +
+	"BD-63\ten=Tangail\tfr=Tanga\tja=タンガイル\tru=Тангайл\n" +
+	"BD-64\ten=Thakurgaon\tru=Тхакургаон\n" +
+	"BD-A\ten=Barishal\tfr=Barisal\tru=Барисал\n" +
+	"BD-B\ten=Chattogram\tfr=Chittagong\tru=Читтагонг\n" +
+	"BD-C\ten=Dhaka\tfr=Dacca\tja=ダッカ\tru=Дакка\n" +
+	"BD-D\ten=Khulna\tja=クールナ\tru=Кхулна\n" +
+	"BD-E\ten=Rajshahi\tja=ラージシャヒ\tru=Раджшахи\n" +
+	"BD-F\ten=Rangpur\tja=ラングプル\tru=Рангпур\n" +
+	"BD-G\ten=Sylhet\tja=シルヘット\tru=Силхет\n" +
+	"BD-H\ten=Mymensingh\tru=Маймансингх\n" +
+	"BE-BRU\ten=Brussels Hoofdstedelijk Gewest\tfr=Région de Bruxelles-Capitale\tru=Брюссельский столичный регион\n" +
+	"BE-VAN\ten=Antwerpen\tfr=Anvers\tja=アントワープ\tru=Антверпен\tzh-CN=安特卫普省\n" +
+	"BE-VBR\tde=Flämisch-Brabant\ten=Vlaams-Brabant\tfr=Brabant-Flamand\tja=フラームスブラバント\tru=Фламандский Брабант\tzh-CN=佛兰芒布拉班特省\n" +
+	"BE-VLG\ten=Vlaams Gewest\tfr=Flamande, Région\tru=Фламандский регион\n" +
+	"BE-VLI\ten=Limburg\tfr=Limourg\tja=リンブルフ\tru=Лимбург\n" +
+	"BE-VOV\tde=Ostflandern\ten=Oost-Vlaanderen\tfr=Flandre-Orientale\tja=オーストフラーンデレン\tru=Восточная Фландрия\tzh-CN=东佛兰德省\n" +
+	"BE-VWV\tde=Westflandern\ten=West-Vlaanderen\tfr=Flandre-Occidentale\tja=ウエストフラーンデレン\tru=Западная Фландрия\tzh-CN=西佛兰德省\n" +
+	"BE-WAL\tde=Wallonne, Région\ten=wallonne, Région\tja=ワロン\tru=Валлония\tzh-CN=瓦隆大区\n" +
+	"BE-WBR\tde=Wallonisch-Brabant\ten=Brabant wallon\tja=ブラバントワロン\tru=Валлонский Брабант\n" +
+	"BE-WHT\tde=Hennegau\ten=Hainaut\tja=エノー\tru=Эно\tzh-CN=埃诺省\n" +
+	"BE-WLG\tde=Lüttich\ten=Liège\tja=リエージュ\tru=Льеж\n" +
+	"BE-WLX\tde=Luxemburg\ten=Luxembourg\tja=ルクセンブルク\tpt-BR=Luxemburgo\tru=Люксембург\tzh-CN=卢森堡\n" +
+	"BE-WNA\ten=Namur\tja=ナミュール\tru=Намюр\tzh-CN=那慕尔省\n" +
+	"BF-01\ten=Boucle du Mouhoun\tru=Букле-ду-Мухун\n" +
+	"BF-02\ten=Cascades\tru=Каскады\n" +
+	"BF-03\ten=Centre\tru=Центральная\tzh-CN=中部\n" +
+	"BF-04\ten=Centre-Est\n" +
+	"BF-05\ten=Centre-Nord\n" +
+	"BF-06\ten=Centre-Ouest\n" +
+	"BF-07\ten=Centre-Sud\n" +
+	"BF-08\ten=Est\tzh-CN=东部省\n" +
+	"BF-09\ten=Hauts-Bassins\n" +
+	"BF-10\ten=Nord\tzh-CN=北部省\n" +
+	"BF-11\ten=Plateau-Central\n" +
+	"BF-12\ten=Sahel\n" +
+	"BF-13\ten=Sud-Ouest\n" +
+	"BF-BAL\ten=Balé\tja=バレ\tru=Бале\tzh-CN=巴雷\n" +
+	"BF-BAM\ten=Bam\tja=バム\tru=Бам\tzh-CN=巴姆\n" +
+	"BF-BAN\ten=Banwa\tja=バンワ\tru=Банва\tzh-CN=巴瓦\n" +
+	"BF-BAZ\ten=Bazèga\tja=バゼーガ\tru=Базега\tzh-CN=巴泽加\n" +
+	"BF-BGR\ten=Bougouriba\tja=ブグリバ\tru=Бугуриба\tzh-CN=布古里巴\n" +
+	"BF-BLG\ten=Boulgou\tja=ブルグ\tru=Бульгу\tzh-CN=布尔古\n" +
+	"BF-BLK\ten=Boulkiemdé\tja=ブルキエムデ\tru=Булькиемде\tzh-CN=布尔基恩德\n" +
+	"BF-COM\ten=Comoé\tja=コモエ\tru=Комоэ\tzh-CN=科莫埃\n" +
+	"BF-GAN\ten=Ganzourgou\tja=ガンズルグ\tru=Ганзургу\tzh-CN=冈祖尔古\n" +
+	"BF-GNA\ten=Gnagna\tja=グナグナ\tru=Ньянья\tzh-CN=尼亚尼亚\n" +
+	"BF-GOU\ten=Gourma\tja=グルマ\tru=Гурма\tzh-CN=古尔马\n" +
+	"BF-HOU\ten=Houet\tja=フエ\tru=Уэ\tzh-CN=乌埃\n" +
+	"BF-IOB\ten=Ioba\tja=イオバ\tru=Иоба\tzh-CN=伊奥巴\n" +
+	"BF-KAD\ten=Kadiogo\tja=カディオゴ\tru=Кадиого\tzh-CN=卡焦戈\n" +
+	"BF-KEN\ten=Kénédougou\tja=ケネドゥグ\tru=Кенедугу\n" +
+	"BF-KMD\ten=Komondjari\tfr=Komandjoari\tja=コモンジャリ\tru=Комонджари\tzh-CN=科蒙加里\n" +
+	"BF-KMP\ten=Kompienga\tja=コンピエンガ\tru=Компьенга\tzh-CN=孔皮恩加\n" +
+	"BF-KOP\ten=Koulpélogo\tja=クルペロゴ\tru=Кульпелого\n" +
+	"BF-KOS\ten=Kossi\tja=コッシ\tru=Коси\tzh-CN=孔西\n" +
+	"BF-KOT\ten=Kouritenga\tru=Куритенга\tzh-CN=库里滕加\n" +
+	"BF-KOW\ten=Kourwéogo\tja=クルウェオゴ\tru=Курвеого\n" +
+	"BF-LER\ten=Léraba\tja=レラバ\tru=Лераба\n" +
+	"BF-LOR\ten=Loroum\tja=ロルム\tru=Лорум\tzh-CN=罗卢姆\n" +
+	"BF-MOU\ten=Mouhoun\tja=ムフン\tru=Мухун\tzh-CN=穆翁\n" +
+	"BF-NAM\ten=Namentenga\tja=ナメテンガ\tru=Наментенга\tzh-CN=纳门滕加\n" +
+	"BF-NAO\ten=Nahouri\tru=Нахури\n" +
+	"BF-NAY\ten=Nayala\tja=ナヤラ\tru=Наяла\tzh-CN=纳亚拉\n" +
+	"BF-NOU\ten=Noumbiel\tja=ヌンビエル\tru=Нумбиель\tzh-CN=努姆比埃尔\n" +
+	"BF-OUB\ten=Oubritenga\tja=ウブリテンガ\tru=Убритенга\tzh-CN=乌布里滕加\n" +
+	"BF-OUD\ten=Oudalan\tja=ウダラン\tru=Удалан\tzh-CN=乌达兰\n" +
+	"BF-PAS\ten=Passoré\tja=パッソレ\tru=Пасоре\n" +
+	"BF-PON\ten=Poni\tja=ポニ\tru=Пони\tzh-CN=波尼\n" +
+	"BF-SEN\ten=Séno\tja=セノ\tru=Сено\n" +
+	"BF-SIS\ten=Sissili\tja=シッシリ\tru=Сисили\n" +
+	"BF-SMT\ten=Sanmatenga\tja=サンマテンガ\tru=Санматенга\tzh-CN=桑马滕加\n" +
+	"BF-SNG\ten=Sanguié\tja=サンギエ\tru=Сангие\n" +
+	"BF-SOM\ten=Soum\tja=スム\tru=Сум\tzh-CN=苏姆\n" +
+	"BF-SOR\ten=Sourou\tja=スル\tru=Суру\tzh-CN=苏鲁\n" +
+	"BF-TAP\ten=Tapoa\tja=タポア\tru=Тапоа\tzh-CN=塔波阿\n" +
+	"BF-TUI\ten=Tuy\tru=Тюи\n" +
+	"BF-YAG\ten=Yagha\tja=ヤガ\tru=Яга\tzh-CN=亚加\n" +
+	"BF-YAT\ten=Yatenga\tja=ヤテンガ\tru=Ятенга\tzh-CN=亚滕加\n" +
+	"BF-ZIR\ten=Ziro\tja=ジロ\tru=Зиро\tzh-CN=济罗\n" +
+	"BF-ZON\ten=Zondoma\tja=ゾンドマ\tru=Зондома\tzh-CN=宗多马\n" +
+	"BF-ZOU\ten=Zoundwéogo\tfr=Zoundéeogo\tja=ズンドウェオゴ\tru=Зундвеого\n" +
+	"BG-01\tde=Blagoewgrad\ten=Blagoevgrad\tja=ブラゴエフグラード\tru=Благоевград\tzh-CN=布拉格耶夫格勒\n" +
+	"BG-02\ten=Burgas\tfr=Bourgas\tja=ブルガス\tru=Бургас\tzh-CN=布尔加斯\n" +
+	"BG-03\tde=Warna\ten=Varna\tja=ヴァルナ\tru=Варна\tzh-CN=瓦尔纳\n" +
+	"BG-04\tde=Weliko Tarnowo\ten=Veliko Tarnovo\tja=ヴェリコトゥルノヴォ\tru=Велико-Тырново\tzh-CN=大特尔诺沃\n" +
+	"BG-05\tde=Widin\ten=Vidin\tja=ヴィディン\tru=Видин\tzh-CN=维丁\n" +
+	"BG-06\tde=Wraza\ten=Vratsa\tja=ヴラツァ\tru=Враца\tzh-CN=弗拉察\n" +
+	"BG-07\tde=Gabrowo\ten=Gabrovo\tru=Габрово\tzh-CN=加布罗沃\n" +
+	"BG-08\tde=Dobritsch\ten=Dobrich\tfr=Dobritch\tja=ドブリチ\tru=Добрич\tzh-CN=多布里奇\n" +
+	"BG-09\tde=Kardschali\ten=Kardzhali\tfr=Kardjali\tru=Кырджали\tzh-CN=克尔贾利州\n" +
+	"BG-10\tde=Kjustendil\ten=Kyustendil\tfr=Kyoustendil\tru=Кюстендил\tzh-CN=丘斯滕迪尔\n" +
+	"BG-11\tde=Lowetsch\ten=Lovech\tfr=Lovetch\tja=ロベチ\tru=Ловеч\tzh-CN=洛维奇\n" +
+	"BG-12\ten=Montana\tja=モンタナ\tru=Монтана\tzh-CN=蒙塔纳\n" +
+	"BG-13\tde=Pasardschik\ten=Pazardzhik\tfr=Pazardjik\tru=Пазарджик\tzh-CN=帕扎尔吉克\n" +
+	"BG-14\ten=Pernik\tja=ペルニク\tru=Перник\tzh-CN=贝尔尼克\n" +
+	"BG-15\tde=Plewen\ten=Pleven\tja=プレヴェン\tru=Плевен\tzh-CN=普列文\n" +
+	"BG-16\tde=Plowdiw\ten=Plovdiv\tja=プロヴディフ\tru=Пловдив\tzh-CN=普罗夫迪夫\n" +
+	"BG-17\tde=Rasgrad\ten=Razgrad\tru=Разград\tzh-CN=拉兹格勒\n" +
+	"BG-18\tde=Russe\ten=Ruse\tfr=Roussé\tja=ルーセ\tzh-CN=鲁塞\n" +
+	"BG-19\ten=Silistra\tru=Силистра\tzh-CN=锡利斯特拉\n" +
+	"BG-20\tde=Sliwen\ten=Sliven\tja=スリヴェン\tru=Сливен\tzh-CN=斯利文\n" +
+	"BG-21\tde=Smoljan\ten=Smolyan\tru=Смолян\tzh-CN=斯莫梁\n" +
+	"BG-22\ten=Sofia (stolitsa)\tfr=Sofia-ville\n" +
+	"BG-23\ten=Sofia\tja=ソフィア\tru=София\tzh-CN=索菲亚州\n" +
+	"BG-24\tde=Stara Sagora\ten=Stara Zagora\tja=スタラザゴラ\tru=Стара-Загора\tzh-CN=旧扎戈拉\n" +
+	"BG-25\tde=Targowischte\ten=Targovishte\tfr=Targovichte\tru=Тырговиште\tzh-CN=特尔戈维什特\n" +
+	"BG-26\tde=Chaskowo\ten=Haskovo\tfr=Khaskovo\tja=ハスコヴォ\tru=Хасково\tzh-CN=哈斯科沃\n" +
+	"BG-27\tde=Schumen\ten=Shumen\tfr=Choumen\tja=シューメン\tru=Шумен\tzh-CN=舒门\n" +
+	"BG-28\tde=Jambol\ten=Yambol\tru=Ямбол\tzh-CN=扬博尔\n" +
+	"BH-13\ten=Al ‘Āşimah\n" +
+	"BH-14\ten=Al Janūbīyah\tfr=Al Janubiyah\tzh-CN=南方省\n" +
+	"BH-15\ten=Al Muḩarraq\tfr=Al Muharraq\tzh-CN=穆哈拉克省\n" +
+	"BH-17\tde=asch-Schamaliyya\ten=Ash Shamālīyah\tfr=Nord\tzh-CN=北方省\n" +
+	"BI-BB\ten=Bubanza\tja=ブバンザ\tzh-CN=布班扎\n" +
+	"BI-BL\ten=Bujumbura Rural\tru=Бужумбура-Рураль\n" +
+	"BI-BM\ten=Bujumbura Mairie\tru=Бужумбура-Мери\n" +
+	"BI-BR\ten=Bururi\tja=ブルリ\tru=Бурури\tzh-CN=布鲁里\n" +
+	"BI-CA\ten=Cankuzo\tja=カンクゾ\tru=Чанкузо\tzh-CN=坎库佐\n" +
+	"BI-CI\ten=Cibitoke\tja=チビトケ\tru=Чибитоке\tzh-CN=锡比托凯\n" +
+	"BI-GI\ten=Gitega\tja=ギテガ\tru=Гитега\tzh-CN=基特加\n" +
+	"BI-KI\ten=Kirundo\tja=キルンド\tru=Кирундо\n" +
+	"BI-KR\ten=Karuzi\tru=Карузи\tzh-CN=卡鲁济\n" +
+	"BI-KY\ten=Kayanza\tja=カヤンザ\tru=Каянза\tzh-CN=卡扬扎\n" +
+	"BI-MA\ten=Makamba\tja=マカンバ\tzh-CN=马坎巴\n" +
+	"BI-MU\ten=Muramvya\tja=ムランビア\tru=Мурамвья\tzh-CN=穆拉姆维亚\n" +
+	"BI-MW\ten=Mwaro\tru=Мваро\tzh-CN=穆瓦洛\n" +
+	"BI-MY\ten=Muyinga\tru=Муйинга\n" +
+	"BI-NG\ten=Ngozi\tja=ヌゴジ\tzh-CN=恩戈齐\n" +
+	"BI-RM\ten=Rumonge\tru=Румонге\n" +
+	"BI-RT\ten=Rutana\tru=Рутана\tzh-CN=鲁塔纳\n" +
+	"BI-RY\ten=Ruyigi\tja=ルイギ\tru=Руйиги\tzh-CN=鲁伊吉\n" +
+	"BJ-AK\ten=Atacora\n" +
+	"BJ-AL\ten=Alibori\tja=アリボリ\tzh-CN=阿黎博里省\n" +
+	"BJ-AQ\ten=Atlantique\tja=アトランティック\tzh-CN=大西洋省\n" +
+	"BJ-BO\ten=Borgou\tja=ボルゴー\tzh-CN=博尔古省\n" +
+	"BJ-CO\ten=Collines\tja=コリネ\tzh-CN=丘陵省\n" +
+	"BJ-DO\ten=Donga\tja=ドンガ\tzh-CN=峡谷省\n" +
+	"BJ-KO\ten=Couffo\n" +
+	"BJ-LI\ten=Littoral\tfr=Litoral\tja=リトラル\tru=Литораль\tzh-CN=滨海省\n" +
+	"BJ-MO\ten=Mono\tja=モノ\tru=Моно\tzh-CN=莫诺省\n" +
+	"BJ-OU\ten=Ouémé\tja=ウェメ\tzh-CN=韦梅省\n" +
+	"BJ-PL\ten=Plateau\tja=プラトー\tru=Плато\tzh-CN=高原省\n" +
+	"BJ-ZO\ten=Zou\tja=ズー\tzh-CN=祖省\n" +
+	"BN-BE\ten=Belait\tja=ブライト\tzh-CN=马来奕\n" +
+	"BN-BM\ten=Brunei-Muara\tja=ブルネイムアラ\tru=Бруней-Муара\tzh-CN=穆阿拉\n" +
+	"BN-TE\ten=Temburong\tja=トゥンブロン\tru=Тембуронг\tzh-CN=腾布荣\n" +
+	"BN-TU\ten=Tutong\tja=トゥトン\tzh-CN=都东\n" +
+	"BO-B\ten=El Beni\tfr=Beni\tzh-CN=贝尼省\n" +
+	"BO-C\ten=Cochabamba\tja=コチャバンバ\tru=Кочабамба\tzh-CN=科恰班巴省\n" +
+	"BO-H\ten=Chuquisaca\tru=Чукисака\tzh-CN=丘基萨卡省\n" +
+	"BO-L\ten=La Paz\tja=ラパス\tru=Ла-Пас\tzh-CN=拉巴斯省\n" +
+	"BO-N\ten=Pando\tja=パンド\tru=Пандо\tzh-CN=潘多省\n" +
+	"BO-O\ten=Oruro\tja=オルロ\tzh-CN=奥鲁罗省\n" +
+	"BO-P\ten=Potosí\tfr=Potosi\tja=ポトシ\tru=Потоси\tzh-CN=波托西省\n" +
+	"BO-S\ten=Santa Cruz\tja=サンタクルス\tru=Санта-Крус\tzh-CN=圣克鲁斯省\n" +
+	"BO-T\ten=Tarija\tja=タリハ\tru=Тариха\tzh-CN=塔里哈省\n" +
+	"BQ-BO\ten=Bonaire\tru=Бонайре\n" +
+	"BQ-SA\ten=Saba\tru=Саба\n" +
+	"BQ-SE\ten=Sint Eustatius\tfr=Saint Eustache\tru=Синт-Эстатиус\n" +
+	"BR-AC\ten=Acre\tja=アクレ\tru=Акр\tzh-CN=阿克里\n" +
+	"BR-AL\ten=Alagoas\tja=アラゴアス\tru=Алагоас\tzh-CN=阿拉戈阿斯\n" +
+	"BR-AM\ten=Amazonas\tfr=Amazone\tja=アマゾナス\tzh-CN=亚马孙州\n" +
+	"BR-AP\ten=Amapá\tfr=Amapa\tja=アマパ\tru=Амапа\n" +
+	"BR-BA\ten=Bahia\tja=バイア\tru=Баия\tzh-CN=巴伊亚\n" +
+	"BR-CE\ten=Ceará\tfr=Ceara\tja=セアラ\tru=Сеара\n" +
+	"BR-DF\ten=Distrito Federal\tfr=District fédéral\tzh-CN=首都特区\n" +
+	"BR-ES\ten=Espírito Santo\tes=Espíritu Santo\tfr=Espirito Santo\tja=エスピリトサント\tru=Эспириту-Санту\n" +
+	"BR-GO\ten=Goiás\tfr=Goias\tja=ゴイアス\tru=Гояс\n" +
+	"BR-MA\ten=Maranhão\tes=Marañón\tfr=Maranhao\tja=マラニョン\tru=Мараньян\n" +
+	"BR-MG\ten=Minas Gerais\tja=ミナスジェライス\tru=Минас-Жерайс\tzh-CN=米纳斯吉拉斯\n" +
+	"BR-MS\ten=Mato Grosso do Sul\tja=マトグロッソドスル\tru=Мату-Гросу-ду-Сул\tzh-CN=南马托格罗索\n" +
+	"BR-MT\ten=Mato Grosso\tja=マトグロッソ\tru=Мату-Гросу\tzh-CN=马托格罗索\n" +
+	"BR-PA\ten=Pará\tfr=Para\tja=パラ\tru=Пара\n" +
+	"BR-PB\ten=Paraíba\tfr=Paraiba\tja=パライバ\tru=Параиба\n" +
+	"BR-PE\ten=Pernambuco\tfr=Pernambouc\tja=ペルナンブコ\tru=Пернамбуку\tzh-CN=伯南布哥\n" +
+	"BR-PI\ten=Piauí\tfr=Piaui\tja=ピアウイ\tru=Пиауи\n" +
+	"BR-PR\ten=Paraná\tfr=Parana\tja=パラナ\tru=Парана\n" +
+	"BR-RJ\ten=Rio de Janeiro\tja=リオデジャネイロ\tru=Рио-де-Жанейро\tzh-CN=里约热内卢\n" +
+	"BR-RN\ten=Rio Grande do Norte\tja=リオグランデドノルテ\tru=Риу-Гранди-ду-Норти\tzh-CN=北里约格朗德\n" +
+	"BR-RO\ten=Rondônia\tfr=Rondonia\tja=ロンドニア\tru=Рондония\n" +
+	"BR-RR\ten=Roraima\tja=ロライマ\tru=Рорайма\tzh-CN=罗赖马\n" +
+	"BR-RS\ten=Rio Grande do Sul\tja=リオグランデドスル\tru=Риу-Гранди-ду-Сул\tzh-CN=南里约格朗德\n" +
+	"BR-SC\ten=Santa Catarina\tfr=Santa-Catarina\tja=サンタカタリナ\tru=Санта Катарина\n" +
+	"BR-SE\ten=Sergipe\tja=セルジッペ\tru=Сержипи\tzh-CN=塞尔希培\n" +
+	"BR-SP\ten=São Paulo\tfr=Sôo Paulo\tja=サンパウロ\tru=Сан-Паулу\n" +
+	"BR-TO\ten=Tocantins\tja=トカンチンス\tru=Токантинс\n" +
+	"BS-AK\ten=Acklins\tja=アクリンズ島\tru=Аклинс\n" +
+	"BS-BI\ten=Bimini\tru=Бимини\n" +
+	"BS-BP\ten=Black Point\n" +
+	"BS-BY\ten=Berry Islands\tfr=Îles Berry\n" +
+	"BS-CE\ten=Central Eleuthera\tfr=Eleuthera Central\n" +
+	"BS-CI\ten=Cat Island\tja=キャット島\tzh-CN=卡特岛\n" +
+	"BS-CK\tde=Crooked Island und Long Cay\ten=Crooked Island and Long Cay\tfr=Île Crooked et Long Cay\n" +
+	"BS-CO\ten=Central Abaco\tfr=Abaco Central\n" +
+	"BS-CS\ten=Central Andros\tfr=Andros central\n" +
+	"BS-EG\ten=East Grand Bahama\tfr=Grand Bahama orientale\n" +
+	"BS-EX\ten=Exuma\tzh-CN=埃克苏马\n" +
+	"BS-FP\tde=Freeport\ten=City of Freeport\tfr=Ville de Freeport\n" +
+	"BS-GC\ten=Grand Cay\n" +
+	"BS-HI\ten=Harbour Island\tzh-CN=哈伯岛\n" +
+	"BS-HT\ten=Hope Town\n" +
+	"BS-IN\ten=Inagua\tja=イナグア島\tru=Инагуа\tzh-CN=伊纳瓜\n" +
+	"BS-LI\ten=Long Island\tja=ロング島\tru=Лонг-Айленд\tzh-CN=长屿\n" +
+	"BS-MC\ten=Mangrove Cay\tru=Мангров-Ки\n" +
+	"BS-MG\ten=Mayaguana\tja=マヤグアナ島\tru=Маягуана\tzh-CN=马亚瓜纳\n" +
+	"BS-MI\ten=Moore's Island\tfr=Île de Moore\n" +
+	"BS-NE\ten=North Eleuthera\tfr=Eleuthera septentrional\n" +
+	"BS-NO\ten=North Abaco\tfr=Abaco septentrional\n" +
+	"BS-NP\ten=New Providence\tru=Нью-Провиденс\n" +
+	"BS-NS\ten=North Andros\tfr=Andros septentrional\n" +
+	"BS-RC\ten=Rum Cay\tru=Рам-Ки\n" +
+	"BS-RI\ten=Ragged Island\tzh-CN=拉吉德岛\n" +
+	"BS-SA\ten=South Andros\tfr=Andros méridional\n" +
+	"BS-SE\ten=South Eleuthera\tfr=Eleuthera méridional\n" +
+	"BS-SO\ten=South Abaco\tfr=Abaco méridional\tru=Южный Абако\n" +
+	"BS-SS\ten=San Salvador\tja=サンサルバドル\tru=Сан-Сальвадор\tzh-CN=圣萨尔瓦多省\n" +
+	"BS-SW\ten=Spanish Wells\tru=Спэниш-Уэллс\n" +
+	"BS-WG\ten=West Grand Bahama\tfr=Grand Bahama occidentale\n" +
+	"BT-11\ten=Paro\tja=パロ\tru=Паро\tzh-CN=帕罗宗\n" +
+	"BT-12\tde=Chukha\ten=Chhukha\tfr=Chukha\tja=チュカ\tru=Мебиса\tzh-CN=楚卡宗\n" +
+	"BT-13\ten=Haa\tru=Хас\n" +
+	"BT-14\ten=Samtse\tru=Самце\n" +
+	"BT-15\ten=Thimphu\tja=ティンプー\tru=Тхимпху\tzh-CN=廷布宗\n" +
+	"BT-21\ten=Tsirang\tja=チラン\tzh-CN=奇朗宗\n" +
+	"BT-22\ten=Dagana\tja=タカナ\tzh-CN=达加纳宗\n" +
+	"BT-23\ten=Punakha\tja=プナカ\tru=Пунакха\tzh-CN=普那卡宗\n" +
+	"BT-24\ten=Wangdue Phodrang\tja=ワンドゥポダン\tru=Вангди-Пходранг\tzh-CN=旺杜波德朗宗\n" +
+	"BT-31\ten=Sarpang\tja=サルパン\tru=Сарпанг\tzh-CN=盖莱普宗\n" +
+	"BT-32\ten=Trongsa\tru=Тронгса\tzh-CN=通萨宗\n" +
+	"BT-33\ten=Bumthang\tja=ブムタン\tzh-CN=布姆唐宗\n" +
+	"BT-34\ten=Zhemgang\tja=シェムガン\tru=Жемганг\tzh-CN=谢姆冈宗\n" +
+	"BT-41\ten=Trashigang\tja=タシガン\tru=Трашиганг\tzh-CN=塔希冈宗\n" +
+	"BT-42\ten=Monggar\tfr=Mongar\tja=モンガル\tru=Монгар\tzh-CN=蒙加尔宗\n" +
+	"BT-43\ten=Pema Gatshel\n" +
+	"BT-44\tde=Lhuntse\ten=Lhuentse\tfr=Lhuntse\tru=Лхунце\tzh-CN=伦奇宗\n" +
+	"BT-45\ten=Samdrup Jongkhar\tru=Самдруп-Джонгхар\n" +
+	"BT-GA\ten=Gasa\tja=ガサ\tzh-CN=加萨宗\n" +
+	"BT-TY\ten=Trashi Yangtse\tfr=Trashiyangtse\tja=ヤンツェ\tru=Трашиянгце\tzh-CN=塔希央奇宗\n" +
+	"BW-CE\ten=Central\tja=セントラル\tzh-CN=中部\n" +
+	"BW-CH\ten=Chobe\tru=Чобе\n" +
+	"BW-FR\ten=Francistown\tru=Франсистаун\n" +
+	"BW-GA\ten=Gaborone\tru=Габороне\n" +
+	"BW-GH\ten=Ghanzi\tja=ガンジ\tzh-CN=杭济\n" +
+	"BW-JW\ten=Jwaneng\tru=Джваненг\n" +
+	"BW-KG\ten=Kgalagadi\tja=ガラガティ\tzh-CN=卡拉哈迪\n" +
+	"BW-KL\ten=Kgatleng\tja=ガトレング\tru=Кгатленг\tzh-CN=卡特伦\n" +
+	"BW-KW\ten=Kweneng\tja=クウェネング\tzh-CN=奎嫩\n" +
+	"BW-LO\ten=Lobatse\tru=Лобаце\n" +
+	"BW-NE\tde=Nordost\ten=North East\tfr=Nord-Est\n" +
+	"BW-NW\tde=Nordwest\ten=North West\tfr=Nord-Ouest\n" +
+	"BW-SE\tde=Südost\ten=South East\tfr=Sud-Est\n" +
+	"BW-SO\tde=Süd\ten=Southern\tfr=Sud\n" +
+	"BW-SP\ten=Selibe Phikwe\n" +
+	"BW-ST\ten=Sowa Town\n" +
+	"BY-BR\ten=Bresckaja voblasć\tfr=Voblast de Brest\n" +
+	"BY-HM\ten=Gorod Minsk\tru=Минск\n" +
+	"BY-HO\ten=Gomel'skaja oblast'\tru=Гомельская область\n" +
+	"BY-HR\ten=Grodnenskaja oblast'\tru=Гродненская область\n" +
+	"BY-MA\tde=Mahiliou\ten=Mahilioŭskaja voblasć\tfr=Voblast de Mahiliow\n" +
+	"BY-MI\ten=Minskaja oblast'\tru=Минская область\n" +
+	"BY-VI\tde=Brest\ten=Viciebskaja voblasć\tfr=Voblast de Vitebsk\n" +
+	"BZ-BZ\ten=Belize\tes=Belice\tja=ベリーズ\tru=Белиз\tzh-CN=伯利兹\n" +
+	"BZ-CY\ten=Cayo\tzh-CN=卡约\n" +
+	"BZ-CZL\ten=Corozal\tja=コロサル\tzh-CN=科罗萨尔\n" +
+	"BZ-OW\ten=Orange Walk\tja=オレンジウォーク\tzh-CN=橘园\n" +
+	"BZ-SC\ten=Stann Creek\tzh-CN=斯坦港\n" +
+	"BZ-TOL\ten=Toledo\tja=トレド\tru=Толедо\n" +
+	"CA-AB\ten=Alberta\tja=アルバータ\tru=Альберта\tzh-CN=艾伯塔\n" +
+	"CA-BC\ten=British Columbia\tes=Columbia Británica\tfr=Colombie-Britannique\tja=ブリティッシュコロンビア\tru=Британская Колумбия\tzh-CN=不列颠哥伦比亚\n" +
+	"CA-MB\ten=Manitoba\tja=マニトバ\tru=Манитоба\tzh-CN=马尼托巴\n" +
+	"CA-NB\ten=New Brunswick\tes=Nuevo Brunswick\tfr=Nouveau Brunswick\tja=ニューブランズウィック\tru=Нью-Брансуик\tzh-CN=新不伦瑞克\n" +
+	"CA-NL\tde=Newfoundland und Labrador\ten=Newfoundland and Labrador\tes=Terranova y Labrador\tfr=Terre-Neuve-et-Labrador\tja=ニューファンドランド=ラブラドル\tru=Ньюфаундленд и Лабрадор\tzh-CN=纽芬兰与拉布拉多\n" +
+	"CA-NS\ten=Nova Scotia\tes=Nueva Escocia\tfr=Nouvelle-Écosse\tja=ノヴァスコシア\tru=Новая Шотландия\tzh-CN=新斯科舍\n" +
+	"CA-NT\tde=Nordwest-Territorien\ten=Northwest Territories\tes=Territorios del Noroeste\tfr=Territoires-du-Nord-Ouest\tja=ノースウェストテリトリーズ\tru=Северо-Западные территории\tzh-CN=西北地区\n" +
+	"CA-NU\ten=Nunavut\tja=ヌナブット\tru=Нунавут\tzh-CN=努纳武特\n" +
+	"CA-ON\ten=Ontario\tja=オンタリオ\tru=Онтарио\tzh-CN=安大略省\n" +
+	"CA-PE\ten=Prince Edward Island\tes=Isla del Príncipe Eduardo\tfr=Île-du-Prince Édouard\tja=プリンスエドワードアイランド\tru=Остров Принца Эдуарда\tzh-CN=爱德华王子岛省\n" +
+	"CA-QC\tde=Québec\ten=Quebec\tfr=Québec\tja=ケベック\tru=Квебек\tzh-CN=魁北克省\n" +
+	"CA-SK\ten=Saskatchewan\tja=サスカチェワン\tru=Саскачеван\tzh-CN=萨斯喀彻温\n" +
+	"CA-YT\ten=Yukon\tru=Юкон\n" +
+	"CD-BC\ten=Kongo Central\tru=Центральное Конго\n" +
+	"CD-BU\ten=Bas-Uélé\tru=Нижнее Уэле\n" +
+	"CD-EQ\ten=Équateur\tja=赤道州\tru=Эквадор\tzh-CN=赤道\n" +
+	"CD-HK\ten=Haut-Katanga\n" +
+	"CD-HL\ten=Haut-Lomami\n" +
+	"CD-HU\ten=Haut-Uélé\n" +
+	"CD-IT\ten=Ituri\n" +
+	"CD-KC\ten=Kasaï Central\n" +
+	"CD-KE\ten=Kasaï Oriental\n" +
+	"CD-KG\ten=Kwango\n" +
+	"CD-KL\ten=Kwilu\n" +
+	"CD-KN\ten=Kinshasa\tja=キンシャサ\tzh-CN=金沙萨直辖市\n" +
+	"CD-KS\ten=Kasaï\n" +
+	"CD-LO\ten=Lomami\n" +
+	"CD-LU\ten=Lualaba\n" +
+	"CD-MA\ten=Maniema\tja=マニエマ州\tzh-CN=马尼埃马\n" +
+	"CD-MN\ten=Mai-Ndombe\n" +
+	"CD-MO\ten=Mongala\n" +
+	"CD-NK\ten=Nord-Kivu\tja=北キブ州\tru=Северное Киву\tzh-CN=北基伍\n" +
+	"CD-NU\ten=Nord-Ubangi\tru=Северное Убанги\n" +
+	"CD-SA\ten=Sankuru\n" +
+	"CD-SK\ten=Sud-Kivu\tja=南キブ州\tru=Южное Киву\tzh-CN=南基伍\n" +
+	"CD-SU\ten=Sud-Ubangi\tru=Южное Убанги\n" +
+	"CD-TA\ten=Tanganyika\tru=Тангайл\n" +
+	"CD-TO\ten=Tshopo\tru=Чопо\n" +
+	"CD-TU\ten=Tshuapa\tru=Чуапа\n" +
+	"CF-AC\ten=Ouham\tja=ウハム州\tru=Уам\tzh-CN=瓦姆\n" +
+	"CF-BB\ten=Bamingui-Bangoran\tja=バミンギバンゴラン州\tru=Баминги-Бангоран\tzh-CN=巴明吉-班戈兰\n" +
+	"CF-BGF\ten=Bangui\tja=バンギ\tru=Банги\tzh-CN=班吉直辖市\n" +
+	"CF-BK\ten=Basse-Kotto\tja=バスコト州\tru=Нижнее Котто\tzh-CN=下科托\n" +
+	"CF-HK\ten=Haute-Kotto\tja=オートコト州\tru=Верхнее Котто\tzh-CN=上科托\n" +
+	"CF-HM\ten=Haut-Mbomou\tja=オームボム州\tru=Верхнее Мбому\tzh-CN=上姆博穆\n" +
+	"CF-HS\ten=Haute-Sangha / Mambéré-Kadéï\tja=マンベレカデイ州\n" +
+	"CF-KB\tde=Nana-Grébizi\ten=Gribingui\tru=Нана-Гребизи\n" +
+	"CF-KG\ten=Kemö-Gïrïbïngï\tru=Кемо\n" +
+	"CF-LB\ten=Lobaye\tja=ロバイエ州\tru=Лобае\tzh-CN=洛巴伊\n" +
+	"CF-MB\ten=Mbomou\tja=ムボム州\tzh-CN=姆博穆\n" +
+	"CF-MP\ten=Ombella-Mpoko\tru=Омбелла-Мпоко\n" +
+	"CF-NM\ten=Nana-Mambéré\tja=ナナメンベレ州\tru=Нана-Мамбере\n" +
+	"CF-OP\ten=Ouham-Pendé\tja=ウハムペンデ州\tru=Уам-Пенде\n" +
+	"CF-SE\ten=Sangha\tru=Сангха\tzh-CN=尚加\n" +
+	"CF-UK\ten=Ouaka\tja=ワカ州\tzh-CN=瓦卡\n" +
+	"CF-VK\ten=Vakaga\tja=バカガ州州\tru=Вакага\tzh-CN=瓦卡加\n" +
+	"CG-11\ten=Bouenza\tja=ブエンザ\tru=Буэнза\tzh-CN=布恩扎\n" +
+	"CG-12\ten=Pool\tja=プール\tzh-CN=普尔\n" +
+	"CG-13\ten=Sangha\tru=Сангха\tzh-CN=尚加\n" +
+	"CG-14\ten=Plateaux\tja=プラトー\n" +
+	"CG-15\ten=Cuvette-Ouest\tzh-CN=西盆地省\n" +
+	"CG-16\ten=Pointe-Noire\n" +
+	"CG-2\ten=Lékoumou\tja=レクム\tru=Лекуму\n" +
+	"CG-5\ten=Kouilou\tja=クイール\tzh-CN=奎卢\n" +
+	"CG-7\ten=Likouala\tja=リクアラ\tzh-CN=利夸拉\n" +
+	"CG-8\ten=Cuvette\tzh-CN=盆地省\n" +
+	"CG-9\ten=Niari\tja=ニアリ\tzh-CN=尼阿利\n" +
+	"CG-BZV\ten=Brazzaville\tja=ブラザビル\tru=Браззавиль\tzh-CN=布拉柴维尔\n" +
+	"CH-AG\ten=Aargau\tfr=Argovie\tja=アールガウ\tru=Аргау\tzh-CN=阿尔高\n" +
+	"CH-AI\ten=Appenzell Innerrhoden\tfr=Appenzell Rhodes-Intérieures\tja=アペンツェルインナーローデン\tru=Аппенцелль-Иннерроден\tzh-CN=内阿彭策尔\n" +
+	"CH-AR\ten=Appenzell Ausserrhoden\tfr=Appenzell Rhodes-Extérieures\tja=アペンツェルアウサーローデン\tru=Аппенцелль-Аусерроден\tzh-CN=外阿彭策尔\n" +
+	"CH-BE\ten=Bern\tfr=Berne\tja=ベルン\tru=Берн\tzh-CN=伯尔尼\n" +
+	"CH-BL\ten=Basel-Landschaft\tfr=Bâle-Campagne\tja=バーゼルランドシャフト\tru=Базель-Ланд\tzh-CN=巴塞尔乡村\n" +
+	"CH-BS\ten=Basel-Stadt\tfr=Bâle-Ville\tja=バーゼルシュタット\tru=Базель-Штадт\tzh-CN=巴塞尔城市\n" +
+	"CH-FR\ten=Freiburg\tru=Лимбург\n" +
+	"CH-GE\tde=Genf\ten=Genève\tja=ジュネーブ\tru=Женева\n" +
+	"CH-GL\ten=Glarus\tfr=Glaris\tja=グラールス\tru=Гларус\tzh-CN=格拉鲁斯\n" +
+	"CH-GR\ten=Graubünden\tfr=Grisons\tja=グラウビュンデン\tru=Граубюнден\n" +
+	"CH-JU\ten=Jura\tja=ジュラ\tru=Юра\tzh-CN=汝拉\n" +
+	"CH-LU\ten=Luzern\tfr=Lucerne\tja=ルツェルン\tru=Люцерн\tzh-CN=卢塞恩\n" +
+	"CH-NE\tde=Neuenburg\ten=Neuchâtel\tja=ヌシャテル\tru=Невшатель\n" +
+	"CH-NW\ten=Nidwalden\tfr=Nidwald\tru=Нидвальден\tzh-CN=下瓦尔登\n" +
+	"CH-OW\ten=Obwalden\tfr=Obwald\tja=オブワルデン\tru=Обвальден\tzh-CN=上瓦尔登\n" +
+	"CH-SG\ten=Sankt Gallen\tfr=Saint-Gall\tja=ザンクトガレン\tzh-CN=圣加仑\n" +
+	"CH-SH\ten=Schaffhausen\tfr=Schaffhouse\tja=シャフハウゼン\tru=Шаффхаузен\tzh-CN=沙夫豪森\n" +
+	"CH-SO\ten=Solothurn\tfr=Soleure\tja=ソーロトゥルン\tru=Золотурн\tzh-CN=索洛图恩\n" +
+	"CH-SZ\ten=Schwyz\tfr=Schwytz\tja=シュウィーツ\tzh-CN=施维茨\n" +
+	"CH-TG\ten=Thurgau\tfr=Thurgovie\tru=Тургау\tzh-CN=图尔高\n" +
+	"CH-TI\tde=Tessin\ten=Ticino\tfr=Tessin\tja=ティチーノ\tru=Тичино\tzh-CN=提契诺\n" +
+	"CH-UR\ten=Uri\tja=ウーリ\tzh-CN=乌里\n" +
+	"CH-VD\tde=Waadt\ten=Vaud\tja=ボー\tzh-CN=沃\n" +
+	"CH-VS\tde=Wallis\ten=Valais\tja=バレ\tzh-CN=瓦莱\n" +
+	"CH-ZG\ten=Zug\tfr=Zoug\tja=ツーク\tru=Цуг\tzh-CN=楚格\n" +
+	"CH-ZH\ten=Zürich\tfr=Zurich\tja=チューリヒ\tru=Цюрих\n" +
+	"CI-AB\ten=Abidjan\tru=Абиджан\n" +
+	"CI-BS\ten=Bas-Sassandra\tru=Ла-Массана\n" +
+	"CI-CM\ten=Comoé\tja=コモエ\tru=Комоэ\tzh-CN=科莫埃\n" +
+	"CI-DN\ten=Denguélé\n" +
+	"CI-GD\ten=Gôh-Djiboua\n" +
+	"CI-LC\ten=Lacs\n" +
+	"CI-LG\ten=Lagunes\n" +
+	"CI-MG\ten=Montagnes\n" +
+	"CI-SM\ten=Sassandra-Marahoué\n" +
+	"CI-SV\ten=Savanes\n" +
+	"CI-VB\ten=Vallée du Bandama\n" +
+	"CI-WR\ten=Woroba\n" +
+	"CI-YM\ten=Yamoussoukro\n" +
+	"CI-ZZ\ten=Zanzan\n" +
+	"CL-AI\ten=Aisén del General Carlos Ibañez del Campo\tru=Айсен-дель-Хенераль-Карлос-Ибаньес-дель-Кампо\n" +
+	"CL-AN\ten=Antofagasta\tja=アントファガスタ\tru=Антофагаста\tzh-CN=安托法加斯塔大区\n" +
+	"CL-AP\ten=Arica y Parinacota\tfr=Arica et Parinacota\tru=Арика-и-Паринакота\n" +
+	"CL-AR\ten=La Araucanía\tru=Арауко\n" +
+	"CL-AT\ten=Atacama\tja=アタカマ\tru=Атакама\tzh-CN=阿塔卡马大区\n" +
+	"CL-BI\ten=Biobío\tru=Био-Био\n" +
+	"CL-CO\ten=Coquimbo\tja=コキンボ\tru=Кокимбо\tzh-CN=科金博大区\n" +
+	"CL-LI\ten=Libertador General Bernardo O'Higgins\tru=Либертадор-Хенераль-Бернардо-О’Хиггинс\tzh-CN=奥伊金斯将军解放者大区\n" +
+	"CL-LL\ten=Los Lagos\tja=ロスラゴス\tru=Лос-Лагос\tzh-CN=湖大区\n" +
+	"CL-LR\ten=Los Ríos\tfr=Los Rios\tzh-CN=洛斯里奥斯省\n" +
+	"CL-MA\ten=Magallanes\tru=Магальянес\n" +
+	"CL-ML\ten=Maule\tja=マウレ\tru=Мауле\tzh-CN=马乌莱大区\n" +
+	"CL-NB\ten=Ñuble\n" +
+	"CL-RM\ten=Región Metropolitana de Santiago\tfr=Région métropolitaine de Santiago\n" +
+	"CL-TA\ten=Tarapacá\tfr=Tarapaca\tja=タラパカ\n" +
+	"CL-VS\ten=Valparaíso\tfr=Valparaiso\tja=バルパライソ\n" +
+	"CM-AD\ten=Adamaoua\tja=アダマワ州\tzh-CN=阿达马瓦省\n" +
+	"CM-CE\ten=Centre\tru=Центральная\tzh-CN=中部\n" +
+	"CM-EN\ten=Far North\tfr=Extrême-Nord\tja=極北州\tzh-CN=极北省\n" +
+	"CM-ES\ten=East\tfr=Est\tja=東部州\tzh-CN=东方省\n" +
+	"CM-LT\ten=Littoral\tfr=Litoral\tja=リトラル\tru=Литораль\tzh-CN=滨海省\n" +
+	"CM-NO\ten=North\tfr=Nord\tja=北部州\tzh-CN=北方省\n" +
+	"CM-NW\tde=Nordwest\ten=North-West\tfr=Nord-Ouest\n" +
+	"CM-OU\ten=West\tfr=Ouest\tja=西部州\tzh-CN=西方省\n" +
+	"CM-SU\ten=South\tfr=Sud\tja=南部州\tzh-CN=南方省\n" +
+	"CM-SW\ten=South-West\tfr=Sud-Ouest\tja=南西州\tzh-CN=西南省\n" +
+	"CN-AH\tde=Anhui\ten=Anhui Sheng\tzh-CN=安徽省\n" +
+	"CN-BJ\tde=Peking\ten=Beijing Shi\tru=Пекин\tzh-CN=北京市\n" +
+	"CN-CQ\tde=Chongqing\ten=Chongqing Shi\tru=Чунцин\tzh-CN=重庆市\n" +
+	"CN-FJ\tde=Fujian\ten=Fujian Sheng\tzh-CN=福建省\n" +
+	"CN-GD\tde=Guangdong\ten=Guangdong Sheng\tru=Гуандун\tzh-CN=广东省\n" +
+	"CN-GS\tde=Gansu\ten=Gansu Sheng\tzh-CN=甘肃省\n" +
+	"CN-GX\tde=Guangxi\ten=Guangxi Zhuangzu Zizhiqu\tzh-CN=广西壮族自治区\n" +
+	"CN-GZ\tde=Guizhou\ten=Guizhou Sheng\tzh-CN=贵州省\n" +
+	"CN-HA\tde=Henan\ten=Henan Sheng\tzh-CN=河南省\n" +
+	"CN-HB\tde=Hubei\ten=Hubei Sheng\tzh-CN=湖北省\n" +
+	"CN-HE\tde=Hebei\ten=Hebei Sheng\tzh-CN=河北省\n" +
+	"CN-HI\tde=Hainan\ten=Hainan Sheng\tru=Хайнань\tzh-CN=海南省\n" +
+	"CN-HK\ten=Hong Kong SAR\tru=Гонконг\tzh-CN=香港特别行政区\n" +
+	"CN-HL\tde=Heilongjiang\ten=Heilongjiang Sheng\tzh-CN=黑龙江省\n" +
+	"CN-HN\tde=Hunan\ten=Hunan Sheng\tru=Хунань\tzh-CN=湖南省\n" +
+	"CN-JL\tde=Jilin\ten=Jilin Sheng\tru=Гирин\tzh-CN=吉林省\n" +
+	"CN-JS\tde=Jiangsu\ten=Jiangsu Sheng\tzh-CN=江苏省\n" +
+	"CN-JX\tde=Jiangxi\ten=Jiangxi Sheng\tzh-CN=江西省\n" +
+	"CN-LN\tde=Liaoning\ten=Liaoning Sheng\tzh-CN=辽宁省\n" +
+	"CN-MO\ten=Macao SAR\tru=Макао\tzh-CN=澳门特别行政区\n" +
+	"CN-NM\tde=Nei Mongol\ten=Nei Mongol Zizhiqu\tru=Внутренняя Монголия\tzh-CN=内蒙古自治区\n" +
+	"CN-NX\tde=Ningxia\ten=Ningxia Huizi Zizhiqu\tzh-CN=宁夏回族自治区\n" +
+	"CN-QH\tde=Qinghai\ten=Qinghai Sheng\tru=Цинхай\tzh-CN=青海省\n" +
+	"CN-SC\tde=Sichuan\ten=Sichuan Sheng\tru=Сычуань\tzh-CN=四川省\n" +
+	"CN-SD\tde=Shandong\ten=Shandong Sheng\tzh-CN=山东省\n" +
+	"CN-SH\tde=Shanghai\ten=Shanghai Shi\tru=Шанхай\tzh-CN=上海市\n" +
+	"CN-SN\tde=Shaanxi\ten=Shaanxi Sheng\tzh-CN=陕西省\n" +
+	"CN-SX\tde=Shanxi\ten=Shanxi Sheng\tzh-CN=山西省\n" +
+	"CN-TJ\tde=Tianjin\ten=Tianjin Shi\tzh-CN=天津市\n" +
+	"CN-TW\ten=Taiwan Sheng\tzh-CN=台湾省\n" +
+	"CN-XJ\tde=Xinjiang\ten=Xinjiang Uygur Zizhiqu\tzh-CN=新疆维吾尔自治区\n" +
+	"CN-XZ\tde=Tibet\ten=Xizang Zizhiqu\tzh-CN=西藏自治区\n" +
+	"CN-YN\tde=Yunnan\ten=Yunnan Sheng\tru=Юньнань\tzh-CN=云南省\n" +
+	"CN-ZJ\tde=Zhejiang\ten=Zhejiang Sheng\tru=Чжэцзян\tzh-CN=浙江省\n" +
+	"CO-AMA\ten=Amazonas\tfr=Amazone\tja=アマゾナス\tzh-CN=亚马孙州\n" +
+	"CO-ANT\ten=Antioquia\tja=アンティオキア\tzh-CN=安提奥基亚\n" +
+	"CO-ARA\ten=Arauca\tja=アラウカ\tru=Араука\tzh-CN=阿劳卡\n" +
+	"CO-ATL\ten=Atlántico\tja=アトランティコ\tzh-CN=大西洋\n" +
+	"CO-BOL\ten=Bolívar\tfr=Bolivar\tja=ボリバル\tzh-CN=玻利瓦尔省\n" +
+	"CO-BOY\ten=Boyacá\tzh-CN=博亚卡\n" +
+	"CO-CAL\ten=Caldas\tja=カルダス\tzh-CN=卡尔达斯\n" +
+	"CO-CAQ\ten=Caquetá\tja=カケタ\tzh-CN=卡克塔\n" +
+	"CO-CAS\ten=Casanare\tja=カサナレ\tzh-CN=卡萨纳雷\n" +
+	"CO-CAU\ten=Cauca\tja=カウカ\tzh-CN=考卡\n" +
+	"CO-CES\ten=Cesar\tja=セサール\tzh-CN=塞萨尔\n" +
+	"CO-CHO\ten=Chocó\tja=チョコ\tzh-CN=乔科\n" +
+	"CO-COR\ten=Córdoba\tfr=Cordoba\tja=コルドバ\tru=Кордова\tzh-CN=科尔多瓦省\n" +
+	"CO-CUN\ten=Cundinamarca\tzh-CN=昆迪纳马卡\n" +
+	"CO-DC\ten=Distrito Capital de Bogotá\tfr=District de la capitale Bogota\tja=ボゴタ\tzh-CN=波哥大首都区\n" +
+	"CO-GUA\ten=Guainía\tja=グアイニア\tzh-CN=瓜伊尼亚\n" +
+	"CO-GUV\ten=Guaviare\tja=グアビアレ\tzh-CN=瓜维亚雷\n" +
+	"CO-HUI\ten=Huila\tja=ウイラ\tzh-CN=乌伊拉\n" +
+	"CO-LAG\ten=La Guajira\tzh-CN=瓜希拉\n" +
+	"CO-MAG\ten=Magdalena\tja=マグダレナ\tzh-CN=马格达雷那\n" +
+	"CO-MET\ten=Meta\tja=メタ\tzh-CN=梅塔\n" +
+	"CO-NAR\ten=Nariño\tja=ナリーニョ\tru=Нариньо\tzh-CN=纳里尼奥\n" +
+	"CO-NSA\ten=Norte de Santander\tja=ノンテデサンタンデール\tru=Северный Сантандер\tzh-CN=北桑坦德\n" +
+	"CO-PUT\ten=Putumayo\tja=プトゥマジョ\tzh-CN=普图马约\n" +
+	"CO-QUI\ten=Quindío\tfr=Quindio\tja=キンディオ\tru=Киндио\n" +
+	"CO-RIS\ten=Risaralda\tja=リサラルダ\tzh-CN=利萨拉尔达\n" +
+	"CO-SAN\ten=Santander\tja=サンタンデール\tzh-CN=桑坦德\n" +
+	"CO-SAP\ten=San Andrés, Providencia y Santa Catalina\tfr=San Andrés y Providencia\tru=Сан-Андрес-и-Провиденсия\tzh-CN=圣安德烈斯-普罗维登西亚\n" +
+	"CO-SUC\ten=Sucre\tja=スクレ\tzh-CN=苏克雷\n" +
+	"CO-TOL\ten=Tolima\tja=トリマ\tzh-CN=托利马\n" +
+	"CO-VAC\ten=Valle del Cauca\tzh-CN=考卡山谷\n" +
+	"CO-VAU\ten=Vaupés\tja=バウペス\tzh-CN=沃佩斯\n" +
+	"CO-VID\ten=Vichada\tja=ビチャダ\tzh-CN=维查达\n" +
+	"CR-A\ten=Alajuela\tja=アラフエラ\tzh-CN=阿拉辉拉\n" +
+	"CR-C\ten=Cartago\tja=カルタゴ\tru=Картаго\tzh-CN=卡塔戈\n" +
+	"CR-G\ten=Guanacaste\tja=グアナカステ\tru=Гуанакасте\tzh-CN=瓜纳卡斯特\n" +
+	"CR-H\ten=Heredia\tja=エレディア\tru=Эредия\tzh-CN=埃雷迪亚\n" +
+	"CR-L\ten=Limón\tfr=Limon\tja=リモン\tru=Лимон\n" +
+	"CR-P\ten=Puntarenas\tja=プンタレナス\tzh-CN=彭塔雷纳斯\n" +
+	"CR-SJ\ten=San José\tja=サンホゼ\n" +
+	"CU-01\ten=Pinar del Río\tru=Пинар-дель-Рио\n" +
+	"CU-03\ten=La Habana\tru=Гавана\tzh-CN=哈瓦那省\n" +
+	"CU-04\ten=Matanzas\tja=マタンサス\tru=Матансас\tzh-CN=马坦萨斯省\n" +
+	"CU-05\ten=Villa Clara\tja=ビヤクララ\tzh-CN=比亚克拉拉省\n" +
+	"CU-06\ten=Cienfuegos\tja=シエンフエゴス\tru=Сьенфуэгос\tzh-CN=西恩富戈斯省\n" +
+	"CU-07\ten=Sancti Spíritus\tfr=Sancti Spiritus\tja=サンクティスピリトゥス\tru=Санкти-Спиритус\tzh-CN=圣斯皮里图斯\n" +
+	"CU-08\ten=Ciego de Ávila\tfr=Ciego de Avila\tja=シエゴデアビラ\tru=Сьего-де-Авила\tzh-CN=谢戈德阿维拉省\n" +
+	"CU-09\ten=Camagüey\tja=カマグエイ\tru=Камагуэй\tzh-CN=卡马圭省\n" +
+	"CU-10\ten=Las Tunas\tja=ラストゥーナス\tzh-CN=拉斯图纳斯省\n" +
+	"CU-11\ten=Holguín\tfr=Holguin\tja=オルギン\tru=Ольгин\tzh-CN=奥尔金\n" +
+	"CU-12\ten=Granma\tja=グランマ\tru=Гранма\tzh-CN=格拉玛省\n" +
+	"CU-13\ten=Santiago de Cuba\tja=サンティアゴデクーバ\tru=Сантьяго-де-Куба\tzh-CN=圣地亚哥省\n" +
+	"CU-14\ten=Guantánamo\tfr=Guantanamo\tja=グアンタナモ\tru=Гуантанамо\tzh-CN=关塔那摩省\n" +
+	"CU-15\ten=Artemisa\tru=Артемиса\n" +
+	"CU-16\ten=Mayabeque\n" +
+	"CU-99\ten=Isla de la Juventud\tfr=Île de la Juventud\tja=ラフベントゥド島\tzh-CN=青年岛特区\n" +
+	"CV-B\ten=Ilhas de Barlavento\tes=Islas de Barlovento\tfr=Îles de Barlavento\tzh-CN=迎风群岛\n" +
+	"CV-BR\ten=Brava\tzh-CN=布拉瓦岛\n" +
+	"CV-BV\ten=Boa Vista\tja=ボアヴィスタ\tru=Боа-Виста\tzh-CN=博阿维斯塔岛\n" +
+	"CV-CA\ten=Santa Catarina\tfr=Santa-Catarina\tja=サンタカタリナ\tru=Санта Катарина\n" +
+	"CV-CF\ten=Santa Catarina do Fogo\n" +
+	"CV-CR\ten=Santa Cruz\tja=サンタクルス\tru=Санта-Крус\tzh-CN=圣克鲁斯省\n" +
+	"CV-MA\ten=Maio\tja=マイオ島\tzh-CN=马尤岛\n" +
+	"CV-MO\ten=Mosteiros\tru=Моштейруш\tzh-CN=莫斯特罗\n" +
+	"CV-PA\ten=Paul\n" +
+	"CV-PN\ten=Porto Novo\tru=Порто-Ново\tzh-CN=波多诺伏\n" +
+	"CV-PR\ten=Praia\tja=プライア\tru=Прая\tzh-CN=普拉亚\n" +
+	"CV-RB\ten=Ribeira Brava\tru=Рибейра-Брава\n" +
+	"CV-RG\ten=Ribeira Grande\tzh-CN=大里贝拉\n" +
+	"CV-RS\ten=Ribeira Grande de Santiago\n" +
+	"CV-S\ten=Ilhas de Sotavento\tfr=Îles de Sotavento\tzh-CN=背风群岛\n" +
+	"CV-SD\ten=São Domingos\tru=Сан-Домингус\tzh-CN=圣多明戈\n" +
+	"CV-SF\ten=São Filipe\tzh-CN=圣菲利普\n" +
+	"CV-SL\ten=Sal\tja=サル\tzh-CN=萨尔岛\n" +
+	"CV-SM\ten=São Miguel\n" +
+	"CV-SO\ten=São Lourenço dos Órgãos\n" +
+	"CV-SS\ten=São Salvador do Mundo\n" +
+	"CV-SV\ten=São Vicente\tja=サンビセンテ島\tru=Сан-Висенти\tzh-CN=圣维森特岛\n" +
+	"CV-TA\ten=Tarrafal\tru=Таррафал\tzh-CN=塔拉法尔\n" +
+	"CV-TS\ten=Tarrafal de São Nicolau\tru=Таррафал-де-Сан-Николау\n" +
+	"CY-01\ten=Lefkosia\n" +
+	"CY-02\ten=Lemesos\tru=Лимасол\n" +
+	"CY-03\ten=Larnaka\tru=Ларнака\n" +
+	"CY-04\ten=Ammochostos\n" +
+	"CY-05\ten=Baf\n" +
+	"CY-06\ten=Girne\n" +
+	"CZ-10\ten=Praha, Hlavní město\n" +
+	"CZ-20\ten=Středočeský kraj\tfr=Bohême centrale\tru=Среднечешский край\tzh-CN=中捷克\n" +
+	"CZ-201\ten=Benešov\tru=Бенешов\n" +
+	"CZ-202\ten=Beroun\tru=Бероун\n" +
+	"CZ-203\ten=Kladno\tru=Кладно\n" +
+	"CZ-204\ten=Kolín\tfr=Kolin\tru=Колин\n" +
+	"CZ-205\ten=Kutná Hora\tfr=Kutna Hora\tru=Кутна-Гора\n" +
+	"CZ-206\ten=Mělník\tfr=Melnik\tru=Мельник\n" +
+	"CZ-207\ten=Mladá Boleslav\tfr=Mlada Boleslav\tru=Млада-Болеслав\n" +
+	"CZ-208\ten=Nymburk\tru=Нимбурк\n" +
+	"CZ-209\ten=Praha-východ\tfr=Prague-Est\tru=Прага-восток\n" +
+	"CZ-20A\ten=Praha-západ\tfr=Prague-Ouest\tru=Прага-запад\n" +
+	"CZ-20B\ten=Příbram\tfr=Pribram\tru=Пршибрам\n" +
+	"CZ-20C\ten=Rakovník\tfr=Rakovnik\tru=Раковник\n" +
+	"CZ-31\ten=Jihočeský kraj\tfr=Bohême du Sud\tru=Южно-Чешский край\tzh-CN=南捷克\n" +
+	"CZ-311\ten=České Budějovice\tfr=Ceske Budejovice\tja=ブジョヨビツェ\tru=Ческе-Будеёвице\n" +
+	"CZ-312\ten=Český Krumlov\tfr=Cesky Krumlov\tru=Чески-Крумлов\n" +
+	"CZ-313\ten=Jindřichův Hradec\tfr=Jindrichuv Hradec\tru=Йиндржихув-Градец\n" +
+	"CZ-314\ten=Písek\tfr=Pisek\tru=Писек\n" +
+	"CZ-315\ten=Prachatice\tru=Прахатице\n" +
+	"CZ-316\ten=Strakonice\tru=Страконице\n" +
+	"CZ-317\ten=Tábor\tfr=Tabor\tru=Табор\n" +
+	"CZ-32\ten=Plzeňský kraj\tfr=Plzeň\tja=プルゼニ\tru=Пльзеньский край\tzh-CN=比尔森\n" +
+	"CZ-321\ten=Domažlice\tfr=Domazlice\tru=Домажлице\n" +
+	"CZ-322\ten=Klatovy\tru=Клатови\n" +
+	"CZ-323\ten=Plzeň-město\tfr=Pilsen-ville\tru=Пльзень-город\n" +
+	"CZ-324\ten=Plzeň-jih\tfr=Pilsen-Sud\tru=Пльзень-юг\n" +
+	"CZ-325\ten=Plzeň-sever\tfr=Pilsen-Nord\tru=Пльзень-север\n" +
+	"CZ-326\ten=Rokycany\tru=Рокицани\n" +
+	"CZ-327\ten=Tachov\tru=Тахов\n" +
+	"CZ-41\ten=Karlovarský kraj\tfr=Karlovy Vary\tja=カルロバリ\tru=Карловарский край\tzh-CN=卡罗维发利\n" +
+	"CZ-411\ten=Cheb\tru=Хеб\n" +
+	"CZ-412\ten=Karlovy Vary\tfr=Carlsbad\tja=カルロバリ\tru=Карловы Вары\n" +
+	"CZ-413\ten=Sokolov\tru=Соколов\n" +
+	"CZ-42\ten=Ústecký kraj\tfr=Ústí nad Labem\tja=ウースチー\tru=Устецкий край\tzh-CN=乌斯季\n" +
+	"CZ-421\ten=Děčín\tfr=Decin\tru=Дечин\n" +
+	"CZ-422\ten=Chomutov\tru=Хомутов\n" +
+	"CZ-423\ten=Litoměřice\tfr=Litomerice\tru=Литомержице\n" +
+	"CZ-424\ten=Louny\tru=Лоуни\n" +
+	"CZ-425\ten=Most\tja=モスト\n" +
+	"CZ-426\ten=Teplice\tru=Теплице\n" +
+	"CZ-427\ten=Ústí nad Labem\tfr=Usti nad Labem\tru=Усти-над-Лабем\n" +
+	"CZ-51\ten=Liberecký kraj\tfr=liberec\tja=リベレツ\tru=Либерецкий край\tzh-CN=利贝雷克\n" +
+	"CZ-511\ten=Česká Lípa\tfr=Ceska Lipa\tru=Ческа-Липа\n" +
+	"CZ-512\ten=Jablonec nad Nisou\tru=Яблонец-над-Нисоу\n" +
+	"CZ-513\ten=Liberec\tru=Либерец\n" +
+	"CZ-514\ten=Semily\tru=Семили\n" +
+	"CZ-52\ten=Královéhradecký kraj\tfr=Hradec Králové\tja=クラードベーフラデツ\tru=Краловеградецкий край\tzh-CN=赫拉德茨-克拉洛韦\n" +
+	"CZ-521\ten=Hradec Králové\tfr=Hradec Kralové\tru=Градец-Кралове\n" +
+	"CZ-522\ten=Jičín\tfr=Jicin\tru=Йичин\n" +
+	"CZ-523\ten=Náchod\tfr=Nachod\tru=Наход\n" +
+	"CZ-524\ten=Rychnov nad Kněžnou\tfr=Rychnov nad Kneznou\tru=Рихнов-над-Кнежноу\n" +
+	"CZ-525\ten=Trutnov\tru=Трутнов\n" +
+	"CZ-53\ten=Pardubický kraj\tfr=Pardubice\tja=パルドゥビツェ\tru=Пардубицкий край\tzh-CN=帕尔杜比采\n" +
+	"CZ-531\ten=Chrudim\tru=Хрудим\n" +
+	"CZ-532\ten=Pardubice\tja=パルドゥビツェ\tru=Пардубице\n" +
+	"CZ-533\ten=Svitavy\tru=Свитави\n" +
+	"CZ-534\ten=Ústí nad Orlicí\tfr=Usti nad Orlici\tru=Усти-над-Орлици\n" +
+	"CZ-63\ten=Kraj Vysočina\tfr=Région de Vysocina\n" +
+	"CZ-631\ten=Havlíčkův Brod\tfr=Havlickuv Brod\tru=Гавличкув-Брод\n" +
+	"CZ-632\ten=Jihlava\tru=Йиглава\n" +
+	"CZ-633\ten=Pelhřimov\tfr=Pelhrimov\tru=Пельгржимов\n" +
+	"CZ-634\ten=Třebíč\tfr=Trebic\tru=Тршебич\n" +
+	"CZ-635\ten=Žďár nad Sázavou\tfr=Zdar nad Sazavou\tru=Ждяр-над-Сазавоу\n" +
+	"CZ-64\ten=Jihomoravský kraj\tfr=Moravie du Sud\tru=Южно-Моравский край\tzh-CN=南摩拉维亚\n" +
+	"CZ-641\ten=Blansko\tru=Бланско\n" +
+	"CZ-642\ten=Brno-město\tfr=Brno-mesto\tru=Брно-город\n" +
+	"CZ-643\ten=Brno-venkov\tru=Брно-пригород\n" +
+	"CZ-644\ten=Břeclav\tfr=Breclav\tru=Бржецлав\n" +
+	"CZ-645\ten=Hodonín\tfr=Hodonin\tru=Годонин\n" +
+	"CZ-646\ten=Vyškov\tfr=Vyskov\tru=Вишков\n" +
+	"CZ-647\ten=Znojmo\tru=Зноймо\n" +
+	"CZ-71\ten=Olomoucký kraj\tfr=Olomouc\tja=オロモウツ\tru=Оломоуцкий край\tzh-CN=奥洛穆茨\n" +
+	"CZ-711\ten=Jeseník\tfr=Jesenik\tru=Есеник\n" +
+	"CZ-712\ten=Olomouc\tja=オロモウツ\tru=Оломоуц\n" +
+	"CZ-713\ten=Prostějov\tfr=Prostejov\tru=Простеёв\n" +
+	"CZ-714\ten=Přerov\tfr=Prerov\tru=Пршеров\n" +
+	"CZ-715\ten=Šumperk\tfr=Sumperk\tru=Шумперк\n" +
+	"CZ-72\ten=Zlínský kraj\tfr=Zlin\tja=ズリーン\tru=Злинский край\tzh-CN=兹林\n" +
+	"CZ-721\ten=Kroměříž\tru=Кромержиж\n" +
+	"CZ-722\ten=Uherské Hradiště\tfr=Uherske Hradiste\tru=Угерске-Градиште\n" +
+	"CZ-723\ten=Vsetín\tfr=Vsetin\tru=Всетин\n" +
+	"CZ-724\ten=Zlín\tfr=Zlin\tru=Злин\n" +
+	"CZ-80\ten=Moravskoslezský kraj\tfr=Moravie-Silésie\tru=Моравско-Силезский край\tzh-CN=摩拉维亚-西里西亚\n" +
+	"CZ-801\ten=Bruntál\tfr=Bruntal\n" +
+	"CZ-802\ten=Frýdek-Místek\tru=Фридек-Мистек\n" +
+	"CZ-803\ten=Karviná\tfr=Karvina\tru=Карвина\n" +
+	"CZ-804\ten=Nový Jičín\tfr=Novy Jicin\tru=Нови-Йичин\n" +
+	"CZ-805\ten=Opava\tru=Опава\n" +
+	"CZ-806\ten=Ostrava-město\tfr=Ostrava-ville\tru=Острава-город\n" +
+	"DE-BB\ten=Brandenburg\tfr=Brandebourg\tja=ブランデンブルク\tru=Бранденбург\tzh-CN=勃兰登堡\n" +
+	"DE-BE\ten=Berlin\tja=ベルリン\tru=Берлин\tzh-CN=柏林\n" +
+	"DE-BW\ten=Baden-Württemberg\tfr=Bade-Wurtemberg\tja=バーデンヴュルテンベルク\tru=Баден-Вюртемберг\n" +
+	"DE-BY\ten=Bayern\tfr=Bavière\tja=バイエルン\tru=Бавария\tzh-CN=巴伐利亚\n" +
+	"DE-HB\ten=Bremen\tfr=Brème\tja=ブレーメン\tru=Бремен\tzh-CN=不来梅\n" +
+	"DE-HE\ten=Hessen\tfr=Hesse\tja=ヘッセン\tru=Гессен\tzh-CN=黑森\n" +
+	"DE-HH\ten=Hamburg\tfr=Hambourg\tja=ハンブルク\tru=Гамбург\tzh-CN=汉堡\n" +
+	"DE-MV\ten=Mecklenburg-Vorpommern\tfr=Mecklembourg-Poméranie occidentale\tja=メクレンブルク=フォアポンメルン\tru=Мекленбург-Передняя Померания\tzh-CN=梅克伦堡-前波美拉尼亚\n" +
+	"DE-NI\ten=Niedersachsen\tfr=Basse-Saxe\tja=ニーダーザクセン\tru=Нижняя Саксония\tzh-CN=下萨克森\n" +
+	"DE-NW\ten=Nordrhein-Westfalen\tfr=Rhénanie du Nord-Westfalie\tja=ノルトライン=ヴェストファーレン\tru=Северный Рейн-Вестфалия\tzh-CN=北莱茵-威斯特法伦\n" +
+	"DE-RP\ten=Rheinland-Pfalz\tfr=Rhénanie-Palatinat\tja=ラインラントファルツ\tru=Рейнланд-Пфальц\tzh-CN=莱茵兰-普法尔茨\n" +
+	"DE-SH\ten=Schleswig-Holstein\tja=シュレースヴィヒホルシュタイン\tru=Шлезвиг-Гольштейн\tzh-CN=石勒苏益格-荷尔斯泰因\n" +
+	"DE-SL\ten=Saarland\tfr=Sarre\tja=ザールラント\tru=Саар\tzh-CN=萨尔\n" +
+	"DE-SN\ten=Sachsen\tfr=Saxe\tja=ザクセン\tzh-CN=萨克森\n" +
+	"DE-ST\ten=Sachsen-Anhalt\tfr=Saxe-Anhalt\tja=ザクセン=アンハルト\tru=Саксония-Анхальт\tzh-CN=萨克森-安哈尔特\n" +
+	"DE-TH\ten=Thüringen\tfr=Turinge\tja=テューリンゲン\tru=Тюрингия\tzh-CN=图林根\n" +
+	"DJ-AR\ten=Arta\tja=アルタ\tru=Арта\tzh-CN=阿尔塔州\n" +
+	"DJ-AS\ten=Ali Sabieh\tja=アリサビエ\tru=Али-Сабих\tzh-CN=阿里·萨比州\n" +
+	"DJ-DI\ten=Dikhil\tja=ディキル\tru=Дикиль\tzh-CN=迪奇尔州\n" +
+	"DJ-DJ\tde=Dschibuti\ten=Djibouti\tes=Yibuti\tja=ジブチ\tru=Джибути\tzh-CN=吉布提市\n" +
+	"DJ-OB\ten=Awbūk\n" +
+	"DJ-TA\ten=Tadjourah\tfr=Tadjoura\tja=タジュラ\tzh-CN=塔朱拉州\n" +
+	"DK-81\ten=Nordjylland\tfr=Jutland septentrional\tzh-CN=北日德兰大区\n" +
+	"DK-82\ten=Midtjylland\tfr=Jutland central\tzh-CN=中日德兰大区\n" +
+	"DK-83\ten=Syddanmark\tfr=Sud-Danemark\tru=Южная Дания\tzh-CN=南丹麦大区\n" +
+	"DK-84\ten=Hovedstaden\tru=Ховедстаден\tzh-CN=京畿大区\n" +
+	"DK-85\ten=Sjælland\tru=Зеландия\tzh-CN=西兰大区\n" +
+	"DM-02\ten=Saint Andrew\tfr=Saint-Andrew\tru=Сент Эндрю\tzh-CN=圣安德鲁斯\n" +
+	"DM-03\ten=Saint David\tfr=Saint-David\tzh-CN=圣大卫\n" +
+	"DM-04\ten=Saint George\tfr=Saint-George\tja=セントジョージ\tru=Сент-Джордж\tzh-CN=圣乔治\n" +
+	"DM-05\ten=Saint John\tfr=Saint-John\tja=セントジョン\tru=Сент-Джон\tzh-CN=圣约翰\n" +
+	"DM-06\ten=Saint Joseph\tfr=Saint-Joseph\tru=Сент Джозеф\tzh-CN=圣约瑟\n" +
+	"DM-07\ten=Saint Luke\tfr=Saint-Luke\tzh-CN=圣路加\n" +
+	"DM-08\ten=Saint Mark\tfr=Saint-Mark\tzh-CN=圣马可\n" +
+	"DM-09\ten=Saint Patrick\tfr=Saint-Patrick\tzh-CN=圣派屈克\n" +
+	"DM-10\ten=Saint Paul\tfr=Saint-Paul\tja=セントポール\tru=Сент-Пол\tzh-CN=圣保罗\n" +
+	"DM-11\ten=Saint Peter\tfr=Saint-Peter\tja=セントピーター\tru=Сент-Питер\tzh-CN=圣彼得\n" +
+	"DO-01\ten=Distrito Nacional (Santo Domingo)\tfr=District National.(Santo Domingo)\tja=首都圏\tzh-CN=国家区\n" +
+	"DO-02\ten=Azua\tja=アスア\tzh-CN=阿苏阿\n" +
+	"DO-03\ten=Baoruco\n" +
+	"DO-04\ten=Barahona\tja=バラオナ\tzh-CN=巴拉奥纳\n" +
+	"DO-05\ten=Dajabón\tja=ダハボン\tzh-CN=达哈翁\n" +
+	"DO-06\ten=Duarte\tja=ドゥアルテ\n" +
+	"DO-07\ten=Elías Piña\n" +
+	"DO-08\ten=El Seibo\n" +
+	"DO-09\ten=Espaillat\tja=エスパイジャット\n" +
+	"DO-10\ten=Independencia\tja=インディペンデンシア\n" +
+	"DO-11\ten=La Altagracia\tja=ラアルタグラシア\n" +
+	"DO-12\ten=La Romana\tja=ラロマーナ\n" +
+	"DO-13\ten=La Vega\tja=ラベーガ\n" +
+	"DO-14\ten=María Trinidad Sánchez\tfr=Maria Trinidad Sanchez\tja=マリアトリニダードサンチェス\n" +
+	"DO-15\ten=Monte Cristi\tja=モンテクリスティ\n" +
+	"DO-16\ten=Pedernales\tja=ペデルナレス\n" +
+	"DO-17\ten=Peravia\n" +
+	"DO-18\ten=Puerto Plata\tja=プエルトプラタ\n" +
+	"DO-19\ten=Hermanas Mirabal\n" +
+	"DO-20\ten=Samaná\tja=サマナ\n" +
+	"DO-21\ten=San Cristóbal\tja=サンクリストバル\tru=Сан-Кристобаль\tzh-CN=圣克里斯托瓦尔\n" +
+	"DO-22\ten=San Juan\tja=サンファン\tru=Сан-Хуан\tzh-CN=圣胡安省\n" +
+	"DO-23\ten=San Pedro de Macorís\tja=サンペドロデマコリス\tru=Сан-Педро-де-Макорис\n" +
+	"DO-24\ten=Sánchez Ramírez\tja=サンチェスラミレス\tru=Санчес-Рамирес\n" +
+	"DO-25\ten=Santiago\tja=サンティアゴ\tru=Сантьяго\tzh-CN=圣地亚哥\n" +
+	"DO-26\ten=Santiago Rodríguez\n" +
+	"DO-27\ten=Valverde\tja=バルベルデ\tru=Валверде\n" +
+	"DO-28\ten=Monseñor Nouel\tja=モンセニョールノエル\n" +
+	"DO-29\ten=Monte Plata\tja=モンテプラタ\n" +
+	"DO-30\ten=Hato Mayor\tja=アトマヨール\n" +
+	"DO-31\ten=San José de Ocoa\n" +
+	"DO-32\ten=Santo Domingo\tru=Санто-Доминго\n" +
+	"DO-33\ten=Cibao Nordeste\n" +
+	"DO-34\ten=Cibao Noroeste\n" +
+	"DO-35\ten=Cibao Norte\n" +
+	"DO-36\ten=Cibao Sur\n" +
+	"DO-37\ten=El Valle\n" +
+	"DO-38\ten=Enriquillo\n" +
+	"DO-39\ten=Higuamo\n" +
+	"DO-40\ten=Ozama\n" +
+	"DO-41\ten=Valdesia\n" +
+	"DO-42\ten=Yuma\n" +
+	"DZ-01\ten=Adrar\tja=アドラル\tru=Адрар\n" +
+	"DZ-02\ten=Chlef\tja=シェリーフ\tru=Эш-Шелифф\tzh-CN=谢里夫\n" +
+	"DZ-03\ten=Laghouat\tja=ラグアッツト\tru=Лагуат\tzh-CN=拉格瓦特\n" +
+	"DZ-04\ten=Oum el Bouaghi\tfr=Oum El-Bouaghi\tja=ウメルプアーギ\tru=Умм-эль-Буаги\tzh-CN=乌姆布阿基\n" +
+	"DZ-05\ten=Batna\tja=バトナ\tru=Батна\tzh-CN=巴特纳\n" +
+	"DZ-06\ten=Béjaïa\tja=ベジャイア\tru=Беджая\n" +
+	"DZ-07\ten=Biskra\tja=ビスクラ\tru=Бискра\tzh-CN=比斯克拉\n" +
+	"DZ-08\ten=Béchar\tja=ベシャル\tru=Бешар\n" +
+	"DZ-09\ten=Blida\tja=ブリダ\tru=Блида\tzh-CN=布利达\n" +
+	"DZ-10\ten=Bouira\tja=ブイラ\tru=Буира\tzh-CN=布依拉\n" +
+	"DZ-11\ten=Tamanrasset\tru=Таманрассет\n" +
+	"DZ-12\ten=Tébessa\tja=テベサ\tru=Тебесса\n" +
+	"DZ-13\ten=Tlemcen\tja=トレムセン\tru=Тлемсен\tzh-CN=特莱姆森\n" +
+	"DZ-14\ten=Tiaret\tja=ティアレト\tru=Тиарет\tzh-CN=提亚雷特\n" +
+	"DZ-15\ten=Tizi Ouzou\tja=ティジウズ\tru=Тизи-Узу\tzh-CN=提济乌祖\n" +
+	"DZ-16\tde=Algier\ten=Alger\tja=アルジェ\tru=Алджер\tzh-CN=阿尔及尔\n" +
+	"DZ-17\ten=Djelfa\tja=ジェルファ\tru=Джельфа\tzh-CN=杰勒法\n" +
+	"DZ-18\ten=Jijel\tja=ジジェル\tru=Джиджель\tzh-CN=吉杰尔\n" +
+	"DZ-19\ten=Sétif\tja=セティフ\tru=Сетиф\n" +
+	"DZ-20\ten=Saïda\tja=サイダ\tru=Саида\n" +
+	"DZ-21\ten=Skikda\tja=スキクダ\tru=Скикда\tzh-CN=斯基克达\n" +
+	"DZ-22\ten=Sidi Bel Abbès\tja=シディベルアベス\tru=Сиди-Бель-Аббес\n" +
+	"DZ-23\ten=Annaba\tja=アナバ\tru=Аннаба\tzh-CN=安纳巴\n" +
+	"DZ-24\ten=Guelma\tja=ゲルマ\tru=Гельма\tzh-CN=盖尔马\n" +
+	"DZ-25\ten=Constantine\tja=コンスタンティーヌ\tzh-CN=君士坦丁\n" +
+	"DZ-26\ten=Médéa\tja=メデア\tru=Медеа\n" +
+	"DZ-27\ten=Mostaganem\tja=モスタガネム\tzh-CN=莫斯塔加纳姆\n" +
+	"DZ-28\ten=M'sila\n" +
+	"DZ-29\ten=Mascara\tja=マスカラ\tzh-CN=马斯卡拉\n" +
+	"DZ-30\ten=Ouargla\tja=ワルグラ\tru=Уаргла\tzh-CN=瓦尔格拉\n" +
+	"DZ-31\ten=Oran\tja=オラン\tru=Оран\tzh-CN=奥兰\n" +
+	"DZ-32\ten=El Bayadh\tfr=El-Bayadh\tja=エルバヤード\tru=Эль-Баяд\tzh-CN=贝伊德\n" +
+	"DZ-33\ten=Illizi\tja=イリジ\tru=Иллизи\tzh-CN=伊利齐\n" +
+	"DZ-34\ten=Bordj Bou Arréridj\tja=ボルジブアレリジ\tru=Бордж-Бу-Арреридж\n" +
+	"DZ-35\ten=Boumerdès\tja=ブーメルデス\tru=Бумердес\n" +
+	"DZ-36\ten=El Tarf\tfr=El-Taref\tja=エルタルフ\tru=Эль-Тарф\tzh-CN=塔里夫\n" +
+	"DZ-37\ten=Tindouf\tja=ティンドーフ\tru=Тиндуф\tzh-CN=廷杜夫\n" +
+	"DZ-38\ten=Tissemsilt\tja=ティセムシルト\tru=Тисемсильт\tzh-CN=蒂斯姆西勒特\n" +
+	"DZ-39\ten=El Oued\tja=エルウエッド\tru=Эль-Уэд\tzh-CN=瓦德\n" +
+	"DZ-40\ten=Khenchela\tja=ヘンシュラ\tru=Хеншела\tzh-CN=罕西拉\n" +
+	"DZ-41\ten=Souk Ahras\tja=スーカハラス\tru=Сук-Ахрас\tzh-CN=苏克·阿赫拉斯\n" +
+	"DZ-42\ten=Tipaza\tja=ティパザ\tru=Типаза\tzh-CN=蒂巴扎\n" +
+	"DZ-43\ten=Mila\tja=ミラ\tru=Мила\tzh-CN=密拉\n" +
+	"DZ-44\ten=Aïn Defla\tja=アインデフラ\tru=Айн-Дефла\n" +
+	"DZ-45\ten=Naama\tfr=Naâma\tja=ナーマ\tzh-CN=纳阿马\n" +
+	"DZ-46\ten=Aïn Témouchent\tja=アインテムシェント\tru=Айн-Темушент\n" +
+	"DZ-47\ten=Ghardaïa\tja=ガルダイア\tru=Гардая\n" +
+	"DZ-48\ten=Relizane\tja=ルリザンヌ\tru=Гализан\tzh-CN=赫利赞\n" +
+	"EC-A\ten=Azuay\tja=アスアイ\tru=Асуай\tzh-CN=阿苏艾省\n" +
+	"EC-B\ten=Bolívar\tfr=Bolivar\tja=ボリバル\tzh-CN=玻利瓦尔省\n" +
+	"EC-C\ten=Carchi\tja=カルチ\tru=Карчи\tzh-CN=卡尔奇省\n" +
+	"EC-D\ten=Orellana\tja=オレジャナ\tru=Орельяна\tzh-CN=奥雷利亚纳省\n" +
+	"EC-E\ten=Esmeraldas\tja=エスメラルダス\tzh-CN=埃斯梅拉尔达斯省\n" +
+	"EC-F\ten=Cañar\tja=カニャル\tzh-CN=卡尼亚尔省\n" +
+	"EC-G\ten=Guayas\tja=グアヤス\tru=Гуаяс\tzh-CN=瓜亚斯省\n" +
+	"EC-H\ten=Chimborazo\tja=チンボラソ\tru=Чимборасо\tzh-CN=钦博拉索省\n" +
+	"EC-I\ten=Imbabura\tja=インバブラ\tzh-CN=因巴布拉省\n" +
+	"EC-L\ten=Loja\tja=ロハ\tzh-CN=洛哈省\n" +
+	"EC-M\ten=Manabí\tfr=Manabi\tja=マナビ\tzh-CN=马纳比省\n" +
+	"EC-N\ten=Napo\tja=ナポ\tzh-CN=纳波省\n" +
+	"EC-O\ten=El Oro\tja=エルオロ\tzh-CN=埃尔奥罗省\n" +
+	"EC-P\ten=Pichincha\tja=ピチンチャ\tru=Пичинча\tzh-CN=皮钦查省\n" +
+	"EC-R\ten=Los Ríos\tfr=Los Rios\tzh-CN=洛斯里奥斯省\n" +
+	"EC-S\ten=Morona Santiago\tru=Морона-Сантьяго\n" +
+	"EC-SD\ten=Santo Domingo de los Tsáchilas\tru=Санто-Доминго-де-лос-Тсачилас\n" +
+	"EC-SE\ten=Santa Elena\tru=Санта-Элена\n" +
+	"EC-T\ten=Tungurahua\tja=トゥングラウア\tru=Тунгурауа\tzh-CN=通古拉瓦省\n" +
+	"EC-U\ten=Sucumbíos\tfr=Sucumbios\tja=スクンビオス\tru=Сукумбиос\tzh-CN=苏昆毕奥斯省\n" +
+	"EC-W\ten=Galápagos\tfr=Galapagos\tja=ガラパゴス\tru=Галапагос\tzh-CN=加拉帕戈斯省\n" +
+	"EC-X\ten=Cotopaxi\tja=コトパクシ\tzh-CN=科托帕希省\n" +
+	"EC-Y\ten=Pastaza\tja=パスタサ\tzh-CN=帕斯塔萨省\n" +
+	"EC-Z\ten=Zamora Chinchipe\tru=Самора-Чинчипе\n" +
+	"EE-130\ten=Alutaguse\tru=Ида-Вирумаа\n" +
+	"EE-141\ten=Anija\n" +
+	"EE-142\ten=Antsla\tru=Антсла\n" +
+	"EE-171\ten=Elva\tru=Елва\n" +
+	"EE-184\ten=Haapsalu\tru=Хаапсалу\n" +
+	"EE-191\ten=Haljala\n" +
+	"EE-198\ten=Harku\n" +
+	"EE-205\tde=Hiiu\ten=Hiiumaa\tja=ヒーウマー\tru=Хийумаа\n" +
+	"EE-214\ten=Häädemeeste\tru=Хяэдемеэсте\n" +
+	"EE-245\ten=Jõelähtme\n" +
+	"EE-247\ten=Jõgeva\tru=Йыгева\n" +
+	"EE-251\ten=Jõhvi\tru=Йыхви\n" +
+	"EE-255\ten=Järva\tru=Ярвамаа\n" +
+	"EE-272\ten=Kadrina\tru=Кадрина\n" +
+	"EE-283\ten=Kambja\n" +
+	"EE-284\ten=Kanepi\n" +
+	"EE-291\ten=Kastre\n" +
+	"EE-293\ten=Kehtna\n" +
+	"EE-296\ten=Keila\tru=Кейла\n" +
+	"EE-303\ten=Kihnu\tru=Кихну\n" +
+	"EE-305\ten=Kiili\tru=Кийли\n" +
+	"EE-317\ten=Kohila\n" +
+	"EE-321\ten=Kohtla-Järve\tru=Кохтла-Ярве\n" +
+	"EE-338\ten=Kose\tru=Козе\n" +
+	"EE-353\ten=Kuusalu\n" +
+	"EE-37\tde=Harju\ten=Harjumaa\tru=Харьюмаа\n" +
+	"EE-39\tde=Hiiu\ten=Hiiumaa\tja=ヒーウマー\tru=Хийумаа\n" +
+	"EE-424\ten=Loksa\tru=Локса\n" +
+	"EE-430\ten=Lääneranna\n" +
+	"EE-431\ten=Lääne-Harju\n" +
+	"EE-432\ten=Luunja\n" +
+	"EE-441\ten=Lääne-Nigula\n" +
+	"EE-442\ten=Lüganuse\tru=Люганузе\n" +
+	"EE-446\ten=Maardu\tru=Маарду\n" +
+	"EE-45\tde=Ida-Viru\ten=Ida-Virumaa\tru=Ида-Вирумаа\n" +
+	"EE-478\ten=Muhu\n" +
+	"EE-480\ten=Mulgi\n" +
+	"EE-486\ten=Mustvee\tru=Муствеэ\n" +
+	"EE-50\tde=Jõgeva\ten=Jõgevamaa\tfr=Jogevamaa\tru=Йыгевамаа\n" +
+	"EE-503\ten=Märjamaa\tru=Мярьямаа\n" +
+	"EE-511\ten=Narva\tru=Нарва\n" +
+	"EE-514\ten=Narva-Jõesuu\tru=Нарва-Йыэсуу\n" +
+	"EE-52\tde=Järva\ten=Järvamaa\tru=Ярвамаа\n" +
+	"EE-528\ten=Nõo\n" +
+	"EE-557\ten=Otepää\tru=Отепя\n" +
+	"EE-56\tde=Lääne\ten=Läänemaa\tru=Ляэнемаа\n" +
+	"EE-567\ten=Paide\tru=Пайде\n" +
+	"EE-586\ten=Peipsiääre\n" +
+	"EE-60\tde=Lääne-Viru\ten=Lääne-Virumaa\tru=Ляэне-Вирумаа\n" +
+	"EE-615\ten=Põhja-Sakala\n" +
+	"EE-618\ten=Põltsamaa\tru=Пылтсамаа\n" +
+	"EE-622\ten=Põlva\tru=Пылва\n" +
+	"EE-624\ten=Pärnu\tru=Пярну\n" +
+	"EE-638\ten=Põhja-Pärnumaa\n" +
+	"EE-64\tde=Põlva\ten=Põlvamaa\tru=Пылвамаа\n" +
+	"EE-651\ten=Raasiku\tru=Раазику\n" +
+	"EE-653\ten=Rae\n" +
+	"EE-661\ten=Rakvere\tru=Раквере\n" +
+	"EE-663\ten=Rakvere\tru=Раквере\n" +
+	"EE-668\ten=Rapla\tru=Рапла\n" +
+	"EE-68\tde=Pärnu\ten=Pärnumaa\tru=Пярнумаа\n" +
+	"EE-689\ten=Ruhnu\tru=Рухну\n" +
+	"EE-698\ten=Rõuge\n" +
+	"EE-708\ten=Räpina\tru=Ряпина\n" +
+	"EE-71\tde=Rapla\ten=Raplamaa\tru=Рапламаа\n" +
+	"EE-712\ten=Saarde\n" +
+	"EE-714\tde=Saare\ten=Saaremaa\tja=サーレマー\tru=Сааремаа\n" +
+	"EE-719\ten=Saku\tru=Саку\n" +
+	"EE-726\ten=Saue\tru=Сауэ\n" +
+	"EE-732\ten=Setomaa\tru=Сетумаа\n" +
+	"EE-735\ten=Sillamäe\tru=Силламяэ\n" +
+	"EE-74\tde=Saare\ten=Saaremaa\tja=サーレマー\tru=Сааремаа\n" +
+	"EE-784\ten=Tallinn\tru=Таллин\n" +
+	"EE-79\tde=Tartu\ten=Tartumaa\tru=Тартумаа\n" +
+	"EE-792\ten=Tapa\tru=Тапа\n" +
+	"EE-793\ten=Tartu\tru=Тарту\n" +
+	"EE-796\ten=Tartu\tru=Тарту\n" +
+	"EE-803\ten=Toila\tru=Тойла\n" +
+	"EE-809\ten=Tori\n" +
+	"EE-81\tde=Valga\ten=Valgamaa\tru=Валгамаа\tzh-CN=瓦尔加省\n" +
+	"EE-824\ten=Tõrva\tru=Тырва\n" +
+	"EE-834\ten=Türi\n" +
+	"EE-84\tde=Viljandi\ten=Viljandimaa\tru=Вильяндимаа\n" +
+	"EE-855\ten=Valga\n" +
+	"EE-87\tde=Võru\ten=Võrumaa\tfr=Vorumaa\tru=Вырумаа\n" +
+	"EE-890\ten=Viimsi\tru=Виймси\n" +
+	"EE-897\ten=Viljandi\tru=Вильянди\n" +
+	"EE-899\ten=Viljandi\tru=Вильянди\n" +
+	"EE-901\ten=Vinni\n" +
+	"EE-903\ten=Viru-Nigula\tru=Виру-Нигула\n" +
+	"EE-907\ten=Vormsi\tru=Вормси\n" +
+	"EE-917\ten=Võru\tru=Выру\n" +
+	"EE-919\ten=Võru\tru=Выру\n" +
+	"EE-928\ten=Väike-Maarja\n" +
+	"EG-ALX\ten=Al Iskandarīyah\tfr=Al Iskandariyah\n" +
+	"EG-ASN\ten=Aswān\tfr=Aswan\tja=アスワン\tru=Асуан\n" +
+	"EG-AST\ten=Asyūţ\tru=Асьют\n" +
+	"EG-BA\tde=Al-Baḩr al-Aḩmar\ten=Al Baḩr al Aḩmar\tfr=Mer Rouge\tja=紅海\n" +
+	"EG-BH\ten=Al Buḩayrah\tru=Эль-Фуджайра\n" +
+	"EG-BNS\ten=Banī Suwayf\tfr=Bani Suwayf\tja=ベニスエフ\tru=Бени-Суэйф\n" +
+	"EG-C\ten=Al Qāhirah\tfr=Al Qahirah\n" +
+	"EG-DK\ten=Ad Daqahlīyah\tfr=Ad Daqahliyah\tru=Дакахлия\n" +
+	"EG-DT\ten=Dumyāţ\n" +
+	"EG-FYM\ten=Al Fayyūm\tfr=Al Fayyum\tja=ファユーム\tru=Эль-Файюм\n" +
+	"EG-GH\ten=Al Gharbīyah\tfr=Al Gharbiyah\tja=ガルビーヤ\tru=Эль-Гарбия\n" +
+	"EG-GZ\ten=Al Jīzah\tfr=Al Jizah\tru=Эль-Гиза\n" +
+	"EG-IS\ten=Al Ismā'īlīyah\n" +
+	"EG-JS\ten=Janūb Sīnā'\tfr=Janub Sina\n" +
+	"EG-KB\ten=Al Qalyūbīyah\tfr=Al Qalyubiyah\n" +
+	"EG-KFS\ten=Kafr ash Shaykh\tru=Кафр-эш-Шейх\tzh-CN=谢赫村\n" +
+	"EG-KN\ten=Qinā\tfr=Qina\tja=キーナ\n" +
+	"EG-LX\ten=Al Uqşur\tru=Луксор\n" +
+	"EG-MN\ten=Al Minyā\tfr=Al Minya\tja=ミニヤ\n" +
+	"EG-MNF\ten=Al Minūfīyah\tfr=Al Minufiyah\n" +
+	"EG-MT\ten=Maţrūḩ\n" +
+	"EG-PTS\ten=Būr Sa‘īd\tru=Порт-Саид\n" +
+	"EG-SHG\ten=Sūhāj\tfr=Suhaj\tja=ソハーグ\tru=Сохаг\n" +
+	"EG-SHR\ten=Ash Sharqīyah\tfr=Ash Sharqiyah\tru=Эш-Шаркия\n" +
+	"EG-SIN\ten=Shamāl Sīnā'\n" +
+	"EG-SUZ\ten=As Suways\tja=スエズ\tzh-CN=苏伊士\n" +
+	"EG-WAD\ten=Al Wādī al Jadīd\tfr=Al Wadi al Jadid\tru=Вади-эль-Гедид\n" +
+	"ER-AN\tde=Anseba\ten=Ansabā\n" +
+	"ER-DK\ten=Debubawi K’eyyĭḥ Baḥri\n" +
+	"ER-DU\ten=Al Janūbī\n" +
+	"ER-GB\ten=Gash-Barka\tru=Гаш-Барка\n" +
+	"ER-MA\ten=Al Awsaţ\n" +
+	"ER-SK\ten=Semienawi K’eyyĭḥ Baḥri\n" +
+	"ES-A\ten=Alacant*\n" +
+	"ES-AB\ten=Albacete\tja=アルバセテ\tru=Альбасете\tzh-CN=阿尔瓦塞特\n" +
+	"ES-AL\ten=Almería\tfr=Almeria\tja=アルメリア\tru=Альмерия\tzh-CN=阿尔梅里亚\n" +
+	"ES-AN\tde=Andalusien\ten=Andalucía\tfr=Andalousie\tja=アンダルシア\tru=Андалусия\n" +
+	"ES-AR\tde=Aragonien\ten=Aragón\tfr=Aragon\tja=アラゴン\tru=Арагон\tzh-CN=阿拉贡\n" +
+	"ES-AS\tde=Asturien\ten=Asturias, Principado de\tfr=Asturies, principauté des\tja=アストゥリアス\tzh-CN=阿斯图利亚斯省\n" +
+	"ES-AV\ten=Ávila\tfr=Avila\tja=アビラ\tzh-CN=阿维拉\n" +
+	"ES-B\ten=Barcelona [Barcelona]\n" +
+	"ES-BA\ten=Badajoz\tja=バダホス\tru=Бадахос\tzh-CN=巴达霍斯\n" +
+	"ES-BI\ten=Bizkaia\tes=Vizcaya\tfr=Biscaye\tru=Бискайя\n" +
+	"ES-BU\ten=Burgos\tja=ブルゴス\tru=Бургос\tzh-CN=布尔戈斯\n" +
+	"ES-C\ten=A Coruña [La Coruña]\tfr=La Corogne\n" +
+	"ES-CA\ten=Cádiz\tfr=Cadix\tja=カディス\tru=Кадис\tzh-CN=加的斯\n" +
+	"ES-CB\tde=Kantabrien\ten=Cantabria\tfr=Cantabrique\tja=カンタブリア\tru=Кантабрия\tzh-CN=坎塔布利亚\n" +
+	"ES-CC\ten=Cáceres\tfr=Caceres\tja=カセレス\tru=Касерес\tzh-CN=卡塞雷斯\n" +
+	"ES-CE\ten=Ceuta\tja=セウタ\tru=Сеута\tzh-CN=休达\n" +
+	"ES-CL\tde=Kastilien und León\ten=Castilla y León\tfr=Castille et Léon\tja=カスティーリャレオン\tru=Кастилия-Леон\n" +
+	"ES-CM\tde=Kastilien-La Mancha\ten=Castilla-La Mancha\tfr=Castille-La Manche\tja=カスティーリャラマンチャ\tru=Кастилия-Ла-Манча\tzh-CN=卡斯蒂利亚－拉曼恰\n" +
+	"ES-CN\tde=Kanaren\ten=Canarias\tfr=Canaries\tja=カナリア\tru=Канариас\tzh-CN=加那利\n" +
+	"ES-CO\ten=Córdoba\tfr=Cordoba\tja=コルドバ\tru=Кордова\tzh-CN=科尔多瓦省\n" +
+	"ES-CR\ten=Ciudad Real\tja=シウダーレアル\tru=Сьюдад-Реаль\tzh-CN=雷阿尔城\n" +
+	"ES-CS\ten=Castelló*\n" +
+	"ES-CT\ten=Catalunya [Cataluña]\n" +
+	"ES-CU\ten=Cuenca\tja=クエンカ\tru=Куэнка\tzh-CN=昆卡\n" +
+	"ES-EX\ten=Extremadura\tfr=Extramadoure\tja=エストレマドゥーラ\tru=Эстремадура\tzh-CN=埃斯特雷马杜拉\n" +
+	"ES-GA\ten=Galicia [Galicia]\n" +
+	"ES-GC\ten=Las Palmas\tja=ラスパルマス\tru=Лас-Пальмас-де-Гран-Канария\tzh-CN=拉斯帕尔马斯\n" +
+	"ES-GI\ten=Girona [Gerona]\n" +
+	"ES-GR\ten=Granada\tfr=Grenade\tja=グラナダ\tru=Гранада\tzh-CN=格拉纳达\n" +
+	"ES-GU\ten=Guadalajara\tja=グアダラハラ\tru=Гвадалахара\tzh-CN=瓜达拉哈拉\n" +
+	"ES-H\ten=Huelva\tja=ウエルバ\tru=Уэльва\tzh-CN=韦尔瓦\n" +
+	"ES-HU\ten=Huesca\tja=ウエスカ\tru=Уэска\tzh-CN=韦斯卡\n" +
+	"ES-IB\ten=Illes Balears [Islas Baleares]\n" +
+	"ES-J\ten=Jaén\tfr=Jaen\tja=ハエン\tzh-CN=哈恩\n" +
+	"ES-L\ten=Lleida [Lérida]\n" +
+	"ES-LE\ten=León\tfr=Léon\tja=レオン\tru=Леон\tzh-CN=莱昂\n" +
+	"ES-LO\ten=La Rioja\tja=ラリオハ\tru=Риоха\tzh-CN=拉里奥哈\n" +
+	"ES-LU\ten=Lugo [Lugo]\n" +
+	"ES-M\ten=Madrid\tja=マドリード\tru=Мадрид\tzh-CN=马德里\n" +
+	"ES-MA\ten=Málaga\tfr=Malaga\tja=マラガ\tru=Малага\tzh-CN=马拉加\n" +
+	"ES-MC\ten=Murcia, Región de\tfr=Murcei, région de\tja=ムルシア\n" +
+	"ES-MD\tde=Madrid\ten=Madrid, Comunidad de\tfr=Madrid, Communauté de\tja=マドリード州\tzh-CN=马德里\n" +
+	"ES-ML\ten=Melilla\tja=メリリヤ\tru=Мелилья\tzh-CN=梅利利亚\n" +
+	"ES-MU\ten=Murcia\tfr=Murcie\tja=ムルシア\tru=Мурсия\tzh-CN=穆尔西亚\n" +
+	"ES-NA\ten=Nafarroa*\n" +
+	"ES-NC\ten=Nafarroako Foru Komunitatea*\n" +
+	"ES-O\tde=Asturien\ten=Asturias\tja=アストゥリアス\tru=Астурия\tzh-CN=阿斯图利亚斯\n" +
+	"ES-OR\ten=Ourense [Orense]\n" +
+	"ES-P\ten=Palencia\tja=パレンシア\tru=Паленсия\tzh-CN=帕伦西亚\n" +
+	"ES-PM\ten=Illes Balears [Islas Baleares]\n" +
+	"ES-PO\ten=Pontevedra [Pontevedra]\n" +
+	"ES-PV\ten=Euskal Herria\tru=Баскские земли\n" +
+	"ES-RI\ten=La Rioja\tja=ラリオハ\tru=Риоха\tzh-CN=拉里奥哈\n" +
+	"ES-S\tde=Kantabrien\ten=Cantabria\tfr=Cantabrique\tja=カンタブリア\tru=Кантабрия\tzh-CN=坎塔布利亚\n" +
+	"ES-SA\ten=Salamanca\tfr=Salamanque\tja=サラマンカ\tru=Саламанка\tzh-CN=萨拉曼卡\n" +
+	"ES-SE\ten=Sevilla\tfr=Séville\tja=セビーリャ\tru=Севилья\tzh-CN=塞维利亚\n" +
+	"ES-SG\ten=Segovia\tfr=Segovie\tja=セゴビア\tru=Сеговия\tzh-CN=塞哥维亚\n" +
+	"ES-SO\ten=Soria\tja=ソリア\tru=Сория\tzh-CN=索里亚\n" +
+	"ES-SS\tde=Guipúzcoa\ten=Gipuzkoa\tes=Guipúzcoa\tfr=Guipuscoa\tru=Гипускоа\n" +
+	"ES-T\ten=Tarragona [Tarragona]\n" +
+	"ES-TE\ten=Teruel\tfr=Téruel\tja=テルエル\tru=Теруэль\tzh-CN=特鲁埃尔\n" +
+	"ES-TF\ten=Santa Cruz de Tenerife\tja=サンタクルス・デ・テネリフェ\tru=Санта-Крус-де-Тенерифе\tzh-CN=圣克鲁斯-德特内里费\n" +
+	"ES-TO\ten=Toledo\tja=トレド\tru=Толедо\n" +
+	"ES-V\ten=Valencia\tru=Валенсия\n" +
+	"ES-VA\ten=Valladolid\tja=バリャドリード\tru=Вальядолид\tzh-CN=巴利亚多利德\n" +
+	"ES-VC\ten=Valenciana, Comunidad\n" +
+	"ES-VI\ten=Araba*\n" +
+	"ES-Z\tde=Saragossa\ten=Zaragoza\tfr=Saragosse\tja=サラゴーサ\tru=Сарагоса\tzh-CN=萨拉戈萨\n" +
+	"ES-ZA\ten=Zamora\tja=サモラ\tru=Самора\tzh-CN=萨莫拉\n" +
+	"ET-AA\ten=Addis Ababa\tfr=Addis-Abeba\tru=Аддис-Абеба\n" +
+	"ET-AF\ten=Afar\n" +
+	"ET-AM\ten=Amara\tru=Амара\n" +
+	"ET-BE\ten=Benshangul-Gumaz\tru=Бенишангуль-Гумуз\n" +
+	"ET-DD\ten=Dire Dawa\tru=Дыре-Дауа\n" +
+	"ET-GA\ten=Gambela Peoples\n" +
+	"ET-HA\ten=Harari People\n" +
+	"ET-OR\ten=Oromia\tru=Оромия\n" +
+	"ET-SN\tde=Southern Nations, Nationalities und Peoples\ten=Southern Nations, Nationalities and Peoples\n" +
+	"ET-SO\ten=Somali\n" +
+	"ET-TI\ten=Tigrai\n" +
+	"FI-01\ten=Åland\tru=Аландские острова\n" +
+	"FI-02\tde=Südkarelien\ten=Etelä-Karjala\tfr=Carélie du Sud\tru=Южная Карелия\n" +
+	"FI-03\tde=Südösterbotten\ten=Etelä-Pohjanmaa\tfr=Ostrobotnie du Sud\tru=Южная Остроботния\n" +
+	"FI-04\tde=Südsavo\ten=Etelä-Savo\tfr=Savonie du Sud\tru=Южное Саво\n" +
+	"FI-05\ten=Kainuu\tru=Кайнуу\n" +
+	"FI-06\ten=Kanta-Häme\n" +
+	"FI-07\tde=Mittelösterbotten\ten=Keski-Pohjanmaa\tfr=Ostrobotnie-Centrale\n" +
+	"FI-08\tde=Mittelfinnland\ten=Keski-Suomi\tfr=Finlande-Centrale\n" +
+	"FI-09\ten=Kymenlaakso\tfr=Vallée de la Kymi\tru=Кюменлааксо\n" +
+	"FI-10\tde=Lappland\ten=Lappi\tfr=Laponie\tja=ラッピ\tru=Лаппи\n" +
+	"FI-11\ten=Pirkanmaa\tru=Бургенланд\n" +
+	"FI-12\tde=Österbotten\ten=Pohjanmaa\tfr=Ostrobotnie\n" +
+	"FI-13\tde=Nordkarelien\ten=Pohjois-Karjala\n" +
+	"FI-14\tde=Nordösterbotten\ten=Pohjois-Pohjanmaa\n" +
+	"FI-15\tde=Nordsavo\ten=Pohjois-Savo\n" +
+	"FI-16\ten=Päijät-Häme\n" +
+	"FI-17\ten=Satakunta\tru=Сатакунта\n" +
+	"FI-18\ten=Uusimaa\tru=Ниланд\n" +
+	"FI-19\ten=Varsinais-Suomi\n" +
+	"FJ-01\ten=Ba\n" +
+	"FJ-02\ten=Bua\n" +
+	"FJ-03\ten=Cakaudrove\n" +
+	"FJ-04\ten=Kadavu\n" +
+	"FJ-05\ten=Lau\n" +
+	"FJ-06\ten=Lomaiviti\n" +
+	"FJ-07\ten=Macuata\n" +
+	"FJ-08\tde=Nadroga und Navosa\ten=Nadroga and Navosa\n" +
+	"FJ-09\ten=Naitasiri\n" +
+	"FJ-10\ten=Namosi\n" +
+	"FJ-11\ten=Ra\n" +
+	"FJ-12\ten=Rewa\n" +
+	"FJ-13\ten=Serua\n" +
+	"FJ-14\ten=Tailevu\n" +
+	"FJ-C\ten=Central\tja=セントラル\tzh-CN=中部\n" +
+	"FJ-E\ten=Eastern\tfr=Est\tzh-CN=东部区\n" +
+	"FJ-N\ten=Northern\tfr=Nord\tzh-CN=北部区\n" +
+	"FJ-R\ten=Rotuma\tja=ロトゥーマ\tzh-CN=罗图马岛\n" +
+	"FJ-W\ten=Western\tfr=Ouest\tzh-CN=西部区\n" +
+	"FM-KSA\ten=Kosrae\tja=コスラエ\tzh-CN=科斯雷\n" +
+	"FM-PNI\ten=Pohnpei\tja=ポーンペイ\tzh-CN=波纳佩\n" +
+	"FM-TRK\ten=Chuuk\tzh-CN=丘克\n" +
+	"FM-YAP\ten=Yap\tja=ヤップ\tzh-CN=雅浦\n" +
+	"FR-01\ten=Ain\tru=Эн\tzh-CN=安省\n" +
+	"FR-02\ten=Aisne\tru=Эна\tzh-CN=埃纳省\n" +
+	"FR-03\ten=Allier\tru=Алье\tzh-CN=阿列省\n" +
+	"FR-04\ten=Alpes-de-Haute-Provence\tru=Альпы Верхнего Прованса\tzh-CN=上普罗旺斯阿尔卑斯省\n" +
+	"FR-05\ten=Hautes-Alpes\tru=Верхние Альпы\tzh-CN=上阿尔卑斯省\n" +
+	"FR-06\ten=Alpes-Maritimes\tru=Приморские Альпы\tzh-CN=滨海阿尔卑斯省\n" +
+	"FR-07\ten=Ardèche\tru=Ардеш\tzh-CN=阿尔代什省\n" +
+	"FR-08\ten=Ardennes\tru=Арденны\tzh-CN=阿登省\n" +
+	"FR-09\ten=Ariège\tru=Арьеж\tzh-CN=阿列日省\n" +
+	"FR-10\ten=Aube\tru=Об\tzh-CN=奥布省\n" +
+	"FR-11\ten=Aude\tru=Од\tzh-CN=奥德省\n" +
+	"FR-12\ten=Aveyron\tru=Аверон\tzh-CN=阿韦龙省\n" +
+	"FR-13\ten=Bouches-du-Rhône\tru=Буш-дю-Рон\tzh-CN=罗讷河口省\n" +
+	"FR-14\ten=Calvados\tru=Кальвадос\tzh-CN=卡尔瓦多斯省\n" +
+	"FR-15\ten=Cantal\tru=Канталь\tzh-CN=康塔尔省\n" +
+	"FR-16\ten=Charente\tru=Шаранта\tzh-CN=夏朗德省\n" +
+	"FR-17\ten=Charente-Maritime\tru=Приморская Шаранта\tzh-CN=滨海夏朗德省\n" +
+	"FR-18\ten=Cher\tru=Шер\tzh-CN=谢尔省\n" +
+	"FR-19\ten=Corrèze\tru=Коррез\tzh-CN=科雷兹省\n" +
+	"FR-20R\tde=Korsika\ten=Corse\tja=コルス (コルシカ)\tru=Корсика\tzh-CN=科西嘉\n" +
+	"FR-21\ten=Côte-d'Or\tru=Кот-д’Ор\tzh-CN=科多尔省\n" +
+	"FR-22\ten=Côtes-d'Armor\tru=Кот-д’Армор\tzh-CN=阿摩尔滨海省\n" +
+	"FR-23\ten=Creuse\tru=Крёз\tzh-CN=克勒兹省\n" +
+	"FR-24\ten=Dordogne\tru=Дордонь\tzh-CN=多尔多涅省\n" +
+	"FR-25\ten=Doubs\tru=Ду\tzh-CN=杜省\n" +
+	"FR-26\ten=Drôme\tru=Дром\tzh-CN=德龙省\n" +
+	"FR-27\ten=Eure\tru=Эр\tzh-CN=厄尔省\n" +
+	"FR-28\ten=Eure-et-Loir\tru=Эр и Луар\tzh-CN=厄尔-卢瓦尔省\n" +
+	"FR-29\ten=Finistère\tru=Финистер\tzh-CN=菲尼斯泰尔省\n" +
+	"FR-2A\ten=Corse-du-Sud\tru=Южная Корсика\tzh-CN=南科西嘉\n" +
+	"FR-2B\ten=Haute-Corse\tru=Верхняя Корсика\tzh-CN=上科西嘉\n" +
+	"FR-30\ten=Gard\tru=Гар\tzh-CN=加尔省\n" +
+	"FR-31\ten=Haute-Garonne\tru=Верхняя Гаронна\tzh-CN=上加龙省\n" +
+	"FR-32\ten=Gers\tru=Жер\tzh-CN=热尔省\n" +
+	"FR-33\ten=Gironde\tru=Жиронда\tzh-CN=吉伦特省\n" +
+	"FR-34\ten=Hérault\tru=Эро\tzh-CN=埃罗省\n" +
+	"FR-35\ten=Ille-et-Vilaine\tru=Иль и Вилен\tzh-CN=伊勒-维莱讷省\n" +
+	"FR-36\ten=Indre\tru=Эндр\tzh-CN=安德尔省\n" +
+	"FR-37\ten=Indre-et-Loire\tru=Эндр и Луара\tzh-CN=安德尔-卢瓦尔省\n" +
+	"FR-38\ten=Isère\tru=Изер\tzh-CN=伊泽尔省\n" +
+	"FR-39\ten=Jura\tja=ジュラ\tru=Юра\tzh-CN=汝拉\n" +
+	"FR-40\ten=Landes\tru=Ланды\tzh-CN=朗德省\n" +
+	"FR-41\ten=Loir-et-Cher\tru=Луар и Шер\tzh-CN=卢瓦尔-谢尔省\n" +
+	"FR-42\ten=Loire\tru=Луара\tzh-CN=卢瓦尔省\n" +
+	"FR-43\ten=Haute-Loire\tru=Верхняя Луара\tzh-CN=上卢瓦尔省\n" +
+	"FR-44\ten=Loire-Atlantique\tru=Атлантическая Луара\tzh-CN=大西洋卢瓦尔省\n" +
+	"FR-45\ten=Loiret\tru=Луаре\tzh-CN=卢瓦雷省\n" +
+	"FR-46\ten=Lot\tru=Лот\tzh-CN=洛特省\n" +
+	"FR-47\ten=Lot-et-Garonne\tru=Ло и Гаронна\tzh-CN=洛特-加龙省\n" +
+	"FR-48\ten=Lozère\tru=Лозер\tzh-CN=洛泽尔省\n" +
+	"FR-49\ten=Maine-et-Loire\tru=Мен и Луара\tzh-CN=曼恩-卢瓦尔省\n" +
+	"FR-50\ten=Manche\tru=Манш\tzh-CN=芒什省\n" +
+	"FR-51\ten=Marne\tru=Марна\tzh-CN=马恩省\n" +
+	"FR-52\ten=Haute-Marne\tru=Верхняя Марна\n" +
+	"FR-53\ten=Mayenne\tru=Майен\tzh-CN=马耶讷省\n" +
+	"FR-54\ten=Meurthe-et-Moselle\tru=Мёрт и Мозель\tzh-CN=默尔特-摩泽尔省\n" +
+	"FR-55\ten=Meuse\tru=Маас\tzh-CN=默兹省\n" +
+	"FR-56\ten=Morbihan\tru=Морбиан\tzh-CN=莫尔比昂省\n" +
+	"FR-57\ten=Moselle\tru=Мозель\tzh-CN=摩泽尔省\n" +
+	"FR-58\ten=Nièvre\tru=Ньевр\tzh-CN=涅夫勒省\n" +
+	"FR-59\ten=Nord\tzh-CN=北部省\n" +
+	"FR-60\ten=Oise\tru=Уаза\tzh-CN=瓦兹省\n" +
+	"FR-61\ten=Orne\tru=Орн\tzh-CN=奥恩省\n" +
+	"FR-62\ten=Pas-de-Calais\tru=Па-де-Кале\tzh-CN=加来海峡省\n" +
+	"FR-63\ten=Puy-de-Dôme\tru=Пюи-де-Дом\tzh-CN=多姆山省\n" +
+	"FR-64\ten=Pyrénées-Atlantiques\tru=Атлантические Пиренеи\tzh-CN=比利牛斯-大西洋省\n" +
+	"FR-65\ten=Hautes-Pyrénées\tru=Верхние Пиренеи\tzh-CN=上比利尼斯省\n" +
+	"FR-66\ten=Pyrénées-Orientales\tru=Восточные Пиренеи\tzh-CN=东比利尼斯省\n" +
+	"FR-67\ten=Bas-Rhin\tru=Нижний Рейн\tzh-CN=下莱茵省\n" +
+	"FR-68\ten=Haut-Rhin\tru=Верхний Рейн\tzh-CN=上莱茵省\n" +
+	"FR-69\ten=Rhône\tru=Рона\tzh-CN=罗讷省\n" +
+	"FR-70\ten=Haute-Saône\tru=Верхняя Сона\tzh-CN=上索恩省\n" +
+	"FR-71\ten=Saône-et-Loire\tru=Сона и Луара\tzh-CN=索恩-卢瓦尔省\n" +
+	"FR-72\ten=Sarthe\tru=Сарта\tzh-CN=萨尔特省\n" +
+	"FR-73\ten=Savoie\tru=Савойя\tzh-CN=萨瓦省\n" +
+	"FR-74\ten=Haute-Savoie\tru=Верхняя Савойя\tzh-CN=上萨瓦省\n" +
+	"FR-75\ten=Paris\tja=パリ\tru=Париж\tzh-CN=巴黎省\n" +
+	"FR-76\ten=Seine-Maritime\tru=Приморская Сена\tzh-CN=滨海塞纳省\n" +
+	"FR-77\ten=Seine-et-Marne\tru=Сена и Марна\tzh-CN=塞纳-马恩省\n" +
+	"FR-78\ten=Yvelines\tru=Ивелин\tzh-CN=伊夫林省\n" +
+	"FR-79\ten=Deux-Sèvres\tru=Дё-Севр\tzh-CN=德塞夫勒省\n" +
+	"FR-80\ten=Somme\tru=Сомма\tzh-CN=索姆省\n" +
+	"FR-81\ten=Tarn\tru=Тарн\tzh-CN=塔恩省\n" +
+	"FR-82\ten=Tarn-et-Garonne\tru=Тарн и Гаронна\tzh-CN=塔恩-加龙省\n" +
+	"FR-83\ten=Var\tzh-CN=瓦尔省\n" +
+	"FR-84\ten=Vaucluse\tru=Воклюз\tzh-CN=沃克吕兹省\n" +
+	"FR-85\ten=Vendée\tru=Вандея\tzh-CN=旺代省\n" +
+	"FR-86\ten=Vienne\tru=Вьенна\tzh-CN=维埃纳省\n" +
+	"FR-87\ten=Haute-Vienne\tru=Верхняя Вьенна\tzh-CN=上维埃纳省\n" +
+	"FR-88\ten=Vosges\tru=Вогезы\tzh-CN=孚日省\n" +
+	"FR-89\ten=Yonne\tru=Йонна\tzh-CN=约讷省\n" +
+	"FR-90\ten=Territoire de Belfort\tes=Territorio de Belfort\tru=Территория Бельфор\tzh-CN=贝尔福地区\n" +
+	"FR-91\ten=Essonne\tru=Эсон\tzh-CN=埃松省\n" +
+	"FR-92\ten=Hauts-de-Seine\tru=О-де-Сен\tzh-CN=上塞纳省\n" +
+	"FR-93\ten=Seine-Saint-Denis\tru=Сен-Сен-Дени\tzh-CN=塞纳-圣但尼省\n" +
+	"FR-94\ten=Val-de-Marne\tru=Валь-де-Марн\tzh-CN=瓦勒德马恩省\n" +
+	"FR-95\ten=Val-d'Oise\tfr=Val-d’Oise\tru=Валь-д’Уаз\n" +
+	"FR-971\ten=Guadeloupe\tes=Guadalupe\tja=グアドループ\tru=Гваделупа\tzh-CN=瓜德罗普\n" +
+	"FR-972\ten=Martinique\tja=マルティニーク\tru=Мартиника\tzh-CN=马提尼克\n" +
+	"FR-973\tde=Französisch-Guayana\ten=Guyane (française)\n" +
+	"FR-974\ten=La Réunion\tru=Реюньон\n" +
+	"FR-976\ten=Mayotte\tja=マヨット\tru=Майотта\tzh-CN=马约特\n" +
+	"FR-ARA\ten=Auvergne-Rhône-Alpes\tru=Овернь — Рона — Альпы\n" +
+	"FR-BFC\ten=Bourgogne-Franche-Comté\tru=Бургундия — Франш-Конте\n" +
+	"FR-BL\ten=Saint-Barthélemy\n" +
+	"FR-BRE\ten=Bretagne\tja=ブルターニュ\tru=Бретань\tzh-CN=布列塔尼\n" +
+	"FR-CP\ten=Clipperton\n" +
+	"FR-CVL\ten=Centre-Val de Loire\tru=Центр — Долина Луары\n" +
+	"FR-GES\ten=Grand-Est\tfr=Grand Est\tru=Гранд-Эст\n" +
+	"FR-GF\tde=Französisch-Guayana\ten=Guyane (française)\n" +
+	"FR-GP\ten=Guadeloupe\tes=Guadalupe\tja=グアドループ\tru=Гваделупа\tzh-CN=瓜德罗普\n" +
+	"FR-HDF\ten=Hauts-de-France\tru=О-де-Франс\n" +
+	"FR-IDF\tde=Île de France\ten=Île-de-France\tja=イルドフランス\tru=Иль-де-Франс\tzh-CN=法兰西岛\n" +
+	"FR-MF\ten=Saint-Martin\n" +
+	"FR-MQ\ten=Martinique\tja=マルティニーク\tru=Мартиника\tzh-CN=马提尼克\n" +
+	"FR-NAQ\ten=Nouvelle-Aquitaine\tru=Новая Аквитания\n" +
+	"FR-NC\tde=Neukaledonien\ten=Nouvelle-Calédonie\tru=Новая Каледония\tzh-CN=新喀里多尼亚\n" +
+	"FR-NOR\ten=Normandie\n" +
+	"FR-OCC\tde=Okzitanien\ten=Occitanie\tes=Occitania\tru=Окситания\n" +
+	"FR-PAC\ten=Provence-Alpes-Côte-d’Azur\tfr=Provence-Alpes-Côte d'Azur\n" +
+	"FR-PDL\ten=Pays-de-la-Loire\tfr=Pays de la Loire\tru=Пеи-де-ла-Луар\n" +
+	"FR-PF\tde=Französisch-Polynesien\ten=Polynésie française\tru=Французская Полинезия\tzh-CN=法属玻里尼西亚\n" +
+	"FR-PM\ten=Saint-Pierre-et-Miquelon\tru=Сен-Пьер и Микелон\tzh-CN=圣皮埃尔和密克隆群岛\n" +
+	"FR-RE\ten=La Réunion\tru=Реюньон\n" +
+	"FR-TF\tde=Französische Südgebiete\ten=Terres australes françaises\tru=Французские Южные и Антарктические территории\tzh-CN=法属南部领地\n" +
+	"FR-WF\ten=Wallis-et-Futuna\tru=Уоллис и Футуна\n" +
+	"FR-YT\ten=Mayotte\tja=マヨット\tru=Майотта\tzh-CN=马约特\n" +
+	"GA-1\ten=Estuaire\tja=エスチュエール\tzh-CN=河口省\n" +
+	"GA-2\ten=Haut-Ogooué\tja=オートオグエ\tru=Верхнее Огове\tzh-CN=上奥果韦省\n" +
+	"GA-3\ten=Moyen-Ogooué\tja=モアイヤンオグエ\tru=Среднее Огове\tzh-CN=中奥果韦省\n" +
+	"GA-4\ten=Ngounié\tja=ングニエ\tzh-CN=恩古涅省\n" +
+	"GA-5\ten=Nyanga\tja=ニヤンガ\tzh-CN=尼扬加省\n" +
+	"GA-6\ten=Ogooué-Ivindo\tja=オグエイビンド\tru=Огове-Ивиндо\tzh-CN=奥果韦－伊温多省\n" +
+	"GA-7\ten=Ogooué-Lolo\tja=オグエロロ\tru=Огове-Лоло\tzh-CN=奥果韦－洛洛省\n" +
+	"GA-8\ten=Ogooué-Maritime\tja=オグエマリティーム\tzh-CN=滨海奥果韦省\n" +
+	"GA-9\ten=Woleu-Ntem\tja=ワォルンテム\tru=Волё-Нтем\tzh-CN=沃勒－恩特姆省\n" +
+	"GB-ABC\tde=Armagh City, Banbridge und Craigavon\ten=Armagh City, Banbridge and Craigavon\n" +
+	"GB-ABD\ten=Aberdeenshire\tja=アバディーンシア\tru=Абердиншир\tzh-CN=阿伯丁郡\n" +
+	"GB-ABE\ten=Aberdeen City\tfr=Aberdeen ville\tja=アバディーン\tzh-CN=阿伯丁市\n" +
+	"GB-AGB\tde=Argyll und Bute\ten=Argyll and Bute\tfr=Argyll et Bute\tja=アーガイル及びビュート\tru=Аргайл-энд-Бьют\tzh-CN=阿盖尔-比特\n" +
+	"GB-AGY\ten=Isle of Anglesey [Sir Ynys Môn GB-YNM]\n" +
+	"GB-AND\tde=Ards und North Down\ten=Ards and North Down\tfr=Ards et North Down\n" +
+	"GB-ANN\tde=Antrim und Newtownabbey\ten=Antrim and Newtownabbey\tfr=Antrim et Newtownabbey\n" +
+	"GB-ANS\ten=Angus\tja=アンガス\tzh-CN=安格斯\n" +
+	"GB-BAS\tde=Bath und North East Somerset\ten=Bath and North East Somerset\tfr=Bath et Somerset Nord-Est\tja=バス及び北西サマセット\tru=Бат и Северо-Восточный Сомерсет\tzh-CN=巴思-东北萨默塞特\n" +
+	"GB-BBD\ten=Blackburn with Darwen\tfr=Blackburn avec Darwen\tja=ブラックバーン with ダーウェン\tru=Блэкберн-уит-Даруэн\tzh-CN=布莱克本\n" +
+	"GB-BCP\tde=Bournemouth, Christchurch und Poole\ten=Bournemouth, Christchurch and Poole\n" +
+	"GB-BDF\ten=Bedford\tja=ベッドフォード\tru=Бедфорд\n" +
+	"GB-BDG\tde=Barking und Dagenham\ten=Barking and Dagenham\tfr=Barking et Dagenham\tja=バーキング及びダゲナム\tru=Баркинг и Дагенем\tzh-CN=巴金-达根汉姆市\n" +
+	"GB-BEN\ten=Brent\tja=ブレント\tru=Брент\tzh-CN=布兰特市\n" +
+	"GB-BEX\ten=Bexley\tja=ベクスリー\tzh-CN=白克斯雷市\n" +
+	"GB-BFS\ten=Belfast City\tru=Белфаст\n" +
+	"GB-BGE\ten=Bridgend [Pen-y-bont ar Ogwr GB-POG]\n" +
+	"GB-BGW\ten=Blaenau Gwent\tja=ブレナウ グウェント\tru=Блайнай-Гуэнт\tzh-CN=布莱耐格温特\n" +
+	"GB-BIR\ten=Birmingham\tja=バーミンガム\tru=Бирмингем\tzh-CN=伯明翰郡\n" +
+	"GB-BKM\ten=Buckinghamshire\tja=バッキンガムシャー\tru=Бакингемшир\tzh-CN=白金汉郡\n" +
+	"GB-BNE\ten=Barnet\tja=バーネット\tru=Барнет\tzh-CN=巴奈特市\n" +
+	"GB-BNH\tde=Brighton und Hove\ten=Brighton and Hove\tfr=Brighton et Hove\tja=ブリントンアンドホーブ\tru=Брайтон-энд-Хов\tzh-CN=布赖顿-霍伍\n" +
+	"GB-BNS\ten=Barnsley\tja=バーンズリー\tru=Барнсли\tzh-CN=巴恩斯利郡\n" +
+	"GB-BOL\ten=Bolton\tja=ボールトン\tru=Болтон\tzh-CN=博尔顿市\n" +
+	"GB-BPL\ten=Blackpool\tja=ブラックプール\tru=Блэкпул\tzh-CN=布莱克浦\n" +
+	"GB-BRC\ten=Bracknell Forest\tja=ブラックネル フォレスト\tru=Бракнелл-Форест\tzh-CN=布拉克内尔森林\n" +
+	"GB-BRD\ten=Bradford\tja=ブラッドフォード\tru=Брадфорд\tzh-CN=布拉德福德郡\n" +
+	"GB-BRY\ten=Bromley\tja=ブロムリー\tru=Бромли\tzh-CN=布劳姆雷市\n" +
+	"GB-BST\ten=Bristol, City of\tfr=Bristol ville\tja=ブリストル\tzh-CN=布里斯托尔市\n" +
+	"GB-BUR\ten=Bury\tja=ベリー\tru=Бэри\tzh-CN=贝里市\n" +
+	"GB-CAM\ten=Cambridgeshire\tja=ケンブリッジシャー\tru=Кембриджшир\tzh-CN=剑桥郡\n" +
+	"GB-CAY\ten=Caerphilly [Caerffili GB-CAF]\n" +
+	"GB-CBF\ten=Central Bedfordshire\tfr=Bedfordshire central\tru=Центральный Бедфордшир\n" +
+	"GB-CCG\tde=Causeway Coast und Glens\ten=Causeway Coast and Glens\tfr=Causeway Coast et Glens\n" +
+	"GB-CGN\ten=Ceredigion [Sir Ceredigion]\n" +
+	"GB-CHE\ten=Cheshire East\tfr=Cheshire oriental\tru=Восточный Чешир\n" +
+	"GB-CHW\tde=Cheshire West und Chester\ten=Cheshire West and Chester\tfr=Cheshire occidental et Chester\tru=Западный Чешир и Честер\n" +
+	"GB-CLD\ten=Calderdale\tru=Колдердейл\tzh-CN=卡里德戴尔郡\n" +
+	"GB-CLK\ten=Clackmannanshire\tja=クラックマナンシア\tru=Клакманнаншир\tzh-CN=克拉克曼南郡\n" +
+	"GB-CMA\ten=Cumbria\tfr=Cumbrie\tja=カンブリア\tzh-CN=坎布里亚\n" +
+	"GB-CMD\ten=Camden\tja=カムデン\tru=Камден\tzh-CN=坎敦市\n" +
+	"GB-CMN\ten=Carmarthenshire [Sir Gaerfyrddin GB-GFY]\n" +
+	"GB-CON\ten=Cornwall\tfr=Cornouailles\tja=コーンウォール\tru=Корнуолл\tzh-CN=康沃尔\n" +
+	"GB-COV\ten=Coventry\tja=コヴェントリー\tru=Ковентри\tzh-CN=考文垂郡\n" +
+	"GB-CRF\ten=Cardiff [Caerdydd GB-CRD]\n" +
+	"GB-CRY\ten=Croydon\tja=クロイドン\tzh-CN=克劳顿市\n" +
+	"GB-CWY\ten=Conwy\tja=コンウェー\tzh-CN=康威\n" +
+	"GB-DAL\ten=Darlington\tja=ダーリントン\tru=Дарлингтон\tzh-CN=达灵顿\n" +
+	"GB-DBY\ten=Derbyshire\tja=ダービーシャー\tru=Дербишир\tzh-CN=德比郡\n" +
+	"GB-DEN\ten=Denbighshire [Sir Ddinbych GB-DDB]\n" +
+	"GB-DER\ten=Derby\tja=ダービー\tzh-CN=德比\n" +
+	"GB-DEV\ten=Devon\tja=デボン\tzh-CN=德文\n" +
+	"GB-DGY\tde=Dumfries und Galloway\ten=Dumfries and Galloway\tfr=Dumfries et Galloway\tja=ダムフリースアンドギャラウェイ\tru=Дамфрис-энд-Галловей\tzh-CN=邓弗里斯盖洛韦\n" +
+	"GB-DNC\ten=Doncaster\tja=ドンカスター\tru=Донкастер\tzh-CN=唐克斯特郡\n" +
+	"GB-DND\ten=Dundee City\tfr=Dundee ville\tja=ダンディーシティ\tzh-CN=邓迪市\n" +
+	"GB-DOR\ten=Dorset\tja=ドーセット\tru=Дорсет\tzh-CN=多塞特\n" +
+	"GB-DRS\tde=Derry und Strabane\ten=Derry and Strabane\tfr=Derry et Strabane\n" +
+	"GB-DUD\ten=Dudley\tja=ダッドリー\tzh-CN=达德利郡\n" +
+	"GB-DUR\ten=Durham, County\n" +
+	"GB-EAL\ten=Ealing\tja=イーリング\tzh-CN=伊林市\n" +
+	"GB-EAY\ten=East Ayrshire\tfr=Ayrshire oriental\tja=東エアーシア\tru=Ист-Эршир\tzh-CN=东艾尔郡\n" +
+	"GB-EDH\ten=Edinburgh, City of\tfr=Edimbourg ville\tja=エディンバラ\tzh-CN=爱丁堡市\n" +
+	"GB-EDU\ten=East Dunbartonshire\tfr=Dunbartonshire oriental\tja=東ダンバートンシャー\tru=Ист-Данбартоншир\tzh-CN=东邓巴顿郡\n" +
+	"GB-ELN\ten=East Lothian\tfr=Lothian oriental\tru=Ист-Лотиан\tzh-CN=东洛锡安\n" +
+	"GB-ELS\ten=Eilean Siar\tzh-CN=埃利安-锡尔\n" +
+	"GB-ENF\ten=Enfield\tru=Энфилд\tzh-CN=英菲尔德市\n" +
+	"GB-ENG\ten=England\n" +
+	"GB-ERW\ten=East Renfrewshire\tfr=Renfrewshire oriental\tja=東レンフルーシャー\tru=Ист-Ренфрушир\tzh-CN=东伦弗鲁郡\n" +
+	"GB-ERY\ten=East Riding of Yorkshire\tfr=Riding of Yorkshire oriental\tja=ヨークシャー州イーストライディング\tru=Ист-Райдинг-оф-Йоркшир\tzh-CN=约克郡东区\n" +
+	"GB-ESS\ten=Essex\tru=Эссекс\tzh-CN=埃塞克斯\n" +
+	"GB-ESX\ten=East Sussex\tfr=Sussex oriental\tja=東サセックス\tru=Восточный Суссекс\tzh-CN=东萨塞克斯\n" +
+	"GB-FAL\ten=Falkirk\tru=Фолкерк\tzh-CN=福尔柯克\n" +
+	"GB-FIF\ten=Fife\tru=Файф\tzh-CN=法夫\n" +
+	"GB-FLN\ten=Flintshire [Sir y Fflint GB-FFL]\n" +
+	"GB-FMO\tde=Fermanagh und Omagh\ten=Fermanagh and Omagh\tfr=Fermanagh et Omagh\n" +
+	"GB-GAT\ten=Gateshead\tru=Гейтсхед\tzh-CN=盖茨黑德郡\n" +
+	"GB-GLG\ten=Glasgow City\tfr=Glasgow ville\tja=グラスゴー\tzh-CN=格拉斯哥市\n" +
+	"GB-GLS\ten=Gloucestershire\tja=グロスターシャー\tru=Глостершир\tzh-CN=格洛斯特郡\n" +
+	"GB-GRE\ten=Greenwich\tja=グリニッジ\tzh-CN=格林尼治市\n" +
+	"GB-GWN\ten=Gwynedd\tru=Гуинет\tzh-CN=圭内斯\n" +
+	"GB-HAL\ten=Halton\tzh-CN=哈尔顿\n" +
+	"GB-HAM\ten=Hampshire\tja=ハンプシャー\tru=Гэмпшир\tzh-CN=汉普郡\n" +
+	"GB-HAV\ten=Havering\tru=Хаверинг\tzh-CN=哈佛英市\n" +
+	"GB-HCK\ten=Hackney\tzh-CN=汉克尼市\n" +
+	"GB-HEF\ten=Herefordshire\tja=ヘレフォードシャー\tru=Херефордшир\n" +
+	"GB-HIL\ten=Hillingdon\tzh-CN=黑灵敦市\n" +
+	"GB-HLD\ten=Highland\tzh-CN=苏格兰高地\n" +
+	"GB-HMF\tde=Hammersmith und Fulham\ten=Hammersmith and Fulham\tfr=Hammersmith et Fulham\tru=Хаммерсмит и Фулем\tzh-CN=哈默斯密斯－佛尔汉姆市\n" +
+	"GB-HNS\ten=Hounslow\tzh-CN=杭斯路市\n" +
+	"GB-HPL\ten=Hartlepool\tru=Хартлпул\tzh-CN=哈特尔浦\n" +
+	"GB-HRT\ten=Hertfordshire\tru=Хартфордшир\tzh-CN=赫里福德郡\n" +
+	"GB-HRW\ten=Harrow\tzh-CN=海伦市\n" +
+	"GB-HRY\ten=Haringey\tru=Харинги\tzh-CN=哈灵根市\n" +
+	"GB-IOS\ten=Isles of Scilly\tfr=Îles Scilly\tru=Силли\n" +
+	"GB-IOW\ten=Isle of Wight\tfr=Île de Wight\tzh-CN=怀特岛\n" +
+	"GB-ISL\ten=Islington\tru=Ислингтон\tzh-CN=艾斯灵顿市\n" +
+	"GB-IVC\ten=Inverclyde\tru=Инверклайд\tzh-CN=因弗克莱德\n" +
+	"GB-KEC\tde=Kensington und Chelsea\ten=Kensington and Chelsea\tfr=Kensington et Chelsea\tzh-CN=坎星顿－切尔西市\n" +
+	"GB-KEN\ten=Kent\tru=Кент\tzh-CN=肯特\n" +
+	"GB-KHL\ten=Kingston upon Hull\tru=Кингстон-апон-Халл\n" +
+	"GB-KIR\ten=Kirklees\tru=Керклис\tzh-CN=柯克利斯郡\n" +
+	"GB-KTT\ten=Kingston upon Thames\tfr=Kingston s/ Tamise\tru=Кингстон-апон-Темс\tzh-CN=泰晤士河畔金斯顿市\n" +
+	"GB-KWL\ten=Knowsley\tzh-CN=诺斯雷市\n" +
+	"GB-LAN\ten=Lancashire\tja=ランカシャー\tru=Ланкашир\tzh-CN=兰开夏\n" +
+	"GB-LBC\tde=Lisburn und Castlereagh\ten=Lisburn and Castlereagh\tfr=Lisburn et Castlereagh\n" +
+	"GB-LBH\ten=Lambeth\tzh-CN=兰贝斯市\n" +
+	"GB-LCE\ten=Leicester\tru=Лестер\tzh-CN=莱斯特\n" +
+	"GB-LDS\ten=Leeds\tru=Лидс\tzh-CN=利兹郡\n" +
+	"GB-LEC\ten=Leicestershire\tru=Лестершир\tzh-CN=莱斯特郡\n" +
+	"GB-LEW\ten=Lewisham\tzh-CN=路易斯汉市\n" +
+	"GB-LIN\ten=Lincolnshire\tru=Линкольншир\tzh-CN=林肯郡\n" +
+	"GB-LIV\ten=Liverpool\tja=リヴァプール\tru=Ливерпуль\tzh-CN=利物浦\n" +
+	"GB-LND\ten=London, City of\tfr=Londres ville\tja=ロンドン\tzh-CN=伦敦市\n" +
+	"GB-LUT\ten=Luton\tru=Лутон\tzh-CN=卢顿\n" +
+	"GB-MAN\ten=Manchester\tja=マンチェスター\tru=Манчестер\n" +
+	"GB-MDB\ten=Middlesbrough\tja=ミドルズブラ\tru=Мидлсбро\tzh-CN=米德尔斯堡\n" +
+	"GB-MDW\ten=Medway\tzh-CN=梅德韦\n" +
+	"GB-MEA\tde=Mid und East Antrim\ten=Mid and East Antrim\tfr=Mid et East Antrim\n" +
+	"GB-MIK\ten=Milton Keynes\tja=ミルトンキーンズ\tru=Милтон-Кинс\tzh-CN=米尔顿凯恩斯\n" +
+	"GB-MLN\ten=Midlothian\tru=Мидлотиан\tzh-CN=中洛锡安\n" +
+	"GB-MON\ten=Monmouthshire [Sir Fynwy GB-FYN]\n" +
+	"GB-MRT\ten=Merton\tru=Мертон\tzh-CN=莫顿市\n" +
+	"GB-MRY\ten=Moray\tzh-CN=马里\n" +
+	"GB-MTY\ten=Merthyr Tydfil [Merthyr Tudful GB-MTU]\n" +
+	"GB-MUL\ten=Mid-Ulster\n" +
+	"GB-NAY\ten=North Ayrshire\tfr=Ayrshire septentrional\tru=Норт-Эршир\n" +
+	"GB-NBL\ten=Northumberland\tru=Нортамберленд\n" +
+	"GB-NEL\ten=North East Lincolnshire\tfr=Lincolnshire nord-est\tru=Северо-Восточный Линкольншир\tzh-CN=东北林肯郡\n" +
+	"GB-NET\ten=Newcastle upon Tyne\tfr=Newcastle s/ Tyne\tja=ニューカッスル\tru=Ньюкасл-апон-Тайн\tzh-CN=泰恩河畔纽卡斯尔郡\n" +
+	"GB-NFK\ten=Norfolk\tja=ノーフォーク\tru=Норфолк\tzh-CN=诺福克\n" +
+	"GB-NGM\ten=Nottingham\tru=Ноттингем\tzh-CN=诺丁汉\n" +
+	"GB-NIR\tde=Nordirland\ten=Northern Ireland\n" +
+	"GB-NLK\ten=North Lanarkshire\tfr=Lanarkshire septentrional\tru=Норт-Ланаркшир\tzh-CN=北拉纳克郡\n" +
+	"GB-NLN\ten=North Lincolnshire\tfr=Lincolnshire septentrional\tru=Северный Линкольншир\tzh-CN=北林肯郡\n" +
+	"GB-NMD\tde=Newry, Mourne und Down\ten=Newry, Mourne and Down\tfr=Newry, Mourne et Down\n" +
+	"GB-NSM\ten=North Somerset\tfr=Somerset septentrional\tru=Северный Сомерсет\tzh-CN=北萨默塞特\n" +
+	"GB-NTH\ten=Northamptonshire\tja=ノーサンプトンシャー\tru=Нортгемптоншир\tzh-CN=北安普敦郡\n" +
+	"GB-NTL\ten=Neath Port Talbot [Castell-nedd Port Talbot GB-CTL]\n" +
+	"GB-NTT\ten=Nottinghamshire\tru=Ноттингемшир\tzh-CN=诺丁汉郡\n" +
+	"GB-NTY\ten=North Tyneside\tfr=Tyneside septentrional\tru=Норт-Тайнсайд\tzh-CN=北泰恩河畔郡\n" +
+	"GB-NWM\ten=Newham\tru=Ньюэм\tzh-CN=纽汉市\n" +
+	"GB-NWP\ten=Newport [Casnewydd GB-CNW]\n" +
+	"GB-NYK\ten=North Yorkshire\tfr=Yorkshire septentrional\tru=Норт-Йоркшир\tzh-CN=北约克郡\n" +
+	"GB-OLD\ten=Oldham\tru=Олдем\tzh-CN=奥尔德姆市\n" +
+	"GB-ORK\ten=Orkney Islands\tfr=Îles Orkney\tru=Оркнейские острова\tzh-CN=奥克尼群岛\n" +
+	"GB-OXF\ten=Oxfordshire\tru=Оксфордшир\tzh-CN=牛津郡\n" +
+	"GB-PEM\ten=Pembrokeshire [Sir Benfro GB-BNF]\n" +
+	"GB-PKN\tde=Perth und Kinross\ten=Perth and Kinross\tfr=Perth et Kinross\tru=Перт-энд-Кинросс\tzh-CN=珀斯-金罗斯\n" +
+	"GB-PLY\ten=Plymouth\tja=プリマス\tzh-CN=普利茅斯\n" +
+	"GB-POR\ten=Portsmouth\tja=ポーツマス\tru=Портсмут\tzh-CN=朴次茅斯\n" +
+	"GB-POW\ten=Powys\tru=Поуис\tzh-CN=波伊斯\n" +
+	"GB-PTE\ten=Peterborough\tja=ピーターバラ\tzh-CN=彼得伯勒\n" +
+	"GB-RCC\tde=Redcar und Cleveland\ten=Redcar and Cleveland\tfr=Redcar et Cleveland\tru=Редкар-энд-Кливленд\tzh-CN=里德卡-克里夫兰\n" +
+	"GB-RCH\ten=Rochdale\tru=Рочдейл\tzh-CN=罗奇代尔市\n" +
+	"GB-RCT\ten=Rhondda Cynon Taff [Rhondda CynonTaf]\n" +
+	"GB-RDB\ten=Redbridge\tzh-CN=红桥市\n" +
+	"GB-RDG\ten=Reading\tzh-CN=雷丁\n" +
+	"GB-RFW\ten=Renfrewshire\tru=Ренфрушир\tzh-CN=伦弗鲁郡\n" +
+	"GB-RIC\ten=Richmond upon Thames\tfr=Richmond s/ Tamise\tru=Ричмонд-апон-Темс\tzh-CN=泰晤士河畔里士满市\n" +
+	"GB-ROT\ten=Rotherham\tru=Ротерем\tzh-CN=罗瑟勒姆郡\n" +
+	"GB-RUT\ten=Rutland\tzh-CN=拉特兰\n" +
+	"GB-SAW\ten=Sandwell\tzh-CN=山德威尔郡\n" +
+	"GB-SAY\ten=South Ayrshire\tfr=Ayrshire méridional\tru=Саут-Эршир\tzh-CN=南艾尔郡\n" +
+	"GB-SCB\ten=Scottish Borders\tru=Скоттиш-Бордерс\n" +
+	"GB-SCT\tde=Schottland\ten=Scotland\n" +
+	"GB-SFK\ten=Suffolk\tru=Суффолк\tzh-CN=萨福克\n" +
+	"GB-SFT\ten=Sefton\n" +
+	"GB-SGC\ten=South Gloucestershire\tfr=Gloucestershire méridional\tru=Южный Глостершир\tzh-CN=南格洛斯特郡\n" +
+	"GB-SHF\ten=Sheffield\tru=Шеффилд\tzh-CN=设菲尔德\n" +
+	"GB-SHN\ten=St. Helens\tfr=Sainte-Hélène\tzh-CN=圣海伦斯郡\n" +
+	"GB-SHR\ten=Shropshire\tru=Шропшир\tzh-CN=什罗普郡\n" +
+	"GB-SKP\ten=Stockport\tru=Стокпорт\tzh-CN=斯托克波特市\n" +
+	"GB-SLF\ten=Salford\tru=Солфорд\tzh-CN=塞尔福德市\n" +
+	"GB-SLG\ten=Slough\tru=Слау\tzh-CN=斯劳\n" +
+	"GB-SLK\ten=South Lanarkshire\tfr=Lanarkshire méridional\tru=Саут-Ланаркшир\tzh-CN=南拉纳克郡\n" +
+	"GB-SND\ten=Sunderland\tja=サンダーランド\tru=Сандерленд\tzh-CN=森得兰郡\n" +
+	"GB-SOL\ten=Solihull\tru=Солихалл\tzh-CN=索利哈尔郡\n" +
+	"GB-SOM\ten=Somerset\tru=Сомерсет\tzh-CN=萨默塞特\n" +
+	"GB-SOS\ten=Southend-on-Sea\tfr=Southend sur Mer\tru=Саутенд-он-Си\tzh-CN=绍森德\n" +
+	"GB-SRY\ten=Surrey\tru=Суррей\tzh-CN=萨里\n" +
+	"GB-STE\ten=Stoke-on-Trent\tru=Сток-он-Трент\tzh-CN=特伦河畔斯托克\n" +
+	"GB-STG\ten=Stirling\tzh-CN=斯特灵\n" +
+	"GB-STH\ten=Southampton\tja=サウサンプトン\tru=Саутгемптон\tzh-CN=南安普敦\n" +
+	"GB-STN\ten=Sutton\tru=Саттон\tzh-CN=萨顿市\n" +
+	"GB-STS\ten=Staffordshire\tru=Стаффордшир\tzh-CN=斯塔福德郡\n" +
+	"GB-STT\ten=Stockton-on-Tees\tru=Стоктон-он-Тис\tzh-CN=蒂斯河畔斯托克\n" +
+	"GB-STY\ten=South Tyneside\tfr=Tyneside méridional\tru=Саут-Тайнсайд\tzh-CN=南泰恩河畔郡\n" +
+	"GB-SWA\ten=Swansea [Abertawe GB-ATA]\n" +
+	"GB-SWD\ten=Swindon\tru=Суиндон\tzh-CN=斯温顿\n" +
+	"GB-SWK\ten=Southwark\tzh-CN=南瓦可市\n" +
+	"GB-TAM\ten=Tameside\tru=Теймсайд\tzh-CN=泰姆赛德市\n" +
+	"GB-TFW\tde=Telford und Wrekin\ten=Telford and Wrekin\tfr=Telford et Wrekin\tru=Телфорд-энд-Рикин\tzh-CN=特尔福德-里金\n" +
+	"GB-THR\ten=Thurrock\tru=Таррок\tzh-CN=瑟罗克\n" +
+	"GB-TOB\ten=Torbay\tru=Торбей\tzh-CN=托贝郡\n" +
+	"GB-TOF\ten=Torfaen [Tor-faen]\n" +
+	"GB-TRF\ten=Trafford\tru=Траффорд\tzh-CN=特兰弗德市\n" +
+	"GB-TWH\ten=Tower Hamlets\tru=Тауэр-Хамлетс\tzh-CN=塔沃哈姆莱茨市\n" +
+	"GB-VGL\ten=Vale of Glamorgan, The [Bro Morgannwg GB-BMG]\n" +
+	"GB-WAR\ten=Warwickshire\tru=Уорикшир\tzh-CN=沃里克郡\n" +
+	"GB-WBK\ten=West Berkshire\tfr=Berkshire occidental\tzh-CN=西巴克夏\n" +
+	"GB-WDU\ten=West Dunbartonshire\tfr=Dunbartonshire occidental\tzh-CN=西邓巴顿郡\n" +
+	"GB-WFT\ten=Waltham Forest\tru=Уолтем-Форест\tzh-CN=威尔詹福利斯特市\n" +
+	"GB-WGN\ten=Wigan\tru=Уиган\tzh-CN=威根市\n" +
+	"GB-WIL\ten=Wiltshire\tru=Уилтшир\n" +
+	"GB-WKF\ten=Wakefield\tzh-CN=韦克菲尔德市\n" +
+	"GB-WLL\ten=Walsall\tru=Уолсолл\tzh-CN=沃尔索尔郡\n" +
+	"GB-WLN\ten=West Lothian\tfr=Lothian occidental\tru=Уэст-Лотиан\tzh-CN=西洛锡安\n" +
+	"GB-WLS\ten=Wales [Cymru GB-CYM]\n" +
+	"GB-WLV\ten=Wolverhampton\tja=ウルヴァーハンプトン\tru=Вулвергемптон\tzh-CN=伍尔弗汉普顿郡\n" +
+	"GB-WND\ten=Wandsworth\tru=Уондсуэрт\tzh-CN=旺茨沃斯市\n" +
+	"GB-WNM\tde=Windsor und Maidenhead\ten=Windsor and Maidenhead\tfr=Windsor et Maidenhead\tru=Виндзор и Мейденхед\tzh-CN=温莎梅登黑德\n" +
+	"GB-WOK\ten=Wokingham\tzh-CN=沃金厄姆\n" +
+	"GB-WOR\ten=Worcestershire\tru=Вустершир\tzh-CN=伍斯特郡\n" +
+	"GB-WRL\ten=Wirral\tzh-CN=威瑞尔郡\n" +
+	"GB-WRT\ten=Warrington\tru=Уоррингтон\tzh-CN=沃灵顿\n" +
+	"GB-WRX\ten=Wrexham [Wrecsam GB-WRC]\n" +
+	"GB-WSM\ten=Westminster\tru=Вестминстер\tzh-CN=西明斯特市\n" +
+	"GB-WSX\ten=West Sussex\tfr=Sussex occidental\tru=Западный Суссекс\tzh-CN=西萨塞克斯\n" +
+	"GB-YOR\ten=York\tja=ヨーク\tru=Йорк\tzh-CN=约克\n" +
+	"GB-ZET\ten=Shetland Islands\tfr=Îles Shetland\tru=Шетландские острова\tzh-CN=设得兰群岛\n" +
+	"GD-01\ten=Saint Andrew\tfr=Saint-Andrew\tru=Сент Эндрю\tzh-CN=圣安德鲁斯\n" +
+	"GD-02\ten=Saint David\tfr=Saint-David\tzh-CN=圣大卫\n" +
+	"GD-03\ten=Saint George\tfr=Saint-George\tja=セントジョージ\tru=Сент-Джордж\tzh-CN=圣乔治\n" +
+	"GD-04\ten=Saint John\tfr=Saint-John\tja=セントジョン\tru=Сент-Джон\tzh-CN=圣约翰\n" +
+	"GD-05\ten=Saint Mark\tfr=Saint-Mark\tzh-CN=圣马可\n" +
+	"GD-06\ten=Saint Patrick\tfr=Saint-Patrick\tzh-CN=圣派屈克\n" +
+	"GD-10\ten=Southern Grenadine Islands\tfr=Îles Grenadines du Sud\tru=Карриаку и Малый Мартиник\tzh-CN=南格林纳达群岛\n" +
+	"GE-AB\tde=Abchasien\ten=Abkhazia\tfr=Abkhazie\tja=アブハジア\tru=Абхазия\tzh-CN=阿布哈兹\n" +
+	"GE-AJ\tde=Adscharien\ten=Ajaria\tfr=Adjarie\tja=アジァリア\tru=Аджария\tzh-CN=阿扎尔\n" +
+	"GE-GU\tde=Gurien\ten=Guria\tru=Гурия\tzh-CN=古利亚\n" +
+	"GE-IM\ten=Imereti\tru=Имеретия\n" +
+	"GE-KA\ten=K'akheti\n" +
+	"GE-KK\ten=Kvemo Kartli\tru=Квемо-Картли\n" +
+	"GE-MM\ten=Mtskheta-Mtianeti\tru=Мцхета-Мтианети\n" +
+	"GE-RL\ten=Rach'a-Lechkhumi-Kvemo Svaneti\n" +
+	"GE-SJ\ten=Samtskhe-Javakheti\tru=Самцхе-Джавахети\n" +
+	"GE-SK\ten=Shida Kartli\tru=Шида-Картли\n" +
+	"GE-SZ\ten=Samegrelo-Zemo Svaneti\tru=Самегрело-Верхняя Сванетия\n" +
+	"GE-TB\ten=Tbilisi\tru=Тбилиси\n" +
+	"GH-AA\ten=Greater Accra\tfr=Grand Accra\tja=グレーターアクラ\tru=Большая Аккра\tzh-CN=大阿克拉地区\n" +
+	"GH-AF\ten=Ahafo\n" +
+	"GH-AH\ten=Ashanti\tja=アシャンティ\tru=Ашанти\tzh-CN=阿散蒂地区\n" +
+	"GH-BE\ten=Bono East\n" +
+	"GH-BO\ten=Bono\n" +
+	"GH-CP\ten=Central\tja=セントラル\tzh-CN=中部\n" +
+	"GH-EP\ten=Eastern\tfr=Est\tzh-CN=东部区\n" +
+	"GH-NE\tde=Nordost\ten=North East\tfr=Nord-Est\n" +
+	"GH-NP\ten=Northern\tfr=Nord\tzh-CN=北部区\n" +
+	"GH-OT\ten=Oti\n" +
+	"GH-SV\ten=Savannah\tru=Саванна\n" +
+	"GH-TV\ten=Volta\tja=ヴォルタ\tzh-CN=沃尔特地区\n" +
+	"GH-UE\ten=Upper East\tfr=Haut Ghana oriental\tja=アッパーイースト\tzh-CN=东北地区\n" +
+	"GH-UW\ten=Upper West\tfr=Haut Ghana Occidental\tja=アッパーウエスト\tzh-CN=西北地区\n" +
+	"GH-WN\ten=Western North\n" +
+	"GH-WP\ten=Western\tfr=Ouest\tzh-CN=西部区\n" +
+	"GL-AV\ten=Avannaata Kommunia\n" +
+	"GL-KU\ten=Kommune Kujalleq\tfr=Municipalité de Kujalleq\n" +
+	"GL-QE\ten=Qeqqata Kommunia\tfr=Municipalité de Qeqqata\n" +
+	"GL-QT\ten=Kommune Qeqertalik\n" +
+	"GL-SM\ten=Kommuneqarfik Sermersooq\tfr=Municipalité de Sermersooq\n" +
+	"GM-B\ten=Banjul\tja=バンジュール\tzh-CN=班珠尔市\n" +
+	"GM-L\ten=Lower River\tfr=Rivière basse\tzh-CN=下河区\n" +
+	"GM-M\ten=Central River\tfr=Rivière haute\tzh-CN=麦卡锡岛区\n" +
+	"GM-N\ten=North Bank\tfr=Rive nord\tzh-CN=北岸区\n" +
+	"GM-U\ten=Upper River\tfr=Rive haute\tzh-CN=上河区\n" +
+	"GM-W\ten=Western\tfr=Ouest\tzh-CN=西部区\n" +
+	"GN-B\ten=Boké\tja=ボケ\tzh-CN=博凯\n" +
+	"GN-BE\ten=Beyla\tja=ベイラ\tzh-CN=贝拉\n" +
+	"GN-BF\ten=Boffa\tja=ボッファ\tzh-CN=博法\n" +
+	"GN-BK\ten=Boké\tja=ボケ\tzh-CN=博凯\n" +
+	"GN-C\ten=Conakry\tja=コナクリ\tru=Конакри\n" +
+	"GN-CO\ten=Coyah\tja=コヤ\tzh-CN=科亚\n" +
+	"GN-D\ten=Kindia\tja=キンディア\tru=Киндиа\tzh-CN=金迪亚\n" +
+	"GN-DB\ten=Dabola\tja=ダボラ\tru=Дабола\tzh-CN=达波拉\n" +
+	"GN-DI\ten=Dinguiraye\tja=ディンギライ\tzh-CN=丁基拉耶\n" +
+	"GN-DL\ten=Dalaba\tja=ダラバ\tzh-CN=达拉巴\n" +
+	"GN-DU\ten=Dubréka\tja=デブレカ\tzh-CN=杜布雷卡\n" +
+	"GN-F\ten=Faranah\tja=ファラナ\tzh-CN=法拉纳\n" +
+	"GN-FA\ten=Faranah\tja=ファラナ\tzh-CN=法拉纳\n" +
+	"GN-FO\ten=Forécariah\tja=フォレカリア\tzh-CN=弗雷卡里亚\n" +
+	"GN-FR\ten=Fria\tja=フリア\tru=Фрия\tzh-CN=弗里亚\n" +
+	"GN-GA\ten=Gaoual\tja=ガワアル\tzh-CN=高瓦尔\n" +
+	"GN-GU\ten=Guékédou\tja=ゲケドゥー\tzh-CN=盖凯杜古\n" +
+	"GN-K\ten=Kankan\tja=カンカン\tzh-CN=康康\n" +
+	"GN-KA\ten=Kankan\tja=カンカン\tzh-CN=康康\n" +
+	"GN-KB\ten=Koubia\tja=クビア\tzh-CN=库比亚\n" +
+	"GN-KD\ten=Kindia\tja=キンディア\tru=Киндиа\tzh-CN=金迪亚\n" +
+	"GN-KE\ten=Kérouané\tja=ケルアネ\tzh-CN=凯鲁阿内\n" +
+	"GN-KN\ten=Koundara\tja=クンダラ\tzh-CN=孔达拉\n" +
+	"GN-KO\ten=Kouroussa\tja=クルーサ\tzh-CN=库鲁萨\n" +
+	"GN-KS\ten=Kissidougou\tja=キシドゥーグー\tzh-CN=吉西杜古\n" +
+	"GN-L\ten=Labé\tja=ラベー\tzh-CN=拉贝\n" +
+	"GN-LA\ten=Labé\tja=ラベー\tzh-CN=拉贝\n" +
+	"GN-LE\ten=Lélouma\tja=レルーマ\tzh-CN=莱鲁马\n" +
+	"GN-LO\ten=Lola\tja=ローラ\tzh-CN=洛拉\n" +
+	"GN-M\ten=Mamou\tja=マムー\tzh-CN=玛木\n" +
+	"GN-MC\ten=Macenta\tja=マセンタ\tzh-CN=马桑达\n" +
+	"GN-MD\ten=Mandiana\tja=マンディアナ\tzh-CN=芒加纳\n" +
+	"GN-ML\ten=Mali\tes=Malí\tja=マリ\tzh-CN=马里省\n" +
+	"GN-MM\ten=Mamou\tja=マムー\tzh-CN=玛木\n" +
+	"GN-N\ten=Nzérékoré\tja=ゼレコレ\tru=Нзерекоре\tzh-CN=恩泽雷科雷\n" +
+	"GN-NZ\ten=Nzérékoré\tja=ゼレコレ\tru=Нзерекоре\tzh-CN=恩泽雷科雷\n" +
+	"GN-PI\ten=Pita\tja=ピタ\tru=Пита\tzh-CN=皮塔\n" +
+	"GN-SI\ten=Siguiri\tja=シギリ\tzh-CN=西基里\n" +
+	"GN-TE\ten=Télimélé\tja=テリメレ\tzh-CN=特里梅莱\n" +
+	"GN-TO\ten=Tougué\tja=トゥゲ\tzh-CN=杜盖\n" +
+	"GN-YO\ten=Yomou\tja=ヨムー\tzh-CN=尤木\n" +
+	"GQ-AN\ten=Annobon\n" +
+	"GQ-BN\ten=Bioko Nord\n" +
+	"GQ-BS\ten=Bioko Sud\n" +
+	"GQ-C\ten=Região Continental\n" +
+	"GQ-CS\ten=Centro Sud\n" +
+	"GQ-DJ\ten=Djibloho\n" +
+	"GQ-I\ten=Região Insular\n" +
+	"GQ-KN\ten=Kié-Ntem\tru=Ке-Нтем\n" +
+	"GQ-LI\ten=Litoral\tru=Литораль\tzh-CN=滨海省\n" +
+	"GQ-WN\tde=Wele-Nzás\ten=Wele-Nzas\tfr=Wele-Nzás\tru=Веле-Нзас\n" +
+	"GR-69\ten=Ágion Óros\n" +
+	"GR-A\ten=Anatolikí Makedonía kai Thráki\n" +
+	"GR-B\ten=Kentrikí Makedonía\n" +
+	"GR-C\ten=Dytikí Makedonía\n" +
+	"GR-D\ten=Ípeiros\n" +
+	"GR-E\ten=Thessalía\n" +
+	"GR-F\ten=Ionía Nísia\n" +
+	"GR-G\ten=Dytikí Elláda\n" +
+	"GR-H\ten=Stereá Elláda\n" +
+	"GR-I\ten=Attikí\tru=Аттика\n" +
+	"GR-J\ten=Pelopónnisos\tru=Пелопоннес\n" +
+	"GR-K\ten=Vóreio Aigaío\n" +
+	"GR-L\ten=Nótio Aigaío\n" +
+	"GR-M\ten=Kríti\tru=Крит\n" +
+	"GT-AV\ten=Alta Verapaz\tja=アルタベラパス\tru=Альта-Верапас\tzh-CN=上韦拉帕斯\n" +
+	"GT-BV\ten=Baja Verapaz\tja=バハベラパス\tru=Баха-Верапас\tzh-CN=下韦拉帕斯\n" +
+	"GT-CM\ten=Chimaltenango\tja=チマルテナンゴ\tru=Чимальтенанго\tzh-CN=奇马尔特南戈\n" +
+	"GT-CQ\ten=Chiquimula\tja=チキムラ\tru=Чикимула\tzh-CN=奇基穆拉\n" +
+	"GT-ES\ten=Escuintla\tja=エスクイントラ\tru=Эскуинтла\tzh-CN=埃斯昆特拉\n" +
+	"GT-GU\ten=Guatemala\tja=グアテマラ\tru=Гватемала\tzh-CN=瓜地马拉\n" +
+	"GT-HU\ten=Huehuetenango\tja=フェヴェテナンゴ\tru=Уэуэтенанго\tzh-CN=韦韦特南戈\n" +
+	"GT-IZ\ten=Izabal\tja=イザバル\tru=Исабаль\tzh-CN=伊萨瓦尔\n" +
+	"GT-JA\ten=Jalapa\tja=ハラパ\tru=Халапа-Энрикес\tzh-CN=哈拉帕\n" +
+	"GT-JU\ten=Jutiapa\tja=フティアパ\tzh-CN=胡蒂亚帕\n" +
+	"GT-PE\ten=Petén\tja=ペテン\tru=Петен\tzh-CN=佩滕\n" +
+	"GT-PR\ten=El Progreso\tja=エルプレグレソ\tru=Эль-Прогресо\tzh-CN=埃尔普罗格雷索\n" +
+	"GT-QC\ten=Quiché\tja=キチュ\tzh-CN=基切\n" +
+	"GT-QZ\ten=Quetzaltenango\tja=ケサルテナンゴ\tru=Кесальтенанго\tzh-CN=克萨尔特南戈\n" +
+	"GT-RE\ten=Retalhuleu\tja=レタルレウ\tzh-CN=雷塔卢莱乌\n" +
+	"GT-SA\ten=Sacatepéquez\tja=サカテペケス\tzh-CN=萨卡特佩克斯\n" +
+	"GT-SM\ten=San Marcos\tja=サンマルコス\tru=Сан-Маркос\tzh-CN=圣马科斯\n" +
+	"GT-SO\ten=Sololá\tja=ソロラ\tzh-CN=索洛拉\n" +
+	"GT-SR\ten=Santa Rosa\tja=サンタロサ\tru=Санта-Роза\tzh-CN=圣罗莎\n" +
+	"GT-SU\ten=Suchitepéquez\tja=スチテペケス\tzh-CN=苏奇特佩克斯\n" +
+	"GT-TO\ten=Totonicapán\tja=トトニカパン\tzh-CN=托托尼卡潘\n" +
+	"GT-ZA\ten=Zacapa\tja=サカパ\tzh-CN=萨卡帕\n" +
+	"GW-BA\ten=Bafatá\tja=バファター\tru=Бафата\n" +
+	"GW-BL\ten=Bolama / Bijagós\n" +
+	"GW-BM\ten=Biombo\tja=ビオンボ\tzh-CN=比翁博\n" +
+	"GW-BS\ten=Bissau\tja=ビサウ\tzh-CN=比绍\n" +
+	"GW-CA\ten=Cacheu\tja=カシュウ\tzh-CN=卡谢乌\n" +
+	"GW-GA\ten=Gabú\tfr=Gabu\tja=ガブー\n" +
+	"GW-L\ten=Leste\tfr=Est\n" +
+	"GW-N\ten=Norte\tfr=Nord\n" +
+	"GW-OI\ten=Oio\tja=オイオ\tzh-CN=奥约\n" +
+	"GW-QU\ten=Quinara\tja=キナラ\tzh-CN=基纳拉\n" +
+	"GW-S\ten=Sul\tfr=Sud\n" +
+	"GW-TO\ten=Tombali\tja=トンバリ\tzh-CN=通巴利\n" +
+	"GY-BA\ten=Barima-Waini\tja=バリマワイニ\tru=Барима-Уайни\tzh-CN=巴里马-瓦伊尼\n" +
+	"GY-CU\ten=Cuyuni-Mazaruni\tja=クユニマザルニ\tru=Куюни-Мазаруни\tzh-CN=库尤尼-马扎鲁尼\n" +
+	"GY-DE\ten=Demerara-Mahaica\tja=デメララマハイカ\tru=Демерара-Махайка\tzh-CN=德梅拉拉-马海卡\n" +
+	"GY-EB\ten=East Berbice-Corentyne\tfr=Berbice Oriental-Courantyne\tja=イーストバービーズコレンタイン\tru=Ист-Бербис-Корентайн\tzh-CN=东伯比斯-科兰太因\n" +
+	"GY-ES\ten=Essequibo Islands-West Demerara\tfr=Îles d'Essequibo-Demerara occidental\tja=エセキポアイランズウエストデメララ\tru=Эссекибо-Айлендс-Уэст-Демерара\tzh-CN=埃塞奎博群岛-西德梅拉拉\n" +
+	"GY-MA\ten=Mahaica-Berbice\tja=マハイカバービーズ\tru=Махайка-Бербис\tzh-CN=马海卡-伯比斯\n" +
+	"GY-PM\ten=Pomeroon-Supenaam\tja=ポメルーンスペナーム\tru=Померун-Супенаам\tzh-CN=波默伦-苏佩纳姆\n" +
+	"GY-PT\ten=Potaro-Siparuni\tja=ポタロシパルニ\tru=Потаро-Сипаруни\tzh-CN=波塔罗-锡帕鲁尼\n" +
+	"GY-UD\ten=Upper Demerara-Berbice\tfr=Haut-Demerara-Berbice\tja=アッパーデメララバービーズ\tru=Аппер-Демерара-Бербис\tzh-CN=上德梅拉拉-伯比斯\n" +
+	"GY-UT\ten=Upper Takutu-Upper Essequibo\tfr=Haut-Takutu-Haut-Essequibo\tja=アッパータクトゥアッパーエセキポ\tru=Аппер-Такуту-Аппер-Эссекибо\tzh-CN=上塔库图-上埃塞奎博\n" +
+	"HN-AT\ten=Atlántida\tja=アトランティダ\n" +
+	"HN-CH\ten=Choluteca\tja=チョルテカ\tzh-CN=乔卢特卡\n" +
+	"HN-CL\ten=Colón\tja=コロン\tzh-CN=科隆省\n" +
+	"HN-CM\ten=Comayagua\tja=コマイアグア\tru=Комаягуа\tzh-CN=科马亚瓜\n" +
+	"HN-CP\ten=Copán\tja=コパン\n" +
+	"HN-CR\ten=Cortés\tja=コルテス\n" +
+	"HN-EP\ten=El Paraíso\tja=エルパライソ\n" +
+	"HN-FM\ten=Francisco Morazán\tja=フランシスコモラサン\n" +
+	"HN-GD\ten=Gracias a Dios\tja=グラシアスアディオス\tru=Грасьяс-а-Дьос\tzh-CN=格拉西亚斯-阿迪奥斯\n" +
+	"HN-IB\ten=Islas de la Bahía\tfr=Îles de la Baie\tru=Ислас-де-ла-Баия\n" +
+	"HN-IN\ten=Intibucá\tja=インティブカ\tru=Интибука\n" +
+	"HN-LE\ten=Lempira\tja=レンピラ\tzh-CN=伦皮拉\n" +
+	"HN-LP\ten=La Paz\tja=ラパス\tru=Ла-Пас\tzh-CN=拉巴斯省\n" +
+	"HN-OC\ten=Ocotepeque\tja=オコテペク\tzh-CN=奥科特佩克\n" +
+	"HN-OL\ten=Olancho\tja=オランチョ\tru=Оланчо\tzh-CN=奥兰乔\n" +
+	"HN-SB\ten=Santa Bárbara\tja=サンタバルバラ\tru=Санта-Барбара\n" +
+	"HN-VA\ten=Valle\tru=Валье\tzh-CN=山谷\n" +
+	"HN-YO\ten=Yoro\tja=ヨロ\tzh-CN=约罗\n" +
+	"HR-01\tde=Gespanschaft Zagreb\ten=Zagrebačka županija\tfr=Zagreb\tru=Загребачка\tzh-CN=萨格勒布市\n" +
+	"HR-02\tde=Gespanschaft Krapina-Zagorje\ten=Krapinsko-zagorska županija\tfr=Krapina-Zagorje\tru=Крапинско-Загорска\tzh-CN=克拉皮纳-扎戈列\n" +
+	"HR-03\tde=Gespanschaft Siska-Moslavina\ten=Sisačko-moslavačka županija\tfr=Sisak-Moslavina\tru=Сисачко-Мославачка\tzh-CN=锡萨克-莫斯拉维纳\n" +
+	"HR-04\tde=Gespanschaft Karlovac\ten=Karlovačka županija\tfr=Karlovac\tru=Карловачка\tzh-CN=卡尔洛瓦茨\n" +
+	"HR-05\tde=Gespanschaft Varaždin\ten=Varaždinska županija\tfr=Varaždin\tru=Вараждинска\tzh-CN=瓦拉日丁\n" +
+	"HR-06\tde=Gespanschaft Koprivnica-Križevci\ten=Koprivničko-križevačka županija\tfr=Koprivnica-Križevci\tru=Копривничко-Крижевачка\tzh-CN=科普里夫尼察-克里热夫齐\n" +
+	"HR-07\tde=Gespanschaft Bjelovar-Bilogora\ten=Bjelovarsko-bilogorska županija\tfr=Bjelovar-Bilogora\tru=Бьеловарско-Билогорска\tzh-CN=别洛瓦尔-比洛戈拉\n" +
+	"HR-08\tde=Gespanschaft Primorje-Gorski\ten=Primorsko-goranska županija\tfr=Primorje-Gorski Kotar\tru=Приморско-Горанска\tzh-CN=滨海和山区\n" +
+	"HR-09\tde=Gespanschaft Lika-Senj\ten=Ličko-senjska županija\tfr=Lika-Senj\tru=Личко-Сеньска\tzh-CN=利卡-塞尼\n" +
+	"HR-10\tde=Gespanschaft Virovitica-Podravina\ten=Virovitičko-podravska županija\tfr=Virovitica-Podravina\tru=Вировитичко-Подравска\tzh-CN=维罗维蒂察-波德拉维纳\n" +
+	"HR-11\tde=Gespanschaft Požega-Slawonien\ten=Požeško-slavonska županija\tfr=Požega-Slavonie\tru=Пожешко-Славонска\tzh-CN=波热加-斯拉沃尼亚\n" +
+	"HR-12\tde=Gespanschaft Brod-Posavina\ten=Brodsko-posavska županija\tfr=Brod-Posavina\tru=Бродско-Посавска\tzh-CN=布罗德-波萨维纳\n" +
+	"HR-13\tde=Gespanschaft Zadar\ten=Zadarska županija\tfr=Zadar\tru=Задарска\tzh-CN=扎达尔\n" +
+	"HR-14\tde=Gespanschaft Osijek-Baranja\ten=Osječko-baranjska županija\tfr=Osijek-Baranja\tru=Осьечко-Бараньска\tzh-CN=奥西耶克-巴拉尼亚\n" +
+	"HR-15\tde=Gespanschaft Šibenik-Knin\ten=Šibensko-kninska županija\tfr=Šibenik-Knin\tru=Шибенско-Книнска\tzh-CN=希贝尼克-克宁\n" +
+	"HR-16\tde=Gespanschaft Vukovar-Srijem\ten=Vukovarsko-srijemska županija\tfr=Vukovar-Syrmie\tru=Вуковарско-Сриемска\tzh-CN=武科瓦尔-斯里耶姆\n" +
+	"HR-17\tde=Gespanschaft Split-Dalmatien\ten=Splitsko-dalmatinska županija\tfr=Split-Dalmatie\tru=Сплитско-Далматинска\tzh-CN=斯普利特-达尔马提亚\n" +
+	"HR-18\tde=Gespanschaft Istrien\ten=Istarska županija\tfr=Istrie\tru=Истарска\tzh-CN=伊斯特拉\n" +
+	"HR-19\tde=Gespanschaft Dubrovnik-Neretva\ten=Dubrovačko-neretvanska županija\tfr=Dubrovnik-Neretva\tru=Дубровачко-Неретванска\tzh-CN=杜布罗夫斯克-内雷特瓦\n" +
+	"HR-20\tde=Gespanschaft Međimurje\ten=Međimurska županija\tfr=Međimurje\tru=Меджимурска\tzh-CN=梅吉穆列\n" +
+	"HR-21\tde=Stadt Zagreb\ten=Grad Zagreb\tfr=Ville de Zagreb\tru=Загреб\tzh-CN=札格雷布直辖市\n" +
+	"HT-AR\ten=Artibonite\tja=アルトヴィン\n" +
+	"HT-CE\ten=Centre\tru=Центральная\tzh-CN=中部\n" +
+	"HT-GA\ten=Grandans\n" +
+	"HT-ND\ten=Nord\tzh-CN=北部省\n" +
+	"HT-NE\ten=Nord-Est\tja=北東県\tzh-CN=东北区\n" +
+	"HT-NI\ten=Nip\n" +
+	"HT-NO\ten=Nord-Ouest\tja=北西県\tzh-CN=西北区\n" +
+	"HT-OU\ten=Lwès\n" +
+	"HT-SD\ten=Sid\n" +
+	"HT-SE\ten=Sidès\n" +
+	"HU-BA\ten=Baranya\tja=バラニャ\tzh-CN=巴兰尼亚州\n" +
+	"HU-BC\ten=Békéscsaba\tru=Бекешчаба\tzh-CN=贝凯什乔包\n" +
+	"HU-BE\ten=Békés\tja=ベーケーシュ\tzh-CN=贝凯什州\n" +
+	"HU-BK\ten=Bács-Kiskun\tja=バーチキシュクン\tru=Бач-Кишкун\tzh-CN=巴奇-基什孔州\n" +
+	"HU-BU\ten=Budapest\tja=ブダペスト\tru=Будапешт\tzh-CN=布达佩斯\n" +
+	"HU-BZ\ten=Borsod-Abaúj-Zemplén\tja=ボルショドアバウジゼムプレーン\tru=Боршод-Абауй-Земплен\tzh-CN=包尔绍德-奥包乌伊-曾普伦州\n" +
+	"HU-CS\ten=Csongrád\tja=チョングラード\tru=Чонград\tzh-CN=琼格拉德州\n" +
+	"HU-DE\ten=Debrecen\tja=デブレツェン\tru=Дебрецен\tzh-CN=德布勒森\n" +
+	"HU-DU\ten=Dunaújváros\tru=Дунауйварош\tzh-CN=多瑙新城\n" +
+	"HU-EG\ten=Eger\tru=Эгер\tzh-CN=埃格尔\n" +
+	"HU-ER\ten=Érd\tru=Эрд\n" +
+	"HU-FE\ten=Fejér\tja=フェイエール\tzh-CN=费耶尔州\n" +
+	"HU-GS\ten=Győr-Moson-Sopron\tja=ジェールモションショプロン\tru=Дьёр-Мошон-Шопрон\tzh-CN=杰尔-莫松-肖普朗州\n" +
+	"HU-GY\ten=Győr\tzh-CN=杰尔\n" +
+	"HU-HB\ten=Hajdú-Bihar\tja=ハイデュービハル\tru=Хайду-Бихар\n" +
+	"HU-HE\ten=Heves\tja=ヘベシュ\tzh-CN=赫维什州\n" +
+	"HU-HV\ten=Hódmezővásárhely\tru=Ходмезёвашархей\tzh-CN=霍德梅泽瓦\n" +
+	"HU-JN\ten=Jász-Nagykun-Szolnok\tja=ヤースナジクンソルノク\tzh-CN=加兹-纳杰孔-索尔诺克州\n" +
+	"HU-KE\ten=Komárom-Esztergom\tja=コマーロムエステルゴム\tru=Комаром-Эстергом\tzh-CN=科马罗姆-埃斯泰尔戈姆州\n" +
+	"HU-KM\ten=Kecskemét\tzh-CN=凯奇凯梅特\n" +
+	"HU-KV\ten=Kaposvár\tzh-CN=考波什堡\n" +
+	"HU-MI\ten=Miskolc\tja=ミシュコルツ\tzh-CN=米什科尔茨\n" +
+	"HU-NK\ten=Nagykanizsa\tzh-CN=瑙吉考尼饶\n" +
+	"HU-NO\ten=Nógrád\tja=ノーグラード\n" +
+	"HU-NY\ten=Nyíregyháza\n" +
+	"HU-PE\ten=Pest\tja=ペシュト\tzh-CN=佩斯州\n" +
+	"HU-PS\ten=Pécs\tzh-CN=佩奇\n" +
+	"HU-SD\ten=Szeged\tzh-CN=塞格德\n" +
+	"HU-SF\ten=Székesfehérvár\tzh-CN=塞克什白堡\n" +
+	"HU-SH\ten=Szombathely\tzh-CN=松博特海伊\n" +
+	"HU-SK\ten=Szolnok\tzh-CN=索尔诺克\n" +
+	"HU-SN\ten=Sopron\tru=Шопрон\tzh-CN=肖普朗\n" +
+	"HU-SO\ten=Somogy\tja=ソモジ\tru=Шомодь\tzh-CN=绍莫吉州\n" +
+	"HU-SS\ten=Szekszárd\tru=Сексард\tzh-CN=塞克萨德\n" +
+	"HU-ST\ten=Salgótarján\tru=Шальготарьян\tzh-CN=绍尔戈陶尔扬\n" +
+	"HU-SZ\ten=Szabolcs-Szatmár-Bereg\tja=サボルチサトマールベレグ\tru=Сабольч-Сатмар-Берег\tzh-CN=索博尔奇-索特马尔-贝拉格州\n" +
+	"HU-TB\ten=Tatabánya\tru=Татабанья\tzh-CN=陶陶巴尼奥\n" +
+	"HU-TO\ten=Tolna\tja=トルナ\tzh-CN=托尔瑙州\n" +
+	"HU-VA\ten=Vas\tja=バシュ\tzh-CN=沃什州\n" +
+	"HU-VE\ten=Veszprém\tja=ベスフレーム\tzh-CN=维斯普雷姆州\n" +
+	"HU-VM\ten=Veszprém\tja=ベスフレーム\tzh-CN=维斯普雷姆州\n" +
+	"HU-ZA\ten=Zala\tja=ザラ\tzh-CN=佐洛州\n" +
+	"HU-ZE\ten=Zalaegerszeg\tru=Залаэгерсег\tzh-CN=佐洛埃格塞格\n" +
+	"ID-AC\ten=Aceh\tja=アチェ\tzh-CN=亚齐\n" +
+	"ID-BA\ten=Bali\tja=バリ\tzh-CN=巴厘\n" +
+	"ID-BB\ten=Kepulauan Bangka Belitung\tru=Банка-Белитунг\n" +
+	"ID-BE\ten=Bengkulu\tja=ブンクル\tzh-CN=明古鲁\n" +
+	"ID-BT\ten=Banten\tru=Бантен\tzh-CN=万丹\n" +
+	"ID-GO\ten=Gorontalo\tja=ゴロンタロ\tru=Горонтало\tzh-CN=哥伦打洛\n" +
+	"ID-JA\ten=Jambi\tja=ジャンビ\tzh-CN=占碑\n" +
+	"ID-JB\ten=Jawa Barat\tfr=Java-Ouest\tja=ジャワ州\tzh-CN=西爪哇\n" +
+	"ID-JI\ten=Jawa Timur\tfr=Java-Est\tja=東ジャワ\tzh-CN=东爪哇\n" +
+	"ID-JK\ten=Jakarta Raya\tfr=Jakarta\tzh-CN=雅加达\n" +
+	"ID-JT\ten=Jawa Tengah\tfr=Java-Centre\tja=中部ジャワ\tzh-CN=中爪哇\n" +
+	"ID-JW\tde=Java\ten=Jawa\tfr=Java\tja=ジャワ\tzh-CN=爪哇\n" +
+	"ID-KA\ten=Kalimantan\tja=カリマンタン\tzh-CN=加里曼丹\n" +
+	"ID-KB\ten=Kalimantan Barat\tfr=Kalimantan-Ouest\tja=カリマンタン州\tzh-CN=西加里曼丹\n" +
+	"ID-KI\ten=Kalimantan Timur\tfr=Kalimantan-Est\tja=東カリマンタン\tzh-CN=东加里曼丹\n" +
+	"ID-KR\ten=Kepulauan Riau\tfr=Îles Riau\tzh-CN=廖内群岛\n" +
+	"ID-KS\ten=Kalimantan Selatan\tfr=Kalimantan-Sud\tja=南カリマンタン\tzh-CN=南加里曼丹\n" +
+	"ID-KT\ten=Kalimantan Tengah\tfr=Kalimantan-Centre\tja=中部カリマンタン\tzh-CN=中加里曼丹\n" +
+	"ID-KU\ten=Kalimantan Utara\n" +
+	"ID-LA\ten=Lampung\tja=ランポン\tru=Лампунг\tzh-CN=楠榜\n" +
+	"ID-MA\tde=Molukken\ten=Maluku\tfr=Moluques\tja=マルク\tzh-CN=马鲁古\n" +
+	"ID-ML\tde=Molukken\ten=Maluku\tfr=Moluques\tja=マルク\tzh-CN=马鲁古\n" +
+	"ID-MU\ten=Maluku Utara\tfr=Moluques-Nord\tja=北マルク\tzh-CN=北马鲁古\n" +
+	"ID-NB\ten=Nusa Tenggara Barat\tfr=Îles de la Sonde occidentales\tja=ヌサトゥンガラ州\tzh-CN=西努沙登加拉\n" +
+	"ID-NT\ten=Nusa Tenggara Timur\tfr=Îles de la Sonde orientales\tja=東ヌサトゥンガラ\tzh-CN=东努沙登加拉\n" +
+	"ID-NU\ten=Nusa Tenggara\tja=ヌサトゥンガラ\tzh-CN=努沙登加拉\n" +
+	"ID-PA\ten=Papua\tja=パプア\tru=Папуа\tzh-CN=巴布亚\n" +
+	"ID-PB\ten=Papua Barat\tja=パプア州\n" +
+	"ID-PP\ten=Papua\tja=パプア\tru=Папуа\tzh-CN=巴布亚\n" +
+	"ID-RI\ten=Riau\tja=リアウ\tru=Риау\tzh-CN=廖内\n" +
+	"ID-SA\ten=Sulawesi Utara\tfr=Sulawesi-Nord\tja=北スラウェシ\tzh-CN=北苏拉威西\n" +
+	"ID-SB\ten=Sumatera Barat\n" +
+	"ID-SG\ten=Sulawesi Tenggara\tfr=Sulawesi du Sud-Est\tja=東南スラウェシ\tzh-CN=东南苏拉威西\n" +
+	"ID-SL\ten=Sulawesi\tfr=Célèbes\tja=スラウェシ\tru=Сулавеси\tzh-CN=苏拉威西\n" +
+	"ID-SM\tde=Sumatra\ten=Sumatera\tfr=Sumatra\tja=スマトラ\tru=Суматра\tzh-CN=苏门答腊\n" +
+	"ID-SN\ten=Sulawesi Selatan\tfr=Sulawesi-Sud\tja=南スラウェシ\tzh-CN=南苏拉威西\n" +
+	"ID-SR\ten=Sulawesi Barat\tfr=Sulawesi-Ouest\tja=スラウェシ州\tzh-CN=西苏拉威西\n" +
+	"ID-SS\ten=Sumatera Selatan\n" +
+	"ID-ST\ten=Sulawesi Tengah\tfr=Sulawesi-Centre\tja=中部スラウェシ\tzh-CN=中苏拉威西\n" +
+	"ID-SU\ten=Sumatera Utara\tfr=Sumatra-Nord\tja=北スマトラ\tzh-CN=北苏门答腊\n" +
+	"ID-YO\ten=Yogyakarta\tja=ジョクジャカルタ\tru=Джокьякарта\tzh-CN=日惹特区\n" +
+	"IE-C\ten=Connaught\tru=Коннахт\n" +
+	"IE-CE\ten=Clare\tja=クレア\tzh-CN=克莱尔郡\n" +
+	"IE-CN\ten=Cavan\tru=Каван\tzh-CN=卡文郡\n" +
+	"IE-CO\ten=Cork\tja=コーク\tru=Корк\tzh-CN=科克郡\n" +
+	"IE-CW\ten=Carlow\tru=Карлоу\tzh-CN=卡娄郡\n" +
+	"IE-D\ten=Dublin\tja=ダブリン\tru=Дублин\tzh-CN=都柏林郡\n" +
+	"IE-DL\ten=Donegal\tja=ドニゴール\tru=Донегол\tzh-CN=当尼戈尔郡\n" +
+	"IE-G\ten=Galway\tja=ゴルウェー\tru=Голуэй\tzh-CN=高维郡\n" +
+	"IE-KE\ten=Kildare\tja=キルデア\tru=Килдэр\tzh-CN=基尔代尔郡\n" +
+	"IE-KK\ten=Kilkenny\tja=キルケニ\tru=Килкенни\tzh-CN=基尔肯尼郡\n" +
+	"IE-KY\ten=Kerry\tja=ケリー\tzh-CN=凯瑞郡\n" +
+	"IE-L\ten=Leinster\tru=Ленстер\tzh-CN=伦斯特\n" +
+	"IE-LD\ten=Longford\tja=ロングフォード\tru=Лонгфорд\tzh-CN=朗福德郡\n" +
+	"IE-LH\ten=Louth\tzh-CN=劳斯郡\n" +
+	"IE-LK\ten=Limerick\tja=リムリック\tru=Лимерик\tzh-CN=利默里克郡\n" +
+	"IE-LM\ten=Leitrim\tja=リートリム\tzh-CN=利特里姆郡\n" +
+	"IE-LS\ten=Laois\tru=Лиишь\tzh-CN=利施郡\n" +
+	"IE-M\ten=Munster\tru=Манстер\tzh-CN=蒙斯特\n" +
+	"IE-MH\ten=Meath\tja=ミース\tzh-CN=米斯郡\n" +
+	"IE-MN\ten=Monaghan\tja=モナガン\tru=Монахан\tzh-CN=莫纳亨郡\n" +
+	"IE-MO\ten=Mayo\tja=メーオー\tru=Майо\tzh-CN=梅欧郡\n" +
+	"IE-OY\ten=Offaly\tja=オファリー\tru=Оффали\tzh-CN=奥法利郡\n" +
+	"IE-RN\ten=Roscommon\tja=ロスコモン\tru=Роскоммон\tzh-CN=罗斯康门郡\n" +
+	"IE-SO\ten=Sligo\tja=スライゴ\tru=Слайго\tzh-CN=斯莱戈郡\n" +
+	"IE-TA\ten=Tipperary\tja=ティペレアリ\tru=Типперери\tzh-CN=蒂珀雷里郡\n" +
+	"IE-U\ten=Ulster\tru=Ольстер\tzh-CN=阿尔斯特\n" +
+	"IE-WD\ten=Waterford\tja=ウォーターフォード\tru=Уотерфорд\tzh-CN=沃特福德郡\n" +
+	"IE-WH\ten=Westmeath\tja=ウェストミース\tru=Уэстмит\tzh-CN=西米斯郡\n" +
+	"IE-WW\ten=Wicklow\tja=ウィックロー\tru=Уиклоу\tzh-CN=威克娄郡\n" +
+	"IE-WX\ten=Wexford\tja=ウェクスフォード\tru=Уэксфорд\tzh-CN=韦克斯福德郡\n" +
+	"IL-D\ten=Al Janūbī\n" +
+	"IL-HA\ten=H̱efa\tru=Хайфа\n" +
+	"IL-JM\ten=Al Quds\tru=Иерусалим\n" +
+	"IL-M\ten=Al Awsaţ\n" +
+	"IL-TA\ten=Tall Abīb\n" +
+	"IL-Z\ten=Ash Shamālī\tru=Шарджа\n" +
+	"IN-AN\tde=Andamanen und Nikobaren\ten=Andaman and Nicobar Islands\tfr=Îles Andaman et Nicobar\tja=アンダマン=ニコバル諸島\tru=Андаманские и Никобарские острова\tzh-CN=安达曼-尼科巴群岛\n" +
+	"IN-AP\ten=Andhra Pradesh\tja=アンドラプラデシュ\tru=Андхра-Прадеш\tzh-CN=安得拉邦\n" +
+	"IN-AR\ten=Arunāchal Pradesh\tru=Аруначал-Прадеш\n" +
+	"IN-AS\ten=Assam\tja=アッサム\tru=Ассам\tzh-CN=阿萨姆邦\n" +
+	"IN-BR\ten=Bihār\tru=Бихар\n" +
+	"IN-CH\ten=Chandīgarh\tru=Чандпур\n" +
+	"IN-CT\ten=Chhattīsgarh\tru=Чхаттисгарх\n" +
+	"IN-DH\tde=Dādra und Nagar Haveli und Damān und Diu\ten=Dādra and Nagar Haveli and Damān and Diu\n" +
+	"IN-DL\ten=Delhi\tja=デリー\tru=Дели\tzh-CN=德里\n" +
+	"IN-GA\ten=Goa\tja=ゴア\tru=Гоа\tzh-CN=果阿邦\n" +
+	"IN-GJ\ten=Gujarāt\tru=Гуджарат\n" +
+	"IN-HP\ten=Himāchal Pradesh\tru=Химачал-Прадеш\n" +
+	"IN-HR\ten=Haryāna\tru=Харьяна\n" +
+	"IN-JH\ten=Jhārkhand\n" +
+	"IN-JK\tde=Jammu und Kashmīr\ten=Jammu and Kashmīr\tru=Джамму и Кашмир\n" +
+	"IN-KA\ten=Karnātaka\tru=Карнатака\n" +
+	"IN-KL\ten=Kerala\tja=ケーララ\tru=Керала\tzh-CN=喀拉拉邦\n" +
+	"IN-LA\ten=Ladākh\n" +
+	"IN-LD\ten=Lakshadweep\tja=ラクシャドウィープ\tru=Лакшадвип\tzh-CN=拉克沙群岛\n" +
+	"IN-MH\ten=Mahārāshtra\tru=Махараштра\n" +
+	"IN-ML\ten=Meghālaya\tru=Мегхалая\n" +
+	"IN-MN\ten=Manipur\tja=マニプル\tru=Манипур\tzh-CN=曼尼普尔邦\n" +
+	"IN-MP\ten=Madhya Pradesh\tja=マッディアプラデシュ\tru=Мадхья-Прадеш\tzh-CN=中央邦\n" +
+	"IN-MZ\ten=Mizoram\tja=ミゾラム\tru=Мизорам\tzh-CN=米佐拉姆邦\n" +
+	"IN-NL\ten=Nāgāland\tru=Нагаленд\n" +
+	"IN-OR\ten=Odisha\tru=Одиша\n" +
+	"IN-PB\ten=Punjab\tfr=Penjab\tja=パンジャーブ\tru=Пенджаб\tzh-CN=旁遮普邦\n" +
+	"IN-PY\ten=Puducherry\tfr=Pondichéry\n" +
+	"IN-RJ\ten=Rājasthān\tru=Раджастхан\n" +
+	"IN-SK\ten=Sikkim\tja=シッキム\tpt-BR=Siquim\tru=Сикким\tzh-CN=泰米尔纳德邦\n" +
+	"IN-TG\ten=Telangāna\tru=Саманган\n" +
+	"IN-TN\ten=Tamil Nādu\tru=Тамилнад\n" +
+	"IN-TR\ten=Tripura\tja=トリプラ\tru=Трипура\tzh-CN=特里普拉邦\n" +
+	"IN-UP\ten=Uttar Pradesh\tja=ウッタルプラデシュ\tru=Уттар-Прадеш\tzh-CN=北方邦\n" +
+	"IN-UT\ten=Uttarākhand\n" +
+	"IN-WB\tde=Westbengalen\ten=West Bengal\tfr=Bengale occidental\tja=ウェストベンガル\tru=Западная Бенгалия\tzh-CN=西孟加拉邦\n" +
+	"IQ-AN\ten=Al Anbār\n" +
+	"IQ-AR\ten=Arbīl\n" +
+	"IQ-BA\ten=Al Başrah\n" +
+	"IQ-BB\ten=Bābil\n" +
+	"IQ-BG\ten=Baghdād\n" +
+	"IQ-DA\ten=Dahūk\n" +
+	"IQ-DI\ten=Diyālá\n" +
+	"IQ-DQ\ten=Dhī Qār\n" +
+	"IQ-KA\ten=Karbalā’\n" +
+	"IQ-KI\ten=Kirkūk\n" +
+	"IQ-MA\ten=Maysān\n" +
+	"IQ-MU\ten=Al Muthanná\n" +
+	"IQ-NA\ten=An Najaf\n" +
+	"IQ-NI\ten=Nīnawá\n" +
+	"IQ-QA\ten=Al Qādisīyah\n" +
+	"IQ-SD\ten=Şalāḩ ad Dīn\n" +
+	"IQ-SU\ten=As Sulaymānīyah\n" +
+	"IQ-WA\ten=Wāsiţ\n" +
+	"IR-00\ten=Markazī\tfr=Markazi\tja=マルキャズィ\tzh-CN=中央\n" +
+	"IR-01\ten=Gīlān\tfr=Gilan\tja=ギーラーン\tzh-CN=吉兰省\n" +
+	"IR-02\ten=Māzandarān\tfr=Mazandaran\tja=マーザンダラン\tzh-CN=马赞德兰\n" +
+	"IR-03\ten=Āz̄ārbāyjān-e Shārqī\n" +
+	"IR-04\ten=Āz̄ārbāyjān-e Ghārbī\n" +
+	"IR-05\tde=Kermānschāh\ten=Kermānshāh\tfr=Kermanshah\tja=ケルマンシャー\tzh-CN=克尔曼沙汗\n" +
+	"IR-06\tde=Chūzestān\ten=Khūzestān\tfr=Khuzestan\tja=フーゼスタン\tzh-CN=胡齐斯坦\n" +
+	"IR-07\ten=Fārs\tfr=Fars\tja=ファールス\tzh-CN=法尔斯\n" +
+	"IR-08\ten=Kermān\tfr=Kerman\tja=ケルマーン\tzh-CN=克尔曼\n" +
+	"IR-09\ten=Khorāsān-e Raẕavī\n" +
+	"IR-10\ten=Eşfahān\tfr=Ispahan\tja=イスファハン\tzh-CN=伊斯法罕\n" +
+	"IR-11\tde=Sīstān und Belūtchistān\ten=Sīstān va Balūchestān\tfr=Sistan-o-Balouchestan\tja=シスタンバルスチスタン\tzh-CN=锡斯坦-俾路支斯坦\n" +
+	"IR-12\ten=Kordestān\tfr=Kurdistan\tja=クルディスタン\tzh-CN=库尔德斯坦\n" +
+	"IR-13\ten=Hamadān\tfr=Hamedan\tja=ハマダーン\tzh-CN=哈马丹\n" +
+	"IR-14\ten=Chahār Maḩāl va Bakhtīārī\n" +
+	"IR-15\ten=Lorestān\tfr=Lorestan\tja=ロレスタン\tzh-CN=洛雷斯坦\n" +
+	"IR-16\ten=Īlām\tfr=Ilam\tja=イーラーム\tzh-CN=伊拉姆省\n" +
+	"IR-17\ten=Kohgīlūyeh va Bowyer Aḩmad\n" +
+	"IR-18\tde=Buschehr\ten=Būshehr\tfr=Bushehr\tja=ブシエール\tzh-CN=布什尔\n" +
+	"IR-19\tde=Zandschān\ten=Zanjān\tfr=Zanjan\tja=ザンジャーン\tzh-CN=赞詹\n" +
+	"IR-20\ten=Semnān\tfr=Semnan\tja=セムナーン\tzh-CN=塞姆南\n" +
+	"IR-21\ten=Yazd\tja=ヤズド\tzh-CN=亚兹德\n" +
+	"IR-22\ten=Hormozgān\tfr=Hormozgan\tja=ホルモズガン\tzh-CN=霍尔木兹甘\n" +
+	"IR-23\tde=Teherān\ten=Tehrān\tfr=Téhéran\tja=テヘラン\tzh-CN=德黑兰\n" +
+	"IR-24\ten=Ardabīl\tfr=Ardabil\tja=アルダビール\tzh-CN=阿尔达比勒\n" +
+	"IR-25\ten=Qom\tja=コム\tzh-CN=库姆\n" +
+	"IR-26\ten=Qazvīn\tfr=Qazvin\tja=ガズヴィーン\tzh-CN=加兹温\n" +
+	"IR-27\ten=Golestān\tfr=Golestan\tja=ゴレスタン\tzh-CN=戈勒斯坦\n" +
+	"IR-28\ten=Khorāsān-e Shomālī\n" +
+	"IR-29\ten=Khorāsān-e Jonūbī\n" +
+	"IR-30\ten=Alborz\n" +
+	"IS-1\ten=Höfuðborgarsvæði\n" +
+	"IS-2\ten=Suðurnes\tfr=Péninsule méridionale\tzh-CN=西南区\n" +
+	"IS-3\ten=Vesturland\tfr=Ouest\tja=ベストゥルラント\tzh-CN=西部区\n" +
+	"IS-4\ten=Vestfirðir\tfr=Fjords de l'ouest\tja=ベストフィルジル\tzh-CN=西峡湾区\n" +
+	"IS-5\ten=Norðurland vestra\tfr=Nord-Ouest\tja=ノルズルラントベストラ\tzh-CN=西北区\n" +
+	"IS-6\ten=Norðurland eystra\tfr=Nord-est\tja=ノルズルラントエイストラ\tzh-CN=东北区\n" +
+	"IS-7\ten=Austurland\tfr=Est\tja=オイストゥルラント\tru=Эйстюрланд\tzh-CN=东部区\n" +
+	"IS-8\ten=Suðurland\tfr=Sud\tja=スーズルラント\tru=Сюдюрланд\tzh-CN=南部区\n" +
+	"IS-AKH\ten=Akrahreppur\n" +
+	"IS-AKN\ten=Akraneskaupstaður\n" +
+	"IS-AKU\ten=Akureyrarbær\tru=Акюрейри\n" +
+	"IS-ARN\ten=Árneshreppur\tru=Аурнесхреппюр\n" +
+	"IS-ASA\ten=Ásahreppur\tru=Аусахреппюр\n" +
+	"IS-BFJ\ten=Borgarfjarðarhreppur\n" +
+	"IS-BLA\ten=Bláskógabyggð\tru=Блаускоугабиггд\n" +
+	"IS-BLO\ten=Blönduósbær\tru=Блёндюоус\n" +
+	"IS-BOG\ten=Borgarbyggð\tru=Боргарбиггд\n" +
+	"IS-BOL\ten=Bolungarvíkurkaupstaður\tru=Болунгарвик\n" +
+	"IS-DAB\ten=Dalabyggð\tru=Далабиггд\n" +
+	"IS-DAV\ten=Dalvíkurbyggð\n" +
+	"IS-DJU\ten=Djúpavogshreppur\tru=Дьюпивогюр\n" +
+	"IS-EOM\ten=Eyja- og Miklaholtshreppur\tru=Эйя-ог-Миклахольтсхреппюр\n" +
+	"IS-EYF\ten=Eyjafjarðarsveit\tru=Эйяфьярдарсвейт\n" +
+	"IS-FJD\ten=Fjarðabyggð\tru=Фьярдабиггд\n" +
+	"IS-FJL\ten=Fjallabyggð\n" +
+	"IS-FLA\ten=Flóahreppur\n" +
+	"IS-FLD\ten=Fljótsdalshérað\n" +
+	"IS-FLR\ten=Fljótsdalshreppur\tru=Фльоутсдальсхреппюр\n" +
+	"IS-GAR\ten=Garðabær\tru=Гардабайр\n" +
+	"IS-GOG\ten=Grímsnes- og Grafningshreppur\n" +
+	"IS-GRN\ten=Grindavíkurbær\tru=Гриндавик\n" +
+	"IS-GRU\ten=Grundarfjarðarbær\tru=Грюндарфьордюр\n" +
+	"IS-GRY\ten=Grýtubakkahreppur\n" +
+	"IS-HAF\ten=Hafnarfjarðarkaupstaður\tru=Хабнарфьордюр\n" +
+	"IS-HEL\ten=Helgafellssveit\tru=Хельгафедльссвейт\n" +
+	"IS-HRG\ten=Hörgársveit\n" +
+	"IS-HRU\ten=Hrunamannahreppur\n" +
+	"IS-HUT\ten=Húnavatnshreppur\n" +
+	"IS-HUV\ten=Húnaþing vestra\n" +
+	"IS-HVA\ten=Hvalfjarðarsveit\tru=Хвальфьярдарсвейт\n" +
+	"IS-HVE\ten=Hveragerðisbær\tru=Хверагерди\n" +
+	"IS-ISA\ten=Ísafjarðarbær\tru=Исафьярдарбайр\n" +
+	"IS-KAL\ten=Kaldrananeshreppur\tru=Кальдрананесхреппюр\n" +
+	"IS-KJO\ten=Kjósarhreppur\n" +
+	"IS-KOP\ten=Kópavogsbær\tru=Коупавогюр\n" +
+	"IS-LAN\ten=Langanesbyggð\tru=Ланганесбигд\n" +
+	"IS-MOS\ten=Mosfellsbær\tru=Мосфедльсбайр\n" +
+	"IS-MYR\ten=Mýrdalshreppur\n" +
+	"IS-NOR\ten=Norðurþing\tru=Нордюртинг\n" +
+	"IS-RGE\ten=Rangárþing eystra\n" +
+	"IS-RGY\ten=Rangárþing ytra\n" +
+	"IS-RHH\ten=Reykhólahreppur\tru=Рейкхоулахреппюр\n" +
+	"IS-RKN\ten=Reykjanesbær\tru=Рейкьянесбайр\n" +
+	"IS-RKV\ten=Reykjavíkurborg\tru=Рейкьявик\n" +
+	"IS-SBH\ten=Svalbarðshreppur\n" +
+	"IS-SBT\ten=Svalbarðsstrandarhreppur\n" +
+	"IS-SDN\ten=Suðurnesjabær\tru=Сюдюрнесьябайр\n" +
+	"IS-SDV\ten=Súðavíkurhreppur\n" +
+	"IS-SEL\ten=Seltjarnarnesbær\tru=Сельтьяднарнес\n" +
+	"IS-SEY\ten=Seyðisfjarðarkaupstaður\tru=Сейдисфьордюр\n" +
+	"IS-SFA\ten=Sveitarfélagið Árborg\n" +
+	"IS-SHF\ten=Sveitarfélagið Hornafjörður\n" +
+	"IS-SKF\ten=Skaftárhreppur\n" +
+	"IS-SKG\ten=Skagabyggð\n" +
+	"IS-SKO\ten=Skorradalshreppur\tru=Скоррадальсхреппюр\n" +
+	"IS-SKU\ten=Skútustaðahreppur\n" +
+	"IS-SNF\ten=Snæfellsbær\tru=Снайфедльсбайр\n" +
+	"IS-SOG\ten=Skeiða- og Gnúpverjahreppur\n" +
+	"IS-SOL\ten=Sveitarfélagið Ölfus\n" +
+	"IS-SSF\ten=Sveitarfélagið Skagafjörður\tru=Скагафьордюр\n" +
+	"IS-SSS\ten=Sveitarfélagið Skagaströnd\n" +
+	"IS-STR\ten=Strandabyggð\n" +
+	"IS-STY\ten=Stykkishólmsbær\tru=Стиккисхоульмюр\n" +
+	"IS-SVG\ten=Sveitarfélagið Vogar\n" +
+	"IS-TAL\ten=Tálknafjarðarhreppur\tru=Таулькнафьярдархреппюр\n" +
+	"IS-THG\ten=Þingeyjarsveit\n" +
+	"IS-TJO\ten=Tjörneshreppur\n" +
+	"IS-VEM\ten=Vestmannaeyjabær\n" +
+	"IS-VER\ten=Vesturbyggð\tru=Вестюрбиггд\n" +
+	"IS-VOP\ten=Vopnafjarðarhreppur\tru=Вопнафьордюр\n" +
+	"IT-21\ten=Piemonte\tes=Piamonte\tfr=Piémont\tja=ピエモンテ\tru=Пьемонт\tzh-CN=皮埃蒙特\n" +
+	"IT-23\ten=Val d'Aoste\tru=Валле-д’Аоста\n" +
+	"IT-25\tde=Lombardei\ten=Lombardia\tes=Lombardía\tfr=Lombardie\tja=ロンバルディア\tru=Ломбардия\tzh-CN=伦巴第\n" +
+	"IT-32\tde=Trentino-Südtirol\ten=Trentino-Alto Adige\tes=Trentino-Alto Adigio\tfr=Trentin- Haut Adige\tja=トレンティノ=アルトアディジェ\tru=Трентино-Альто-Адидже\tzh-CN=特伦蒂诺-上阿迪杰\n" +
+	"IT-34\tde=Venetien\ten=Veneto\tes=Véneto\tfr=Vénétie\tja=ヴェネト\tzh-CN=威尼托\n" +
+	"IT-36\ten=Friuli Venezia Giulia\tru=Фриули-Венеция-Джулия\n" +
+	"IT-42\tde=Ligurien\ten=Liguria\tfr=Ligurie\tja=リグーリア\tru=Лигурия\tzh-CN=利古里亚\n" +
+	"IT-45\ten=Emilia-Romagna\tes=Emilia-Romaña\tfr=Émilie-Romagne\tja=エミリア=ロマーニャ\tru=Эмилия-Романья\tzh-CN=艾米利亚-罗马涅\n" +
+	"IT-52\tde=Toskana\ten=Toscana\tfr=Toscane\tja=トスカーナ\tru=Тоскана\tzh-CN=托斯卡纳\n" +
+	"IT-55\tde=Umbrien\ten=Umbria\tes=Umbría\tfr=Ombrie\tja=ウンブリア\tru=Умбрия\tzh-CN=翁布里亚\n" +
+	"IT-57\tde=Marken\ten=Marche\tes=Marcas\tja=マルケ\tru=Марке\tzh-CN=马尔凯\n" +
+	"IT-62\tde=Latium\ten=Lazio\tes=Lacio\tfr=Latium\tja=ラツィオ\tru=Лацио\tzh-CN=拉齐奥\n" +
+	"IT-65\tde=Abruzzen\ten=Abruzzo\tes=Abruzos\tfr=Abruzzes\tja=アブルッツォ\tru=Абруцци\tzh-CN=阿布鲁佐\n" +
+	"IT-67\ten=Molise\tja=モリーゼ\tru=Молизе\tzh-CN=莫利塞\n" +
+	"IT-72\tde=Kampanien\ten=Campania\tfr=Campanie\tja=カンパニア\tru=Кампания\tzh-CN=坎帕尼亚\n" +
+	"IT-75\tde=Apulien\ten=Puglia\tes=Apulia\tfr=Pouilles\tja=プーリア\tru=Апулия\tzh-CN=普利亚\n" +
+	"IT-77\tde=Basilikata\ten=Basilicata\tfr=Basilicate\tja=バジリカータ\tru=Базиликата\tzh-CN=巴斯利卡塔\n" +
+	"IT-78\tde=Kalabrien\ten=Calabria\tfr=Calabre\tja=カラブリア\tru=Калабрия\tzh-CN=卡拉布里亚\n" +
+	"IT-82\tde=Sizilien\ten=Sicilia\tfr=Sicile\tja=シチリア\tru=Сицилия\tzh-CN=西西里\n" +
+	"IT-88\tde=Sardinien\ten=Sardegna\tes=Cerdeña\tfr=Sardaigne\tja=サルデーニャ\tru=Сардиния\tzh-CN=萨丁\n" +
+	"IT-AG\tde=Agrigent\ten=Agrigento\tfr=Agrigente\tja=アグリジェント\tru=Агридженто\tzh-CN=阿格里根托\n" +
+	"IT-AL\ten=Alessandria\tfr=Alexandrie\tru=Алессандрия\tzh-CN=亚历山德里亚\n" +
+	"IT-AN\ten=Ancona\tfr=Ancône\tja=アンコーナ\tru=Анкона\tzh-CN=安科纳\n" +
+	"IT-AP\ten=Ascoli Piceno\tja=アスコリピチェーノ\tru=Асколи-Пичено\tzh-CN=阿斯科利皮切诺\n" +
+	"IT-AQ\ten=L'Aquila\tja=ラクイラ\tru=Л’Акуила\tzh-CN=拉奎拉\n" +
+	"IT-AR\ten=Arezzo\tja=アレッツォ\tru=Ареццо\tzh-CN=阿雷佐\n" +
+	"IT-AT\ten=Asti\tja=アスティ\tru=Асти\tzh-CN=阿斯蒂\n" +
+	"IT-AV\ten=Avellino\tja=アヴェリーノ\tru=Авеллино\tzh-CN=阿韦利诺\n" +
+	"IT-BA\ten=Bari\tja=バリ\tru=Бари\n" +
+	"IT-BG\ten=Bergamo\tfr=Bergame\tja=ベルガモ\tru=Бергамо\tzh-CN=贝加莫\n" +
+	"IT-BI\ten=Biella\tru=Биелла\tzh-CN=比耶拉\n" +
+	"IT-BL\ten=Belluno\tru=Беллуно\tzh-CN=贝卢诺\n" +
+	"IT-BN\ten=Benevento\tja=ベネヴェント\tru=Беневенто\tzh-CN=贝内文托\n" +
+	"IT-BO\ten=Bologna\tes=Bolonia\tfr=Bologne\tja=ボローニャ\tru=Болонья\tzh-CN=博洛尼亚\n" +
+	"IT-BR\ten=Brindisi\tja=ブリンディジ\tru=Бриндизи\tzh-CN=布林迪西\n" +
+	"IT-BS\ten=Brescia\tja=ブレシア\tru=Брешиа\tzh-CN=布雷西亚\n" +
+	"IT-BT\ten=Barletta-Andria-Trani\tru=Барлетта-Андрия-Трани\n" +
+	"IT-BZ\tde=Bozen\ten=Bolzano\tru=Больцано\tzh-CN=博尔扎诺\n" +
+	"IT-CA\ten=Cagliari\tja=カリアリ\tru=Кальяри\tzh-CN=卡利亚里\n" +
+	"IT-CB\ten=Campobasso\tru=Кампобассо\tzh-CN=坎波巴索\n" +
+	"IT-CE\ten=Caserta\tfr=Caserte\tru=Казерта\tzh-CN=卡塞尔塔\n" +
+	"IT-CH\ten=Chieti\tja=キエティ\tru=Кьети\tzh-CN=奇耶蒂\n" +
+	"IT-CL\ten=Caltanissetta\tja=カルタニセッタ\tru=Кальтаниссетта\tzh-CN=卡尔塔尼塞塔\n" +
+	"IT-CN\ten=Cuneo\tfr=Cunéo\tja=クーネオ\tru=Кунео\tzh-CN=库内奥\n" +
+	"IT-CO\ten=Como\tfr=Côme\tja=コモ\tru=Комо\tzh-CN=科莫\n" +
+	"IT-CR\ten=Cremona\tfr=Crémone\tru=Кремона\tzh-CN=克雷莫纳\n" +
+	"IT-CS\ten=Cosenza\tja=コゼンツァ\tru=Козенца\tzh-CN=科森扎\n" +
+	"IT-CT\ten=Catania\tfr=Catane\tja=カターニア\tru=Катания\tzh-CN=卡塔尼亚\n" +
+	"IT-CZ\ten=Catanzaro\tja=カタンザーロ\tru=Катандзаро\tzh-CN=卡坦扎罗\n" +
+	"IT-EN\ten=Enna\tja=エンナ\tru=Энна\tzh-CN=恩纳\n" +
+	"IT-FC\ten=Forlì-Cesena\tru=Форли-Чезена\n" +
+	"IT-FE\ten=Ferrara\tfr=Ferrare\tja=フェラーラ\tru=Феррара\tzh-CN=费拉拉\n" +
+	"IT-FG\ten=Foggia\tja=フォッジャ\tru=Фоджа\tzh-CN=福贾\n" +
+	"IT-FI\tde=Florenz\ten=Firenze\tes=Florencia\tfr=Florence\tja=フィレンツェ\tru=Флоренция\tzh-CN=佛罗伦萨\n" +
+	"IT-FM\ten=Fermo\tru=Фермо\n" +
+	"IT-FR\ten=Frosinone\tja=フロジノーネ\tru=Фрозиноне\tzh-CN=弗洛西诺内\n" +
+	"IT-GE\tde=Genua\ten=Genova\tes=Génova\tfr=Gênes\tja=ジェノバ\tru=Генуя\tzh-CN=热那亚\n" +
+	"IT-GO\tde=Görz\ten=Gorizia\tru=Гориция\tzh-CN=戈里奇亚\n" +
+	"IT-GR\ten=Grosseto\tru=Гроссето\tzh-CN=格罗塞托\n" +
+	"IT-IM\ten=Imperia\tzh-CN=因佩里亚\n" +
+	"IT-IS\ten=Isernia\tja=イゼルニア\tru=Изерния\tzh-CN=伊塞尔尼亚\n" +
+	"IT-KR\ten=Crotone\tja=クロトーネ\tru=Кротоне\tzh-CN=克罗托内\n" +
+	"IT-LC\ten=Lecco\tru=Лекко\tzh-CN=雷科\n" +
+	"IT-LE\ten=Lecce\tja=レッチェ\tru=Лечче\tzh-CN=莱切\n" +
+	"IT-LI\ten=Livorno\tfr=Livourne\tja=リヴォルノ\tru=Ливорно\tzh-CN=里窝那\n" +
+	"IT-LO\ten=Lodi\tzh-CN=洛迪\n" +
+	"IT-LT\ten=Latina\tzh-CN=拉蒂纳\n" +
+	"IT-LU\ten=Lucca\tfr=Lucques\tru=Лукка\tzh-CN=卢卡\n" +
+	"IT-MB\tde=Monza und Brianza\ten=Monza e Brianza\tfr=Monza et Brianza\tru=Монца-э-Брианца\n" +
+	"IT-MC\ten=Macerata\tja=マチェラータ\tru=Мачерата\tzh-CN=马塞拉塔\n" +
+	"IT-ME\ten=Messina\tfr=Messine\tja=メッシーナ\tru=Мессина\tzh-CN=墨西拿\n" +
+	"IT-MI\tde=Mailand\ten=Milano\tfr=Milan\tja=ミラノ\tru=Милан\tzh-CN=米兰\n" +
+	"IT-MN\tde=Mantua\ten=Mantova\tfr=Mantoue\tru=Мантуя\tzh-CN=蒙托瓦\n" +
+	"IT-MO\ten=Modena\tfr=Modène\tja=モデナ\tru=Модена\tzh-CN=摩德纳\n" +
+	"IT-MS\ten=Massa-Carrara\tru=Масса-Каррара\tzh-CN=马萨-卡拉拉\n" +
+	"IT-MT\ten=Matera\tja=マテーラ\tru=Матера\tzh-CN=梅特拉\n" +
+	"IT-NA\tde=Neapel\ten=Napoli\tfr=Naples\tja=ナポリ\tru=Неаполь\tzh-CN=那波利\n" +
+	"IT-NO\ten=Novara\tfr=Novare\tja=ノヴァーラ\tru=Новара\tzh-CN=诺瓦拉\n" +
+	"IT-NU\ten=Nuoro\tru=Нуоро\tzh-CN=努奥罗\n" +
+	"IT-OR\ten=Oristano\tru=Ористано\tzh-CN=奥里斯塔诺\n" +
+	"IT-PA\ten=Palermo\tfr=Palerme\tja=パレルモ\tru=Палермо\tzh-CN=巴勒莫\n" +
+	"IT-PC\ten=Piacenza\tfr=Piacence\tja=ピアチェンツァ\tru=Пьяченца\tzh-CN=皮亚琴察\n" +
+	"IT-PD\tde=Padua\ten=Padova\tfr=Padoue\tja=パドヴァ\tru=Падуя\tzh-CN=帕多瓦\n" +
+	"IT-PE\ten=Pescara\tja=ペスカーラ\tru=Пескара\tzh-CN=佩斯卡拉\n" +
+	"IT-PG\ten=Perugia\tfr=Pérouse\tja=ペルージャ\tru=Перуджа\tzh-CN=佩鲁贾\n" +
+	"IT-PI\ten=Pisa\tfr=Pise\tru=Пиза\tzh-CN=比萨\n" +
+	"IT-PN\ten=Pordenone\tru=Порденоне\tzh-CN=波代诺内\n" +
+	"IT-PO\ten=Prato\tru=Прато\tzh-CN=普拉托\n" +
+	"IT-PR\ten=Parma\tfr=Parme\tja=パルマ\tru=Парма\tzh-CN=帕尔马\n" +
+	"IT-PT\ten=Pistoia\tru=Пистоя\tzh-CN=比斯托利亚\n" +
+	"IT-PU\tde=Pesaro und Urbino\ten=Pesaro e Urbino\tfr=Pésaro et Urbino\tru=Пезаро-э-Урбино\tzh-CN=佩萨洛乌尔毕诺\n" +
+	"IT-PV\ten=Pavia\tfr=Pavie\tru=Павия\tzh-CN=帕维亚\n" +
+	"IT-PZ\ten=Potenza\tja=ポテンツァ\tru=Потенца\tzh-CN=波坦察\n" +
+	"IT-RA\ten=Ravenna\tfr=Ravenne\tja=ラヴェンナ\tru=Равенна\tzh-CN=拉韦纳\n" +
+	"IT-RC\ten=Reggio Calabria\tja=レッジョディカラブリア\tru=Реджо-ди-Калабрия\tzh-CN=雷焦卡拉布里亚\n" +
+	"IT-RE\ten=Reggio Emilia\tru=Реджо-нель-Эмилия\tzh-CN=雷焦艾米利亚\n" +
+	"IT-RG\ten=Ragusa\tfr=Ragouse\tja=ラグーサ\tru=Рагуза\tzh-CN=拉古萨\n" +
+	"IT-RI\ten=Rieti\tru=Риети\tzh-CN=列蒂\n" +
+	"IT-RM\tde=Rom\ten=Roma\tfr=roma\tja=ローマ\tzh-CN=罗马\n" +
+	"IT-RN\ten=Rimini\tja=リミニ\tru=Римини\tzh-CN=里米尼\n" +
+	"IT-RO\ten=Rovigo\tru=Ровиго\tzh-CN=罗维戈\n" +
+	"IT-SA\ten=Salerno\tfr=Salerne\tja=サレルノ\tru=Салерно\tzh-CN=萨莱诺\n" +
+	"IT-SI\ten=Siena\tfr=Sienne\tja=シエナ\tru=Сиена\tzh-CN=锡耶纳\n" +
+	"IT-SO\ten=Sondrio\tja=ソンドリオ\tru=Сондрио\tzh-CN=松德里奥\n" +
+	"IT-SP\ten=La Spezia\tzh-CN=拉斯佩奇亚\n" +
+	"IT-SR\tde=Syrakus\ten=Siracusa\tfr=Syracuse\tja=シラクーサ\tzh-CN=锡拉库萨\n" +
+	"IT-SS\ten=Sassari\tja=サッサリ\tru=Сассари\tzh-CN=萨萨里\n" +
+	"IT-SU\ten=Sud Sardegna\tru=Южная Сардиния\n" +
+	"IT-SV\ten=Savona\tfr=Savone\tja=サヴォーナ\tru=Савона\tzh-CN=萨窝那\n" +
+	"IT-TA\tde=Tarent\ten=Taranto\tfr=Tarante\tja=タラント\tru=Таранто\tzh-CN=塔兰托\n" +
+	"IT-TE\ten=Teramo\tru=Терамо\tzh-CN=泰拉莫\n" +
+	"IT-TN\tde=Trient\ten=Trento\tfr=Trente\tja=トレント\tru=Тренто\tzh-CN=特伦托\n" +
+	"IT-TO\tde=Turin\ten=Torino\tfr=Turin\tja=トリノ\tru=Турин\tzh-CN=都灵\n" +
+	"IT-TP\ten=Trapani\tja=トラパニ\tru=Трапани\tzh-CN=特拉帕尼\n" +
+	"IT-TR\ten=Terni\tru=Терни\tzh-CN=特尔尼\n" +
+	"IT-TS\tde=Triest\ten=Trieste\tja=トリエステ\tru=Триест\tzh-CN=的里雅斯特\n" +
+	"IT-TV\ten=Treviso\tfr=Trévise\tja=トレヴィーゾ\tru=Тревизо\tzh-CN=特雷维索\n" +
+	"IT-UD\ten=Udine\tja=ウーディネ\tru=Удине\tzh-CN=乌迪内\n" +
+	"IT-VA\ten=Varese\tfr=Varèse\tru=Варесе\tzh-CN=瓦雷泽\n" +
+	"IT-VB\ten=Verbano-Cusio-Ossola\tru=Вербано-Кузио-Оссола\tzh-CN=韦巴诺-库西奥-奥索拉\n" +
+	"IT-VC\ten=Vercelli\tru=Верчелли\tzh-CN=韦塞利\n" +
+	"IT-VE\tde=Venedig\ten=Venezia\tfr=Venise\tja=ヴェネツィア\tru=Венеция\tzh-CN=威尼斯\n" +
+	"IT-VI\ten=Vicenza\tfr=Vicence\tja=ヴィチェンツァ\tru=Виченца\tzh-CN=维琴察\n" +
+	"IT-VR\ten=Verona\tfr=Vérone\tja=ヴェローナ\tru=Верона\tzh-CN=维罗那\n" +
+	"IT-VT\ten=Viterbo\tja=ヴィテルボ\tru=Витербо\tzh-CN=维特尔博\n" +
+	"IT-VV\ten=Vibo Valentia\tru=Вибо-Валентия\tzh-CN=维伯瓦伦蒂亚\n" +
+	"JM-01\ten=Kingston\tja=キングストン\tru=Кингстон\tzh-CN=金斯敦\n" +
+	"JM-02\ten=Saint Andrew\tfr=Saint-Andrew\tru=Сент Эндрю\tzh-CN=圣安德鲁斯\n" +
+	"JM-03\ten=Saint Thomas\tfr=Saint-Thomas\tru=Сент Томас\tzh-CN=圣托马斯\n" +
+	"JM-04\ten=Portland\tja=ポートランド\tru=Портленд\tzh-CN=波特兰\n" +
+	"JM-05\ten=Saint Mary\tfr=Saint-Mary\tja=セントメアリー\tru=Сент-Мэри\tzh-CN=圣玛丽\n" +
+	"JM-06\ten=Saint Ann\tja=セントアン\tzh-CN=圣安娜\n" +
+	"JM-07\ten=Trelawny\tja=トリローニー\tzh-CN=特里洛尼\n" +
+	"JM-08\ten=Saint James\tfr=Saint-James\tru=Сент Джеймс\tzh-CN=圣詹姆斯\n" +
+	"JM-09\ten=Hanover\tja=ハノーバー\tru=Ганновер\tzh-CN=汉诺威\n" +
+	"JM-10\ten=Westmoreland\tja=ウェストモアランド\tru=Уэстморленд\tzh-CN=西摩兰\n" +
+	"JM-11\ten=Saint Elizabeth\tja=セントエリザベス\tzh-CN=圣伊丽莎白\n" +
+	"JM-12\ten=Manchester\tja=マンチェスター\tru=Манчестер\n" +
+	"JM-13\ten=Clarendon\tja=クラレンドン\tru=Кларендон\tzh-CN=克拉伦登\n" +
+	"JM-14\ten=Saint Catherine\tja=セントキャサリン\tzh-CN=圣凯瑟琳\n" +
+	"JO-AJ\tde=Adschlun\ten=‘Ajlūn\tja=アジュルン\n" +
+	"JO-AM\ten=Al ‘A̅şimah\n" +
+	"JO-AQ\tde=Aqaba\ten=Al ‘Aqabah\tja=アカバ\n" +
+	"JO-AT\tde=At-Tafila\ten=Aţ Ţafīlah\tja=タフィーラ\n" +
+	"JO-AZ\ten=Az Zarqā’\n" +
+	"JO-BA\ten=Al Balqā’\n" +
+	"JO-IR\ten=Irbid\tja=イルビド\tzh-CN=伊尔比德\n" +
+	"JO-JA\tde=Dscharasch\ten=Jarash\tja=ジャラシュ\tzh-CN=杰拉什\n" +
+	"JO-KA\tde=Al-Karak\ten=Al Karak\tja=カラク\tzh-CN=卡拉克\n" +
+	"JO-MA\tde=Al-Mafraq\ten=Al Mafraq\tja=マフラク\tzh-CN=马夫拉克\n" +
+	"JO-MD\ten=Mādabā\tja=マーダバー\n" +
+	"JO-MN\tde=Ma'an\ten=Ma‘ān\tja=マアーン\n" +
+	"JP-01\ten=Hokkaido\tja=北海道\tru=Хоккайдо\tzh-CN=北海道\n" +
+	"JP-02\ten=Aomori\tja=青森\tru=Аомори\tzh-CN=青森县\n" +
+	"JP-03\ten=Iwate\tja=岩手\tru=Ивате\tzh-CN=岩手县\n" +
+	"JP-04\ten=Miyagi\tja=宮城\tru=Мияги\tzh-CN=宫城县\n" +
+	"JP-05\ten=Akita\tja=秋田\tru=Акита\tzh-CN=秋田县\n" +
+	"JP-06\ten=Yamagata\tja=山形\tzh-CN=山形县\n" +
+	"JP-07\ten=Fukushima\tja=福島\tru=Фукусима\tzh-CN=福岛县\n" +
+	"JP-08\ten=Ibaraki\tja=茨城\tzh-CN=茨城县\n" +
+	"JP-09\ten=Tochigi\tja=栃木\tru=Тотиги\tzh-CN=枥木县\n" +
+	"JP-10\ten=Gunma\tja=群馬\tzh-CN=群马县\n" +
+	"JP-11\ten=Saitama\tja=埼玉\tru=Сайтама\tzh-CN=琦玉县\n" +
+	"JP-12\ten=Chiba\tja=千葉\tru=Тиба\tzh-CN=千叶县\n" +
+	"JP-13\ten=Tokyo\tja=東京\tru=Токио\tzh-CN=东京都\n" +
+	"JP-14\ten=Kanagawa\tja=神奈川\tru=Канагава\tzh-CN=神奈川县\n" +
+	"JP-15\ten=Niigata\tja=新潟\tru=Ниигата\tzh-CN=新泻县\n" +
+	"JP-16\ten=Toyama\tja=富山\tru=Тояма\tzh-CN=富山县\n" +
+	"JP-17\ten=Ishikawa\tja=石川\tzh-CN=石川县\n" +
+	"JP-18\ten=Fukui\tja=福井\tru=Фукуи\tzh-CN=福井县\n" +
+	"JP-19\ten=Yamanashi\tja=山梨\tru=Яманаси\tzh-CN=山梨县\n" +
+	"JP-20\ten=Nagano\tja=長野\tzh-CN=长野县\n" +
+	"JP-21\ten=Gifu\tja=岐阜\tzh-CN=岐阜县\n" +
+	"JP-22\ten=Shizuoka\tja=静岡\tru=Сидзуока\tzh-CN=静冈县\n" +
+	"JP-23\ten=Aichi\tja=愛知\tzh-CN=爱知县\n" +
+	"JP-24\ten=Mie\tja=三重\tzh-CN=三重县\n" +
+	"JP-25\ten=Shiga\tja=滋賀\tru=Сига\tzh-CN=滋贺县\n" +
+	"JP-26\ten=Kyoto\tja=京都\tru=Киото\tzh-CN=京都府\n" +
+	"JP-27\ten=Osaka\tja=大阪\tru=Осака\tzh-CN=大阪府\n" +
+	"JP-28\ten=Hyogo\tja=兵庫\tru=Хиого\tzh-CN=兵库县\n" +
+	"JP-29\ten=Nara\tja=奈良\tru=Нара\tzh-CN=奈良县\n" +
+	"JP-30\ten=Wakayama\tja=和歌山\tru=Вакаяма\tzh-CN=和歌山县\n" +
+	"JP-31\ten=Tottori\tja=鳥取\tru=Тоттори\tzh-CN=鸟取县\n" +
+	"JP-32\ten=Shimane\tja=島根\tru=Симане\tzh-CN=岛根县\n" +
+	"JP-33\ten=Okayama\tja=岡山\tru=Окаяма\tzh-CN=冈山县\n" +
+	"JP-34\ten=Hiroshima\tja=広島\tru=Хиросима\tzh-CN=广岛县\n" +
+	"JP-35\ten=Yamaguchi\tja=山口\tru=Ямагути\tzh-CN=山口县\n" +
+	"JP-36\ten=Tokushima\tja=徳島\tru=Токусима\tzh-CN=德岛县\n" +
+	"JP-37\ten=Kagawa\tja=香川\tru=Кагава\tzh-CN=香川县\n" +
+	"JP-38\ten=Ehime\tja=愛媛\tru=Эхиме\tzh-CN=爱媛县\n" +
+	"JP-39\ten=Kochi\tja=高知\tzh-CN=高知县\n" +
+	"JP-40\ten=Fukuoka\tja=福岡\tru=Фукуока\tzh-CN=福冈县\n" +
+	"JP-41\ten=Saga\tja=佐賀\tzh-CN=佐贺县\n" +
+	"JP-42\ten=Nagasaki\tja=長崎\tru=Нагасаки\tzh-CN=长崎县\n" +
+	"JP-43\ten=Kumamoto\tja=熊本\tru=Кумамото\tzh-CN=熊本县\n" +
+	"JP-44\ten=Oita\tja=大分\tru=Оита\tzh-CN=大分县\n" +
+	"JP-45\ten=Miyazaki\tja=宮崎\tru=Миядзаки\tzh-CN=宫崎县\n" +
+	"JP-46\ten=Kagoshima\tja=鹿児島\tru=Кагосима\tzh-CN=鹿儿岛县\n" +
+	"JP-47\ten=Okinawa\tja=沖縄\tru=Окинава\tzh-CN=冲绳县\n" +
+	"KE-01\ten=Baringo\n" +
+	"KE-02\ten=Bomet\n" +
+	"KE-03\ten=Bungoma\n" +
+	"KE-04\ten=Busia\tja=ブシア\tzh-CN=布西亚\n" +
+	"KE-05\ten=Elgeyo/Marakwet\n" +
+	"KE-06\ten=Embu\n" +
+	"KE-07\ten=Garissa\n" +
+	"KE-08\ten=Homa Bay\n" +
+	"KE-09\ten=Isiolo\n" +
+	"KE-10\ten=Kajiado\n" +
+	"KE-11\ten=Kakamega\n" +
+	"KE-12\ten=Kericho\n" +
+	"KE-13\ten=Kiambu\n" +
+	"KE-14\ten=Kilifi\n" +
+	"KE-15\ten=Kirinyaga\n" +
+	"KE-16\ten=Kisii\n" +
+	"KE-17\ten=Kisumu\n" +
+	"KE-18\ten=Kitui\n" +
+	"KE-19\ten=Kwale\n" +
+	"KE-20\ten=Laikipia\n" +
+	"KE-21\ten=Lamu\n" +
+	"KE-22\ten=Machakos\n" +
+	"KE-23\ten=Makueni\n" +
+	"KE-24\ten=Mandera\n" +
+	"KE-25\ten=Marsabit\n" +
+	"KE-26\ten=Meru\n" +
+	"KE-27\ten=Migori\n" +
+	"KE-28\ten=Mombasa\n" +
+	"KE-29\ten=Murang'a\n" +
+	"KE-30\tde=Nairobi\ten=Nairobi City\n" +
+	"KE-31\ten=Nakuru\n" +
+	"KE-32\ten=Nandi\n" +
+	"KE-33\ten=Narok\n" +
+	"KE-34\ten=Nyamira\n" +
+	"KE-35\ten=Nyandarua\n" +
+	"KE-36\ten=Nyeri\n" +
+	"KE-37\ten=Samburu\n" +
+	"KE-38\ten=Siaya\n" +
+	"KE-39\ten=Taita/Taveta\n" +
+	"KE-40\ten=Tana River\n" +
+	"KE-41\ten=Tharaka-Nithi\n" +
+	"KE-42\ten=Trans Nzoia\n" +
+	"KE-43\ten=Turkana\n" +
+	"KE-44\ten=Uasin Gishu\n" +
+	"KE-45\ten=Vihiga\n" +
+	"KE-46\ten=Wajir\n" +
+	"KE-47\ten=West Pokot\n" +
+	"KG-B\ten=Batken\tja=バトケン\tzh-CN=巴特肯州\n" +
+	"KG-C\ten=Chuyskaya oblast'\n" +
+	"KG-GB\ten=Bishkek Shaary\n" +
+	"KG-GO\ten=Gorod Osh\n" +
+	"KG-J\ten=Dzhalal-Abadskaya oblast'\n" +
+	"KG-N\ten=Naryn\tja=ナルイン\tzh-CN=纳伦州\n" +
+	"KG-O\tde=Osch\ten=Osh\tja=オシ\tzh-CN=奥什州\n" +
+	"KG-T\ten=Talas\tja=タラス\tzh-CN=塔拉斯州\n" +
+	"KG-Y\ten=Issyk-Kul'skaja oblast'\n" +
+	"KH-1\ten=Banteay Mean Choăy\n" +
+	"KH-10\ten=Kracheh\n" +
+	"KH-11\tde=Mondulkiri\ten=Mondol Kiri\tja=モンドルキリ\tzh-CN=蒙多基里省\n" +
+	"KH-12\ten=Phnom Penh\tja=プノンペン\tzh-CN=金边市\n" +
+	"KH-13\ten=Preah Vihear\tja=プレアビヒア\tzh-CN=柏威夏省\n" +
+	"KH-14\tde=Prey Veng\ten=Prey Veaeng\tja=プレイベーン\tzh-CN=波罗勉省\n" +
+	"KH-15\tde=Pursat\ten=Pousaat\tfr=Pothisat\tja=プルサット\tzh-CN=菩萨省\n" +
+	"KH-16\tde=Ratanakkiri\ten=Rotanak Kiri\tja=ラタナキリ\tzh-CN=拉达那基里省\n" +
+	"KH-17\tde=Siem Reap\ten=Siem Reab\tja=シエムリアプ\tzh-CN=暹粒省\n" +
+	"KH-18\ten=Preah Sihanouk\n" +
+	"KH-19\ten=Stoĕng Trêng\n" +
+	"KH-2\ten=Baat Dambang\n" +
+	"KH-20\tde=Svay Rieng\ten=Svaay Rieng\tja=スバイリエン\tzh-CN=柴桢省\n" +
+	"KH-21\tde=Takeo\ten=Taakaev\tja=タケオ\tzh-CN=茶胶省\n" +
+	"KH-22\tde=Oddar Meancheay\ten=Otdar Mean Chey\tja=オッドーミアンチェイ\tzh-CN=奥多棉芷省\n" +
+	"KH-23\ten=Kaeb\n" +
+	"KH-24\ten=Pailin\n" +
+	"KH-25\ten=Tbong Khmum\n" +
+	"KH-3\ten=Kampong Chaam\n" +
+	"KH-4\ten=Kampong Chhnang\tja=コンポンチュナン\tzh-CN=磅清扬省\n" +
+	"KH-5\ten=Kampong Spueu\n" +
+	"KH-6\ten=Kampong Thum\n" +
+	"KH-7\ten=Kampot\tja=カンポット\tzh-CN=贡布省\n" +
+	"KH-8\ten=Kandaal\n" +
+	"KH-9\ten=Kaoh Kong\n" +
+	"KI-G\tde=Gilbertinseln\ten=Gilbert Islands\tfr=Îles Gilbert\tja=ギルバート諸島\tzh-CN=吉尔伯特群岛\n" +
+	"KI-L\tde=Linieinseln\ten=Line Islands\tfr=Îles Line\tja=ライン諸島\tzh-CN=莱恩群岛\n" +
+	"KI-P\tde=Phoenixinseln\ten=Phoenix Islands\tfr=Îles Phoenix\tja=フェニックス諸島\tzh-CN=菲尼克斯群岛\n" +
+	"KM-A\ten=Andjouân\n" +
+	"KM-G\ten=Andjazîdja\n" +
+	"KM-M\ten=Mohéli\n" +
+	"KN-01\ten=Christ Church Nichola Town\tru=Крайст-Черч-Никола-Таун\n" +
+	"KN-02\ten=Saint Anne Sandy Point\tru=Сент-Энн-Сэнди-Пойнт\n" +
+	"KN-03\ten=Saint George Basseterre\tru=Сент-Джордж-Бастер\n" +
+	"KN-04\ten=Saint George Gingerland\tru=Сент-Джордж-Джинджерланд\n" +
+	"KN-05\ten=Saint James Windward\tru=Сент-Джеймс-Уиндуорд\n" +
+	"KN-06\ten=Saint John Capisterre\tru=Сент-Джон-Капистер\n" +
+	"KN-07\ten=Saint John Figtree\tru=Сент-Джон-Фигтри\n" +
+	"KN-08\ten=Saint Mary Cayon\tru=Сент-Мери-Кайон\n" +
+	"KN-09\ten=Saint Paul Capisterre\n" +
+	"KN-10\ten=Saint Paul Charlestown\tru=Сент-Пол-Чарлстаун\n" +
+	"KN-11\ten=Saint Peter Basseterre\tru=Сент-Питер-Бастер\n" +
+	"KN-12\ten=Saint Thomas Lowland\tru=Сент-Томас-Лоуленд\n" +
+	"KN-13\ten=Saint Thomas Middle Island\tru=Сент-Томас-Мидл-Айленд\n" +
+	"KN-15\ten=Trinity Palmetto Point\tru=Тринити-Палметто-Пойнт\n" +
+	"KN-K\ten=Saint Kitts\tja=セントキッツ\tru=Сент-Китс\tzh-CN=圣科特司\n" +
+	"KN-N\ten=Nevis\tja=ネーヴィス\tru=Невис\tzh-CN=尼维斯\n" +
+	"KP-01\ten=P'yǒngyang\n" +
+	"KP-02\ten=P'yǒngan-namdo\n" +
+	"KP-03\ten=P'yǒngan-bukto\n" +
+	"KP-04\ten=Chagang-do\tja=チャガンド(慈江道)\tzh-CN=慈江道\n" +
+	"KP-05\ten=Hwanghae-namdo\tfr=Hwanghae méridional\tja=ファンヘナムド(黄海南道)\n" +
+	"KP-06\tde=Hwanghae-pukto\ten=Hwanghae-bukto\tfr=Hwanghae septentrional\tja=ファンヘブクド(黄海北道)\tru=Хванхэ-Пукто\n" +
+	"KP-07\ten=Kangweonto\n" +
+	"KP-08\ten=Hamgyǒng-namdo\n" +
+	"KP-09\ten=Hamgyǒng-bukto\n" +
+	"KP-10\ten=Ryanggang-do\tru=Янгандо\n" +
+	"KP-13\ten=Raseon\tru=Расон\n" +
+	"KP-14\ten=Nampho\tru=Нампхо\n" +
+	"KR-11\ten=Seoul-teukbyeolsi\tru=Сеул\n" +
+	"KR-26\ten=Busan-gwangyeoksi\n" +
+	"KR-27\ten=Daegu-gwangyeoksi\n" +
+	"KR-28\ten=Incheon-gwangyeoksi\n" +
+	"KR-29\ten=Gwangju-gwangyeoksi\n" +
+	"KR-30\ten=Daejeon-gwangyeoksi\n" +
+	"KR-31\ten=Ulsan-gwangyeoksi\n" +
+	"KR-41\ten=Gyeonggi-do\n" +
+	"KR-42\ten=Gangwon-do\n" +
+	"KR-43\ten=Chungcheongbuk-do\n" +
+	"KR-44\ten=Chungcheongnam-do\n" +
+	"KR-45\ten=Jeollabuk-do\n" +
+	"KR-46\ten=Jeollanam-do\n" +
+	"KR-47\ten=Gyeongsangbuk-do\n" +
+	"KR-48\ten=Gyeongsangnam-do\n" +
+	"KR-49\ten=Jeju-teukbyeoljachido\n" +
+	"KR-50\ten=Sejong\n" +
+	"KW-AH\ten=Al Aḩmadī\n" +
+	"KW-FA\ten=Al Farwānīyah\tja=ファルワーニーヤ\n" +
+	"KW-HA\ten=Ḩawallī\n" +
+	"KW-JA\ten=Al Jahrā’\n" +
+	"KW-KU\ten=Al ‘Āşimah\n" +
+	"KW-MU\tde=Mubarak al-Kabir\ten=Mubārak al Kabīr\n" +
+	"KZ-AKM\ten=Akmolinskaja oblast'\tru=Акмолинская область\n" +
+	"KZ-AKT\ten=Aktjubinskaja oblast'\tru=Актюбинская область\n" +
+	"KZ-ALA\ten=Almaty\tja=アルマティ\tru=Алма-Ата\tzh-CN=阿拉木图市\n" +
+	"KZ-ALM\ten=Almatinskaja oblast'\tru=Алматинская область\n" +
+	"KZ-AST\ten=Nur-Sultan\tru=Нур-Султан\n" +
+	"KZ-ATY\ten=Atyrauskaja oblast'\tru=Атырауская область\n" +
+	"KZ-KAR\ten=Karagandinskaja oblast'\tru=Карагандинская область\n" +
+	"KZ-KUS\ten=Kostanajskaja oblast'\tru=Костанайская область\n" +
+	"KZ-KZY\ten=Kyzylordinskaja oblast'\tru=Кызылординская область\n" +
+	"KZ-MAN\tde=Provinz Mangghystaū\ten=Mangghystaū oblysy\tfr=Manguistaou\tja=マンギスタウ州\tru=Мангистауская область\n" +
+	"KZ-PAV\tde=Provinz Pawlodar\ten=Pavlodar oblysy\tfr=Pavlodar\tja=パブロダル州\tru=Павлодарская область\tzh-CN=巴甫洛达尔\n" +
+	"KZ-SEV\ten=Severo-Kazahstanskaja oblast'\tru=Северо-Казахстанская область\n" +
+	"KZ-SHY\ten=Shymkent\tru=Шымкент\n" +
+	"KZ-VOS\tde=Provinz Ostkasachstan\ten=Shyghys Qazaqstan oblysy\tfr=Kazakhstan oriental\tru=Восточно-Казахстанская область\n" +
+	"KZ-YUZ\ten=Turkestankaya oblast'\n" +
+	"KZ-ZAP\ten=Batys Qazaqstan oblysy\tru=Западно-Казахстанская область\n" +
+	"KZ-ZHA\tde=Provinz Schambyl\ten=Zhambyl oblysy\tfr=Djamboul\tru=Жамбылская область\n" +
+	"LA-AT\ten=Attapu\tja=アタプー\tzh-CN=阿速坡\n" +
+	"LA-BK\ten=Bokèo\tja=ボケオ\tru=Бокэу\tzh-CN=博乔\n" +
+	"LA-BL\tde=Bolikhamsai\ten=Bolikhamxai\tru=Боликхамсай\tzh-CN=波里坎赛\n" +
+	"LA-CH\ten=Champasak\tja=チャンパサック\tzh-CN=占巴塞\n" +
+	"LA-HO\ten=Houaphan\tja=フワパン\tzh-CN=华潘\n" +
+	"LA-KH\tde=Khammuan\ten=Khammouan\tja=カムアン\tzh-CN=甘蒙\n" +
+	"LA-LM\tde=Luang Namtha\ten=Louang Namtha\tja=ルアンナムタ\tzh-CN=琅南塔\n" +
+	"LA-LP\tde=Luang Prabang\ten=Louangphabang\tja=ルアンパバーン\tzh-CN=琅勃拉邦\n" +
+	"LA-OU\tde=Oudômxay\ten=Oudômxai\tja=ウドムサイ\tzh-CN=乌多姆赛\n" +
+	"LA-PH\ten=Phôngsali\tja=ポーンサリー\tzh-CN=丰沙里\n" +
+	"LA-SL\ten=Salavan\tja=サラワン\tzh-CN=沙拉湾\n" +
+	"LA-SV\ten=Savannakhét\tja=サバナケット\tzh-CN=沙湾拿吉\n" +
+	"LA-VI\ten=Viangchan\n" +
+	"LA-VT\ten=Viangchan\n" +
+	"LA-XA\tde=Sayaburi\ten=Xaignabouli\tja=サイヤブリ\tzh-CN=沙耶武里\n" +
+	"LA-XE\tde=Sekong\ten=Xékong\tja=セコン\n" +
+	"LA-XI\ten=Xiangkhouang\n" +
+	"LA-XS\tde=Saysomboun\ten=Xaisômboun\n" +
+	"LB-AK\ten=Aakkâr\n" +
+	"LB-AS\ten=Ash Shimāl\n" +
+	"LB-BA\ten=Bayrūt\n" +
+	"LB-BH\ten=Baalbek-Hermel\tja=バールベック\n" +
+	"LB-BI\ten=Al Biqā‘\n" +
+	"LB-JA\ten=Al Janūb\n" +
+	"LB-JL\ten=Jabal Lubnān\n" +
+	"LB-NA\ten=An Nabaţīyah\n" +
+	"LC-01\ten=Anse la Raye\n" +
+	"LC-02\ten=Castries\n" +
+	"LC-03\ten=Choiseul\tja=チョイセル\n" +
+	"LC-05\ten=Dennery\n" +
+	"LC-06\ten=Gros Islet\n" +
+	"LC-07\ten=Laborie\n" +
+	"LC-08\ten=Micoud\n" +
+	"LC-10\ten=Soufrière\n" +
+	"LC-11\ten=Vieux Fort\n" +
+	"LC-12\ten=Canaries\n" +
+	"LI-01\ten=Balzers\tja=バルツェルス\tzh-CN=巴札尔\n" +
+	"LI-02\ten=Eschen\tzh-CN=埃申\n" +
+	"LI-03\ten=Gamprin\tzh-CN=甘普林\n" +
+	"LI-04\ten=Mauren\tja=マウレン\tzh-CN=毛伦\n" +
+	"LI-05\ten=Planken\tzh-CN=伯朗肯\n" +
+	"LI-06\ten=Ruggell\tzh-CN=儒格尔\n" +
+	"LI-07\ten=Schaan\tja=シャーン\tzh-CN=沙恩\n" +
+	"LI-08\ten=Schellenberg\tzh-CN=许内勒贝格\n" +
+	"LI-09\ten=Triesen\tzh-CN=特里森\n" +
+	"LI-10\ten=Triesenberg\tzh-CN=特里森贝格\n" +
+	"LI-11\ten=Vaduz\tja=ファドゥーツ\tzh-CN=瓦杜茨\n" +
+	"LK-1\ten=Western Province\n" +
+	"LK-11\ten=Colombo\tru=Коломбо\n" +
+	"LK-12\ten=Gampaha\tzh-CN=加姆珀哈\n" +
+	"LK-13\ten=Kalutara\n" +
+	"LK-2\ten=Central Province\n" +
+	"LK-21\ten=Kandy\n" +
+	"LK-22\ten=Matale\n" +
+	"LK-23\ten=Nuwara Eliya\n" +
+	"LK-3\ten=Southern Province\n" +
+	"LK-31\ten=Galle\n" +
+	"LK-32\ten=Matara\n" +
+	"LK-33\ten=Hambantota\n" +
+	"LK-4\ten=Northern Province\n" +
+	"LK-41\ten=Jaffna\n" +
+	"LK-42\ten=Kilinochchi\n" +
+	"LK-43\ten=Mannar\n" +
+	"LK-44\ten=Vavuniya\n" +
+	"LK-45\ten=Mullaittivu\n" +
+	"LK-5\ten=Eastern Province\n" +
+	"LK-51\ten=Batticaloa\n" +
+	"LK-52\ten=Ampara\n" +
+	"LK-53\ten=Trincomalee\n" +
+	"LK-6\ten=North Western Province\n" +
+	"LK-61\ten=Kurunegala\n" +
+	"LK-62\ten=Puttalam\n" +
+	"LK-7\ten=North Central Province\n" +
+	"LK-71\ten=Anuradhapura\n" +
+	"LK-72\ten=Polonnaruwa\n" +
+	"LK-8\ten=Uva Province\n" +
+	"LK-81\ten=Badulla\tzh-CN=巴杜勒\n" +
+	"LK-82\ten=Monaragala\n" +
+	"LK-9\ten=Sabaragamuwa Province\n" +
+	"LK-91\tde=Rathnapura\ten=Ratnapura\tja=ラトナプラ\tzh-CN=拉特纳普勒\n" +
+	"LK-92\ten=Kegalla\n" +
+	"LR-BG\ten=Bong\tja=ボング\tzh-CN=邦县\n" +
+	"LR-BM\ten=Bomi\tja=ボミ\tzh-CN=伯米县\n" +
+	"LR-CM\ten=Grand Cape Mount\tja=グランドケープマウント\tru=Гранд-Кейп-Маунт\tzh-CN=大角山县\n" +
+	"LR-GB\ten=Grand Bassa\tja=グランドバッサ\tru=Гранд-Басса\n" +
+	"LR-GG\ten=Grand Gedeh\tja=グランドゲデー\tru=Гранд-Джиде\tzh-CN=大各德县\n" +
+	"LR-GK\ten=Grand Kru\tja=グランドクルー\tru=Гранд-Кру\tzh-CN=大克鲁县\n" +
+	"LR-GP\ten=Gbarpolu\n" +
+	"LR-LO\ten=Lofa\tja=ロファ\tzh-CN=罗发县\n" +
+	"LR-MG\ten=Margibi\tja=マルギビ\tzh-CN=马及比县\n" +
+	"LR-MO\ten=Montserrado\tja=モントセラド\tzh-CN=孟色罗拉多县\n" +
+	"LR-MY\ten=Maryland\tja=メリーランド\n" +
+	"LR-NI\ten=Nimba\tja=ニンバ\tzh-CN=年巴县\n" +
+	"LR-RG\ten=River Gee\n" +
+	"LR-RI\ten=River Cess\n" +
+	"LR-SI\ten=Sinoe\tja=シノー\tzh-CN=习诺县\n" +
+	"LS-A\ten=Maseru\tja=マセル\tzh-CN=马塞卢\n" +
+	"LS-B\ten=Botha-Bothe\n" +
+	"LS-C\ten=Leribe\tzh-CN=莱里贝\n" +
+	"LS-D\ten=Berea\tru=Берея\tzh-CN=伯里亚\n" +
+	"LS-E\ten=Mafeteng\tja=マフェテング\tru=Мафетенг\tzh-CN=马费滕\n" +
+	"LS-F\ten=Mohale's Hoek\tja=モハレスフーク\tru=Мохалес-Хук\tzh-CN=莫哈莱斯胡克\n" +
+	"LS-G\ten=Quthing\tru=Цгутхинг\tzh-CN=古廷\n" +
+	"LS-H\ten=Qacha's Nek\tja=カチャズネック\tru=Цгачас-Нек\tzh-CN=加查斯内克\n" +
+	"LS-J\ten=Mokhotlong\tja=モコトロング\tru=Мокхотлонг\tzh-CN=莫霍特隆\n" +
+	"LS-K\ten=Thaba-Tseka\tru=Тхаба-Цека\tzh-CN=塔巴采卡\n" +
+	"LT-01\ten=Akmenė\tru=Акмяне\n" +
+	"LT-02\ten=Alytaus miestas\n" +
+	"LT-03\ten=Alytus\n" +
+	"LT-04\ten=Anykščiai\n" +
+	"LT-05\ten=Birštono\n" +
+	"LT-06\ten=Biržai\n" +
+	"LT-07\ten=Druskininkai\n" +
+	"LT-08\ten=Elektrėnai\n" +
+	"LT-09\ten=Ignalina\n" +
+	"LT-10\ten=Jonava\n" +
+	"LT-11\ten=Joniškis\n" +
+	"LT-12\ten=Jurbarkas\n" +
+	"LT-13\ten=Kaišiadorys\n" +
+	"LT-14\ten=Kalvarijos\n" +
+	"LT-15\ten=Kauno miestas\n" +
+	"LT-16\ten=Kaunas\n" +
+	"LT-17\ten=Kazlų Rūdos\n" +
+	"LT-18\ten=Kėdainiai\n" +
+	"LT-19\ten=Kelmė\n" +
+	"LT-20\ten=Klaipėdos miestas\n" +
+	"LT-21\ten=Klaipėda\n" +
+	"LT-22\ten=Kretinga\n" +
+	"LT-23\ten=Kupiškis\n" +
+	"LT-24\ten=Lazdijai\n" +
+	"LT-25\ten=Marijampolė\n" +
+	"LT-26\ten=Mažeikiai\n" +
+	"LT-27\ten=Molėtai\n" +
+	"LT-28\ten=Neringa\n" +
+	"LT-29\ten=Pagėgiai\n" +
+	"LT-30\ten=Pakruojis\n" +
+	"LT-31\ten=Palangos miestas\n" +
+	"LT-32\ten=Panevėžio miestas\n" +
+	"LT-33\ten=Panevėžys\tru=Паневежис\n" +
+	"LT-34\ten=Pasvalys\tru=Пасвалис\n" +
+	"LT-35\ten=Plungė\tru=Плунге\n" +
+	"LT-36\ten=Prienai\tru=Пренай\n" +
+	"LT-37\ten=Radviliškis\tru=Радвилишкис\n" +
+	"LT-38\ten=Raseiniai\tru=Расейняй\n" +
+	"LT-39\ten=Rietavo\n" +
+	"LT-40\ten=Rokiškis\tru=Рокишкис\n" +
+	"LT-41\ten=Šakiai\tru=Шакяй\n" +
+	"LT-42\ten=Šalčininkai\tru=Шальчининкай\n" +
+	"LT-43\ten=Šiaulių miestas\n" +
+	"LT-44\ten=Šiauliai\tru=Шяуляй\n" +
+	"LT-45\ten=Šilalė\tru=Шилале\n" +
+	"LT-46\ten=Šilutė\tru=Шилуте\n" +
+	"LT-47\ten=Širvintos\tru=Ширвинтос\n" +
+	"LT-48\ten=Skuodas\tru=Скуодас\n" +
+	"LT-49\ten=Švenčionys\tru=Швенчёнис\n" +
+	"LT-50\ten=Tauragė\tru=Таураге\n" +
+	"LT-51\ten=Telšiai\tru=Тельшяй\n" +
+	"LT-52\ten=Trakai\tru=Тракай\n" +
+	"LT-53\ten=Ukmergė\tru=Укмерге\n" +
+	"LT-54\ten=Utena\tru=Утена\n" +
+	"LT-55\ten=Varėna\tru=Варена\n" +
+	"LT-56\ten=Vilkaviškis\tru=Вилкавишкис\n" +
+	"LT-57\ten=Vilniaus miestas\n" +
+	"LT-58\ten=Vilnius\tru=Вильнюс\n" +
+	"LT-59\ten=Visaginas\tru=Висагинас\n" +
+	"LT-60\ten=Zarasai\tru=Зарасай\n" +
+	"LT-AL\ten=Alytaus apskritis\n" +
+	"LT-KL\ten=Klaipėdos apskritis\n" +
+	"LT-KU\ten=Kauno apskritis\n" +
+	"LT-MR\ten=Marijampolės apskritis\n" +
+	"LT-PN\ten=Panevėžio apskritis\n" +
+	"LT-SA\ten=Šiaulių apskritis\n" +
+	"LT-TA\ten=Tauragės apskritis\n" +
+	"LT-TE\ten=Telšių apskritis\n" +
+	"LT-UT\ten=Utenos apskritis\n" +
+	"LT-VL\ten=Vilniaus apskritis\n" +
+	"LU-CA\ten=Capellen\n" +
+	"LU-CL\ten=Clerf\n" +
+	"LU-DI\ten=Diekirch\tja=ディーキルヒ\tzh-CN=迪基希\n" +
+	"LU-EC\ten=Echternach\n" +
+	"LU-ES\ten=Esch an der Alzette\n" +
+	"LU-GR\ten=Grevenmacher\tja=グレーベンマッハー\tzh-CN=格雷文马赫\n" +
+	"LU-LU\tde=Luxemburg\ten=Luxembourg\tja=ルクセンブルク\tpt-BR=Luxemburgo\tru=Люксембург\tzh-CN=卢森堡\n" +
+	"LU-ME\ten=Mersch\n" +
+	"LU-RD\ten=Redange\n" +
+	"LU-RM\ten=Remich\n" +
+	"LU-VD\ten=Veianen\n" +
+	"LU-WI\ten=Wiltz\n" +
+	"LV-001\ten=Aglonas novads\tfr=Aglonas (commune)\tru=Аглонский край\n" +
+	"LV-002\ten=Aizkraukles novads\tfr=Aizkraukles (commune)\tru=Айзкраукльский край\n" +
+	"LV-003\ten=Aizputes novads\tfr=Aizputes (commune)\tru=Айзпутский край\n" +
+	"LV-004\ten=Aknīstes novads\tfr=Aknīstes (commune)\tru=Акнистский край\n" +
+	"LV-005\ten=Alojas novads\tfr=Alojas (commune)\tru=Алойский край\n" +
+	"LV-006\ten=Alsungas novads\tfr=Alsungas (commune)\tru=Алсунгский край\n" +
+	"LV-007\ten=Alūksnes novads\tfr=Alūksnes (commune)\tru=Алуксненский край\n" +
+	"LV-008\ten=Amatas novads\tfr=Amatas (commune)\tru=Аматский край\n" +
+	"LV-009\ten=Apes novads\tfr=Apes (commune)\tru=Апский край\n" +
+	"LV-010\ten=Auces novads\tfr=Auces (commune)\tru=Ауцский край\n" +
+	"LV-011\ten=Ādažu novads\tfr=Ādažu (commune)\tru=Адажский край\n" +
+	"LV-012\ten=Babītes novads\tfr=Babītes (commune)\tru=Бабитский край\n" +
+	"LV-013\ten=Baldones novads\tfr=Baldones (commune)\tru=Балдонский край\n" +
+	"LV-014\ten=Baltinavas novads\tfr=Baltinavas (commune)\tru=Балтинавский край\n" +
+	"LV-015\ten=Balvu novads\tfr=Balvu (commune)\tru=Балвский край\n" +
+	"LV-016\ten=Bauskas novads\tfr=Bauskas (commune)\tru=Бауский край\n" +
+	"LV-017\ten=Beverīnas novads\tfr=Beverīnas (commune)\tru=Беверинский край\n" +
+	"LV-018\ten=Brocēnu novads\tfr=Brocēnu (commune)\tru=Броценский край\n" +
+	"LV-019\ten=Burtnieku novads\tfr=Burtnieku (commune)\tru=Буртниекский край\n" +
+	"LV-020\ten=Carnikavas novads\tfr=Carnikavas (commune)\tru=Царникавский край\n" +
+	"LV-021\ten=Cesvaines novads\tfr=Cesvaines (commune)\tru=Цесвайнский край\n" +
+	"LV-022\ten=Cēsu novads\tfr=Cēsu (commune)\tru=Цесисский край\n" +
+	"LV-023\ten=Ciblas novads\tfr=Ciblas (commune)\tru=Циблский край\n" +
+	"LV-024\ten=Dagdas novads\tfr=Dagdas (commune)\tru=Дагдский край\n" +
+	"LV-025\ten=Daugavpils novads\tfr=Daugavpils (commune)\tja=ダウガフピルス\tru=Даугавпилсский край\n" +
+	"LV-026\ten=Dobeles novads\tfr=Dobeles (commune)\tru=Добельский край\n" +
+	"LV-027\ten=Dundagas novads\tfr=Dundagas (commune)\tru=Дундагский край\n" +
+	"LV-028\ten=Durbes novads\tfr=Durbes (commune)\tru=Дурбский край\n" +
+	"LV-029\ten=Engures novads\tfr=Engures (commune)\tru=Энгурский край\n" +
+	"LV-030\ten=Ērgļu novads\tfr=Ērgļu (commune)\tru=Эргльский край\n" +
+	"LV-031\ten=Garkalnes novads\tfr=Garkalnes (commune)\tru=Гаркалнский край\n" +
+	"LV-032\ten=Grobiņas novads\tfr=Grobiņas (commune)\tru=Гробинский край\n" +
+	"LV-033\ten=Gulbenes novads\tfr=Gulbenes (commune)\tru=Гулбенский край\n" +
+	"LV-034\ten=Iecavas novads\tfr=Iecavas (commune)\tru=Иецавский край\n" +
+	"LV-035\ten=Ikšķiles novads\tfr=Ikšķiles (commune)\tru=Икшкильский край\n" +
+	"LV-036\ten=Ilūkstes novads\tfr=Ilūkstes (commune)\tru=Илукстский край\n" +
+	"LV-037\ten=Inčukalna novads\tfr=Inčukalna (commune)\tru=Инчукалнский край\n" +
+	"LV-038\ten=Jaunjelgavas novads\tfr=Jaunjelgavas (commune)\tru=Яунелгавский край\n" +
+	"LV-039\ten=Jaunpiebalgas novads\tfr=Jaunpiebalgas (commune)\tru=Яунпиебалгский край\n" +
+	"LV-040\ten=Jaunpils novads\tfr=Jaunpils (commune)\tru=Яунпилсский край\n" +
+	"LV-041\ten=Jelgavas novads\tfr=Jelgavas (commune)\tru=Елгавский край\n" +
+	"LV-042\ten=Jēkabpils novads\tfr=Jēkabpils (commune)\tru=Екабпилсский край\n" +
+	"LV-043\ten=Kandavas novads\tfr=Kandavas (commune)\tru=Кандавский край\n" +
+	"LV-044\ten=Kārsavas novads\tfr=Kārsavas (commune)\tru=Карсавский край\n" +
+	"LV-045\ten=Kocēnu novads\tfr=Kocēnu (commune)\tru=Коценский край\n" +
+	"LV-046\ten=Kokneses novads\tfr=Kokneses (commune)\tru=Кокнесский край\n" +
+	"LV-047\ten=Krāslavas novads\tfr=Krāslavas (commune)\tru=Краславский край\n" +
+	"LV-048\ten=Krimuldas novads\tfr=Krimuldas (commune)\tru=Кримулдский край\n" +
+	"LV-049\ten=Krustpils novads\tfr=Krustpils (commune)\tru=Крустпилсский край\n" +
+	"LV-050\ten=Kuldīgas novads\tfr=Kuldīgas (commune)\tru=Кулдигский край\n" +
+	"LV-051\ten=Ķeguma novads\tfr=Ķeguma (commune)\tru=Кегумский край\n" +
+	"LV-052\ten=Ķekavas novads\tfr=Ķekavas (commune)\tru=Кекавский край\n" +
+	"LV-053\ten=Lielvārdes novads\tfr=Lielvārdes (commune)\tru=Лиелвардский край\n" +
+	"LV-054\ten=Limbažu novads\tfr=Limbažu (commune)\tru=Лимбажский край\n" +
+	"LV-055\ten=Līgatnes novads\tfr=Līgatnes (commune)\tru=Лигатненский край\n" +
+	"LV-056\ten=Līvānu novads\tfr=Līvānu (commune)\tru=Ливанский край\n" +
+	"LV-057\ten=Lubānas novads\tfr=Lubānas (commune)\tru=Лубанский край\n" +
+	"LV-058\ten=Ludzas novads\tfr=Ludzas (commune)\tru=Лудзенский край\n" +
+	"LV-059\ten=Madonas novads\tfr=Madonas (commune)\tru=Мадонский край\n" +
+	"LV-060\ten=Mazsalacas novads\tfr=Mazsalacas (commune)\tru=Мазсалацский край\n" +
+	"LV-061\ten=Mālpils novads\tfr=Mālpils (commune)\tru=Малпилсский край\n" +
+	"LV-062\ten=Mārupes novads\tfr=Mārupes (commune)\tru=Марупский край\n" +
+	"LV-063\ten=Mērsraga novads\tfr=Mērsraga (commune)\tru=Мерсрагский край\n" +
+	"LV-064\ten=Naukšēnu novads\tfr=Naukšēnu (commune)\tru=Наукшенский край\n" +
+	"LV-065\ten=Neretas novads\tfr=Neretas (commune)\tru=Неретский край\n" +
+	"LV-066\ten=Nīcas novads\tfr=Nīcas (commune)\tru=Ницский край\n" +
+	"LV-067\ten=Ogres novads\tfr=Ogres (commune)\tru=Огрский край\n" +
+	"LV-068\ten=Olaines novads\tfr=Olaines (commune)\tru=Олайнский край\n" +
+	"LV-069\ten=Ozolnieku novads\tfr=Ozolnieku (commune)\tru=Озолниекский край\n" +
+	"LV-070\ten=Pārgaujas novads\tfr=Pārgaujas (commune)\tru=Паргауйский край\n" +
+	"LV-071\ten=Pāvilostas novads\tfr=Pāvilostas (commune)\tru=Павилостский край\n" +
+	"LV-072\ten=Pļaviņu novads\tfr=Pļaviņu (commune)\tru=Плявинский край\n" +
+	"LV-073\ten=Preiļu novads\tfr=Preiļu (commune)\tru=Прейльский край\n" +
+	"LV-074\ten=Priekules novads\tfr=Priekules (commune)\n" +
+	"LV-075\ten=Priekuļu novads\tfr=Priekuļu (commune)\n" +
+	"LV-076\ten=Raunas novads\tfr=Raunas (commune)\tru=Раунский край\n" +
+	"LV-077\ten=Rēzeknes novads\tfr=Rēzeknes (commune)\tru=Резекненский край\n" +
+	"LV-078\ten=Riebiņu novads\tfr=Riebiņu (commune)\tru=Риебинский край\n" +
+	"LV-079\ten=Rojas novads\tfr=Rojas (commune)\tru=Ройский край\n" +
+	"LV-080\ten=Ropažu novads\tfr=Ropažu (commune)\tru=Ропажский край\n" +
+	"LV-081\ten=Rucavas novads\tfr=Rucavas (commune)\tru=Руцавский край\n" +
+	"LV-082\ten=Rugāju novads\tfr=Rugāju (commune)\tru=Ругайский край\n" +
+	"LV-083\ten=Rundāles novads\tfr=Rundāles (commune)\tru=Рундальский край\n" +
+	"LV-084\ten=Rūjienas novads\tfr=Rūjienas (commune)\tru=Руйиенский край\n" +
+	"LV-085\ten=Salas novads\tfr=Salas (commune)\tru=Салский край\n" +
+	"LV-086\ten=Salacgrīvas novads\tfr=Salacgrīvas (commune)\tru=Салацгривский край\n" +
+	"LV-087\ten=Salaspils novads\tfr=Salaspils (commune)\tru=Саласпилсский край\n" +
+	"LV-088\ten=Saldus novads\tfr=Saldus (commune)\tru=Салдусский край\n" +
+	"LV-089\ten=Saulkrastu novads\tfr=Saulkrastu (commune)\tru=Саулкрастский край\n" +
+	"LV-090\ten=Sējas novads\tfr=Sējas (commune)\tru=Сейский край\n" +
+	"LV-091\ten=Siguldas novads\tfr=Siguldas (commune)\tru=Сигулдский край\n" +
+	"LV-092\ten=Skrīveru novads\tfr=Skrīveru (commune)\tru=Скриверский край\n" +
+	"LV-093\ten=Skrundas novads\tfr=Skrundas (commune)\tru=Скрундский край\n" +
+	"LV-094\ten=Smiltenes novads\tfr=Smiltenes (commune)\tru=Смилтенский край\n" +
+	"LV-095\ten=Stopiņu novads\tfr=Stopiņu (commune)\tru=Стопинский край\n" +
+	"LV-096\ten=Strenču novads\tfr=Strenču (commune)\tru=Стренчский край\n" +
+	"LV-097\ten=Talsu novads\tfr=Talsu (commune)\tru=Талсинский край\n" +
+	"LV-098\ten=Tērvetes novads\tfr=Tērvetes (commune)\tru=Терветский край\n" +
+	"LV-099\ten=Tukuma novads\tfr=Tukuma (commune)\tru=Тукумский край\n" +
+	"LV-100\ten=Vaiņodes novads\tfr=Vaiņodes (commune)\tru=Вайнёдский край\n" +
+	"LV-101\ten=Valkas novads\tfr=Valkas (commune)\tru=Валкский край\n" +
+	"LV-102\ten=Varakļānu novads\tfr=Varakļānu (commune)\tru=Вараклянский край\n" +
+	"LV-103\ten=Vārkavas novads\tfr=Vārkavas (commune)\tru=Варкавский край\n" +
+	"LV-104\ten=Vecpiebalgas novads\tfr=Vecpiebalgas (commune)\tru=Вецпиебалгский край\n" +
+	"LV-105\ten=Vecumnieku novads\tfr=Vecumnieku (commune)\tru=Вецумниекский край\n" +
+	"LV-106\ten=Ventspils novads\tfr=Ventspils (commune)\tru=Вентспилсский край\n" +
+	"LV-107\ten=Viesītes novads\tfr=Viesītes (commune)\tru=Виеситский край\n" +
+	"LV-108\ten=Viļakas novads\tfr=Viļakas (commune)\tru=Вилякский край\n" +
+	"LV-109\ten=Viļānu novads\tfr=Viļānu (commune)\tru=Вилянский край\n" +
+	"LV-110\ten=Zilupes novads\tfr=Zilupes (commune)\tru=Зилупский край\n" +
+	"LV-DGV\ten=Daugavpils\tja=ダウガフピルス\tru=Даугавпилс\tzh-CN=陶格夫皮尔斯\n" +
+	"LV-JEL\ten=Jelgava\tja=エルガヴァ\tru=Елгава\tzh-CN=叶尔加瓦\n" +
+	"LV-JKB\ten=Jēkabpils\tja=エカプピルス\tru=Екабпилс\n" +
+	"LV-JUR\ten=Jūrmala\tru=Юрмала\n" +
+	"LV-LPX\ten=Liepāja\tja=リエパヤ\tru=Лиепая\n" +
+	"LV-REZ\ten=Rēzekne\tja=レゼクネ\tru=Резекне\n" +
+	"LV-RIX\ten=Rīga\tja=リガ\tru=Рига\n" +
+	"LV-VEN\ten=Ventspils\tru=Вентспилс\tzh-CN=文茨皮尔斯\n" +
+	"LV-VMR\ten=Valmiera\tru=Валмиера\n" +
+	"LY-BA\tde=Benghāzī\ten=Banghāzī\tfr=Benghazi\tja=ベンガジ\tru=Бенгази\tzh-CN=班加西\n" +
+	"LY-BU\ten=Al Buţnān\tru=Эль-Бутнан\tzh-CN=巴特曼\n" +
+	"LY-DR\tde=Darna\ten=Darnah\tja=ダルナ\tzh-CN=德尔纳\n" +
+	"LY-GT\ten=Ghāt\tja=ガート\n" +
+	"LY-JA\tde=Al Dschabal al Achḑar\ten=Al Jabal al Akhḑar\tru=Эль-Джебель-эль-Ахдар\tzh-CN=绿山\n" +
+	"LY-JG\tde=Al Dschabal al Gharbī\ten=Al Jabal al Gharbī\n" +
+	"LY-JI\ten=Al Jafārah\n" +
+	"LY-JU\tde=Al Dschufra\ten=Al Jufrah\tzh-CN=胡夫拉\n" +
+	"LY-KF\tde=Al Kufra\ten=Al Kufrah\tja=クフラ\tru=Эль-Куфра\tzh-CN=库夫拉\n" +
+	"LY-MB\tde=Al Murgub\ten=Al Marqab\n" +
+	"LY-MI\tde=Mişrāta\ten=Mişrātah\tja=ミスラータ\tru=Мисурата\tzh-CN=米苏拉塔\n" +
+	"LY-MJ\tde=Al Mardsch\ten=Al Marj\tzh-CN=迈尔季\n" +
+	"LY-MQ\tde=Murzuk\ten=Murzuq\tja=ムルズーク\tru=Марзук\tzh-CN=迈尔祖格\n" +
+	"LY-NL\ten=Nālūt\tja=ナールート\tru=Налут\n" +
+	"LY-NQ\ten=An Nuqāţ al Khams\n" +
+	"LY-SB\ten=Sabhā\tja=セブハー\tzh-CN=塞卜哈\n" +
+	"LY-SR\ten=Surt\tja=スルト\tzh-CN=苏尔特\n" +
+	"LY-TB\tde=Tripolis\ten=Ţarābulus\tru=Триполи\tzh-CN=的黎波里\n" +
+	"LY-WA\ten=Al Wāḩāt\tru=Эль-Вахат\n" +
+	"LY-WD\tde=Wādī al Ḩayā\ten=Wādī al Ḩayāt\tru=Вади-эль-Хаят\n" +
+	"LY-WS\ten=Wādī ash Shāţi’\n" +
+	"LY-ZA\tde=Az Zāwiya\ten=Az Zāwiyah\tja=ザーウィア\n" +
+	"MA-01\ten=Tanger-Tétouan-Al Hoceïma\n" +
+	"MA-02\ten=L'Oriental\n" +
+	"MA-03\ten=Fès-Meknès\n" +
+	"MA-04\ten=Rabat-Salé-Kénitra\n" +
+	"MA-05\ten=Béni Mellal-Khénifra\n" +
+	"MA-06\ten=Casablanca-Settat\n" +
+	"MA-07\ten=Marrakech-Safi\n" +
+	"MA-08\ten=Drâa-Tafilalet\n" +
+	"MA-09\ten=Souss-Massa\n" +
+	"MA-10\tde=Guelmim-Oued Noun (teilweise EH)\ten=Guelmim-Oued Noun (EH-partial)\tfr=Guelmim-Oued Noun (EH partiel)\n" +
+	"MA-11\tde=Laâyoune-Sakia El Hamra (teilweise EH)\ten=Laâyoune-Sakia El Hamra (EH-partial)\tfr=Laâyoune-Sakia El Hamra (EH partiel)\n" +
+	"MA-12\ten=Dakhla-Oued Ed-Dahab (EH)\n" +
+	"MA-AGD\ten=Agadir-Ida-Ou-Tanane\n" +
+	"MA-AOU\ten=Aousserd (EH)\n" +
+	"MA-ASZ\tde=Assa-Zag (teilweise EH)\ten=Assa-Zag (EH-partial)\tfr=Assa-Zag (EH partiel)\n" +
+	"MA-AZI\ten=Azilal\tja=アジラル\tzh-CN=艾济拉勒\n" +
+	"MA-BEM\ten=Béni Mellal\n" +
+	"MA-BER\ten=Berkane\tzh-CN=贝尔坎\n" +
+	"MA-BES\ten=Benslimane\n" +
+	"MA-BOD\ten=Boujdour (EH)\n" +
+	"MA-BOM\ten=Boulemane\tzh-CN=布勒曼\n" +
+	"MA-BRR\ten=Berrechid\tru=Беррешид\n" +
+	"MA-CAS\ten=Casablanca\tru=Касабланка\n" +
+	"MA-CHE\ten=Chefchaouen\tfr=Chefchaouene\tru=Шавен\n" +
+	"MA-CHI\ten=Chichaoua\tzh-CN=希沙瓦\n" +
+	"MA-CHT\ten=Chtouka-Ait Baha\n" +
+	"MA-DRI\ten=Driouch\n" +
+	"MA-ERR\ten=Errachidia\tru=Эр-Рашидия\tzh-CN=拉希迪耶\n" +
+	"MA-ESI\ten=Essaouira\tru=Эс-Сувейра\tzh-CN=索维拉\n" +
+	"MA-ESM\tde=Es-Semara (teilweise EH)\ten=Es-Semara (EH-partial)\tfr=Es-Semara (EH partiel)\n" +
+	"MA-FAH\ten=Fahs-Anjra\n" +
+	"MA-FES\ten=Fès\tru=Фес\n" +
+	"MA-FIG\ten=Figuig\tja=フィギグ\tzh-CN=菲吉格\n" +
+	"MA-FQH\ten=Fquih Ben Salah\tru=Фких-Бен-Салах\n" +
+	"MA-GUE\ten=Guelmim\tja=ゲルミン\tru=Гулимин\tzh-CN=盖勒敏\n" +
+	"MA-GUF\ten=Guercif\tru=Герсиф\n" +
+	"MA-HAJ\ten=El Hajeb\tzh-CN=哈杰布\n" +
+	"MA-HAO\ten=Al Haouz\tru=Эль-Хауз\tzh-CN=豪兹\n" +
+	"MA-HOC\ten=Al Hoceïma\tru=Эль-Хосейма\tzh-CN=胡塞马\n" +
+	"MA-IFR\ten=Ifrane\tja=イフラーヌ\tzh-CN=伊夫兰\n" +
+	"MA-INE\ten=Inezgane-Ait Melloul\n" +
+	"MA-JDI\ten=El Jadida\tja=ジャディーダ\tzh-CN=杰迪代\n" +
+	"MA-JRA\ten=Jerada\n" +
+	"MA-KEN\ten=Kénitra\tja=ケニトラ\tru=Кенитра\tzh-CN=盖尼特拉\n" +
+	"MA-KES\ten=El Kelâa des Sraghna\n" +
+	"MA-KHE\ten=Khémisset\tru=Хемиссет\n" +
+	"MA-KHN\ten=Khénifra\tru=Хенифра\n" +
+	"MA-KHO\ten=Khouribga\tja=クーリブガ\tru=Хурибга\tzh-CN=胡里卜盖\n" +
+	"MA-LAA\ten=Laâyoune (EH)\tzh-CN=阿尤恩\n" +
+	"MA-LAR\ten=Larache\tja=ララシェ\tru=Лараш\tzh-CN=拉拉谢\n" +
+	"MA-MAR\ten=Marrakech\tru=Марракеш\n" +
+	"MA-MDF\ten=M’diq-Fnideq\n" +
+	"MA-MED\ten=Médiouna\n" +
+	"MA-MEK\ten=Meknès\tru=Мекнес\n" +
+	"MA-MID\ten=Midelt\tru=Мидельт\n" +
+	"MA-MOH\ten=Mohammadia\n" +
+	"MA-MOU\ten=Moulay Yacoub\n" +
+	"MA-NAD\ten=Nador\tja=ナドル\tru=Надор\tzh-CN=纳祖尔\n" +
+	"MA-NOU\ten=Nouaceur\n" +
+	"MA-OUA\ten=Ouarzazate\tzh-CN=瓦尔扎扎特\n" +
+	"MA-OUD\ten=Oued Ed-Dahab (EH)\n" +
+	"MA-OUJ\ten=Oujda-Angad\n" +
+	"MA-OUZ\ten=Ouezzane\n" +
+	"MA-RAB\ten=Rabat\tja=ラバト\tru=Рабат\n" +
+	"MA-REH\ten=Rehamna\n" +
+	"MA-SAF\ten=Safi\tja=サフィー\tru=Сафи\tzh-CN=萨非\n" +
+	"MA-SAL\ten=Salé\tru=Сале\n" +
+	"MA-SEF\ten=Sefrou\tru=Сефру\tzh-CN=塞夫劳\n" +
+	"MA-SET\ten=Settat\tja=セッタート\tru=Сеттат\tzh-CN=塞塔特\n" +
+	"MA-SIB\ten=Sidi Bennour\tru=Сиди-Беннур\n" +
+	"MA-SIF\ten=Sidi Ifni\tru=Сиди-Ифни\n" +
+	"MA-SIK\ten=Sidi Kacem\tru=Сиди-Касем\n" +
+	"MA-SIL\ten=Sidi Slimane\n" +
+	"MA-SKH\ten=Skhirate-Témara\n" +
+	"MA-TAF\tde=Tarfaya (teilweise EH)\ten=Tarfaya (EH-partial)\tfr=Tarfaya (EH partiel)\n" +
+	"MA-TAI\ten=Taourirt\n" +
+	"MA-TAO\ten=Taounate\tja=タウナト\tzh-CN=陶纳特\n" +
+	"MA-TAR\ten=Taroudannt\tru=Тарудант\n" +
+	"MA-TAT\ten=Tata\tja=タタ\tzh-CN=塔塔\n" +
+	"MA-TAZ\ten=Taza\tja=タザ\tru=Таза\tzh-CN=塔扎\n" +
+	"MA-TET\ten=Tétouan\tja=テトワン\tru=Тетуан\tzh-CN=得土安\n" +
+	"MA-TIN\ten=Tinghir\tru=Тингир\n" +
+	"MA-TIZ\ten=Tiznit\tja=ティズニト\tru=Тизнит\tzh-CN=提兹尼特\n" +
+	"MA-TNG\ten=Tanger-Assilah\n" +
+	"MA-TNT\tde=Tan-Tan (teilweise EH)\ten=Tan-Tan (EH-partial)\tfr=Tan-Tan (EH partiel)\n" +
+	"MA-YUS\ten=Youssoufia\n" +
+	"MA-ZAG\ten=Zagora\n" +
+	"MC-CL\ten=La Colle\n" +
+	"MC-CO\ten=La Condamine\tja=ラコンダミーヌ\n" +
+	"MC-FO\ten=Fontvieille\tja=フォンビエイユ\n" +
+	"MC-GA\ten=La Gare\n" +
+	"MC-JE\ten=Jardin Exotique\n" +
+	"MC-LA\ten=Larvotto\n" +
+	"MC-MA\ten=Malbousquet\n" +
+	"MC-MC\tde=Monte Carlo\ten=Monte-Carlo\tja=モンテカルロ\tru=Монте-Карло\n" +
+	"MC-MG\ten=Moneghetti\tru=Монегетти\n" +
+	"MC-MO\ten=Monaco-Ville\tru=Монако\n" +
+	"MC-MU\ten=Moulins\tru=Мулен\n" +
+	"MC-PH\ten=Port-Hercule\n" +
+	"MC-SD\ten=Sainte-Dévote\n" +
+	"MC-SO\ten=La Source\n" +
+	"MC-SP\ten=Spélugues\n" +
+	"MC-SR\ten=Saint-Roman\n" +
+	"MC-VR\ten=Vallon de la Rousse\n" +
+	"MD-AN\ten=Anenii Noi\n" +
+	"MD-BA\ten=Bălți\tja=バルツィ\n" +
+	"MD-BD\ten=Bender [Tighina]\n" +
+	"MD-BR\ten=Briceni\n" +
+	"MD-BS\ten=Basarabeasca\n" +
+	"MD-CA\ten=Cahul\tzh-CN=卡胡尔\n" +
+	"MD-CL\ten=Călărași\n" +
+	"MD-CM\ten=Cimișlia\n" +
+	"MD-CR\ten=Criuleni\n" +
+	"MD-CS\ten=Căușeni\n" +
+	"MD-CT\ten=Cantemir\n" +
+	"MD-CU\ten=Chișinău\tja=キシナウ\n" +
+	"MD-DO\ten=Dondușeni\n" +
+	"MD-DR\ten=Drochia\n" +
+	"MD-DU\ten=Dubăsari\n" +
+	"MD-ED\ten=Edineț\n" +
+	"MD-FA\ten=Fălești\n" +
+	"MD-FL\ten=Florești\n" +
+	"MD-GA\ten=Găgăuzia, Unitatea teritorială autonomă (UTAG)\n" +
+	"MD-GL\ten=Glodeni\n" +
+	"MD-HI\ten=Hîncești\n" +
+	"MD-IA\ten=Ialoveni\n" +
+	"MD-LE\ten=Leova\n" +
+	"MD-NI\ten=Nisporeni\n" +
+	"MD-OC\ten=Ocnița\n" +
+	"MD-OR\ten=Orhei\tzh-CN=奥尔海伊\n" +
+	"MD-RE\ten=Rezina\n" +
+	"MD-RI\ten=Rîșcani\n" +
+	"MD-SD\ten=Șoldănești\n" +
+	"MD-SI\ten=Sîngerei\n" +
+	"MD-SN\tde=Autonome territoriale Einheit Transnistrien\ten=Stînga Nistrului, unitatea teritorială din\tfr=Transnistrie\tzh-CN=德涅斯特河左岸地区\n" +
+	"MD-SO\ten=Soroca\tzh-CN=索罗卡\n" +
+	"MD-ST\ten=Strășeni\n" +
+	"MD-SV\ten=Ștefan Vodă\n" +
+	"MD-TA\ten=Taraclia\tzh-CN=塔拉克利亚\n" +
+	"MD-TE\ten=Telenești\n" +
+	"MD-UN\ten=Ungheni\tzh-CN=温盖尼\n" +
+	"ME-01\ten=Andrijevica\tzh-CN=安德里耶维察\n" +
+	"ME-02\ten=Bar\tzh-CN=巴尔\n" +
+	"ME-03\ten=Berane\tzh-CN=贝拉内\n" +
+	"ME-04\ten=Bijelo Polje\tzh-CN=比耶洛波列\n" +
+	"ME-05\ten=Budva\tzh-CN=布德瓦\n" +
+	"ME-06\ten=Cetinje\tzh-CN=采蒂涅\n" +
+	"ME-07\ten=Danilovgrad\tzh-CN=达尼洛夫格勒\n" +
+	"ME-08\ten=Herceg-Novi\tzh-CN=新海尔采格\n" +
+	"ME-09\ten=Kolašin\tzh-CN=科拉辛\n" +
+	"ME-10\ten=Kotor\tzh-CN=科托尔\n" +
+	"ME-11\ten=Mojkovac\tzh-CN=莫伊科瓦茨\n" +
+	"ME-12\ten=Nikšić\tja=ニクシッチ\tzh-CN=尼克希奇\n" +
+	"ME-13\ten=Plav\tzh-CN=普拉夫\n" +
+	"ME-14\ten=Pljevlja\tzh-CN=普卢日内\n" +
+	"ME-15\ten=Plužine\tzh-CN=普列夫利亚\n" +
+	"ME-16\ten=Podgorica\tja=ポドゴリツァ\tzh-CN=波德戈里察\n" +
+	"ME-17\ten=Rožaje\tzh-CN=罗扎伊\n" +
+	"ME-18\ten=Šavnik\tzh-CN=沙夫尼克\n" +
+	"ME-19\ten=Tivat\tzh-CN=蒂瓦特\n" +
+	"ME-20\ten=Ulcinj\tzh-CN=乌尔齐尼\n" +
+	"ME-21\ten=Žabljak\tzh-CN=扎布良克\n" +
+	"ME-22\ten=Gusinje\n" +
+	"ME-23\ten=Petnjica\n" +
+	"ME-24\ten=Tuzi\n" +
+	"MG-A\ten=Toamasina\tja=トアマシナ\tzh-CN=图阿马西拉省\n" +
+	"MG-D\ten=Antsiranana\tja=アンツィラナナ\tzh-CN=安齐拉纳纳省\n" +
+	"MG-F\ten=Fianarantsoa\tja=フィアナランツォア\tzh-CN=菲亚纳兰楚阿省\n" +
+	"MG-M\ten=Mahajanga\tja=マハジャンガ\tzh-CN=马哈赞加省\n" +
+	"MG-T\ten=Antananarivo\tfr=Tananarive\tja=アンタナナリボ\tzh-CN=塔那那利佛省\n" +
+	"MG-U\ten=Toliara\tja=トリアラ\tzh-CN=图利亚拉省\n" +
+	"MH-ALK\ten=Ailuk\tzh-CN=艾卢克\n" +
+	"MH-ALL\tde=Ailinginae\ten=Ailinglaplap\n" +
+	"MH-ARN\ten=Arno\tzh-CN=阿尔诺\n" +
+	"MH-AUR\ten=Aur\tzh-CN=奥尔\n" +
+	"MH-EBO\ten=Ebon\tzh-CN=埃崩\n" +
+	"MH-ENI\ten=Enewetak & Ujelang\n" +
+	"MH-JAB\tde=Jabwot\ten=Jabat\n" +
+	"MH-JAL\ten=Jaluit\tzh-CN=贾卢伊特\n" +
+	"MH-KIL\ten=Bikini & Kili\n" +
+	"MH-KWA\ten=Kwajalein\tja=クワジャリン\tzh-CN=夸贾林\n" +
+	"MH-L\tde=Ralik-Kette\ten=Ralik chain\tfr=Chaîne de Ralik\tja=ラリク列島\n" +
+	"MH-LAE\ten=Lae\tzh-CN=拉埃\n" +
+	"MH-LIB\ten=Lib\tzh-CN=里布\n" +
+	"MH-LIK\ten=Likiep\tzh-CN=利基埃普\n" +
+	"MH-MAJ\ten=Majuro\tja=マジュロ\tzh-CN=马朱罗\n" +
+	"MH-MAL\ten=Maloelap\tja=マロエラプ\tzh-CN=马洛埃拉普\n" +
+	"MH-MEJ\ten=Mejit\tzh-CN=梅吉特\n" +
+	"MH-MIL\ten=Mili\tzh-CN=米利\n" +
+	"MH-NMK\tde=Namorik\ten=Namdrik\n" +
+	"MH-NMU\ten=Namu\tzh-CN=纳木\n" +
+	"MH-RON\ten=Rongelap\tzh-CN=朗格拉普\n" +
+	"MH-T\tde=Ratak-Kette\ten=Ratak chain\tfr=Chaîne de Ratak\tja=ラタク列島\n" +
+	"MH-UJA\ten=Ujae\tzh-CN=乌贾\n" +
+	"MH-UTI\ten=Utrik\n" +
+	"MH-WTH\ten=Wotho\tzh-CN=沃特\n" +
+	"MH-WTJ\ten=Wotje\tzh-CN=沃特杰\n" +
+	"MK-101\ten=Veles\n" +
+	"MK-102\ten=Gradsko\n" +
+	"MK-103\ten=Demir Kapija\n" +
+	"MK-104\ten=Kavadarci\n" +
+	"MK-105\ten=Lozovo\n" +
+	"MK-106\ten=Negotino\n" +
+	"MK-107\ten=Rosoman\n" +
+	"MK-108\ten=Sveti Nikole\n" +
+	"MK-109\ten=Čaška\n" +
+	"MK-201\ten=Berovo\n" +
+	"MK-202\ten=Vinica\n" +
+	"MK-203\ten=Delčevo\n" +
+	"MK-204\ten=Zrnovci\n" +
+	"MK-205\ten=Karbinci\n" +
+	"MK-206\ten=Kočani\n" +
+	"MK-207\ten=Makedonska Kamenica\n" +
+	"MK-208\ten=Pehčevo\n" +
+	"MK-209\ten=Probištip\n" +
+	"MK-210\ten=Češinovo-Obleševo\n" +
+	"MK-211\ten=Štip\n" +
+	"MK-301\ten=Vevčani\n" +
+	"MK-303\ten=Debar\tja=デバル\n" +
+	"MK-304\ten=Debrca\n" +
+	"MK-307\ten=Kičevo\n" +
+	"MK-308\ten=Makedonski Brod\n" +
+	"MK-310\ten=Ohrid\tja=オフリド\n" +
+	"MK-311\ten=Plasnica\n" +
+	"MK-312\ten=Struga\tja=ストルガ\n" +
+	"MK-313\ten=Centar Župa\n" +
+	"MK-401\ten=Bogdanci\n" +
+	"MK-402\ten=Bosilovo\n" +
+	"MK-403\ten=Valandovo\n" +
+	"MK-404\ten=Vasilevo\n" +
+	"MK-405\ten=Gevgelija\n" +
+	"MK-406\ten=Dojran\n" +
+	"MK-407\ten=Konče\n" +
+	"MK-408\ten=Novo Selo\n" +
+	"MK-409\ten=Radoviš\n" +
+	"MK-410\ten=Strumica\n" +
+	"MK-501\ten=Bitola\tja=ビトラ\n" +
+	"MK-502\ten=Demir Hisar\n" +
+	"MK-503\ten=Dolneni\n" +
+	"MK-504\ten=Krivogaštani\n" +
+	"MK-505\ten=Kruševo\n" +
+	"MK-506\ten=Mogila\n" +
+	"MK-507\ten=Novaci\n" +
+	"MK-508\ten=Prilep\tja=プリレップ\n" +
+	"MK-509\ten=Resen\n" +
+	"MK-601\ten=Bogovinje\n" +
+	"MK-602\ten=Brvenica\n" +
+	"MK-603\ten=Vrapčište\n" +
+	"MK-604\ten=Gostivar\tja=ゴスティバル\n" +
+	"MK-605\ten=Želino\n" +
+	"MK-606\ten=Jegunovce\n" +
+	"MK-607\ten=Mavrovo i Rostuše\n" +
+	"MK-608\ten=Tearce\n" +
+	"MK-609\ten=Tetovo\tja=テトボ\n" +
+	"MK-701\ten=Kratovo\n" +
+	"MK-702\ten=Kriva Palanka\n" +
+	"MK-703\ten=Kumanovo\tja=クマノボ\n" +
+	"MK-704\ten=Lipkovo\n" +
+	"MK-705\ten=Rankovce\n" +
+	"MK-706\ten=Staro Nagoričane\n" +
+	"MK-801\ten=Aerodrom †\n" +
+	"MK-802\ten=Aračinovo\tja=アラチノボ\n" +
+	"MK-803\ten=Butel †\n" +
+	"MK-804\ten=Gazi Baba †\n" +
+	"MK-805\ten=Gjorče Petrov †\n" +
+	"MK-806\ten=Zelenikovo\n" +
+	"MK-807\ten=Ilinden\n" +
+	"MK-808\ten=Karpoš †\n" +
+	"MK-809\ten=Kisela Voda †\n" +
+	"MK-810\ten=Petrovec\n" +
+	"MK-811\ten=Saraj †\n" +
+	"MK-812\ten=Sopište\n" +
+	"MK-813\ten=Studeničani\n" +
+	"MK-814\ten=Centar †\n" +
+	"MK-815\ten=Čair †\n" +
+	"MK-816\ten=Čučer-Sandevo\n" +
+	"MK-817\ten=Šuto Orizari †\n" +
+	"ML-1\ten=Kayes\tja=カエス\tzh-CN=卡伊地区\n" +
+	"ML-10\ten=Taoudénit\n" +
+	"ML-2\ten=Koulikoro\tja=クリコロ\tzh-CN=库里克罗地区\n" +
+	"ML-3\ten=Sikasso\tja=シカソ\tzh-CN=锡加索地区\n" +
+	"ML-4\ten=Ségou\tja=セグー\tzh-CN=塞古地区\n" +
+	"ML-5\ten=Mopti\tja=モプティ\tzh-CN=莫普提地区\n" +
+	"ML-6\tde=Timbuktu\ten=Tombouctou\tja=トンブクトゥ\tzh-CN=通布图地区\n" +
+	"ML-7\ten=Gao\tja=ガオ\tzh-CN=加奥地区\n" +
+	"ML-8\ten=Kidal\tja=キダル\tzh-CN=基达尔地区\n" +
+	"ML-9\ten=Ménaka\n" +
+	"ML-BKO\ten=Bamako\tja=バマコ\tzh-CN=巴马科\n" +
+	"MM-01\ten=Sagaing\tja=サガイン\tzh-CN=实皆省\n" +
+	"MM-02\ten=Bago\tja=バゴー\tzh-CN=勃固省\n" +
+	"MM-03\tde=Magwe\ten=Magway\tja=マグウェ\tzh-CN=马圭省\n" +
+	"MM-04\ten=Mandalay\tja=マンダレー\tzh-CN=曼德勒省\n" +
+	"MM-05\ten=Tanintharyi\tja=タニンダリー\tzh-CN=德林达依省\n" +
+	"MM-06\ten=Yangon\tja=ヤンゴン\tzh-CN=仰光省\n" +
+	"MM-07\tde=Irawadi\ten=Ayeyarwady\tja=エーヤワディー\tzh-CN=伊洛瓦底省\n" +
+	"MM-11\ten=Kachin\tja=カチン\tzh-CN=克钦邦\n" +
+	"MM-12\ten=Kayah\tja=カヤー\tzh-CN=克耶邦\n" +
+	"MM-13\ten=Kayin\tzh-CN=克伦邦\n" +
+	"MM-14\ten=Chin\tja=チン\tzh-CN=钦邦\n" +
+	"MM-15\ten=Mon\tja=モン\tzh-CN=孟邦\n" +
+	"MM-16\tde=Rakhaing\ten=Rakhine\tja=ラカイン\tzh-CN=若开邦\n" +
+	"MM-17\ten=Shan\tfr=shan\tja=シャン\tzh-CN=掸邦\n" +
+	"MM-18\ten=Nay Pyi Taw\n" +
+	"MN-035\tde=Orchon\ten=Orhon\tzh-CN=鄂尔浑省\n" +
+	"MN-037\tde=Darchan-Uul\ten=Darhan uul\tzh-CN=达尔汗乌勒省\n" +
+	"MN-039\tde=Chentii\ten=Hentiy\tzh-CN=肯特省\n" +
+	"MN-041\tde=Chöwsgöl\ten=Hövsgöl\n" +
+	"MN-043\tde=Chowd\ten=Hovd\tzh-CN=科布多省\n" +
+	"MN-046\tde=Uws\ten=Uvs\tzh-CN=乌布苏省\n" +
+	"MN-047\tde=Töw\ten=Töv\n" +
+	"MN-049\ten=Selenge\tzh-CN=色楞格省\n" +
+	"MN-051\tde=Süchbaatar\ten=Sühbaatar\n" +
+	"MN-053\ten=Ömnögovĭ\n" +
+	"MN-055\tde=Öwörchangai\ten=Övörhangay\n" +
+	"MN-057\tde=Zawchan\ten=Dzavhan\tzh-CN=扎布汗省\n" +
+	"MN-059\ten=Dundgovĭ\n" +
+	"MN-061\ten=Dornod\tzh-CN=东方省\n" +
+	"MN-063\ten=Dornogovĭ\n" +
+	"MN-064\ten=Govĭ-Sümber\n" +
+	"MN-065\ten=Govĭ-Altay\n" +
+	"MN-067\ten=Bulgan\tja=ブルガン\tzh-CN=布尔干省\n" +
+	"MN-069\tde=Bajanchongor\ten=Bayanhongor\tja=バヤンホンゴル\tzh-CN=巴彦洪戈尔省\n" +
+	"MN-071\tde=Bajan-Ölgii\ten=Bayan-Ölgiy\n" +
+	"MN-073\tde=Archangai\ten=Arhangay\tzh-CN=后杭爱省\n" +
+	"MN-1\ten=Ulaanbaatar\n" +
+	"MR-01\ten=Hodh ech Chargui\tja=ホッドエッシャルギ\tzh-CN=西胡德\n" +
+	"MR-02\ten=Hodh el Gharbi\n" +
+	"MR-03\ten=Assaba\tja=アッサバ\n" +
+	"MR-04\ten=Gorgol\tja=ゴルゴル\tzh-CN=戈尔戈勒\n" +
+	"MR-05\ten=Brakna\tja=ブラクナ\tzh-CN=卜拉克纳\n" +
+	"MR-06\ten=Trarza\tja=トラルザ\tzh-CN=特拉扎\n" +
+	"MR-07\ten=Adrar\tja=アドラル\tru=Адрар\n" +
+	"MR-08\ten=Dakhlet Nouâdhibou\n" +
+	"MR-09\ten=Tagant\tja=タガント\tzh-CN=塔甘特\n" +
+	"MR-10\ten=Guidimaka\tja=ギディマカ\tzh-CN=吉迪马卡\n" +
+	"MR-11\ten=Tiris Zemmour\tja=ティリスゼムール\tzh-CN=提里斯-宰穆尔\n" +
+	"MR-12\ten=Inchiri\tja=インシリ\tzh-CN=因希里\n" +
+	"MR-13\ten=Nouakchott Ouest\n" +
+	"MR-14\ten=Nouakchott Nord\n" +
+	"MR-15\ten=Nouakchott Sud\n" +
+	"MT-01\ten=Attard\n" +
+	"MT-02\ten=Balzan\n" +
+	"MT-03\ten=Birgu\n" +
+	"MT-04\ten=Birkirkara\tja=ビルキルカーラ\n" +
+	"MT-05\ten=Birżebbuġa\n" +
+	"MT-06\ten=Bormla\n" +
+	"MT-07\ten=Dingli\n" +
+	"MT-08\ten=Fgura\n" +
+	"MT-09\ten=Floriana\n" +
+	"MT-10\ten=Fontana\n" +
+	"MT-11\ten=Gudja\n" +
+	"MT-12\ten=Gżira\n" +
+	"MT-13\ten=Għajnsielem\n" +
+	"MT-14\ten=Għarb\n" +
+	"MT-15\ten=Għargħur\n" +
+	"MT-16\ten=Għasri\n" +
+	"MT-17\ten=Għaxaq\n" +
+	"MT-18\ten=Ħamrun\n" +
+	"MT-19\ten=Iklin\n" +
+	"MT-20\ten=Isla\n" +
+	"MT-21\ten=Kalkara\n" +
+	"MT-22\ten=Kerċem\n" +
+	"MT-23\ten=Kirkop\n" +
+	"MT-24\ten=Lija\n" +
+	"MT-25\ten=Luqa\n" +
+	"MT-26\ten=Marsa\n" +
+	"MT-27\ten=Marsaskala\n" +
+	"MT-28\ten=Marsaxlokk\tja=マルサシュロック\n" +
+	"MT-29\ten=Mdina\n" +
+	"MT-30\ten=Mellieħa\n" +
+	"MT-31\ten=Mġarr\n" +
+	"MT-32\ten=Mosta\n" +
+	"MT-33\ten=Mqabba\n" +
+	"MT-34\ten=Msida\n" +
+	"MT-35\ten=Mtarfa\n" +
+	"MT-36\ten=Munxar\n" +
+	"MT-37\ten=Nadur\n" +
+	"MT-38\ten=Naxxar\n" +
+	"MT-39\ten=Paola\n" +
+	"MT-40\ten=Pembroke\n" +
+	"MT-41\ten=Pietà\n" +
+	"MT-42\ten=Qala\n" +
+	"MT-43\ten=Qormi\n" +
+	"MT-44\ten=Qrendi\n" +
+	"MT-45\ten=Rabat Gozo\n" +
+	"MT-46\ten=Rabat Malta\n" +
+	"MT-47\ten=Safi\tja=サフィー\tru=Сафи\tzh-CN=萨非\n" +
+	"MT-48\ten=Saint Julian's\n" +
+	"MT-49\ten=Saint John\tfr=Saint-John\tja=セントジョン\tru=Сент-Джон\tzh-CN=圣约翰\n" +
+	"MT-50\ten=Saint Lawrence\n" +
+	"MT-51\ten=Saint Paul's Bay\n" +
+	"MT-52\ten=Sannat\n" +
+	"MT-53\ten=Saint Lucia's\n" +
+	"MT-54\ten=Santa Venera\n" +
+	"MT-55\ten=Siġġiewi\n" +
+	"MT-56\ten=Sliema\n" +
+	"MT-57\ten=Swieqi\n" +
+	"MT-58\ten=Ta' Xbiex\n" +
+	"MT-59\ten=Tarxien\n" +
+	"MT-60\ten=Valletta\tfr=La Valette\tja=バレッタ\n" +
+	"MT-61\ten=Xagħra\n" +
+	"MT-62\ten=Xewkija\n" +
+	"MT-63\ten=Xgħajra\n" +
+	"MT-64\ten=Żabbar\n" +
+	"MT-65\ten=Żebbuġ Gozo\n" +
+	"MT-66\ten=Żebbuġ Malta\n" +
+	"MT-67\ten=Żejtun\n" +
+	"MT-68\ten=Żurrieq\n" +
+	"MU-AG\tde=Agalega-Inseln\ten=Agalega Islands\tfr=Îles Agalega\tzh-CN=阿加莱加群岛\n" +
+	"MU-BL\ten=Black River\tzh-CN=黑河\n" +
+	"MU-CC\tde=»Cargados Carajos«-Schar\ten=Cargados Carajos Shoals\n" +
+	"MU-FL\ten=Flacq\tzh-CN=弗拉克\n" +
+	"MU-GP\ten=Grand Port\tzh-CN=大港\n" +
+	"MU-MO\ten=Moka\tzh-CN=莫卡\n" +
+	"MU-PA\ten=Pamplemousses\tzh-CN=庞普勒穆斯\n" +
+	"MU-PL\ten=Port Louis\tja=ポートルイス\tzh-CN=路易港\n" +
+	"MU-PW\ten=Plaines Wilhems\tzh-CN=威廉平原\n" +
+	"MU-RO\tde=Rodrigues-Insel\ten=Rodrigues Island\tfr=Île Rodrigues\tzh-CN=罗德里格斯\n" +
+	"MU-RR\ten=Rivière du Rempart\n" +
+	"MU-SA\ten=Savanne\tzh-CN=萨凡纳\n" +
+	"MV-00\ten=South Ari Atoll\n" +
+	"MV-01\ten=Addu City\n" +
+	"MV-02\ten=North Ari Atoll\n" +
+	"MV-03\ten=Faadhippolhu\n" +
+	"MV-04\ten=Felidhu Atoll\n" +
+	"MV-05\ten=Hahdhunmathi\n" +
+	"MV-07\ten=North Thiladhunmathi\n" +
+	"MV-08\ten=Kolhumadulu\n" +
+	"MV-12\ten=Mulaku Atoll\n" +
+	"MV-13\ten=North Maalhosmadulu\n" +
+	"MV-14\ten=North Nilandhe Atoll\n" +
+	"MV-17\ten=South Nilandhe Atoll\n" +
+	"MV-20\ten=South Maalhosmadulu\n" +
+	"MV-23\ten=South Thiladhunmathi\n" +
+	"MV-24\ten=North Miladhunmadulu\n" +
+	"MV-25\ten=South Miladhunmadulu\n" +
+	"MV-26\ten=Male Atoll\n" +
+	"MV-27\ten=North Huvadhu Atoll\n" +
+	"MV-28\ten=South Huvadhu Atoll\n" +
+	"MV-29\ten=Fuvammulah\n" +
+	"MV-MLE\ten=Male\tja=マレ\tzh-CN=马累\n" +
+	"MW-BA\ten=Balaka\tzh-CN=巴拉卡县\n" +
+	"MW-BL\ten=Blantyre\tzh-CN=布兰太尔县\n" +
+	"MW-C\ten=Central Region\tfr=Région centrale\tja=中部\n" +
+	"MW-CK\ten=Chikwawa\tzh-CN=奇克瓦瓦县\n" +
+	"MW-CR\ten=Chiradzulu\tzh-CN=奇拉朱卢县\n" +
+	"MW-CT\ten=Chitipa\tzh-CN=奇蒂帕县\n" +
+	"MW-DE\ten=Dedza\tzh-CN=代扎县\n" +
+	"MW-DO\ten=Dowa\tzh-CN=多瓦县\n" +
+	"MW-KR\ten=Karonga\tzh-CN=卡龙加县\n" +
+	"MW-KS\ten=Kasungu\tzh-CN=卡松古县\n" +
+	"MW-LI\ten=Lilongwe\tja=リロングウェ\tzh-CN=利隆圭县\n" +
+	"MW-LK\ten=Likoma\n" +
+	"MW-MC\ten=Mchinji\tzh-CN=姆钦吉县\n" +
+	"MW-MG\ten=Mangochi\tzh-CN=曼戈切县\n" +
+	"MW-MH\ten=Machinga\tzh-CN=马钦加县\n" +
+	"MW-MU\ten=Mulanje\tzh-CN=姆兰杰县\n" +
+	"MW-MW\ten=Mwanza\tzh-CN=姆万扎区\n" +
+	"MW-MZ\ten=Mzimba\tzh-CN=姆津巴县\n" +
+	"MW-N\ten=Northern Region\tfr=Région septentrionale\tja=北部\n" +
+	"MW-NB\ten=Nkhata Bay\tfr=Baie de Nkhata\tzh-CN=恩卡塔湾县\n" +
+	"MW-NE\ten=Neno\n" +
+	"MW-NI\ten=Ntchisi\tzh-CN=恩奇斯县\n" +
+	"MW-NK\ten=Nkhotakota\tzh-CN=恩科塔科塔县\n" +
+	"MW-NS\ten=Nsanje\tzh-CN=恩桑杰县\n" +
+	"MW-NU\ten=Ntcheu\tzh-CN=恩彻乌县\n" +
+	"MW-PH\ten=Phalombe\n" +
+	"MW-RU\ten=Rumphi\tzh-CN=伦比县\n" +
+	"MW-S\ten=Southern Region\tfr=Région méridionale\tja=南部\n" +
+	"MW-SA\ten=Salima\tzh-CN=萨利马县\n" +
+	"MW-TH\ten=Thyolo\tzh-CN=蒂约罗县\n" +
+	"MW-ZO\ten=Zomba\tzh-CN=松巴县\n" +
+	"MX-AGU\ten=Aguascalientes\tja=アグアスカリエンテス\tzh-CN=阿瓜斯卡连特斯州\n" +
+	"MX-BCN\ten=Baja California\tfr=Basse Californie\tja=バハカリフォルニア\tzh-CN=下加利福尼亚州\n" +
+	"MX-BCS\ten=Baja California Sur\tfr=Basse Californie méridionale\tja=バハカリフォルニアスル\tzh-CN=南下加利福尼亚州\n" +
+	"MX-CAM\ten=Campeche\tja=カンペチェ\tzh-CN=坎佩切州\n" +
+	"MX-CHH\ten=Chihuahua\tja=チワワ\tzh-CN=奇瓦瓦州\n" +
+	"MX-CHP\ten=Chiapas\tja=チアパス\tzh-CN=恰帕斯州\n" +
+	"MX-CMX\tde=Mexiko-Stadt\ten=Ciudad de México\tfr=Ville de Mexico\n" +
+	"MX-COA\ten=Coahuila de Zaragoza\tfr=État de Coahuila de Zaragoza\n" +
+	"MX-COL\ten=Colima\tja=コリマ\tzh-CN=科利马州\n" +
+	"MX-DUR\ten=Durango\tja=ドゥランゴ\tzh-CN=杜兰戈州\n" +
+	"MX-GRO\ten=Guerrero\tja=ゲレロ\tzh-CN=格雷罗州\n" +
+	"MX-GUA\ten=Guanajuato\tja=グアナフアト\tzh-CN=瓜纳华托州\n" +
+	"MX-HID\ten=Hidalgo\tja=イダルゴ\tzh-CN=伊达尔戈州\n" +
+	"MX-JAL\ten=Jalisco\tja=ハリスコ\tzh-CN=哈利斯科州\n" +
+	"MX-MEX\ten=México\tfr=Mexico\tja=メキシコ\n" +
+	"MX-MIC\ten=Michoacán de Ocampo\n" +
+	"MX-MOR\ten=Morelos\tja=モレロス\tzh-CN=莫雷洛斯州\n" +
+	"MX-NAY\ten=Nayarit\tja=ナヤリット\tzh-CN=纳亚里特州\n" +
+	"MX-NLE\ten=Nuevo León\tfr=Nouveau León\tja=ヌエボレオン\n" +
+	"MX-OAX\ten=Oaxaca\tja=オアハカ\tzh-CN=瓦哈卡州\n" +
+	"MX-PUE\ten=Puebla\tja=プエブラ\tzh-CN=普埃布拉州\n" +
+	"MX-QUE\ten=Querétaro\tja=ケレタロ\n" +
+	"MX-ROO\ten=Quintana Roo\tja=キンタナロー\tzh-CN=金塔纳罗奥州\n" +
+	"MX-SIN\ten=Sinaloa\tja=シナロア\tzh-CN=锡那罗亚州\n" +
+	"MX-SLP\ten=San Luis Potosí\tja=サンルイスポトシ\n" +
+	"MX-SON\ten=Sonora\tja=ソノラ\tzh-CN=索诺拉州\n" +
+	"MX-TAB\ten=Tabasco\tja=タバスコ\tzh-CN=塔巴斯科州\n" +
+	"MX-TAM\ten=Tamaulipas\tja=タマウリパス\tzh-CN=塔毛利帕斯州\n" +
+	"MX-TLA\ten=Tlaxcala\tja=トラスカラ\tzh-CN=特拉斯卡拉州\n" +
+	"MX-VER\ten=Veracruz de Ignacio de la Llave\n" +
+	"MX-YUC\ten=Yucatán\tja=ユカタン\n" +
+	"MX-ZAC\ten=Zacatecas\tja=サカテカス\tzh-CN=萨卡特卡斯州\n" +
+	"MY-01\ten=Johor\tja=ジョホール\tzh-CN=柔佛\n" +
+	"MY-02\ten=Kedah\tzh-CN=吉打\n" +
+	"MY-03\ten=Kelantan\tja=クランタン\tzh-CN=吉兰丹\n" +
+	"MY-04\tde=Malakka\ten=Melaka\tzh-CN=马六甲\n" +
+	"MY-05\ten=Negeri Sembilan\tja=ヌグリスンビラン\tzh-CN=森美兰\n" +
+	"MY-06\ten=Pahang\tja=パハン\tzh-CN=彭亨\n" +
+	"MY-07\tde=Penang\ten=Pulau Pinang\tzh-CN=槟城\n" +
+	"MY-08\ten=Perak\tzh-CN=霹雳\n" +
+	"MY-09\ten=Perlis\tja=ペルリス\tzh-CN=玻璃市\n" +
+	"MY-10\ten=Selangor\tja=スランゴール\tzh-CN=雪兰莪\n" +
+	"MY-11\ten=Terengganu\tja=トレンガヌ\tzh-CN=登嘉楼\n" +
+	"MY-12\ten=Sabah\tja=サバ\tzh-CN=沙巴\n" +
+	"MY-13\ten=Sarawak\tja=サラワク\tzh-CN=砂拉越\n" +
+	"MY-14\tde=Bundesterritorium Kuala Lumpur\ten=Wilayah Persekutuan Kuala Lumpur\tfr=Kuala Lumpur (territoire fédéral)\tzh-CN=吉隆坡\n" +
+	"MY-15\tde=Bundesterritorium Labuan\ten=Wilayah Persekutuan Labuan\tfr=Labuan (territoire fédéral)\tzh-CN=纳闽\n" +
+	"MY-16\tde=Bundesterritorium Putrajaya\ten=Wilayah Persekutuan Putrajaya\tfr=Putrajaya (territoire fédéral)\tzh-CN=布特拉再也\n" +
+	"MZ-A\ten=Niassa\tja=ニアサ\tzh-CN=尼亚萨省\n" +
+	"MZ-B\ten=Manica\tja=マニカ\tzh-CN=马尼卡省\n" +
+	"MZ-G\ten=Gaza\tja=ガザ\tzh-CN=加扎省\n" +
+	"MZ-I\ten=Inhambane\tja=イニャンバネ\tzh-CN=伊尼扬巴内\n" +
+	"MZ-L\ten=Maputo\tja=マプト\tzh-CN=马普托市\n" +
+	"MZ-MPM\ten=Maputo\tja=マプト\tzh-CN=马普托市\n" +
+	"MZ-N\ten=Nampula\n" +
+	"MZ-P\ten=Cabo Delgado\tja=カーボデルガード\tzh-CN=德尔加杜角省\n" +
+	"MZ-Q\ten=Zambézia\n" +
+	"MZ-S\ten=Sofala\tja=ソファラ\tzh-CN=索法拉省\n" +
+	"MZ-T\ten=Tete\tja=テテ\tzh-CN=太特省\n" +
+	"NA-CA\ten=Zambezi\n" +
+	"NA-ER\ten=Erongo\tja=エロンゴ\tzh-CN=埃龙戈\n" +
+	"NA-HA\ten=Hardap\tja=ハルダプ\tzh-CN=哈达普\n" +
+	"NA-KA\ten=//Karas\n" +
+	"NA-KE\ten=Kavango East\n" +
+	"NA-KH\ten=Khomas\tja=ホマス\tzh-CN=霍马斯\n" +
+	"NA-KU\ten=Kunene\tja=ケネネ\tzh-CN=库内内\n" +
+	"NA-KW\ten=Kavango West\n" +
+	"NA-OD\ten=Otjozondjupa\tja=オチョゾンデュパ\tzh-CN=奥乔宗蒂约巴\n" +
+	"NA-OH\ten=Omaheke\tja=オマヘケ\tzh-CN=奥马赫科\n" +
+	"NA-ON\ten=Oshana\tja=オシャナ\tzh-CN=奥沙纳\n" +
+	"NA-OS\ten=Omusati\tja=オムサティ\tzh-CN=奥姆沙蒂\n" +
+	"NA-OT\ten=Oshikoto\tja=オシコト\tzh-CN=奥希科托\n" +
+	"NA-OW\ten=Ohangwena\tja=オハンウェナ\tzh-CN=奥汉圭纳\n" +
+	"NE-1\ten=Agadez\tfr=Agadès\tja=アガデズ\tzh-CN=阿加德兹\n" +
+	"NE-2\ten=Diffa\tja=ディファ\tzh-CN=迪法\n" +
+	"NE-3\ten=Dosso\tja=ドーソ\tzh-CN=多索\n" +
+	"NE-4\ten=Maradi\tja=マラディ\tzh-CN=马拉迪\n" +
+	"NE-5\ten=Tahoua\tja=タウア\tzh-CN=塔瓦\n" +
+	"NE-6\ten=Tillabéri\tja=ティラベリ\tzh-CN=蒂拉贝里\n" +
+	"NE-7\ten=Zinder\tja=ジンデル\tzh-CN=津德尔\n" +
+	"NE-8\ten=Niamey\tja=ニアメ\tzh-CN=尼亚美市\n" +
+	"NG-AB\ten=Abia\tja=アビア\tzh-CN=阿比亚州\n" +
+	"NG-AD\ten=Adamawa\tja=アダマワ\tzh-CN=阿达马瓦州\n" +
+	"NG-AK\ten=Akwa Ibom\tja=アクワイボム\tzh-CN=阿夸伊博姆州\n" +
+	"NG-AN\ten=Anambra\tja=アナンブラ\tzh-CN=阿南布拉州\n" +
+	"NG-BA\ten=Bauchi\tja=バウチ\tzh-CN=包奇州\n" +
+	"NG-BE\ten=Benue\tja=ベルエ\tzh-CN=贝努埃州\n" +
+	"NG-BO\ten=Borno\tja=ボルノ\tzh-CN=博尔诺州\n" +
+	"NG-BY\ten=Bayelsa\tja=バイエルサ\tzh-CN=巴耶尔萨州\n" +
+	"NG-CR\ten=Cross River\tja=クロスリバー\tzh-CN=克里斯河州\n" +
+	"NG-DE\ten=Delta\tja=デルタ\tzh-CN=三角州\n" +
+	"NG-EB\ten=Ebonyi\tja=エボニー\tzh-CN=埃邦伊州\n" +
+	"NG-ED\ten=Edo\tja=エド\tzh-CN=埃多州\n" +
+	"NG-EK\ten=Ekiti\tja=エキティ\tzh-CN=埃基蒂州\n" +
+	"NG-EN\ten=Enugu\tja=エヌーグー\tzh-CN=埃努古州\n" +
+	"NG-FC\ten=Abuja Federal Capital Territory\n" +
+	"NG-GO\ten=Gombe\tja=ゴンベ\tzh-CN=贡贝州\n" +
+	"NG-IM\ten=Imo\tja=イモ\tzh-CN=伊莫州\n" +
+	"NG-JI\ten=Jigawa\tja=ジガワ\tzh-CN=吉加瓦州\n" +
+	"NG-KD\ten=Kaduna\tja=カドゥナ\tzh-CN=卡杜纳州\n" +
+	"NG-KE\ten=Kebbi\tja=ケビ\tzh-CN=凯比州\n" +
+	"NG-KN\ten=Kano\tja=カノ\tzh-CN=卡诺州\n" +
+	"NG-KO\ten=Kogi\tja=コギ\tzh-CN=科吉州\n" +
+	"NG-KT\ten=Katsina\tja=カッシナ\tzh-CN=卡齐纳州\n" +
+	"NG-KW\ten=Kwara\tja=クワラ\tzh-CN=夸拉州\n" +
+	"NG-LA\ten=Lagos\tja=ラゴス\tzh-CN=拉哥斯州\n" +
+	"NG-NA\ten=Nasarawa\tzh-CN=纳萨拉瓦州\n" +
+	"NG-NI\ten=Niger\tes=Níger\tja=ナイジャー\tzh-CN=尼日尔州\n" +
+	"NG-OG\ten=Ogun\tja=オグン\tzh-CN=奥贡州\n" +
+	"NG-ON\ten=Ondo\tja=オンド\tzh-CN=翁多州\n" +
+	"NG-OS\ten=Osun\tja=オスン\tzh-CN=奥孙州\n" +
+	"NG-OY\ten=Oyo\tja=オヨ\tzh-CN=奥约州\n" +
+	"NG-PL\ten=Plateau\tja=プラトー\tru=Плато\tzh-CN=高原省\n" +
+	"NG-RI\ten=Rivers\tfr=Rivières\tja=リバーズ\tzh-CN=河流州\n" +
+	"NG-SO\ten=Sokoto\tja=ソコト\tzh-CN=索科托州\n" +
+	"NG-TA\ten=Taraba\tja=タラバ\tzh-CN=塔拉巴州\n" +
+	"NG-YO\ten=Yobe\tja=ヨベ\tzh-CN=约贝州\n" +
+	"NG-ZA\ten=Zamfara\tja=ザンファラ\tzh-CN=扎姆法拉州\n" +
+	"NI-AN\ten=Costa Caribe Norte\n" +
+	"NI-AS\ten=Costa Caribe Sur\n" +
+	"NI-BO\ten=Boaco\tja=ボアコ\tzh-CN=博阿科\n" +
+	"NI-CA\ten=Carazo\tja=カラソ\tzh-CN=卡拉索\n" +
+	"NI-CI\ten=Chinandega\tja=チナンデガ\tzh-CN=奇南德加\n" +
+	"NI-CO\ten=Chontales\tja=チョンタレス\tzh-CN=琼塔莱斯\n" +
+	"NI-ES\ten=Estelí\tja=エステリ\tzh-CN=埃斯特利\n" +
+	"NI-GR\ten=Granada\tfr=Grenade\tja=グラナダ\tru=Гранада\tzh-CN=格拉纳达\n" +
+	"NI-JI\ten=Jinotega\tja=ヒノテガ\tzh-CN=希诺特加\n" +
+	"NI-LE\ten=León\tfr=Léon\tja=レオン\tru=Леон\tzh-CN=莱昂\n" +
+	"NI-MD\ten=Madriz\tja=マドリス\tzh-CN=马德里斯\n" +
+	"NI-MN\ten=Managua\tja=マナグア\tzh-CN=马那瓜\n" +
+	"NI-MS\ten=Masaya\tja=マサヤ\tzh-CN=马萨亚\n" +
+	"NI-MT\ten=Matagalpa\tja=マタガルパ\tzh-CN=马塔加尔帕\n" +
+	"NI-NS\ten=Nueva Segovia\tfr=Nouvelle Ségovie\tja=ヌエバセゴビア\tzh-CN=新塞哥维亚\n" +
+	"NI-RI\ten=Rivas\tja=リバス\tzh-CN=里瓦斯\n" +
+	"NI-SJ\ten=Río San Juan\tfr=Rivière San Juan\tja=リオサンファン\tzh-CN=圣胡安河\n" +
+	"NL-AW\ten=Aruba\tja=アルーバ\tzh-CN=阿鲁巴\n" +
+	"NL-BQ1\ten=Bonaire\tru=Бонайре\n" +
+	"NL-BQ2\ten=Saba\tru=Саба\n" +
+	"NL-BQ3\ten=Sint Eustatius\tfr=Saint Eustache\tru=Синт-Эстатиус\n" +
+	"NL-CW\ten=Curaçao\tes=Curazao\tja=キュラソー\tzh-CN=库拉索\n" +
+	"NL-DR\ten=Drenthe\tja=ドレンテ\tzh-CN=德伦特省\n" +
+	"NL-FL\ten=Flevoland\tja=フレーフォラント\tzh-CN=菲仕兰省\n" +
+	"NL-FR\ten=Fryslân\n" +
+	"NL-GE\ten=Gelderland\tfr=Gueldre\tja=ヘルデルラント\tzh-CN=海尔德兰\n" +
+	"NL-GR\ten=Groningen\tfr=Groningue\tja=フローニンゲン\tzh-CN=格罗宁根\n" +
+	"NL-LI\ten=Limburg\tfr=Limourg\tja=リンブルフ\tru=Лимбург\n" +
+	"NL-NB\tde=Nordbrabant\ten=Noord-Brabant\tfr=Brabant septentrional\tja=ノールトブラーバント\tzh-CN=北部拉班特\n" +
+	"NL-NH\tde=Nordholland\ten=Noord-Holland\tfr=Hollande septentrionale\tja=ノールトホラント\tzh-CN=北荷兰\n" +
+	"NL-OV\ten=Overijssel\tja=オーフェルアイセル\tzh-CN=上艾瑟尔\n" +
+	"NL-SX\ten=Sint Maarten\tfr=Saint-Martin\n" +
+	"NL-UT\ten=Utrecht\tja=ユトレヒト\tzh-CN=乌德勒支\n" +
+	"NL-ZE\ten=Zeeland\tfr=Zélande\tja=ゼーラント\tzh-CN=泽兰\n" +
+	"NL-ZH\tde=Südholland\ten=Zuid-Holland\tfr=Hollande méridionale\tja=ザイトホラント\tzh-CN=南荷兰\n" +
+	"NO-03\ten=Oslo\tja=オスロ\tzh-CN=奥斯陆\n" +
+	"NO-11\ten=Rogaland\tja=ローガラン\tzh-CN=罗加兰\n" +
+	"NO-15\ten=Møre og Romsdal\tja=メーレオロムスダール\n" +
+	"NO-18\ten=Nordland\tja=ノールラン\tzh-CN=诺尔兰\n" +
+	"NO-21\tde=Svalbard (Arktische Region)\ten=Svalbard (Arctic Region)\tfr=Svalbard (région arctique)\n" +
+	"NO-22\tde=Jan Mayen (Arktische Region)\ten=Jan Mayen (Arctic Region)\tfr=Jan Mayen (région arctique)\n" +
+	"NO-30\ten=Viken\n" +
+	"NO-34\ten=Innlandet\n" +
+	"NO-38\ten=Vestfold og Telemark\n" +
+	"NO-42\ten=Agder\n" +
+	"NO-46\ten=Vestland\n" +
+	"NO-50\ten=Trööndelage\n" +
+	"NO-54\ten=Romssa ja Finnmárkku\n" +
+	"NP-1\ten=Central\tja=セントラル\tzh-CN=中部\n" +
+	"NP-2\ten=Mid Western\n" +
+	"NP-3\ten=Western\tfr=Ouest\tzh-CN=西部区\n" +
+	"NP-4\ten=Eastern\tfr=Est\tzh-CN=东部区\n" +
+	"NP-5\ten=Far Western\n" +
+	"NP-BA\ten=Bagmati\n" +
+	"NP-BH\ten=Bheri\n" +
+	"NP-DH\tde=Dhaulagiri\ten=Dhawalagiri\n" +
+	"NP-GA\ten=Gandaki\n" +
+	"NP-JA\ten=Janakpur\n" +
+	"NP-KA\ten=Karnali\n" +
+	"NP-KO\ten=Kosi\n" +
+	"NP-LU\ten=Lumbini\tja=ルンビニ\n" +
+	"NP-MA\ten=Mahakali\n" +
+	"NP-ME\ten=Mechi\n" +
+	"NP-NA\ten=Narayani\n" +
+	"NP-P1\ten=Province 1\n" +
+	"NP-P2\ten=Province 2\n" +
+	"NP-P3\ten=Bāgmatī\n" +
+	"NP-P4\ten=Gandaki\n" +
+	"NP-P5\ten=Province 5\n" +
+	"NP-P6\ten=Karnali\n" +
+	"NP-P7\ten=Sudūr Pashchim\n" +
+	"NP-RA\ten=Rapti\n" +
+	"NP-SA\ten=Sagarmatha\n" +
+	"NP-SE\ten=Seti\n" +
+	"NR-01\ten=Aiwo\n" +
+	"NR-02\ten=Anabar\tja=アナバル\n" +
+	"NR-03\ten=Anetan\n" +
+	"NR-04\ten=Anibare\tja=アニバレ\n" +
+	"NR-05\ten=Baitsi\n" +
+	"NR-06\ten=Boe\n" +
+	"NR-07\ten=Buada\n" +
+	"NR-08\ten=Denigomodu\n" +
+	"NR-09\ten=Ewa\n" +
+	"NR-10\ten=Ijuw\n" +
+	"NR-11\ten=Meneng\n" +
+	"NR-12\ten=Nibok\n" +
+	"NR-13\ten=Uaboe\n" +
+	"NR-14\ten=Yaren\tja=ヤレン\n" +
+	"NZ-AUK\ten=Auckland\tja=オークランド\tzh-CN=奥克兰\n" +
+	"NZ-BOP\ten=Bay of Plenty\tfr=Baie de Plenty\tja=ベイオブプレンティ\tzh-CN=丰盛湾\n" +
+	"NZ-CAN\ten=Canterbury\tja=カンタベリー\tru=Кентербери\tzh-CN=坎特伯雷\n" +
+	"NZ-CIT\tde=Chatham Islands Territorium\ten=Chatham Islands Territory\tfr=Territoire des îles Chatham\n" +
+	"NZ-GIS\ten=Gisborne\n" +
+	"NZ-HKB\ten=Hawke's Bay\tfr=Baie de Hawke\tja=ホークスベイ\n" +
+	"NZ-MBH\ten=Marlborough\n" +
+	"NZ-MWT\ten=Manawatu-Wanganui\tja=マナワツウォンガヌイ\tzh-CN=马努瓦图-旺格纽伊\n" +
+	"NZ-NSN\ten=Nelson\n" +
+	"NZ-NTL\ten=Northland\tfr=Pays du Nord\tja=ノースランド\tzh-CN=北部地区\n" +
+	"NZ-OTA\ten=Otago\tja=オターゴ\tzh-CN=奥塔哥地区\n" +
+	"NZ-STL\ten=Southland\tfr=Pays du Sud\tja=サウスランド\tzh-CN=南部地区\n" +
+	"NZ-TAS\ten=Tasman\n" +
+	"NZ-TKI\ten=Taranaki\tzh-CN=塔拉纳奇\n" +
+	"NZ-WGN\ten=Wellington\tja=ウェリントン\tzh-CN=惠灵顿\n" +
+	"NZ-WKO\ten=Waikato\tja=ワイカト\tzh-CN=怀卡托\n" +
+	"NZ-WTC\ten=West Coast\tfr=Côte occidentale\tja=ウエストコースト\tzh-CN=西岸\n" +
+	"OM-BJ\ten=Janūb al Bāţinah\n" +
+	"OM-BS\ten=Shamāl al Bāţinah\n" +
+	"OM-BU\tde=Al Buraimī\ten=Al Buraymī\n" +
+	"OM-DA\ten=Ad Dākhilīyah\n" +
+	"OM-MA\tde=Maskat\ten=Masqaţ\tfr=Mascate\tja=マスカット\n" +
+	"OM-MU\ten=Musandam\tja=ムサンダム\tzh-CN=穆桑达姆省\n" +
+	"OM-SJ\ten=Janūb ash Sharqīyah\n" +
+	"OM-SS\ten=Shamāl ash Sharqīyah\n" +
+	"OM-WU\ten=Al Wusţá\tfr=Al Wusta\tzh-CN=中部省\n" +
+	"OM-ZA\tde=Az̧ Z̧āhira\ten=Az̧ Z̧āhirah\tja=ザーヒラ\tru=Эз-Захира\n" +
+	"OM-ZU\tde=Dhofar\ten=Z̧ufār\tja=ズファール\n" +
+	"PA-1\ten=Bocas del Toro\tja=ボカスデルトロ\tru=Бокас-дель-Торо\tzh-CN=博卡斯·德尔托罗省\n" +
+	"PA-10\ten=Panamá Oeste\n" +
+	"PA-2\ten=Coclé\tja=コクレ\tzh-CN=科克莱省\n" +
+	"PA-3\ten=Colón\tja=コロン\tzh-CN=科隆省\n" +
+	"PA-4\ten=Chiriquí\tja=チリキ\tru=Чирики\tzh-CN=奇里基省\n" +
+	"PA-5\ten=Darién\tja=ダリエン\tru=Дарьен\tzh-CN=达连省\n" +
+	"PA-6\ten=Herrera\tja=エレーラ\tru=Эррера\tzh-CN=埃雷拉省\n" +
+	"PA-7\ten=Los Santos\tja=ロスサントス\tru=Лос-Сантос\tzh-CN=洛斯桑托斯省\n" +
+	"PA-8\ten=Panamá\tfr=Panama\tja=パナマ\tru=Панама\tzh-CN=巴拿马省\n" +
+	"PA-9\ten=Veraguas\tja=ベアグアス\tru=Верагуас\tzh-CN=贝拉瓜斯省\n" +
+	"PA-EM\ten=Emberá\tja=エンベラ\n" +
+	"PA-KY\ten=Guna Yala\tru=Гуна-Яла\n" +
+	"PA-NB\ten=Ngöbe-Buglé\tja=ノーベブグレ\tru=Нгобе-Бугле\n" +
+	"PE-AMA\ten=Amarumayu\n" +
+	"PE-ANC\ten=Ancash\tes=Áncash\tja=アンカシュ\tzh-CN=安卡什区域\n" +
+	"PE-APU\ten=Apurimaq\n" +
+	"PE-ARE\ten=Arequipa\tja=アレキパ\tru=Арекипа\tzh-CN=阿雷基帕区域\n" +
+	"PE-AYA\ten=Ayacucho\tja=アヤクチョ\tru=Аякучо\tzh-CN=阿亚库乔区域\n" +
+	"PE-CAJ\ten=Cajamarca\tja=カハマルカ\tru=Кахамарка\tzh-CN=卡哈马卡区域\n" +
+	"PE-CAL\ten=El Callao\tja=カリャオ憲法特別市\tzh-CN=卡亚俄区\n" +
+	"PE-CUS\ten=Cusco\n" +
+	"PE-HUC\ten=Huánuco\tja=ワヌコ\n" +
+	"PE-HUV\ten=Huancavelica\tja=ワンカベリカ\tzh-CN=万卡维利卡区域\n" +
+	"PE-ICA\ten=Ica\tja=イカ\tzh-CN=伊卡区域\n" +
+	"PE-JUN\ten=Hunin\n" +
+	"PE-LAL\ten=La Libertad\tja=ラリベルタッド\tru=Ла-Либертад\n" +
+	"PE-LAM\ten=Lambayeque\tja=ランバイエケ\tru=Ламбаеке\tzh-CN=兰巴耶克区域\n" +
+	"PE-LIM\ten=Lima\tja=リマ\tru=Лима\tzh-CN=利马区域\n" +
+	"PE-LMA\ten=Lima hatun llaqta\n" +
+	"PE-LOR\ten=Loreto\tja=ロレト\tru=Лорето\tzh-CN=洛雷托区域\n" +
+	"PE-MDD\ten=Madre de Dios\tja=マドレデディオス\tru=Мадре-де-Дьос\tzh-CN=马德雷·德迪奥斯区域\n" +
+	"PE-MOQ\ten=Moquegua\tja=モケグア\tzh-CN=莫克瓜区域\n" +
+	"PE-PAS\ten=Pasco\tja=パスコ\tru=Паско\tzh-CN=帕斯科区域\n" +
+	"PE-PIU\ten=Piura\tja=ピウラ\tru=Пьюра\tzh-CN=皮乌拉区域\n" +
+	"PE-PUN\ten=Puno\tja=プノ\tru=Пуно\tzh-CN=普诺区域\n" +
+	"PE-SAM\ten=San Martin\n" +
+	"PE-TAC\ten=Tacna\tja=タクナ\tru=Такна\tzh-CN=塔克纳区域\n" +
+	"PE-TUM\ten=Tumbes\tja=トゥンペス\tzh-CN=通贝斯区域\n" +
+	"PE-UCA\ten=Ucayali\tja=ウカヤリ\tru=Укаяли\tzh-CN=乌卡亚利区域\n" +
+	"PG-CPK\ten=Chimbu\tja=チンブ\tzh-CN=钦布省\n" +
+	"PG-CPM\ten=Central\tja=セントラル\tzh-CN=中部\n" +
+	"PG-EBR\ten=East New Britain\tfr=Nouvelle-Bretagne orientale\tja=東ニューブリテン\tzh-CN=东新不列颠省\n" +
+	"PG-EHG\ten=Eastern Highlands\tfr=Highlands orientaux\tja=東ハイランド\tzh-CN=东高地省\n" +
+	"PG-EPW\ten=Enga\tja=エンガ\tzh-CN=恩加省\n" +
+	"PG-ESW\ten=East Sepik\tfr=Spik oriental\tja=東セピック\tzh-CN=东塞皮克省\n" +
+	"PG-GPK\ten=Gulf\tfr=Golfe\tja=ガルフ\tzh-CN=海湾省\n" +
+	"PG-HLA\ten=Hela\n" +
+	"PG-JWK\ten=Jiwaka\n" +
+	"PG-MBA\ten=Milne Bay\tfr=Baie de Milne\tja=ミルンベイ\tzh-CN=米尔恩湾省\n" +
+	"PG-MPL\ten=Morobe\tja=モロベ\tzh-CN=莫雷贝省\n" +
+	"PG-MPM\ten=Madang\tja=マダン\tzh-CN=马当省\n" +
+	"PG-MRL\ten=Manus\tja=マヌス島\tzh-CN=马努斯省\n" +
+	"PG-NCD\ten=National Capital District (Port Moresby)\tfr=Port-Moresby (district de la capitale)\tja=首都特別区 (ポートモレスビー)\tzh-CN=国家首都区 (莫尔兹比港)\n" +
+	"PG-NIK\ten=New Ireland\tfr=Nouvelle-Irlande\tja=ニューアイルランド\tzh-CN=新爱尔兰省\n" +
+	"PG-NPP\ten=Northern\tfr=Nord\tzh-CN=北部区\n" +
+	"PG-NSB\ten=Bougainville\tja=ブーゲンビル\n" +
+	"PG-SAN\ten=West Sepik\n" +
+	"PG-SHM\ten=Southern Highlands\tfr=Highlands méridionaux\tja=南ハイランド\tzh-CN=南高地省\n" +
+	"PG-WBK\ten=West New Britain\tfr=Nouvelle-Bretagne occidentale\tja=西ニューブリテン\tzh-CN=西新不列颠省\n" +
+	"PG-WHM\ten=Western Highlands\tfr=Highlands occidentaux\tja=西ハイランド\tzh-CN=西高地省\n" +
+	"PG-WPD\ten=Western\tfr=Ouest\tzh-CN=西部区\n" +
+	"PH-00\ten=National Capital Region\tes=Región de la Capital Nacional\tfr=Région de la capitale nationale\n" +
+	"PH-01\ten=Ilocos (Region I)\tes=Ilocos (Región I)\tfr=Ilocos (Région I)\n" +
+	"PH-02\ten=Cagayan Valley (Region II)\tes=Valle del Cagayán (Región II)\tfr=Vallée de Cagayan (Région II)\n" +
+	"PH-03\ten=Central Luzon (Region III)\tes=Luzón Central (Región III)\tfr=Luzon central (Région III)\n" +
+	"PH-05\ten=Bicol (Region V)\tes=Bícol (Región V)\tfr=Bicol (Région V)\n" +
+	"PH-06\ten=Western Visayas (Region VI)\tes=Visayas Occidentales (Región VI)\tfr=Visayas occidental (Région VI)\n" +
+	"PH-07\ten=Central Visayas (Region VII)\tes=Visayas Centrales (Región VII)\tfr=Visayas central (Région VII)\n" +
+	"PH-08\ten=Eastern Visayas (Region VIII)\tes=Visayas Orientales (Región VIII)\tfr=Visayas oriental (Région VIII)\n" +
+	"PH-09\ten=Zamboanga Peninsula (Region IX)\tes=Península de Zamboanga (Región IX)\tfr=Péninsule de Zamboanga (Région IX)\n" +
+	"PH-10\ten=Northern Mindanao (Region X)\tes=Mindanao del Norte (Región X)\tfr=Mindanao septentrional (Région X)\n" +
+	"PH-11\ten=Davao (Region XI)\tfr=Davao (Région XI)\tja=ダバオ\n" +
+	"PH-12\ten=Soccsksargen (Region XII)\tfr=Soccsksargen (Région XII)\n" +
+	"PH-13\ten=Caraga (Region XIII)\tfr=Caraga (Région XIII)\n" +
+	"PH-14\tde=Autonome Region Muslimisches Mindanao (ARMM)\ten=Autonomous Region in Muslim Mindanao (ARMM)\tfr=Région autonome musulmane de Mindanao (ARMM)\n" +
+	"PH-15\ten=Cordillera Administrative Region (CAR)\tfr=Région administrative de Cordillera (CAR)\n" +
+	"PH-40\ten=Calabarzon (Region IV-A)\n" +
+	"PH-41\ten=Mimaropa (Region IV-B)\n" +
+	"PH-ABR\ten=Abra\tzh-CN=阿布拉\n" +
+	"PH-AGN\ten=Agusan del Norte\tfr=Agusan du Nord\tja=北アグサン\tzh-CN=北阿古桑\n" +
+	"PH-AGS\ten=Agusan del Sur\tfr=Agusan du Sud\tja=南アグサン\tzh-CN=南阿古桑\n" +
+	"PH-AKL\ten=Aklan\tzh-CN=阿克兰\n" +
+	"PH-ALB\ten=Albay\tzh-CN=阿尔拜\n" +
+	"PH-ANT\ten=Antique\tzh-CN=安蒂克\n" +
+	"PH-APA\ten=Apayao\tzh-CN=阿巴尧\n" +
+	"PH-AUR\ten=Aurora\tzh-CN=奥罗拉\n" +
+	"PH-BAN\ten=Bataan\n" +
+	"PH-BAS\ten=Basilan\tzh-CN=巴西兰\n" +
+	"PH-BEN\ten=Benguet\tzh-CN=本格特\n" +
+	"PH-BIL\ten=Biliran\tzh-CN=比利兰\n" +
+	"PH-BOH\ten=Bohol\tja=ボホール\tzh-CN=保和\n" +
+	"PH-BTG\ten=Batangas\tja=バタンガス\tzh-CN=八打雁\n" +
+	"PH-BTN\ten=Batanes\tzh-CN=巴坦群岛\n" +
+	"PH-BUK\ten=Bukidnon\tja=ブキドノン\tzh-CN=布基农\n" +
+	"PH-BUL\ten=Bulacan\tzh-CN=布拉干\n" +
+	"PH-CAG\ten=Cagayan\tzh-CN=卡加延\n" +
+	"PH-CAM\ten=Camiguin\tja=カミギン\tzh-CN=甘米银\n" +
+	"PH-CAN\ten=Camarines Norte\tfr=Camarines Nord\tzh-CN=北甘马磷\n" +
+	"PH-CAP\ten=Capiz\tzh-CN=卡皮斯\n" +
+	"PH-CAS\ten=Camarines Sur\tfr=Camarines Sud\tzh-CN=南甘马磷\n" +
+	"PH-CAT\ten=Catanduanes\tzh-CN=卡坦端内斯\n" +
+	"PH-CAV\ten=Cavite\tzh-CN=甲米地\n" +
+	"PH-CEB\ten=Cebu\tja=セブ\tzh-CN=宿务\n" +
+	"PH-COM\ten=Davao de Oro\n" +
+	"PH-DAO\ten=Davao Oriental\tfr=Davao oriental\tzh-CN=东达沃\n" +
+	"PH-DAS\ten=Davao del Sur\tfr=Davao du Sud\tja=南ダバオ\tzh-CN=南达沃\n" +
+	"PH-DAV\ten=Davao del Norte\tfr=Davao du Nord\n" +
+	"PH-DIN\ten=Dinagat Islands\tfr=Îles Dinagat\tja=ディナガットアイランズ\n" +
+	"PH-DVO\ten=Davao Occidental\n" +
+	"PH-EAS\ten=Eastern Samar\tfr=Samar Oriental\tzh-CN=东萨马\n" +
+	"PH-GUI\ten=Guimaras\tzh-CN=吉马拉斯\n" +
+	"PH-IFU\ten=Ifugao\tzh-CN=伊富高\n" +
+	"PH-ILI\ten=Iloilo\tja=イロイロ\tzh-CN=伊洛伊洛\n" +
+	"PH-ILN\ten=Ilocos Norte\tfr=Ilocos Nord\tzh-CN=北伊罗戈\n" +
+	"PH-ILS\ten=Ilocos Sur\tfr=Ilocos Sud\tzh-CN=南伊罗戈\n" +
+	"PH-ISA\ten=Isabela\tja=イサベラ\tzh-CN=伊莎贝拉\n" +
+	"PH-KAL\ten=Kalinga\n" +
+	"PH-LAG\ten=Laguna\tzh-CN=内湖\n" +
+	"PH-LAN\ten=Lanao del Norte\tfr=Lanao du Nord\tja=北ラナオ\tzh-CN=北拉瑙\n" +
+	"PH-LAS\ten=Lanao del Sur\tfr=Lanao du Sud\tja=南ラナオ\tzh-CN=南拉瑙\n" +
+	"PH-LEY\ten=Leyte\tja=レイテ\tzh-CN=莱特\n" +
+	"PH-LUN\ten=La Union\n" +
+	"PH-MAD\ten=Marinduque\tzh-CN=马林杜克\n" +
+	"PH-MAG\ten=Maguindanao\tja=マギンダナオ\tzh-CN=马京达瑙\n" +
+	"PH-MAS\ten=Masbate\tja=マスバテ\tzh-CN=马斯巴特\n" +
+	"PH-MDC\ten=Mindoro Occidental\tfr=Mindoro occidental\tzh-CN=西民都洛\n" +
+	"PH-MDR\ten=Mindoro Oriental\tfr=Mindoro oriental\tzh-CN=东民都洛\n" +
+	"PH-MOU\ten=Mountain Province\tfr=Province de la Montagne\tzh-CN=高山省\n" +
+	"PH-MSC\ten=Misamis Occidental\tfr=Misamis occidental\tja=西ミサミス\tzh-CN=西米萨米斯\n" +
+	"PH-MSR\ten=Misamis Oriental\tfr=Misamis oriental\tja=東ミサミス\tzh-CN=东米萨米斯\n" +
+	"PH-NCO\ten=Cotabato\n" +
+	"PH-NEC\ten=Negros Occidental\tfr=Negros occidental\n" +
+	"PH-NER\ten=Negros Oriental\tfr=Negros oriental\tja=東ネグロス\tzh-CN=东内格罗斯\n" +
+	"PH-NSA\ten=Northern Samar\tfr=Samar septentrional\tzh-CN=北萨马\n" +
+	"PH-NUE\ten=Nueva Ecija\tfr=Nouvelle-Ecija\tzh-CN=新怡诗夏\n" +
+	"PH-NUV\ten=Nueva Vizcaya\tfr=Nouvelle-Biscaye\tzh-CN=新比斯开\n" +
+	"PH-PAM\ten=Pampanga\tfr=pampangan\tzh-CN=邦板牙\n" +
+	"PH-PAN\ten=Pangasinan\tzh-CN=班诗兰\n" +
+	"PH-PLW\ten=Palawan\tzh-CN=巴拉望\n" +
+	"PH-QUE\ten=Quezon\tja=ケソン\tzh-CN=奎松\n" +
+	"PH-QUI\ten=Quirino\tzh-CN=季里诺\n" +
+	"PH-RIZ\ten=Rizal\tzh-CN=黎刹\n" +
+	"PH-ROM\ten=Romblon\tzh-CN=朗布隆\n" +
+	"PH-SAR\ten=Sarangani\tja=サランガニ\tzh-CN=萨兰加尼\n" +
+	"PH-SCO\ten=South Cotabato\tfr=Cotabato Sud\tja=南コタバト\tzh-CN=南哥打巴托\n" +
+	"PH-SIG\ten=Siquijor\tzh-CN=锡基霍尔\n" +
+	"PH-SLE\ten=Southern Leyte\tfr=Leyte méridional\tja=南レイテ\tzh-CN=南莱特\n" +
+	"PH-SLU\ten=Sulu\tja=スールー\tzh-CN=苏禄\n" +
+	"PH-SOR\ten=Sorsogon\tzh-CN=索索贡\n" +
+	"PH-SUK\ten=Sultan Kudarat\tja=スルタンクダラット\tzh-CN=苏丹库达拉\n" +
+	"PH-SUN\ten=Surigao del Norte\tfr=Surigao du Nord\tja=北スリガオ\tzh-CN=北苏里高\n" +
+	"PH-SUR\ten=Surigao del Sur\tfr=Surigao du Sud\tja=南スリガオ\tzh-CN=南苏里高\n" +
+	"PH-TAR\ten=Tarlac\tzh-CN=打拉\n" +
+	"PH-TAW\ten=Tawi-Tawi\tzh-CN=塔威塔威\n" +
+	"PH-WSA\ten=Samar\n" +
+	"PH-ZAN\ten=Zamboanga del Norte\tfr=Zamboanga du Nord\tja=北サンボアンガ\tzh-CN=北三宝颜\n" +
+	"PH-ZAS\ten=Zamboanga del Sur\tfr=Zamboanga du Sud\tja=南サンボアンガ\tzh-CN=南三宝颜\n" +
+	"PH-ZMB\ten=Zambales\tzh-CN=三描礼士\n" +
+	"PH-ZSI\ten=Zamboanga Sibugay\tja=サンボアンガシブガイ\n" +
+	"PK-BA\tde=Belutschistan\ten=Balochistan\tfr=Baloutchistan\tja=バルチスターン\n" +
+	"PK-GB\ten=Gilgit-Baltistan\tja=ギルギットバルチスタン\n" +
+	"PK-IS\ten=Islamabad\tja=イスラマバード\tzh-CN=伊斯兰堡\n" +
+	"PK-JK\tde=Azad Jammu und Kashmir\ten=Azad Jammu and Kashmir\n" +
+	"PK-KP\ten=Khyber Pakhtunkhwa\tja=ハイバルパフトゥンハー\n" +
+	"PK-PB\ten=Punjab\tfr=Penjab\tja=パンジャーブ\tru=Пенджаб\tzh-CN=旁遮普邦\n" +
+	"PK-SD\ten=Sindh\tja=シンド\n" +
+	"PL-02\tde=Niederschlesien\ten=Dolnośląskie\tfr=Basse-Silésie\tja=ドルノシロンスク\tru=Нижнесилезское воеводство\tzh-CN=下西里西亚省\n" +
+	"PL-04\tde=Kujawien-Pommern\ten=Kujawsko-pomorskie\tfr=Cujavie-Poméranie\tja=クヤウスコポモジェ\tru=Куявско-Поморское воеводство\tzh-CN=库亚维-滨海省\n" +
+	"PL-06\tde=Lublin\ten=Lubelskie\tfr=Lublin\tru=Люблинское воеводство\tzh-CN=卢布林省\n" +
+	"PL-08\tde=Lebus\ten=Lubuskie\tfr=Lubusz\tja=ルブスコ\tru=Любушское воеводство\tzh-CN=卢布斯卡省\n" +
+	"PL-10\tde=Łódz\ten=Łódzkie\tfr=Łódź\tru=Лодзинское воеводство\tzh-CN=罗兹省\n" +
+	"PL-12\tde=Kleinpolen\ten=Małopolskie\tfr=Petite-Pologne\tja=マウォポルスカ\tru=Малопольское воеводство\tzh-CN=小波兰省\n" +
+	"PL-14\tde=Masowien\ten=Mazowieckie\tfr=Mazovie\tru=Мазовецкое воеводство\tzh-CN=马佐夫舍省\n" +
+	"PL-16\tde=Oppeln\ten=Opolskie\tfr=Opole (Silésie centrale)\tru=Опольское воеводство\tzh-CN=奥波莱省\n" +
+	"PL-18\tde=Karpatenvorland\ten=Podkarpackie\tfr=Basses-Carpates\tja=ポドカルパチ\tru=Подкарпатское воеводство\tzh-CN=喀尔巴阡山省\n" +
+	"PL-20\tde=Podlachien\ten=Podlaskie\tfr=Podlachie\tja=ポドラスカ\tru=Подляское воеводство\tzh-CN=波德拉谢省\n" +
+	"PL-22\tde=Pommern\ten=Pomorskie\tfr=Poméranie\tru=Поморское воеводство\tzh-CN=滨海省\n" +
+	"PL-24\tde=Schlesien\ten=Śląskie\tfr=Silésie (Haute-Silésie)\tru=Силезское воеводство\tzh-CN=西里西亚省\n" +
+	"PL-26\tde=Heiligkreuz\ten=Świętokrzyskie\tfr=Sainte-Croix\tru=Свентокшиское воеводство\tzh-CN=圣十字省\n" +
+	"PL-28\tde=Ermland-Masuren\ten=Warmińsko-mazurskie\tfr=Varmie-Mazurie\tja=ワルミンスコマズーリ\tru=Варминьско-Мазурское воеводство\tzh-CN=瓦尔米亚-马祖里省\n" +
+	"PL-30\tde=Großpolen\ten=Wielkopolskie\tfr=Grande-Pologne\tru=Великопольское воеводство\tzh-CN=大波兰省\n" +
+	"PL-32\tde=Westpommern\ten=Zachodniopomorskie\tfr=Poméranie occidentale\tja=ザホドニオポモジェ\tru=Западно-Поморское воеводство\tzh-CN=西滨海省\n" +
+	"PS-BTH\ten=Bethlehem\tja=ベツレヘム\tru=Вифлеем\n" +
+	"PS-DEB\tde=Dair al-Balah\ten=Deir El Balah\n" +
+	"PS-GZA\ten=Gaza\tja=ガザ\tzh-CN=加扎省\n" +
+	"PS-HBN\ten=Hebron\tfr=Hébron\tja=ヘブロン\tru=Хеврон\n" +
+	"PS-JEM\ten=Jerusalem\tfr=Jérusalem\tja=エルサレム\tru=Иерусалим\n" +
+	"PS-JEN\tde=Dschenin\ten=Jenin\tfr=Jénine\tru=Дженин\n" +
+	"PS-JRH\tde=Jericho und Al Aghwar\ten=Jericho and Al Aghwar\n" +
+	"PS-KYS\tde=Chan Yunis\ten=Khan Yunis\n" +
+	"PS-NBS\ten=Nablus\n" +
+	"PS-NGZ\tde=Nordgaza\ten=North Gaza\tfr=Gaza du Nord\n" +
+	"PS-QQA\ten=Qalqilya\tja=カルキリヤ\n" +
+	"PS-RBH\ten=Ramallah\tru=Рамалла\n" +
+	"PS-RFH\ten=Rafah\n" +
+	"PS-SLT\ten=Salfit\n" +
+	"PS-TBS\ten=Tubas\n" +
+	"PS-TKM\ten=Tulkarm\tfr=Tulkarem\n" +
+	"PT-01\ten=Aveiro\tja=アヴェイロ\tzh-CN=亚威罗\n" +
+	"PT-02\ten=Beja\tja=ベジャ\tzh-CN=贝雅\n" +
+	"PT-03\ten=Braga\tja=ブラガ\tzh-CN=布拉加\n" +
+	"PT-04\ten=Bragança\tja=ブラガンサ\n" +
+	"PT-05\ten=Castelo Branco\tja=カステロブランコ\tzh-CN=布朗库堡\n" +
+	"PT-06\ten=Coimbra\tja=コインブラ\tzh-CN=科英布拉\n" +
+	"PT-07\ten=Évora\tja=エヴォラ\n" +
+	"PT-08\ten=Faro\tja=ファロ\tzh-CN=法鲁\n" +
+	"PT-09\ten=Guarda\tja=グアルダ\tzh-CN=瓜达\n" +
+	"PT-10\ten=Leiria\tja=レイリア\tzh-CN=莱里亚\n" +
+	"PT-11\tde=Lissabon\ten=Lisboa\tfr=Lisbonne\tja=リスボン\tru=Лиссабон\tzh-CN=里斯本\n" +
+	"PT-12\ten=Portalegre\tja=ポルタレグレ\tzh-CN=波塔莱格雷\n" +
+	"PT-13\ten=Porto\tja=ポルト\tru=Порту\tzh-CN=波尔图\n" +
+	"PT-14\ten=Santarém\tja=サンタレン\tru=Сантарен\n" +
+	"PT-15\ten=Setúbal\tja=セトゥーヴァル\tru=Сетубал\n" +
+	"PT-16\ten=Viana do Castelo\tja=ビアナドカステロ\tru=Виана-ду-Каштелу\tzh-CN=维亚纳堡\n" +
+	"PT-17\ten=Vila Real\tja=ヴィラレアル\tru=Вила-Реал\tzh-CN=雷阿尔城\n" +
+	"PT-18\ten=Viseu\tja=ビゼウ\tzh-CN=维塞乌\n" +
+	"PT-20\tde=Autonome Region Azoren\ten=Região Autónoma dos Açores\tfr=Açores (région autonome)\n" +
+	"PT-30\tde=Autonome Region Madeira\ten=Região Autónoma da Madeira\tfr=Madère (région autonome)\n" +
+	"PW-002\ten=Aimeliik\tzh-CN=艾梅利克\n" +
+	"PW-004\ten=Airai\tzh-CN=艾拉伊\n" +
+	"PW-010\ten=Angaur\tja=アンガウル\tzh-CN=安加尔\n" +
+	"PW-050\ten=Hatohobei\n" +
+	"PW-100\ten=Kayangel\tzh-CN=卡扬埃尔\n" +
+	"PW-150\ten=Koror\tja=コロール\tzh-CN=科罗尔\n" +
+	"PW-212\ten=Melekeok\tzh-CN=梅莱凯奥克\n" +
+	"PW-214\ten=Ngaraard\tzh-CN=雅拉尔德\n" +
+	"PW-218\ten=Ngarchelong\tzh-CN=雅切隆\n" +
+	"PW-222\ten=Ngardmau\tzh-CN=雅德马乌\n" +
+	"PW-224\ten=Ngatpang\tzh-CN=雅庞\n" +
+	"PW-226\ten=Ngchesar\tzh-CN=恩切萨尔\n" +
+	"PW-227\ten=Ngeremlengui\tzh-CN=埃雷姆伦维\n" +
+	"PW-228\ten=Ngiwal\tzh-CN=宜瓦尔\n" +
+	"PW-350\ten=Peleliu\tja=ペリリュー\tzh-CN=贝里琉\n" +
+	"PW-370\ten=Sonsorol\tzh-CN=松索罗尔\n" +
+	"PY-1\ten=Concepción\tja=コンセプシオン\tru=Консепсьон\n" +
+	"PY-10\ten=Alto Paraná\tfr=Haut-Paraná\tja=アルトパラナ\tru=Альто-Парана\n" +
+	"PY-11\ten=Central\tja=セントラル\tzh-CN=中部\n" +
+	"PY-12\ten=Ñeembucú\tja=ニェエンプケ\tru=Ньеэмбуку\n" +
+	"PY-13\ten=Amambay\tja=アマンバイ\tru=Амамбай\tzh-CN=阿曼拜省\n" +
+	"PY-14\ten=Canindeyú\tja=カネンディユ\tru=Канендию\n" +
+	"PY-15\ten=Presidente Hayes\tja=プレジデンテアイエス\tru=Пресиденте-Аес\tzh-CN=阿耶斯总统省\n" +
+	"PY-16\ten=Alto Paraguay\tfr=Haut-Paraguay\tja=アルトパラグアイ\tru=Альто-Парагвай\tzh-CN=上巴拉圭省\n" +
+	"PY-19\ten=Boquerón\tja=ボケロン\n" +
+	"PY-2\ten=San Pedro\tja=サンペドロ\tru=Сан-Педро\tzh-CN=圣佩德罗省\n" +
+	"PY-3\ten=Cordillera\tfr=Cordillère\tja=コルディエラ\tzh-CN=科迪勒拉省\n" +
+	"PY-4\ten=Guairá\tja=グアイラ\n" +
+	"PY-5\ten=Caaguazú\tja=カーグア\n" +
+	"PY-6\ten=Caazapá\tja=カアサパ\n" +
+	"PY-7\ten=Itapúa\tja=イタプア\tru=Итапуа\n" +
+	"PY-8\ten=Misiones\tja=ミシオネス\tru=Мисьонес\tzh-CN=米西奥内斯省\n" +
+	"PY-9\ten=Paraguarí\tja=パラグアリ\tru=Парагуари\n" +
+	"PY-ASU\ten=Asunción\tja=アスンシオン\tru=Асунсьон\n" +
+	"QA-DA\ten=Ad Dawḩah\n" +
+	"QA-KH\ten=Al Khawr wa adh Dhakhīrah\n" +
+	"QA-MS\ten=Ash Shamāl\n" +
+	"QA-RA\ten=Ar Rayyān\n" +
+	"QA-SH\ten=Ash Shīḩānīyah\n" +
+	"QA-US\ten=Umm Şalāl\n" +
+	"QA-WA\tde=al-Wakra\ten=Al Wakrah\tja=ワクラ\tzh-CN=沃克拉\n" +
+	"QA-ZA\ten=Az̧ Z̧a‘āyin\n" +
+	"RO-AB\ten=Alba\tja=アルバ\tzh-CN=阿尔巴\n" +
+	"RO-AG\ten=Argeș\tja=アルジェシュ\n" +
+	"RO-AR\ten=Arad\tja=アラド\tzh-CN=阿拉德\n" +
+	"RO-B\tde=Bukarest\ten=București\tfr=Bucarest\tja=ブカレスト\n" +
+	"RO-BC\ten=Bacău\tja=バカウ\n" +
+	"RO-BH\ten=Bihor\tja=ビホル\tzh-CN=比霍尔\n" +
+	"RO-BN\ten=Bistrița-Năsăud\tja=ビストリツァナサウド\n" +
+	"RO-BR\ten=Brăila\tja=ブライラ\n" +
+	"RO-BT\ten=Botoșani\tja=ボトシャニ\n" +
+	"RO-BV\ten=Brașov\tja=ブラショブ\n" +
+	"RO-BZ\ten=Buzău\tja=ブザウ\n" +
+	"RO-CJ\ten=Cluj\tja=クルジュ\tzh-CN=克鲁日\n" +
+	"RO-CL\ten=Călărași\n" +
+	"RO-CS\ten=Caraș-Severin\tja=カラシュセベリン\n" +
+	"RO-CT\ten=Constanța\tja=コンスタンツァ\n" +
+	"RO-CV\ten=Covasna\tja=コバスナ\tzh-CN=科瓦斯纳\n" +
+	"RO-DB\ten=Dâmbovița\tja=ドゥンボビツァ\n" +
+	"RO-DJ\ten=Dolj\tja=ドルジュ\tzh-CN=多尔日\n" +
+	"RO-GJ\ten=Gorj\tja=ゴルジュ\tzh-CN=戈尔日\n" +
+	"RO-GL\ten=Galați\tja=ガラツィ\n" +
+	"RO-GR\ten=Giurgiu\tja=ジュルジュ\tzh-CN=朱尔朱\n" +
+	"RO-HD\ten=Hunedoara\tja=フネドアラ\tzh-CN=洪尼多阿拉\n" +
+	"RO-HR\ten=Harghita\tja=ハルギータ\tzh-CN=哈尔吉塔\n" +
+	"RO-IF\ten=Ilfov\tzh-CN=伊尔福夫\n" +
+	"RO-IL\ten=Ialomița\tja=ヤロミツァ\n" +
+	"RO-IS\ten=Iași\tja=ヤシ\n" +
+	"RO-MH\ten=Mehedinți\tja=メヘディンツ\n" +
+	"RO-MM\ten=Maramureș\tja=マラムレシュ\n" +
+	"RO-MS\ten=Mureș\tja=ムレシュ\n" +
+	"RO-NT\ten=Neamț\tja=ネアムツ\n" +
+	"RO-OT\ten=Olt\tja=オルト\tzh-CN=奥尔特\n" +
+	"RO-PH\ten=Prahova\tja=プラホバ\tzh-CN=普拉霍瓦\n" +
+	"RO-SB\ten=Sibiu\tja=シビウ\tzh-CN=锡比乌\n" +
+	"RO-SJ\ten=Sălaj\tja=サラジュ\n" +
+	"RO-SM\ten=Satu Mare\tja=サトゥマーレ\tzh-CN=萨图·马雷\n" +
+	"RO-SV\ten=Suceava\tja=スチャバ\tzh-CN=苏恰瓦\n" +
+	"RO-TL\ten=Tulcea\tja=トゥルチャ\tzh-CN=图尔恰\n" +
+	"RO-TM\ten=Timiș\tja=ティミシュ\n" +
+	"RO-TR\ten=Teleorman\tja=テレオルマン\tzh-CN=特列奥尔曼\n" +
+	"RO-VL\ten=Vâlcea\tja=バルチャ\n" +
+	"RO-VN\ten=Vrancea\tja=ブランチャ\tzh-CN=弗郎恰\n" +
+	"RO-VS\ten=Vaslui\tja=バスルイ\tzh-CN=瓦斯鲁伊\n" +
+	"RS-00\tde=Belgrad\ten=Beograd\tfr=Belgrade\tru=Белград\tzh-CN=贝尔格莱德\n" +
+	"RS-01\tde=Bezirk Severna Bačka\ten=Severnobački okrug\tfr=Bačka septentrionale\tru=Северно-Бачский округ\n" +
+	"RS-02\tde=Bezirk Srednji Banat\ten=Srednjebanatski okrug\tfr=Banatčka méridionale\tru=Средне-Банатский округ\n" +
+	"RS-03\tde=Bezirk Severni Banat\ten=Severnobanatski okrug\tfr=Banatčka septentrionale\tru=Северно-Банатский округ\n" +
+	"RS-04\tde=Bezirk Južni Bačka\ten=Južnobanatski okrug\tfr=Južnobanat\tru=Южно-Банатский округ\n" +
+	"RS-05\tde=Bezirk Zapadna Bačka\ten=Zapadnobački okrug\tfr=Bačka occidentale\tru=Западно-Бачский округ\n" +
+	"RS-06\tde=Bezirk Južna Bačka\ten=Južnobački okrug\tfr=Južnobač\tru=Южно-Бачский округ\n" +
+	"RS-07\tde=Bezirk Srem\ten=Sremski okrug\tfr=Srem\tru=Сремский округ\n" +
+	"RS-08\tde=Bezirk Mačvan\ten=Mačvanski okrug\tfr=Mačvan\tru=Мачванский округ\n" +
+	"RS-09\tde=Bezirk Kolubara\ten=Kolubarski okrug\tfr=Kolubara\tru=Колубарский округ\n" +
+	"RS-10\tde=Bezirk Podunavlje\ten=Podunavski okrug\tfr=Podunavlje\tru=Подунайский округ\n" +
+	"RS-11\tde=Bezirk Braničevo\ten=Braničevski okrug\tfr=Braničevo\tru=Браничевский округ\n" +
+	"RS-12\tde=Bezirk Šumadija\ten=Šumadijski okrug\tfr=Šumadija\tru=Шумадийский округ\n" +
+	"RS-13\tde=Bezirk Pomoravlje\ten=Pomoravski okrug\tfr=Pomoravlje\tru=Поморавский округ\n" +
+	"RS-14\tde=Bezirk Bor\ten=Borski okrug\tfr=Bor\tru=Борский округ\n" +
+	"RS-15\tde=Bezirk Zaječar\ten=Zaječarski okrug\tfr=Zaječar\tru=Заечарский округ\n" +
+	"RS-16\tde=Bezirk Zlatibor\ten=Zlatiborski okrug\tfr=Zlatibor\tru=Златиборский округ\n" +
+	"RS-17\tde=Bezirk Moraviča\ten=Moravički okrug\tfr=Moraviča\tru=Моравичский округ\n" +
+	"RS-18\tde=Bezirk Raška\ten=Raški okrug\tfr=Raška\tru=Рашский округ\n" +
+	"RS-19\tde=Bezirk Rasina\ten=Rasinski okrug\tfr=Rasina\tru=Расинский округ\n" +
+	"RS-20\tde=Bezirk Nišava\ten=Nišavski okrug\tfr=Nišava\tru=Нишавский округ\n" +
+	"RS-21\tde=Bezirk Toplica\ten=Toplički okrug\tfr=Topliča\tru=Топличский округ\n" +
+	"RS-22\tde=Bezirk Pirot\ten=Pirotski okrug\tfr=Pirot\tru=Пиротский округ\n" +
+	"RS-23\tde=Bezirk Jablaniča\ten=Jablanički okrug\tfr=Jablaniča\tru=Ябланичский округ\n" +
+	"RS-24\tde=Bezirk Pčinja\ten=Pčinjski okrug\tfr=Pčinja\tru=Пчиньский округ\n" +
+	"RS-25\tde=Bezirk Kosovo\ten=Kosovski okrug\tfr=Kosovo\tru=Косовский округ\n" +
+	"RS-26\tde=Bezirk Peć\ten=Pećki okrug\tfr=Peć\tru=Печский округ\n" +
+	"RS-27\tde=Bezirk Prizren\ten=Prizrenski okrug\tfr=Prizren\tru=Призренский округ\n" +
+	"RS-28\tde=Bezirk Kosovska Mitrovica\ten=Kosovsko-Mitrovački okrug\tfr=Kosovo Mitroviča\n" +
+	"RS-29\tde=Bezirk Kosovo-Pomoravlje\ten=Kosovsko-Pomoravski okrug\tfr=Kosovo-Pomoravlje\n" +
+	"RS-KM\tde=Kosovo\ten=Kosovo-Metohija\tfr=Kosovo\tja=コソボ-Metohija\tzh-CN=科索沃-梅托西亚\n" +
+	"RS-VO\ten=Vojvodina\tfr=Voïvodine\tja=ヴォイヴォディナ\tru=Воеводина\tzh-CN=沃伊伏丁那\n" +
+	"RU-AD\ten=Adygeja, Respublika\tru=Республика Адыгея\n" +
+	"RU-AL\ten=Altaj, Respublika\tru=Республика Алтай\n" +
+	"RU-ALT\ten=Altajskij kraj\tru=Алтайский край\n" +
+	"RU-AMU\ten=Amurskaja oblast'\tru=Амурская область\n" +
+	"RU-ARK\ten=Arhangel'skaja oblast'\tru=Архангельская область\n" +
+	"RU-AST\ten=Astrahanskaja oblast'\tru=Астраханская область\n" +
+	"RU-BA\tde=Republik Baschkortostan\ten=Bashkortostan, Respublika\tfr=Bachkirie\tru=Республика Башкортостан\n" +
+	"RU-BEL\ten=Belgorodskaja oblast'\tru=Белгородская область\n" +
+	"RU-BRY\ten=Brjanskaja oblast'\tru=Брянская область\n" +
+	"RU-BU\ten=Burjatija, Respublika\tru=Республика Бурятия\n" +
+	"RU-CE\tde=Republik Tschetschenien\ten=Chechenskaya Respublika\tfr=Tchétchénie\tja=チェチェン共和国\tru=Чеченская республика\tzh-CN=车臣共和国\n" +
+	"RU-CHE\tde=Oblast Tscheljabinsk\ten=Chelyabinskaya oblast'\tfr=Tchéliabinsk\tru=Челябинская область\n" +
+	"RU-CHU\tde=Autonomer Kreis Tschuktschen\ten=Chukotskiy avtonomnyy okrug\tfr=Tchoukotka\tru=Чукотский автономный округ\tzh-CN=楚科奇自治区\n" +
+	"RU-CU\tde=Republik Tschuwaschien\ten=Chuvashskaya Respublika\tfr=Tchouvachie\tru=Чувашская республика\tzh-CN=楚瓦什共和国\n" +
+	"RU-DA\tde=Republik Dagestan\ten=Dagestan, Respublika\tfr=Daguestan\tja=ダゲスタン共和国\tru=Республика Дагестан\tzh-CN=达吉斯坦共和国\n" +
+	"RU-IN\ten=Ingushetiya, Respublika\tru=Республика Ингушетия\n" +
+	"RU-IRK\ten=Irkutskaja oblast'\tru=Иркутская область\n" +
+	"RU-IVA\ten=Ivanovskaja oblast'\tru=Ивановская область\n" +
+	"RU-KAM\tde=Region Kamtschatka\ten=Kamchatskiy kray\tfr=Kamtchatka\tja=カムチャツカ\tru=Камчатский край\n" +
+	"RU-KB\ten=Kabardino-Balkarskaja Respublika\tru=Кабардино-Балкарская республика\n" +
+	"RU-KC\tde=Republik Karatschai-Tscherkessien\ten=Karachayevo-Cherkesskaya Respublika\tfr=Karatchaïévo-Tcherkessie\tja=カラチャイチェルケス共和国\tru=Карачаево-Черкесская республика\n" +
+	"RU-KDA\ten=Krasnodarskij kraj\tru=Краснодарский край\n" +
+	"RU-KEM\ten=Kemerovskaja oblast'\tru=Кемеровская область\n" +
+	"RU-KGD\ten=Kaliningradskaja oblast'\tru=Калининградская область\n" +
+	"RU-KGN\ten=Kurganskaja oblast'\tru=Курганская область\n" +
+	"RU-KHA\ten=Habarovskij kraj\tru=Хабаровский край\n" +
+	"RU-KHM\ten=Hanty-Mansijskij avtonomnyj okrug\tru=Ханты-Мансийский автономный округ\n" +
+	"RU-KIR\ten=Kirovskaja oblast'\tru=Кировская область\n" +
+	"RU-KK\ten=Hakasija, Respublika\tru=Республика Хакассия\n" +
+	"RU-KL\ten=Kalmykija, Respublika\tru=Республика Калмыкия\n" +
+	"RU-KLU\tde=Oblast Kaluga\ten=Kaluzhskaya oblast'\tfr=Kalouga\tru=Калужская область\tzh-CN=卡卢加州\n" +
+	"RU-KO\tde=Republik Komi\ten=Komi, Respublika\tfr=Komis\tja=コミ共和国\tru=Республика Коми\tzh-CN=科米共和国\n" +
+	"RU-KOS\ten=Kostromskaja oblast'\tru=Костромская область\n" +
+	"RU-KR\ten=Karelija, Respublika\tru=Республика Карелия\n" +
+	"RU-KRS\ten=Kurskaja oblast'\tru=Курская область\n" +
+	"RU-KYA\ten=Krasnojarskij kraj\tru=Красноярский край\n" +
+	"RU-LEN\ten=Leningradskaja oblast'\tru=Ленинградская область\n" +
+	"RU-LIP\ten=Lipeckaja oblast'\tru=Липецкая область\n" +
+	"RU-MAG\ten=Magadanskaja oblast'\tru=Магаданская область\n" +
+	"RU-ME\ten=Marij Èl, Respublika\tru=Республика Марий Эл\n" +
+	"RU-MO\ten=Mordovija, Respublika\tru=Республика Мордовия\n" +
+	"RU-MOS\ten=Moskovskaja oblast'\tru=Московская область\n" +
+	"RU-MOW\tde=Moskau\ten=Moskva\tfr=Moscou\tru=Москва\tzh-CN=莫斯科\n" +
+	"RU-MUR\ten=Murmanskaja oblast'\tru=Мурманская область\n" +
+	"RU-NEN\ten=Neneckij avtonomnyj okrug\tru=Ненецкий автономный округ\n" +
+	"RU-NGR\ten=Novgorodskaja oblast'\tru=Новгородская область\n" +
+	"RU-NIZ\tde=Oblast Nischni Nowgorod\ten=Nizhegorodskaya oblast'\tfr=Nijni Novgorod\tru=Нижегородская область\tzh-CN=下诺夫哥罗德州\n" +
+	"RU-NVS\ten=Novosibirskaja oblast'\tru=Новосибирская область\n" +
+	"RU-OMS\ten=Omskaja oblast'\tru=Омская область\n" +
+	"RU-ORE\ten=Orenburgskaja oblast'\tru=Оренбургская область\n" +
+	"RU-ORL\ten=Orlovskaja oblast'\tru=Орловская область\n" +
+	"RU-PER\ten=Permskij kraj\tru=Пермский край\n" +
+	"RU-PNZ\ten=Penzenskaja oblast'\tru=Пензенская область\n" +
+	"RU-PRI\ten=Primorskij kraj\tru=Приморский край\n" +
+	"RU-PSK\ten=Pskovskaja oblast'\tru=Псковская область\n" +
+	"RU-ROS\ten=Rostovskaja oblast'\tru=Ростовская область\n" +
+	"RU-RYA\ten=Rjazanskaja oblast'\tru=Рязанская область\n" +
+	"RU-SA\ten=Saha, Respublika\tru=Республика Саха\n" +
+	"RU-SAK\ten=Sahalinskaja oblast'\tru=Сахалинская область\n" +
+	"RU-SAM\ten=Samarskaja oblast'\tru=Самарская область\n" +
+	"RU-SAR\ten=Saratovskaja oblast'\tru=Саратовская область\n" +
+	"RU-SE\ten=Severnaja Osetija, Respublika\tru=Республика Северная Осетия\n" +
+	"RU-SMO\ten=Smolenskaja oblast'\tru=Смоленская область\n" +
+	"RU-SPE\tde=Sankt Peterburg\ten=Sankt-Peterburg\tfr=Saint-Pétersbourg\tru=Санкт-Петербург\tzh-CN=圣彼得堡\n" +
+	"RU-STA\ten=Stavropol'skij kraj\tru=Ставропольский край\n" +
+	"RU-SVE\ten=Sverdlovskaja oblast'\tru=Свердловская область\n" +
+	"RU-TA\tde=Republik Tatarstan\ten=Tatarstan, Respublika\tfr=Tatarstan\tru=Республика Татарстан\tzh-CN=鞑靼斯坦共和国\n" +
+	"RU-TAM\ten=Tambovskaja oblast'\tru=Тамбовская область\n" +
+	"RU-TOM\ten=Tomskaja oblast'\tru=Томская область\n" +
+	"RU-TUL\ten=Tul'skaja oblast'\tru=Тульская область\n" +
+	"RU-TVE\ten=Tverskaja oblast'\tru=Тверская область\n" +
+	"RU-TY\ten=Tyva, Respublika\tru=Республика Тува\n" +
+	"RU-TYU\ten=Tjumenskaja oblast'\tru=Тюменская область\n" +
+	"RU-UD\ten=Udmurtskaja Respublika\tru=Республика Удмуртия\n" +
+	"RU-ULY\ten=Ul'janovskaja oblast'\tru=Ульяновская область\n" +
+	"RU-VGG\ten=Volgogradskaja oblast'\tru=Волгоградская область\n" +
+	"RU-VLA\ten=Vladimirskaja oblast'\tru=Владимирская область\n" +
+	"RU-VLG\ten=Vologodskaja oblast'\tru=Вологодская область\n" +
+	"RU-VOR\tde=Oblast Woronesch\ten=Voronezhskaya oblast'\tfr=Voronej\tru=Воронежская область\tzh-CN=沃罗涅日州\n" +
+	"RU-YAN\ten=Jamalo-Neneckij avtonomnyj okrug\tru=Ямало-Ненецкий автономный округ\n" +
+	"RU-YAR\ten=Jaroslavskaja oblast'\tru=Ярославская область\n" +
+	"RU-YEV\ten=Evrejskaja avtonomnaja oblast'\tru=Еврейская автономная область\n" +
+	"RU-ZAB\tde=Region Transbaikalien\ten=Zabajkal'skij kraj\tfr=Transbaïkalie\tru=Забайкальский край\n" +
+	"RW-01\ten=City of Kigali\n" +
+	"RW-02\ten=Eastern\tfr=Est\tzh-CN=东部区\n" +
+	"RW-03\ten=Northern\tfr=Nord\tzh-CN=北部区\n" +
+	"RW-04\ten=Western\tfr=Ouest\tzh-CN=西部区\n" +
+	"RW-05\tde=Süd\ten=Southern\tfr=Sud\n" +
+	"SA-01\ten=Ar Riyāḑ\tru=Эр-Рияд\n" +
+	"SA-02\ten=Makkah al Mukarramah\n" +
+	"SA-03\ten=Al Madīnah al Munawwarah\n" +
+	"SA-04\ten=Ash Sharqīyah\tfr=Ash Sharqiyah\tru=Эш-Шаркия\n" +
+	"SA-05\tde=Qasim\ten=Al Qaşīm\tja=カシーム\tru=Эль-Касим\n" +
+	"SA-06\ten=Ḩā'il\n" +
+	"SA-07\ten=Tabūk\tja=タブーク\n" +
+	"SA-08\ten=Al Ḩudūd ash Shamālīyah\tru=Эль-Худуд-эш-Шамалия\n" +
+	"SA-09\ten=Jāzān\n" +
+	"SA-10\tde=Nadschran\ten=Najrān\tja=ナジュラーン\tru=Наджран\n" +
+	"SA-11\ten=Al Bāḩah\n" +
+	"SA-12\tde=Dschauf\ten=Al Jawf\tja=ジャウフ\n" +
+	"SA-14\ten='Asīr\n" +
+	"SB-CE\ten=Central\tja=セントラル\tzh-CN=中部\n" +
+	"SB-CH\ten=Choiseul\tja=チョイセル\n" +
+	"SB-CT\tde=Hauptstadt (Honiara)\ten=Capital Territory (Honiara)\tfr=Honiara (territoire de la capitale)\tzh-CN=首都直辖区 (霍尼亚拉)\n" +
+	"SB-GU\ten=Guadalcanal\tja=ガダルカナル\tru=Гуадалканал\tzh-CN=瓜达尔卡纳尔\n" +
+	"SB-IS\ten=Isabel\tru=Изабелла\tzh-CN=伊萨贝尔\n" +
+	"SB-MK\ten=Makira-Ulawa\tru=Макира-Улава\n" +
+	"SB-ML\ten=Malaita\tja=マライタ\tzh-CN=马莱塔\n" +
+	"SB-RB\tde=Rennell und Bellona\ten=Rennell and Bellona\tfr=Rennell et Bellona\tru=Реннелл и Беллона\tzh-CN=拉纳尔和贝罗纳\n" +
+	"SB-TE\ten=Temotu\tzh-CN=泰莫图\n" +
+	"SB-WE\ten=Western\tfr=Ouest\tzh-CN=西部区\n" +
+	"SC-01\ten=Anse aux Pins\n" +
+	"SC-02\ten=Anse Boileau\n" +
+	"SC-03\ten=Anse Etoile\tfr=Anse Étoile\n" +
+	"SC-04\ten=Au Cap\n" +
+	"SC-05\ten=Anse Royale\n" +
+	"SC-06\ten=Baie Lazare\n" +
+	"SC-07\ten=Baie Sainte Anne\n" +
+	"SC-08\ten=Beau Vallon\n" +
+	"SC-09\ten=Bel Air\n" +
+	"SC-10\ten=Bel Ombre\n" +
+	"SC-11\ten=Cascade\n" +
+	"SC-12\ten=Glacis\n" +
+	"SC-13\ten=Grand Anse Mahe\tfr=Grand'Anse Mahé\n" +
+	"SC-14\ten=Grand Anse Praslin\tfr=Grand'Anse Praslin\n" +
+	"SC-15\ten=La Digue\n" +
+	"SC-16\ten=English River\tfr=La Rivière Anglaise\n" +
+	"SC-17\ten=Mont Buxton\n" +
+	"SC-18\ten=Mont Fleuri\n" +
+	"SC-19\ten=Plaisance\n" +
+	"SC-20\ten=Pointe Larue\tfr=Pointe La Rue\n" +
+	"SC-21\ten=Port Glaud\n" +
+	"SC-22\ten=Saint Louis\n" +
+	"SC-23\ten=Takamaka\n" +
+	"SC-24\ten=Les Mamelles\n" +
+	"SC-25\ten=Roche Caiman\tfr=Roche Caïman\n" +
+	"SC-26\ten=Ile Perseverance I\n" +
+	"SC-27\ten=Ile Perseverance II\n" +
+	"SD-DC\ten=Central Darfur\n" +
+	"SD-DE\ten=East Darfur\n" +
+	"SD-DN\ten=North Darfur\n" +
+	"SD-DS\ten=South Darfur\n" +
+	"SD-DW\ten=West Darfur\n" +
+	"SD-GD\ten=Gedaref\n" +
+	"SD-GK\ten=West Kordofan\n" +
+	"SD-GZ\ten=Gezira\n" +
+	"SD-KA\ten=Kassala\n" +
+	"SD-KH\ten=Khartoum\n" +
+	"SD-KN\ten=North Kordofan\n" +
+	"SD-KS\ten=South Kordofan\n" +
+	"SD-NB\ten=Blue Nile\n" +
+	"SD-NO\ten=Northern\tfr=Nord\tzh-CN=北部区\n" +
+	"SD-NR\ten=River Nile\n" +
+	"SD-NW\ten=White Nile\n" +
+	"SD-RS\ten=Red Sea\n" +
+	"SD-SI\ten=Sennar\n" +
+	"SE-AB\ten=Stockholms län [SE-01]\n" +
+	"SE-AC\ten=Västerbottens län [SE-24]\n" +
+	"SE-BD\ten=Norrbottens län [SE-25]\n" +
+	"SE-C\ten=Uppsala län [SE-03]\n" +
+	"SE-D\ten=Södermanlands län [SE-04]\n" +
+	"SE-E\ten=Östergötlands län [SE-05]\n" +
+	"SE-F\ten=Jönköpings län [SE-06]\n" +
+	"SE-G\ten=Kronobergs län [SE-07]\n" +
+	"SE-H\ten=Kalmar län [SE-08]\n" +
+	"SE-I\ten=Gotlands län [SE-09]\n" +
+	"SE-K\ten=Blekinge län [SE-10]\n" +
+	"SE-M\ten=Skåne län [SE-12]\n" +
+	"SE-N\ten=Hallands län [SE-13]\n" +
+	"SE-O\ten=Västra Götalands län [SE-14]\n" +
+	"SE-S\ten=Värmlands län [SE-17]\n" +
+	"SE-T\ten=Örebro län [SE-18]\n" +
+	"SE-U\ten=Västmanlands län [SE-19]\n" +
+	"SE-W\ten=Dalarnas län [SE-20]\n" +
+	"SE-X\ten=Gävleborgs län [SE-21]\n" +
+	"SE-Y\ten=Västernorrlands län [SE-22]\n" +
+	"SE-Z\ten=Jämtlands län [SE-23]\n" +
+	"SG-01\tde=Zentral Singapur\ten=Central Singapore\tfr=Singapour centre\n" +
+	"SG-02\tde=Nordost\ten=North East\tfr=Nord-Est\n" +
+	"SG-03\tde=Nordwest\ten=North West\tfr=Nord-Ouest\n" +
+	"SG-04\tde=Südost\ten=South East\tfr=Sud-Est\n" +
+	"SG-05\tde=Südwest\ten=South West\tfr=Sud-Ouest\n" +
+	"SH-AC\ten=Ascension\n" +
+	"SH-HL\ten=Saint Helena\tfr=Sainte-Hélène\n" +
+	"SH-TA\ten=Tristan da Cunha\n" +
+	"SI-001\ten=Ajdovščina\tzh-CN=阿伊多夫什契纳\n" +
+	"SI-002\ten=Beltinci\tzh-CN=贝尔廷齐\n" +
+	"SI-003\ten=Bled\tzh-CN=不莱德\n" +
+	"SI-004\ten=Bohinj\tzh-CN=博希尼\n" +
+	"SI-005\ten=Borovnica\tzh-CN=博罗夫尼察\n" +
+	"SI-006\ten=Bovec\tzh-CN=博韦茨\n" +
+	"SI-007\ten=Brda\tzh-CN=布尔达\n" +
+	"SI-008\ten=Brezovica\tzh-CN=布罗佐维察\n" +
+	"SI-009\ten=Brežice\tzh-CN=布雷日采\n" +
+	"SI-010\ten=Tišina\tzh-CN=蒂希纳\n" +
+	"SI-011\ten=Celje\tzh-CN=采列\n" +
+	"SI-012\ten=Cerklje na Gorenjskem\tzh-CN=戈雷尼斯卡地区采尔克列\n" +
+	"SI-013\ten=Cerknica\tzh-CN=采尔克尼察\n" +
+	"SI-014\ten=Cerkno\tzh-CN=采尔克诺\n" +
+	"SI-015\ten=Črenšovci\tzh-CN=奇伦绍夫奇\n" +
+	"SI-016\ten=Črna na Koroškem\tzh-CN=科罗什卡地区奇尔纳\n" +
+	"SI-017\ten=Črnomelj\tzh-CN=奇尔诺梅利\n" +
+	"SI-018\ten=Destrnik\tzh-CN=代斯特尔尼克-特尔诺夫斯克村\n" +
+	"SI-019\ten=Divača\tzh-CN=迪瓦查\n" +
+	"SI-020\ten=Dobrepolje\tzh-CN=多布雷波列\n" +
+	"SI-021\ten=Dobrova-Polhov Gradec\tzh-CN=多布罗瓦-霍尔尤尔-波尔霍夫格拉代茨\n" +
+	"SI-022\ten=Dol pri Ljubljani\tzh-CN=卢布尔雅那附近多尔\n" +
+	"SI-023\ten=Domžale\tzh-CN=多姆扎莱\n" +
+	"SI-024\ten=Dornava\tzh-CN=多尔纳瓦\n" +
+	"SI-025\ten=Dravograd\tzh-CN=德拉沃格勒\n" +
+	"SI-026\ten=Duplek\tzh-CN=杜普莱克\n" +
+	"SI-027\ten=Gorenja vas-Poljane\tzh-CN=戈雷尼亚村-波利亚内\n" +
+	"SI-028\ten=Gorišnica\tzh-CN=戈里什尼察\n" +
+	"SI-029\ten=Gornja Radgona\tzh-CN=上拉德戈纳\n" +
+	"SI-030\ten=Gornji Grad\tzh-CN=戈尔尼格勒\n" +
+	"SI-031\ten=Gornji Petrovci\tzh-CN=上彼得罗夫齐\n" +
+	"SI-032\ten=Grosuplje\tzh-CN=格罗苏普列\n" +
+	"SI-033\ten=Šalovci\tzh-CN=沙洛夫齐\n" +
+	"SI-034\ten=Hrastnik\tzh-CN=赫拉斯特尼克\n" +
+	"SI-035\ten=Hrpelje-Kozina\tzh-CN=赫尔佩列-科济纳\n" +
+	"SI-036\ten=Idrija\tzh-CN=伊德里亚\n" +
+	"SI-037\ten=Ig\tzh-CN=伊格\n" +
+	"SI-038\ten=Ilirska Bistrica\tzh-CN=伊利尔斯卡比斯特里察\n" +
+	"SI-039\ten=Ivančna Gorica\tzh-CN=伊万奇纳戈里察\n" +
+	"SI-040\ten=Izola\n" +
+	"SI-041\ten=Jesenice\tzh-CN=耶塞尼采\n" +
+	"SI-042\ten=Juršinci\tzh-CN=尤尔欣齐\n" +
+	"SI-043\ten=Kamnik\tzh-CN=卡姆尼克\n" +
+	"SI-044\ten=Kanal\tzh-CN=卡纳尔\n" +
+	"SI-045\ten=Kidričevo\tzh-CN=基德里切沃\n" +
+	"SI-046\ten=Kobarid\tzh-CN=科巴里德\n" +
+	"SI-047\ten=Kobilje\tzh-CN=科比列\n" +
+	"SI-048\ten=Kočevje\n" +
+	"SI-049\ten=Komen\tzh-CN=科门\n" +
+	"SI-050\ten=Koper\n" +
+	"SI-051\ten=Kozje\tzh-CN=科济耶\n" +
+	"SI-052\ten=Kranj\tzh-CN=克拉尼\n" +
+	"SI-053\ten=Kranjska Gora\tzh-CN=克拉尼斯卡戈拉\n" +
+	"SI-054\ten=Krško\tzh-CN=克尔什科\n" +
+	"SI-055\ten=Kungota\tzh-CN=昆戈塔\n" +
+	"SI-056\ten=Kuzma\tzh-CN=库兹马\n" +
+	"SI-057\ten=Laško\tzh-CN=拉什科\n" +
+	"SI-058\ten=Lenart\tzh-CN=来纳尔特\n" +
+	"SI-059\ten=Lendava\n" +
+	"SI-060\ten=Litija\tzh-CN=利蒂亚\n" +
+	"SI-061\ten=Ljubljana\tzh-CN=卢布尔雅那\n" +
+	"SI-062\ten=Ljubno\tzh-CN=柳布诺\n" +
+	"SI-063\ten=Ljutomer\tzh-CN=柳托梅尔\n" +
+	"SI-064\ten=Logatec\tzh-CN=洛加泰茨\n" +
+	"SI-065\ten=Loška dolina\tzh-CN=洛什卡多利纳\n" +
+	"SI-066\ten=Loški Potok\tzh-CN=洛什基波托克\n" +
+	"SI-067\ten=Luče\tzh-CN=卢切\n" +
+	"SI-068\ten=Lukovica\tzh-CN=卢科维察\n" +
+	"SI-069\ten=Majšperk\tzh-CN=马伊什佩克\n" +
+	"SI-070\ten=Maribor\tzh-CN=马里博尔\n" +
+	"SI-071\ten=Medvode\tzh-CN=梅德沃代\n" +
+	"SI-072\ten=Mengeš\tzh-CN=门盖什\n" +
+	"SI-073\ten=Metlika\tzh-CN=梅特利卡\n" +
+	"SI-074\ten=Mežica\tzh-CN=梅日察\n" +
+	"SI-075\ten=Miren-Kostanjevica\tzh-CN=米伦-科斯塔涅维察\n" +
+	"SI-076\ten=Mislinja\tzh-CN=米斯利尼亚\n" +
+	"SI-077\ten=Moravče\tzh-CN=摩拉夫切\n" +
+	"SI-078\ten=Moravske Toplice\tzh-CN=摩拉瓦-托普利采\n" +
+	"SI-079\ten=Mozirje\tzh-CN=莫济列\n" +
+	"SI-080\ten=Murska Sobota\tzh-CN=穆尔斯卡索博塔\n" +
+	"SI-081\ten=Muta\tzh-CN=穆塔\n" +
+	"SI-082\ten=Naklo\tzh-CN=纳克洛\n" +
+	"SI-083\ten=Nazarje\tzh-CN=纳扎列\n" +
+	"SI-084\ten=Nova Gorica\tzh-CN=新戈里察\n" +
+	"SI-085\ten=Novo Mesto\n" +
+	"SI-086\ten=Odranci\n" +
+	"SI-087\ten=Ormož\n" +
+	"SI-088\ten=Osilnica\n" +
+	"SI-089\ten=Pesnica\n" +
+	"SI-090\ten=Piran\n" +
+	"SI-091\ten=Pivka\n" +
+	"SI-092\ten=Podčetrtek\n" +
+	"SI-093\ten=Podvelka\n" +
+	"SI-094\ten=Postojna\n" +
+	"SI-095\ten=Preddvor\n" +
+	"SI-096\ten=Ptuj\n" +
+	"SI-097\ten=Puconci\n" +
+	"SI-098\ten=Rače-Fram\n" +
+	"SI-099\ten=Radeče\n" +
+	"SI-100\ten=Radenci\n" +
+	"SI-101\ten=Radlje ob Dravi\n" +
+	"SI-102\ten=Radovljica\n" +
+	"SI-103\ten=Ravne na Koroškem\n" +
+	"SI-104\ten=Ribnica\n" +
+	"SI-105\ten=Rogašovci\n" +
+	"SI-106\ten=Rogaška Slatina\n" +
+	"SI-107\ten=Rogatec\n" +
+	"SI-108\ten=Ruše\tzh-CN=鲁塞\n" +
+	"SI-109\ten=Semič\n" +
+	"SI-110\ten=Sevnica\n" +
+	"SI-111\ten=Sežana\n" +
+	"SI-112\ten=Slovenj Gradec\n" +
+	"SI-113\ten=Slovenska Bistrica\n" +
+	"SI-114\ten=Slovenske Konjice\n" +
+	"SI-115\ten=Starše\n" +
+	"SI-116\ten=Sveti Jurij ob Ščavnici\n" +
+	"SI-117\ten=Šenčur\tzh-CN=申丘尔\n" +
+	"SI-118\ten=Šentilj\tzh-CN=申蒂利\n" +
+	"SI-119\ten=Šentjernej\tzh-CN=申特耶尔内伊\n" +
+	"SI-120\ten=Šentjur\n" +
+	"SI-121\ten=Škocjan\tzh-CN=什科茨杨\n" +
+	"SI-122\ten=Škofja Loka\tzh-CN=什科菲亚洛卡\n" +
+	"SI-123\ten=Škofljica\tzh-CN=什科夫利察\n" +
+	"SI-124\ten=Šmarje pri Jelšah\tzh-CN=耶尔沙赫附近什马列\n" +
+	"SI-125\ten=Šmartno ob Paki\tzh-CN=帕卡河畔什马尔特诺\n" +
+	"SI-126\ten=Šoštanj\tzh-CN=绍什塔尼\n" +
+	"SI-127\ten=Štore\tzh-CN=什托雷\n" +
+	"SI-128\ten=Tolmin\tzh-CN=托尔明\n" +
+	"SI-129\ten=Trbovlje\tzh-CN=特雷布涅\n" +
+	"SI-130\ten=Trebnje\tzh-CN=特雷布涅\n" +
+	"SI-131\ten=Tržič\tzh-CN=特尔日奇\n" +
+	"SI-132\ten=Turnišče\tzh-CN=图尔尼什切\n" +
+	"SI-133\ten=Velenje\tzh-CN=韦莱涅\n" +
+	"SI-134\ten=Velike Lašče\tzh-CN=大拉什切\n" +
+	"SI-135\ten=Videm\tzh-CN=维代姆\n" +
+	"SI-136\ten=Vipava\tzh-CN=维帕瓦\n" +
+	"SI-137\ten=Vitanje\tzh-CN=维塔涅\n" +
+	"SI-138\ten=Vodice\n" +
+	"SI-139\ten=Vojnik\tzh-CN=沃伊尼克\n" +
+	"SI-140\ten=Vrhnika\tzh-CN=弗尔赫尼卡\n" +
+	"SI-141\ten=Vuzenica\tzh-CN=武泽尼察\n" +
+	"SI-142\ten=Zagorje ob Savi\tzh-CN=萨瓦河畔扎列格\n" +
+	"SI-143\ten=Zavrč\tzh-CN=扎夫尔奇\n" +
+	"SI-144\ten=Zreče\tzh-CN=兹雷切\n" +
+	"SI-146\ten=Železniki\tzh-CN=热莱兹尼基\n" +
+	"SI-147\ten=Žiri\tzh-CN=日里\n" +
+	"SI-148\ten=Benedikt\n" +
+	"SI-149\ten=Bistrica ob Sotli\tzh-CN=索特里河畔比什特里察\n" +
+	"SI-150\ten=Bloke\tzh-CN=不洛科\n" +
+	"SI-151\ten=Braslovče\tzh-CN=布拉斯洛夫采\n" +
+	"SI-152\ten=Cankova\tzh-CN=灿科瓦-蒂希纳\n" +
+	"SI-153\ten=Cerkvenjak\tzh-CN=采尔克芬尼亚克\n" +
+	"SI-154\ten=Dobje\tzh-CN=多布耶\n" +
+	"SI-155\ten=Dobrna\tzh-CN=多布尔纳\n" +
+	"SI-156\ten=Dobrovnik\n" +
+	"SI-157\ten=Dolenjske Toplice\tzh-CN=多莱尼斯科托普利采\n" +
+	"SI-158\ten=Grad\tzh-CN=格拉德\n" +
+	"SI-159\ten=Hajdina\tzh-CN=哈伊蒂纳\n" +
+	"SI-160\ten=Hoče-Slivnica\tzh-CN=霍策-什里夫尼察\n" +
+	"SI-161\ten=Hodoš\n" +
+	"SI-162\ten=Horjul\n" +
+	"SI-163\ten=Jezersko\tzh-CN=耶则尔斯科\n" +
+	"SI-164\ten=Komenda\tzh-CN=科门达\n" +
+	"SI-165\ten=Kostel\tzh-CN=科斯特尔\n" +
+	"SI-166\ten=Križevci\tzh-CN=克里兹夫奇\n" +
+	"SI-167\ten=Lovrenc na Pohorju\tzh-CN=波霍里尤地区洛夫伦茨\n" +
+	"SI-168\ten=Markovci\tzh-CN=马里科夫奇\n" +
+	"SI-169\ten=Miklavž na Dravskem polju\tzh-CN=德拉夫斯肯波尔尤地区米克拉夫兹\n" +
+	"SI-170\ten=Mirna Peč\tzh-CN=米尔纳比茨\n" +
+	"SI-171\ten=Oplotnica\n" +
+	"SI-172\ten=Podlehnik\n" +
+	"SI-173\ten=Polzela\n" +
+	"SI-174\ten=Prebold\n" +
+	"SI-175\ten=Prevalje\n" +
+	"SI-176\ten=Razkrižje\n" +
+	"SI-177\ten=Ribnica na Pohorju\n" +
+	"SI-178\ten=Selnica ob Dravi\n" +
+	"SI-179\ten=Sodražica\n" +
+	"SI-180\ten=Solčava\n" +
+	"SI-181\ten=Sveta Ana\n" +
+	"SI-182\ten=Sveti Andraž v Slovenskih goricah\n" +
+	"SI-183\ten=Šempeter-Vrtojba\tzh-CN=赛姆比特-费尔托伊巴\n" +
+	"SI-184\ten=Tabor\tzh-CN=塔波尔\n" +
+	"SI-185\ten=Trnovska Vas\n" +
+	"SI-186\ten=Trzin\tzh-CN=特尔兹恩\n" +
+	"SI-187\ten=Velika Polana\tzh-CN=维里卡波兰纳\n" +
+	"SI-188\ten=Veržej\tzh-CN=维尔泽伊\n" +
+	"SI-189\ten=Vransko\tzh-CN=弗兰斯科\n" +
+	"SI-190\ten=Žalec\tzh-CN=扎列奇\n" +
+	"SI-191\ten=Žetale\tzh-CN=热塔莱\n" +
+	"SI-192\ten=Žirovnica\tzh-CN=兹罗夫尼察\n" +
+	"SI-193\ten=Žužemberk\tzh-CN=祖泽姆波克\n" +
+	"SI-194\ten=Šmartno pri Litiji\tzh-CN=利蒂基附近什马尔特诺\n" +
+	"SI-195\ten=Apače\n" +
+	"SI-196\ten=Cirkulane\n" +
+	"SI-197\ten=Kosanjevica na Krki\n" +
+	"SI-198\ten=Makole\n" +
+	"SI-199\ten=Mokronog-Trebelno\n" +
+	"SI-200\ten=Poljčane\n" +
+	"SI-201\ten=Renče-Vogrsko\n" +
+	"SI-202\ten=Središče ob Dravi\n" +
+	"SI-203\ten=Straža\n" +
+	"SI-204\ten=Sveta Trojica v Slovenskih goricah\n" +
+	"SI-205\ten=Sveti Tomaž\n" +
+	"SI-206\ten=Šmarješke Toplice\n" +
+	"SI-207\ten=Gorje\n" +
+	"SI-208\ten=Log-Dragomer\n" +
+	"SI-209\ten=Rečica ob Savinji\n" +
+	"SI-210\ten=Sveti Jurij v Slovenskih goricah\n" +
+	"SI-211\ten=Šentrupert\n" +
+	"SI-212\ten=Mirna\n" +
+	"SI-213\ten=Ankaran\n" +
+	"SK-BC\tde=Bezirk Banská Bystrica\ten=Banskobystrický kraj\tfr=Banská Bystrica\tja=バンスカービストリツァ\tzh-CN=班斯卡·比斯特理察州\n" +
+	"SK-BL\tde=Preßburger Bezirk\ten=Bratislavský kraj\tfr=Bratislava\tja=ブラチスラバ\tzh-CN=布拉迪斯拉发州\n" +
+	"SK-KI\tde=Kaschauer Landschaftsverband\ten=Košický kraj\tfr=Košice\tja=コシツェ\tzh-CN=科希策州\n" +
+	"SK-NI\tde=Neutraer Landschaftsverband\ten=Nitriansky kraj\tfr=Nitra\tja=ニトラ\tzh-CN=尼特拉州\n" +
+	"SK-PV\tde=Eperieser Landschaftsverband\ten=Prešovský kraj\tfr=Prešov\tja=プレショフ\tzh-CN=普列索夫州\n" +
+	"SK-TA\tde=Tyrnauer Landschaftsverband\ten=Trnavský kraj\tfr=Trnava\tja=トルナバ\tzh-CN=特尔纳瓦州\n" +
+	"SK-TC\tde=Trentschiner Landschaftsverband\ten=Trenčiansky kraj\tfr=Trenčín\tja=トレンチーン\tzh-CN=特伦钦州\n" +
+	"SK-ZI\tde=Silleiner Landschaftsverband\ten=Žilinský kraj\tfr=Žilina\tja=ジリナ\tzh-CN=日利纳州\n" +
+	"SL-E\ten=Eastern\tfr=Est\tzh-CN=东部区\n" +
+	"SL-N\ten=Northern\tfr=Nord\tzh-CN=北部区\n" +
+	"SL-NW\ten=North Western\n" +
+	"SL-S\tde=Süd\ten=Southern\tfr=Sud\n" +
+	"SL-W\ten=Western Area (Freetown)\tfr=Zone Ouest (Freetown)\tzh-CN=弗里敦\n" +
+	"SM-01\ten=Acquaviva\n" +
+	"SM-02\ten=Chiesanuova\n" +
+	"SM-03\ten=Domagnano\n" +
+	"SM-04\ten=Faetano\n" +
+	"SM-05\ten=Fiorentino\n" +
+	"SM-06\ten=Borgo Maggiore\tzh-CN=博尔戈马焦雷\n" +
+	"SM-07\ten=Città di San Marino\n" +
+	"SM-08\ten=Montegiardino\n" +
+	"SM-09\ten=Serravalle\tja=セッラバッレ\n" +
+	"SN-DB\ten=Diourbel\tja=ジュルベル\tzh-CN=久尔贝勒\n" +
+	"SN-DK\ten=Dakar\tja=ダカール\tzh-CN=达喀尔\n" +
+	"SN-FK\ten=Fatick\tja=ファティック\tzh-CN=法蒂克\n" +
+	"SN-KA\ten=Kaffrine\tja=カフリン\n" +
+	"SN-KD\ten=Kolda\tja=コルダ\tzh-CN=科尔达\n" +
+	"SN-KE\ten=Kédougou\tja=ケドゥグ\n" +
+	"SN-KL\ten=Kaolack\tja=カオラック\tzh-CN=考拉克\n" +
+	"SN-LG\ten=Louga\tja=ルガ\tzh-CN=卢加\n" +
+	"SN-MT\ten=Matam\tja=マタム\tzh-CN=马塔姆\n" +
+	"SN-SE\ten=Sédhiou\tja=セジュ\n" +
+	"SN-SL\ten=Saint-Louis\tja=サン・ルイ\tzh-CN=圣路易\n" +
+	"SN-TC\ten=Tambacounda\tja=タンバクンダ\tzh-CN=坦巴昆达\n" +
+	"SN-TH\ten=Thiès\tja=チェス\tzh-CN=捷斯\n" +
+	"SN-ZG\ten=Ziguinchor\tja=ジガンショール\tzh-CN=济金绍尔\n" +
+	"SO-AW\ten=Awdal\n" +
+	"SO-BK\ten=Bakool\tja=バコール\n" +
+	"SO-BN\ten=Banaadir\n" +
+	"SO-BR\ten=Bari\tja=バリ\tru=Бари\n" +
+	"SO-BY\ten=Bay\tja=バイ\n" +
+	"SO-GA\ten=Galguduud\tja=ガルグドゥード\n" +
+	"SO-GE\ten=Gedo\tja=ゲド\n" +
+	"SO-HI\ten=Hiiraan\n" +
+	"SO-JD\ten=Jubbada Dhexe\tja=ジュバダデヘ\n" +
+	"SO-JH\ten=Jubbada Hoose\tja=ジュバダホーセ\n" +
+	"SO-MU\ten=Mudug\tja=ムドゥグ\n" +
+	"SO-NU\ten=Nugaal\tja=ヌガール\n" +
+	"SO-SA\ten=Sanaag\n" +
+	"SO-SD\ten=Shabeellaha Dhexe\tja=シャベーラハデヘ\n" +
+	"SO-SH\ten=Shabeellaha Hoose\tja=シャベーラハホーセ\n" +
+	"SO-SO\ten=Sool\n" +
+	"SO-TO\ten=Togdheer\tja=トクデール\n" +
+	"SO-WO\ten=Woqooyi Galbeed\tja=ウォコーイガルベード\n" +
+	"SR-BR\ten=Brokopondo\tja=ブロコポンド\tzh-CN=布罗科蓬多\n" +
+	"SR-CM\ten=Commewijne\tja=コメウィーネ\tzh-CN=科默韦讷\n" +
+	"SR-CR\ten=Coronie\tja=コロニー\tzh-CN=科罗尼\n" +
+	"SR-MA\ten=Marowijne\tja=マロウィーネ\tzh-CN=马罗韦纳\n" +
+	"SR-NI\ten=Nickerie\tja=ニッケリエ\tzh-CN=尼克里\n" +
+	"SR-PM\ten=Paramaribo\tja=パラマリボ\tzh-CN=帕拉马里博区\n" +
+	"SR-PR\ten=Para\tja=パラ\n" +
+	"SR-SA\ten=Saramacca\tja=サラマクカ\tzh-CN=萨拉马卡\n" +
+	"SR-SI\ten=Sipaliwini\tja=シパリウィニ\tzh-CN=西帕利维尼\n" +
+	"SR-WA\ten=Wanica\tja=ワニカ\tzh-CN=瓦尼卡\n" +
+	"SS-BN\ten=Northern Bahr el Ghazal\tfr=Bahr el Ghazal du Nord\tru=Северный Бахр-эль-Газаль\n" +
+	"SS-BW\ten=Western Bahr el Ghazal\tfr=Bahr el Gazal occidental\tru=Западный Бахр-эль-Газаль\n" +
+	"SS-EC\ten=Central Equatoria\tfr=Équateur central\n" +
+	"SS-EE\ten=Eastern Equatoria\tfr=Équateur Oriental\n" +
+	"SS-EW\ten=Western Equatoria\tfr=Équateur occidental\n" +
+	"SS-JG\tde=Dschunqali\ten=Jonglei\n" +
+	"SS-LK\ten=Lakes\tfr=Lacs\n" +
+	"SS-NU\ten=Upper Nile\tfr=Haut-Nil\n" +
+	"SS-UY\ten=Unity\tfr=Unité\n" +
+	"SS-WR\ten=Warrap\n" +
+	"ST-01\ten=Água Grande\n" +
+	"ST-02\ten=Cantagalo\n" +
+	"ST-03\ten=Caué\n" +
+	"ST-04\ten=Lembá\n" +
+	"ST-05\ten=Lobata\n" +
+	"ST-06\ten=Mé-Zóchi\n" +
+	"ST-P\ten=Príncipe\tja=プリンシペ\tzh-CN=普林西比\n" +
+	"SV-AH\ten=Ahuachapán\tja=アウアチャパン\n" +
+	"SV-CA\ten=Cabañas\tja=カバニャス\n" +
+	"SV-CH\ten=Chalatenango\tja=チャラテナンゴ\tzh-CN=查拉特南戈省\n" +
+	"SV-CU\ten=Cuscatlán\tja=クスカトラン\n" +
+	"SV-LI\ten=La Libertad\tja=ラリベルタッド\tru=Ла-Либертад\n" +
+	"SV-MO\ten=Morazán\tja=モラサン\n" +
+	"SV-PA\ten=La Paz\tja=ラパス\tru=Ла-Пас\tzh-CN=拉巴斯省\n" +
+	"SV-SA\ten=Santa Ana\tja=サンタ・アナ\tzh-CN=圣安娜省\n" +
+	"SV-SM\ten=San Miguel\tja=サン・ミゲル\tzh-CN=圣米格尔省\n" +
+	"SV-SO\ten=Sonsonate\tja=ソンソナテ\tzh-CN=松索纳特省\n" +
+	"SV-SS\ten=San Salvador\tja=サンサルバドル\tru=Сан-Сальвадор\tzh-CN=圣萨尔瓦多省\n" +
+	"SV-SV\ten=San Vicente\tja=サン・ビセンテ\tzh-CN=圣维森特省\n" +
+	"SV-UN\ten=La Unión\tja=ラウニオン\n" +
+	"SV-US\ten=Usulután\tja=ウスルタン\n" +
+	"SY-DI\tde=Dimaschq\ten=Dimashq\tfr=Damas\tja=ダマスカス\tzh-CN=大马士革市\n" +
+	"SY-DR\ten=Dar'ā\n" +
+	"SY-DY\tde=Dair az-Zaur\ten=Dayr az Zawr\tja=デリゾール\tzh-CN=代尔祖尔\n" +
+	"SY-HA\ten=Al Ḩasakah\n" +
+	"SY-HI\ten=Ḩimş\n" +
+	"SY-HL\ten=Ḩalab\n" +
+	"SY-HM\ten=Ḩamāh\n" +
+	"SY-ID\ten=Idlib\tja=イドゥリブ\tzh-CN=伊德利卜\n" +
+	"SY-LA\ten=Al Lādhiqīyah\n" +
+	"SY-QU\ten=Al Qunayţirah\n" +
+	"SY-RA\tde=Ar Raqqa\ten=Ar Raqqah\tja=ラッカ\tzh-CN=拉卡\n" +
+	"SY-RD\ten=Rīf Dimashq\n" +
+	"SY-SU\ten=As Suwaydā'\n" +
+	"SY-TA\ten=Ţarţūs\n" +
+	"SZ-HH\ten=Hhohho\tzh-CN=霍霍\n" +
+	"SZ-LU\ten=Lubombo\tzh-CN=卢邦博\n" +
+	"SZ-MA\ten=Manzini\tja=マンジーニ\tzh-CN=曼齐尼区\n" +
+	"SZ-SH\ten=Shiselweni\tzh-CN=希塞卢韦尼\n" +
+	"TD-BA\ten=Al Baţḩā’\n" +
+	"TD-BG\ten=Bahr el Ghazal\n" +
+	"TD-BO\ten=Borkou\n" +
+	"TD-CB\ten=Chari-Baguirmi\n" +
+	"TD-EE\ten=Ennedi-Est\n" +
+	"TD-EO\ten=Ennedi-Ouest\n" +
+	"TD-GR\ten=Guéra\n" +
+	"TD-HL\ten=Hadjer Lamis\n" +
+	"TD-KA\ten=Kanem\n" +
+	"TD-LC\ten=Al Buḩayrah\tru=Эль-Фуджайра\n" +
+	"TD-LO\ten=Logone-Occidental\n" +
+	"TD-LR\ten=Logone-Oriental\n" +
+	"TD-MA\ten=Mandoul\n" +
+	"TD-MC\ten=Moyen-Chari\n" +
+	"TD-ME\ten=Mayo-Kebbi-Est\n" +
+	"TD-MO\ten=Mayo-Kebbi-Ouest\n" +
+	"TD-ND\ten=Madīnat Injamīnā\tfr=Ville de Ndjamena\n" +
+	"TD-OD\ten=Ouaddaï\n" +
+	"TD-SA\ten=Salamat\n" +
+	"TD-SI\ten=Sila\n" +
+	"TD-TA\ten=Tandjilé\n" +
+	"TD-TI\tde=Tibestī\ten=Tibastī\tfr=Tibesti\n" +
+	"TD-WF\ten=Wadi Fira\n" +
+	"TG-C\ten=Centrale\n" +
+	"TG-K\ten=Kara\n" +
+	"TG-M\ten=Maritime (Région)\n" +
+	"TG-P\ten=Plateaux\tja=プラトー\n" +
+	"TG-S\ten=Savanes\n" +
+	"TH-10\ten=Krung Thep Maha Nakhon\n" +
+	"TH-11\ten=Samut Prakan\tja=サムットプラカーン\tzh-CN=北榄府\n" +
+	"TH-12\ten=Nonthaburi\tja=ノンタブリー\tzh-CN=暖武里府\n" +
+	"TH-13\ten=Pathum Thani\tja=パトンターニー\tzh-CN=巴吞他尼府\n" +
+	"TH-14\ten=Phra Nakhon Si Ayutthaya\tja=プラナコンシータマラート,\tzh-CN=大城府\n" +
+	"TH-15\ten=Ang Thong\tja=アントーン\tzh-CN=红统府\n" +
+	"TH-16\ten=Lop Buri\tja=ロッブリー\tzh-CN=华富里府\n" +
+	"TH-17\ten=Sing Buri\tja=シンブリー\tzh-CN=信武里府\n" +
+	"TH-18\ten=Chai Nat\tja=チャイナート\tzh-CN=猜纳府\n" +
+	"TH-19\ten=Saraburi\tja=サラブリー\tzh-CN=北标府\n" +
+	"TH-20\ten=Chon Buri\tja=チョンブリー\tzh-CN=春武里府\n" +
+	"TH-21\ten=Rayong\tja=ラヨーン\tzh-CN=罗勇府\n" +
+	"TH-22\ten=Chanthaburi\tja=チャンタブリー\tzh-CN=尖竹汶府\n" +
+	"TH-23\ten=Trat\tja=トラート\tzh-CN=桐艾府\n" +
+	"TH-24\ten=Chachoengsao\tja=チャチェンサオ\tzh-CN=北柳府\n" +
+	"TH-25\ten=Prachin Buri\tja=プラチンブリー\tzh-CN=巴真府\n" +
+	"TH-26\ten=Nakhon Nayok\tja=ナコンナヨーク\tzh-CN=坤西育府\n" +
+	"TH-27\ten=Sa Kaeo\tja=サケオ\tzh-CN=沙缴府\n" +
+	"TH-30\ten=Nakhon Ratchasima\tja=ナコンラチャシマ\tzh-CN=呵叻府\n" +
+	"TH-31\ten=Buri Ram\tja=ブリラム\tzh-CN=武里喃府\n" +
+	"TH-32\ten=Surin\tja=スリン\tzh-CN=素辇府\n" +
+	"TH-33\ten=Si Sa Ket\tja=シーサケット\tzh-CN=四色菊府\n" +
+	"TH-34\ten=Ubon Ratchathani\tja=ウボンラチャタニー\tzh-CN=乌汶府\n" +
+	"TH-35\ten=Yasothon\tja=ヤソートーン\tzh-CN=益梭通府\n" +
+	"TH-36\ten=Chaiyaphum\tja=チャイヤプーム\tzh-CN=猜也贲府\n" +
+	"TH-37\ten=Amnat Charoen\tja=アムナートチャルン\tzh-CN=安纳乍能府\n" +
+	"TH-38\ten=Bueng Kan\n" +
+	"TH-39\ten=Nong Bua Lam Phu\tja=ノーンブアランプー\tzh-CN=廊磨喃蒲府\n" +
+	"TH-40\ten=Khon Kaen\tja=コーンケーン\tzh-CN=坤敬府\n" +
+	"TH-41\ten=Udon Thani\tja=ウドンタニー\tzh-CN=莫肯府\n" +
+	"TH-42\ten=Loei\tja=ルーイ\tzh-CN=黎府\n" +
+	"TH-43\ten=Nong Khai\tja=ノーンカーイ\tzh-CN=廊开府\n" +
+	"TH-44\ten=Maha Sarakham\tja=マハーサーラーカム\tzh-CN=吗哈沙拉堪府\n" +
+	"TH-45\ten=Roi Et\tja=ロイエット\tzh-CN=横逸府\n" +
+	"TH-46\ten=Kalasin\tja=カラシン\tzh-CN=加拉信府\n" +
+	"TH-47\ten=Sakon Nakhon\tja=サコンナコン\tzh-CN=色军府\n" +
+	"TH-48\ten=Nakhon Phanom\tja=ナコンパノム\tzh-CN=那空拍侬府\n" +
+	"TH-49\ten=Mukdahan\tja=ムクダハーン\tzh-CN=莫拉限府\n" +
+	"TH-50\ten=Chiang Mai\tja=チェンマイ\tzh-CN=清迈府\n" +
+	"TH-51\ten=Lamphun\tja=ランパーン\tzh-CN=南奔府\n" +
+	"TH-52\ten=Lampang\tja=ランパーン\tzh-CN=南邦府\n" +
+	"TH-53\ten=Uttaradit\tja=ウタラディット\tzh-CN=程逸府\n" +
+	"TH-54\ten=Phrae\tja=プレー\tzh-CN=帕府\n" +
+	"TH-55\ten=Nan\tja=ナン\tzh-CN=楠府\n" +
+	"TH-56\ten=Phayao\tja=パヤオ\tzh-CN=拍天府\n" +
+	"TH-57\ten=Chiang Rai\tja=チェンライ\tzh-CN=清莱府\n" +
+	"TH-58\ten=Mae Hong Son\tja=メーホーンソン\tzh-CN=湄宏顺府\n" +
+	"TH-60\ten=Nakhon Sawan\tja=ナコンサワン\tzh-CN=北榄坡府\n" +
+	"TH-61\ten=Uthai Thani\tja=ウタイタニー\tzh-CN=乌泰他尼府\n" +
+	"TH-62\ten=Kamphaeng Phet\tja=カンペーンペット\tzh-CN=甘烹碧府\n" +
+	"TH-63\ten=Tak\tja=ターク\tzh-CN=来兴府\n" +
+	"TH-64\ten=Sukhothai\tja=スコータイ\tzh-CN=素可泰\n" +
+	"TH-65\ten=Phitsanulok\tja=ピサヌローク\tzh-CN=彭世洛府\n" +
+	"TH-66\ten=Phichit\tja=ピチット\tzh-CN=披集府\n" +
+	"TH-67\ten=Phetchabun\tja=ペチャブーン\tzh-CN=碧差汶府\n" +
+	"TH-70\ten=Ratchaburi\tja=ラッブリー\tzh-CN=叻丕府\n" +
+	"TH-71\ten=Kanchanaburi\tja=カンチャナブリ\tzh-CN=北碧府\n" +
+	"TH-72\ten=Suphan Buri\tja=スパンブリー\tzh-CN=素攀府\n" +
+	"TH-73\ten=Nakhon Pathom\tja=ナコンパトム\tzh-CN=佛统府\n" +
+	"TH-74\ten=Samut Sakhon\tja=サムットサコーン\tzh-CN=龙仔厝府\n" +
+	"TH-75\ten=Samut Songkhram\tfr=Samdrup Jongkhar\tja=サムットソンクラム\tzh-CN=夜功府\n" +
+	"TH-76\ten=Phetchaburi\tja=ペッブリー\tzh-CN=佛丕府\n" +
+	"TH-77\ten=Prachuap Khiri Khan\tja=プラチュアップキリカン\tzh-CN=班武里府\n" +
+	"TH-80\ten=Nakhon Si Thammarat\tja=ナコンシータマラート\tzh-CN=洛坤府\n" +
+	"TH-81\ten=Krabi\tja=クラビー\tzh-CN=甲米府\n" +
+	"TH-82\ten=Phangnga\tja=パンガー\tzh-CN=攀牙府\n" +
+	"TH-83\ten=Phuket\tja=プーケット\tzh-CN=普吉府\n" +
+	"TH-84\ten=Surat Thani\tja=スラーターニー\tzh-CN=素叻府\n" +
+	"TH-85\ten=Ranong\tja=ラノーン\tzh-CN=拉廊府\n" +
+	"TH-86\ten=Chumphon\tja=チュムポーン\tzh-CN=春蓬府\n" +
+	"TH-90\ten=Songkhla\tja=ソンクラー\tzh-CN=宋卡府\n" +
+	"TH-91\ten=Satun\tja=サトゥーン\tzh-CN=沙敦府\n" +
+	"TH-92\ten=Trang\tja=トラン\tzh-CN=董里府\n" +
+	"TH-93\ten=Phatthalung\tja=パッタルン\tzh-CN=博他仑府\n" +
+	"TH-94\ten=Pattani\tja=パッタニー\tzh-CN=北大年府\n" +
+	"TH-95\ten=Yala\tja=ヤラー\tzh-CN=惹拉府\n" +
+	"TH-96\ten=Narathiwat\tja=ナラティワート\tzh-CN=陶公府\n" +
+	"TH-S\tde=Pattaya\ten=Phatthaya\tzh-CN=芭达亚\n" +
+	"TJ-DU\ten=Dushanbe\n" +
+	"TJ-GB\ten=Kŭhistoni Badakhshon\n" +
+	"TJ-KT\tde=Chatlon\ten=Khatlon\tja=ハトロン\tzh-CN=哈特隆州\n" +
+	"TJ-RA\ten=nohiyahoi tobei jumhurí\n" +
+	"TJ-SU\ten=Sughd\tja=スド\tzh-CN=索格特州\n" +
+	"TL-AL\ten=Aileu\tja=アイレウ\tzh-CN=阿伊莱乌区\n" +
+	"TL-AN\ten=Ainaro\tja=アイナロ\tzh-CN=阿伊纳罗区\n" +
+	"TL-BA\ten=Baucau\tja=バウカウ\tzh-CN=包考区\n" +
+	"TL-BO\ten=Bobonaro\tja=ボボナロ\tzh-CN=博博纳罗区\n" +
+	"TL-CO\ten=Cova Lima\tja=コバリマ\tzh-CN=科瓦利马区\n" +
+	"TL-DI\ten=Díli\tja=ディリ\n" +
+	"TL-ER\ten=Ermera\tja=エルメラ\tzh-CN=埃尔梅拉区\n" +
+	"TL-LA\ten=Lautein\n" +
+	"TL-LI\ten=Likisá\n" +
+	"TL-MF\ten=Manufahi\tja=マヌファイ\tzh-CN=马努法伊区\n" +
+	"TL-MT\ten=Manatuto\tja=マナトゥトゥ\tzh-CN=马纳图托区\n" +
+	"TL-OE\ten=Oekusi-Ambenu\n" +
+	"TL-VI\ten=Vikeke\n" +
+	"TM-A\ten=Ahal\tja=アハル\tzh-CN=阿哈尔\n" +
+	"TM-B\ten=Balkan\tja=バルカン\tzh-CN=巴尔坎\n" +
+	"TM-D\ten=Daşoguz\tja=ダシュハウズ\tzh-CN=达沙古兹\n" +
+	"TM-L\ten=Lebap\tja=レバプ\tzh-CN=列巴普\n" +
+	"TM-M\ten=Mary\tja=マリー\tzh-CN=马雷\n" +
+	"TM-S\ten=Aşgabat\tfr=Achgabat\tja=アシガバット\n" +
+	"TN-11\ten=Tunis\tja=チュニス\tzh-CN=突尼斯\n" +
+	"TN-12\ten=L'Ariana\n" +
+	"TN-13\ten=Ben Arous\tja=ベンアルース\tzh-CN=本阿鲁斯\n" +
+	"TN-14\ten=La Manouba\tja=マヌーバ\tzh-CN=马努巴\n" +
+	"TN-21\ten=Nabeul\tja=ナブール\tzh-CN=纳布勒\n" +
+	"TN-22\ten=Zaghouan\tja=ザグアン\tzh-CN=宰格万\n" +
+	"TN-23\ten=Bizerte\tja=ビゼルト\tzh-CN=比塞大\n" +
+	"TN-31\ten=Béja\tja=ベジャ\tzh-CN=巴杰\n" +
+	"TN-32\ten=Jendouba\tja=ジャンドゥーバ\tzh-CN=坚杜拜\n" +
+	"TN-33\ten=Le Kef\tja=ケフ\tzh-CN=卡夫\n" +
+	"TN-34\ten=Siliana\tja=シリアナ\tzh-CN=锡勒亚奈\n" +
+	"TN-41\ten=Kairouan\tja=カイロアン\tzh-CN=凯鲁万\n" +
+	"TN-42\ten=Kasserine\tja=カスリン\tzh-CN=卡塞林\n" +
+	"TN-43\ten=Sidi Bouzid\tja=シディブジット\tzh-CN=西迪布济德\n" +
+	"TN-51\ten=Sousse\tja=スース\tzh-CN=苏塞\n" +
+	"TN-52\ten=Monastir\tja=モナスティール\tzh-CN=莫纳斯提尔\n" +
+	"TN-53\ten=Mahdia\tja=マハディア\tzh-CN=马赫迪耶\n" +
+	"TN-61\ten=Sfax\tja=スファックス\tzh-CN=斯法克斯\n" +
+	"TN-71\ten=Gafsa\tja=ガフサ\tzh-CN=加夫萨\n" +
+	"TN-72\ten=Tozeur\tja=トズール\tzh-CN=托泽尔\n" +
+	"TN-73\ten=Kébili\n" +
+	"TN-81\ten=Gabès\tja=ガベス\tzh-CN=加贝斯\n" +
+	"TN-82\ten=Médenine\n" +
+	"TN-83\ten=Tataouine\tja=タタウィン\tzh-CN=泰塔温\n" +
+	"TO-01\ten='Eua\tja=エウア\tzh-CN=埃瓦岛\n" +
+	"TO-02\ten=Ha'apai\tja=ハアパイ\tzh-CN=哈派群岛\n" +
+	"TO-03\ten=Niuas\tzh-CN=纽阿斯\n" +
+	"TO-04\ten=Tongatapu\tja=トンガタプ\tzh-CN=汤加塔布岛\n" +
+	"TO-05\ten=Vava'u\tja=ババウ\tzh-CN=瓦瓦乌群岛\n" +
+	"TR-01\ten=Adana\tja=アダナ\tzh-CN=阿达纳\n" +
+	"TR-02\ten=Adıyaman\tja=アドゥヤマン\tzh-CN=阿德亚曼\n" +
+	"TR-03\ten=Afyonkarahisar\tja=アフヨン\n" +
+	"TR-04\ten=Ağrı\tja=アール\tzh-CN=阿勒\n" +
+	"TR-05\ten=Amasya\tja=アマスヤ\tzh-CN=阿马西亚\n" +
+	"TR-06\ten=Ankara\tja=アンカラ\tzh-CN=安卡拉\n" +
+	"TR-07\ten=Antalya\tja=アンタルヤ\tzh-CN=安塔利亚\n" +
+	"TR-08\ten=Artvin\tja=アルトヴィン\tzh-CN=阿尔特温\n" +
+	"TR-09\ten=Aydın\tja=アイドゥン\tzh-CN=艾登\n" +
+	"TR-10\ten=Balıkesir\tja=バルケシル\tzh-CN=巴勒克埃西尔\n" +
+	"TR-11\ten=Bilecik\tja=ビレジック\tzh-CN=比莱吉克\n" +
+	"TR-12\ten=Bingöl\tja=ビンギョル\tzh-CN=宾格尔\n" +
+	"TR-13\ten=Bitlis\tja=ビトリス\tzh-CN=比特利斯\n" +
+	"TR-14\ten=Bolu\tja=ボル\tzh-CN=博卢\n" +
+	"TR-15\ten=Burdur\tja=ブルドゥル\tzh-CN=布尔杜尔\n" +
+	"TR-16\ten=Bursa\tja=ブルサ\tzh-CN=布尔萨\n" +
+	"TR-17\ten=Çanakkale\tja=チャナッカレ\tzh-CN=恰纳卡莱\n" +
+	"TR-18\ten=Çankırı\tja=チャンクル\tzh-CN=昌克勒\n" +
+	"TR-19\ten=Çorum\tja=チョルム\tzh-CN=乔鲁姆\n" +
+	"TR-20\ten=Denizli\tja=デニズリ\tzh-CN=代尼兹利\n" +
+	"TR-21\ten=Diyarbakır\tja=ディヤルバクル\tzh-CN=迪亚巴克尔\n" +
+	"TR-22\ten=Edirne\tja=エディルネ\tzh-CN=埃迪尔内\n" +
+	"TR-23\ten=Elazığ\tja=エラズ\tzh-CN=埃拉泽省\n" +
+	"TR-24\ten=Erzincan\tja=エルジンジャン\tzh-CN=埃尔津詹\n" +
+	"TR-25\ten=Erzurum\tja=エルズルム\tzh-CN=埃尔祖鲁姆\n" +
+	"TR-26\ten=Eskişehir\tja=エスキシェヒル\tzh-CN=埃斯基谢希尔\n" +
+	"TR-27\ten=Gaziantep\tja=ガジアンテップ\tzh-CN=加济安泰普\n" +
+	"TR-28\ten=Giresun\tja=ギレスン\tzh-CN=吉雷松\n" +
+	"TR-29\ten=Gümüşhane\tja=ギュミュシュネ\tzh-CN=居米什哈内\n" +
+	"TR-30\ten=Hakkâri\tja=ハッカーリ\tzh-CN=哈卡里\n" +
+	"TR-31\ten=Hatay\tja=ハタイ\tzh-CN=哈塔伊\n" +
+	"TR-32\ten=Isparta\tja=イスパルタ\tzh-CN=伊斯帕尔塔\n" +
+	"TR-33\ten=Mersin\n" +
+	"TR-34\ten=İstanbul\tja=イスタンブール\tzh-CN=伊斯坦布尔\n" +
+	"TR-35\ten=İzmir\tja=イズミール\tzh-CN=伊兹密尔\n" +
+	"TR-36\ten=Kars\tja=カルス\tzh-CN=卡尔斯\n" +
+	"TR-37\ten=Kastamonu\tja=カスタモヌ\tzh-CN=卡斯塔莫努\n" +
+	"TR-38\ten=Kayseri\tja=カイセリ\tzh-CN=开塞利\n" +
+	"TR-39\ten=Kırklareli\tja=クルクラレリ\tzh-CN=柯克拉雷利\n" +
+	"TR-40\ten=Kırşehir\tja=クルシェヒル\tzh-CN=克尔谢希尔\n" +
+	"TR-41\ten=Kocaeli\tja=コジャエリ\tzh-CN=科贾埃利\n" +
+	"TR-42\ten=Konya\tja=コンヤ\tzh-CN=科尼亚\n" +
+	"TR-43\ten=Kütahya\tja=キュタヒヤ\tzh-CN=屈塔希亚\n" +
+	"TR-44\ten=Malatya\tja=マラティア\tzh-CN=马拉蒂亚\n" +
+	"TR-45\ten=Manisa\tja=マニサ\tzh-CN=马尼萨\n" +
+	"TR-46\ten=Kahramanmaraş\tja=カラマンマラシュ\tzh-CN=卡赫拉曼马拉什\n" +
+	"TR-47\ten=Mardin\tja=マルディン\tzh-CN=马尔丁\n" +
+	"TR-48\ten=Muğla\tja=ムーラ\tzh-CN=穆拉\n" +
+	"TR-49\ten=Muş\tja=ムシュ\tzh-CN=穆什\n" +
+	"TR-50\ten=Nevşehir\tja=ネウシェヒル\tzh-CN=内夫谢希尔\n" +
+	"TR-51\ten=Niğde\tja=ニーデ\tzh-CN=尼代\n" +
+	"TR-52\ten=Ordu\tja=オルデュ\tzh-CN=奥尔杜\n" +
+	"TR-53\ten=Rize\tja=リゼ\tzh-CN=里泽\n" +
+	"TR-54\ten=Sakarya\tja=サカルヤ\tzh-CN=萨卡里亚\n" +
+	"TR-55\ten=Samsun\tja=サムスン\tzh-CN=萨姆松\n" +
+	"TR-56\ten=Siirt\tja=シイルト\tzh-CN=锡尔特\n" +
+	"TR-57\ten=Sinop\tja=シノップ\tzh-CN=锡诺普\n" +
+	"TR-58\ten=Sivas\tja=シワス\tzh-CN=锡瓦斯\n" +
+	"TR-59\ten=Tekirdağ\tja=テキルダー\tzh-CN=泰基尔达\n" +
+	"TR-60\ten=Tokat\tja=トカト\tzh-CN=托卡特\n" +
+	"TR-61\ten=Trabzon\tja=トラブゾン\tzh-CN=特拉布宗\n" +
+	"TR-62\ten=Tunceli\tja=トゥンジェリ\tzh-CN=通杰利\n" +
+	"TR-63\ten=Şanlıurfa\tja=シャンルウルファ\tzh-CN=尚利乌尔法\n" +
+	"TR-64\ten=Uşak\tja=ウシャク\tzh-CN=乌萨克\n" +
+	"TR-65\ten=Van\tja=ヴァン\tzh-CN=凡\n" +
+	"TR-66\ten=Yozgat\tja=ヨズガット\tzh-CN=约兹加特\n" +
+	"TR-67\ten=Zonguldak\tja=ゾングルダク\tzh-CN=宗古尔达克\n" +
+	"TR-68\ten=Aksaray\tja=アクサライ\tzh-CN=阿克萨赖\n" +
+	"TR-69\ten=Bayburt\tja=バイブルト\tzh-CN=巴伊布尔特\n" +
+	"TR-70\ten=Karaman\tja=カラマン\tzh-CN=卡拉曼\n" +
+	"TR-71\ten=Kırıkkale\tja=クルツカレ\tzh-CN=克勒克卡莱\n" +
+	"TR-72\ten=Batman\tja=バトマン\tzh-CN=巴特曼\n" +
+	"TR-73\ten=Şırnak\tja=シュルナク\tzh-CN=锡尔纳克\n" +
+	"TR-74\ten=Bartın\tja=バルトゥン\tzh-CN=巴尔腾\n" +
+	"TR-75\ten=Ardahan\tja=アルダハン\tzh-CN=阿尔达罕\n" +
+	"TR-76\ten=Iğdır\tja=イーディル\tzh-CN=厄德尔\n" +
+	"TR-77\ten=Yalova\tja=ヤロバ\tzh-CN=亚罗法\n" +
+	"TR-78\ten=Karabük\tja=カラビュク\tzh-CN=卡拉比克\n" +
+	"TR-79\ten=Kilis\tja=キリス\tzh-CN=基利斯\n" +
+	"TR-80\ten=Osmaniye\tja=オスマニエ\tzh-CN=奥斯曼尼菲\n" +
+	"TR-81\ten=Düzce\tja=デュズジェ\tzh-CN=迪兹杰\n" +
+	"TT-ARI\ten=Arima\tja=アリマ\tzh-CN=阿里马\n" +
+	"TT-CHA\ten=Chaguanas\tja=チャグアナス\tzh-CN=查瓜纳斯\n" +
+	"TT-CTT\ten=Couva-Tabaquite-Talparo\tzh-CN=库瓦-塔巴基特-塔尔帕罗\n" +
+	"TT-DMN\ten=Diego Martin\tzh-CN=迭哥马丁\n" +
+	"TT-MRC\ten=Mayaro-Rio Claro\n" +
+	"TT-PED\ten=Penal-Debe\tzh-CN=皮纳尔-德贝\n" +
+	"TT-POS\ten=Port of Spain\tes=Puerto España\tfr=Port d'Espagne\tja=ポートオブスペイン\tzh-CN=西班牙港市\n" +
+	"TT-PRT\ten=Princes Town\tzh-CN=王子镇\n" +
+	"TT-PTF\ten=Point Fortin\tzh-CN=福廷岬\n" +
+	"TT-SFO\ten=San Fernando\tja=サンフェルナンド\tzh-CN=圣费尔南多市\n" +
+	"TT-SGE\ten=Sangre Grande\tzh-CN=大桑格雷\n" +
+	"TT-SIP\ten=Siparia\tzh-CN=锡帕里亚\n" +
+	"TT-SJL\ten=San Juan-Laventille\tzh-CN=圣胡安-拉芬蒂勒\n" +
+	"TT-TOB\ten=Tobago\n" +
+	"TT-TUP\ten=Tunapuna-Piarco\tzh-CN=图纳普纳-皮亚尔科\n" +
+	"TV-FUN\ten=Funafuti\tja=フナフティ\tzh-CN=富纳富提\n" +
+	"TV-NIT\ten=Niutao\tja=ニウタオ\tzh-CN=纽乌道乌\n" +
+	"TV-NKF\ten=Nukufetau\tja=ヌクフェタウ\tzh-CN=努谷费陶\n" +
+	"TV-NKL\ten=Nukulaelae\tja=ヌクライライ\tzh-CN=努谷拉耶拉耶\n" +
+	"TV-NMA\ten=Nanumea\tja=ナヌーメア\tzh-CN=那努米亚\n" +
+	"TV-NMG\ten=Nanumaga\n" +
+	"TV-NUI\ten=Nui\tja=ヌイ\tzh-CN=努伊\n" +
+	"TV-VAI\ten=Vaitupu\tja=ヴァイトゥプ\tzh-CN=婓伊托波\n" +
+	"TW-CHA\ten=Changhua\tja=彰化\tzh-CN=彰化县\n" +
+	"TW-CYI\ten=Chiayi\tja=嘉義\tzh-CN=嘉义县\n" +
+	"TW-CYQ\ten=Chiayi\tja=嘉義\tzh-CN=嘉义县\n" +
+	"TW-HSQ\ten=Hsinchu\tja=新竹\tzh-CN=新竹县\n" +
+	"TW-HSZ\ten=Hsinchu\tja=新竹\tzh-CN=新竹县\n" +
+	"TW-HUA\ten=Hualien\tja=花蓮\tzh-CN=花莲县\n" +
+	"TW-ILA\ten=Yilan\n" +
+	"TW-KEE\ten=Keelung\n" +
+	"TW-KHH\ten=Kaohsiung\tja=高雄\tzh-CN=高雄县\n" +
+	"TW-KIN\ten=Kinmen\n" +
+	"TW-LIE\ten=Lienchiang\n" +
+	"TW-MIA\ten=Miaoli\tja=苗栗\tzh-CN=苗栗县\n" +
+	"TW-NAN\ten=Nantou\tja=南投\tzh-CN=南投县\n" +
+	"TW-NWT\ten=New Taipei\n" +
+	"TW-PEN\ten=Penghu\tja=澎湖\tzh-CN=澎湖县\n" +
+	"TW-PIF\ten=Pingtung\tja=屏東\tzh-CN=屏东县\n" +
+	"TW-TAO\ten=Taoyuan\tja=桃園\tzh-CN=桃园县\n" +
+	"TW-TNN\ten=Tainan\tja=台南\tru=Тайнань\tzh-CN=台南县\n" +
+	"TW-TPE\ten=Taipei\tja=台北\tru=Тайбэй\tzh-CN=台北县\n" +
+	"TW-TTT\ten=Taitung\tja=台東\tzh-CN=台东县\n" +
+	"TW-TXG\ten=Taichung\tja=台中\tru=Тайчжун\tzh-CN=台中县\n" +
+	"TW-YUN\ten=Yunlin\tja=雲林\tzh-CN=云林县\n" +
+	"TZ-01\ten=Arusha\tja=アルーシャ\tzh-CN=阿鲁沙\n" +
+	"TZ-02\ten=Dar es Salaam\n" +
+	"TZ-03\ten=Dodoma\tja=ドドマ\tzh-CN=多多马\n" +
+	"TZ-04\ten=Iringa\tja=イリンガ\tzh-CN=伊林加\n" +
+	"TZ-05\ten=Kagera\tja=カゲラ\tzh-CN=卡盖拉\n" +
+	"TZ-06\ten=Pemba North\n" +
+	"TZ-07\ten=Zanzibar North\n" +
+	"TZ-08\ten=Kigoma\tja=キゴマ\tzh-CN=基戈马\n" +
+	"TZ-09\ten=Kilimanjaro\tes=Kilimanyaro\tfr=Kilimandjaro\tja=キリマンジャロ\tzh-CN=乞力马扎罗\n" +
+	"TZ-10\ten=Pemba South\n" +
+	"TZ-11\ten=Zanzibar South\n" +
+	"TZ-12\ten=Lindi\tja=リンディ\tzh-CN=林迪\n" +
+	"TZ-13\ten=Mara\tja=マラ\tzh-CN=马腊\n" +
+	"TZ-14\ten=Mbeya\tja=ムベヤ\tzh-CN=姆贝亚\n" +
+	"TZ-15\ten=Zanzibar West\n" +
+	"TZ-16\ten=Morogoro\tja=モロゴロ\tzh-CN=莫罗戈罗\n" +
+	"TZ-17\ten=Mtwara\tja=ムトワラ\tzh-CN=姆特瓦拉\n" +
+	"TZ-18\ten=Mwanza\tzh-CN=姆万扎区\n" +
+	"TZ-19\ten=Coast\n" +
+	"TZ-20\ten=Rukwa\tja=ルークワ\tzh-CN=鲁夸\n" +
+	"TZ-21\ten=Ruvuma\tja=ルブマ\tzh-CN=鲁伍马\n" +
+	"TZ-22\ten=Shinyanga\tja=シニャンガ\tzh-CN=希尼安加\n" +
+	"TZ-23\ten=Singida\tja=シンギダ\tzh-CN=辛吉达\n" +
+	"TZ-24\ten=Tabora\tja=タボラ\tzh-CN=塔波拉\n" +
+	"TZ-25\ten=Tanga\tja=タンガ\tzh-CN=坦噶\n" +
+	"TZ-26\ten=Manyara\tzh-CN=曼亚拉\n" +
+	"TZ-27\ten=Geita\n" +
+	"TZ-28\ten=Katavi\n" +
+	"TZ-29\ten=Njombe\n" +
+	"TZ-30\ten=Simiyu\n" +
+	"TZ-31\ten=Songwe\n" +
+	"UA-05\ten=Vinnytska oblast\tru=Винницкая область\n" +
+	"UA-07\ten=Volynska oblast\tru=Волынская область\n" +
+	"UA-09\ten=Luhanska oblast\tru=Луганская область\n" +
+	"UA-12\ten=Dnipropetrovska oblast\tru=Днепропетровская область\n" +
+	"UA-14\ten=Donetska oblast\tru=Донецкая область\n" +
+	"UA-18\ten=Zhytomyrska oblast\tru=Житомирская область\n" +
+	"UA-21\ten=Zakarpatska oblast\tru=Закарпатская область\n" +
+	"UA-23\ten=Zaporizka oblast\tru=Запорожская область\n" +
+	"UA-26\ten=Ivano-Frankivska oblast\tru=Ивано-Франковская область\n" +
+	"UA-30\ten=Kyiv\tru=Киев\n" +
+	"UA-32\ten=Kyivska oblast\tru=Киевская область\n" +
+	"UA-35\ten=Kirovohradska oblast\tru=Кировоградская область\n" +
+	"UA-40\tde=Sewastopol\ten=Sevastopol\tes=Sebastopol\tfr=Municipalité de Sébastopol\tru=Севастополь\tzh-CN=塞瓦斯托波尔市\n" +
+	"UA-43\ten=Avtonomna Respublika Krym\tru=Автономная Республика Крым\n" +
+	"UA-46\ten=Lvivska oblast\tru=Львовская область\n" +
+	"UA-48\ten=Mykolaivska oblast\n" +
+	"UA-51\ten=Odeska oblast\tru=Одесская область\n" +
+	"UA-53\ten=Poltavska oblast\tru=Полтавская область\n" +
+	"UA-56\ten=Rivnenska oblast\tru=Ровненская область\n" +
+	"UA-59\ten=Sumska oblast\tru=Сумская область\n" +
+	"UA-61\ten=Ternopilska oblast\tru=Тернопольская область\n" +
+	"UA-63\ten=Kharkivska oblast\tru=Харьковская область\n" +
+	"UA-65\ten=Khersonska oblast\tru=Херсонская область\n" +
+	"UA-68\ten=Khmelnytska oblast\tru=Хмельницкая область\n" +
+	"UA-71\ten=Cherkaska oblast\tru=Черкасская область\n" +
+	"UA-74\ten=Chernihivska oblast\tru=Черниговская область\n" +
+	"UA-77\ten=Chernivetska oblast\tru=Черновицкая область\n" +
+	"UG-101\ten=Kalangala\tja=カランガラ\tzh-CN=卡兰加拉\n" +
+	"UG-102\ten=Kampala\tja=カンパラ\tzh-CN=坎帕拉\n" +
+	"UG-103\ten=Kiboga\tja=キボガ\tzh-CN=基博加\n" +
+	"UG-104\ten=Luwero\tja=ルウェロ\tzh-CN=卢韦罗\n" +
+	"UG-105\ten=Masaka\tja=マサカ\tzh-CN=马萨卡\n" +
+	"UG-106\ten=Mpigi\tja=ムピギ\tzh-CN=姆皮吉\n" +
+	"UG-107\ten=Mubende\tja=ムベンデ\tzh-CN=穆本德\n" +
+	"UG-108\ten=Mukono\tja=ムコノ\tzh-CN=穆科诺\n" +
+	"UG-109\ten=Nakasongola\tja=ナカソンゴラ\tzh-CN=纳卡松戈拉\n" +
+	"UG-110\ten=Rakai\tja=ラカイ\tzh-CN=拉卡伊\n" +
+	"UG-111\ten=Sembabule\tja=センバブレ\tzh-CN=森巴布莱\n" +
+	"UG-112\ten=Kayunga\tja=カユンガ\tzh-CN=卡永加\n" +
+	"UG-113\ten=Wakiso\tja=ワキソ\tzh-CN=瓦基索\n" +
+	"UG-114\ten=Lyantonde\n" +
+	"UG-115\ten=Mityana\tja=ミチャナ\n" +
+	"UG-116\ten=Nakaseke\tja=ナカセケ\n" +
+	"UG-117\ten=Buikwe\n" +
+	"UG-118\ten=Bukomansibi\n" +
+	"UG-119\ten=Butambala\n" +
+	"UG-120\ten=Buvuma\n" +
+	"UG-121\ten=Gomba\n" +
+	"UG-122\ten=Kalungu\n" +
+	"UG-123\ten=Kyankwanzi\n" +
+	"UG-124\ten=Lwengo\n" +
+	"UG-125\ten=Kyotera\n" +
+	"UG-126\ten=Kasanda\n" +
+	"UG-201\ten=Bugiri\tja=ブギリ\tzh-CN=布吉里\n" +
+	"UG-202\ten=Busia\tja=ブシア\tzh-CN=布西亚\n" +
+	"UG-203\ten=Iganga\tja=イガンガ\tzh-CN=伊甘加\n" +
+	"UG-204\ten=Jinja\tja=ジンジャ\tzh-CN=金贾\n" +
+	"UG-205\ten=Kamuli\tja=カムリ\tzh-CN=卡穆利\n" +
+	"UG-206\ten=Kapchorwa\tja=カプチョールワ\tzh-CN=卡普乔鲁瓦\n" +
+	"UG-207\ten=Katakwi\tja=カタクイ\tzh-CN=卡塔奎\n" +
+	"UG-208\ten=Kumi\tja=クミ\tzh-CN=库米\n" +
+	"UG-209\ten=Mbale\tja=ムバレ\tzh-CN=姆巴莱\n" +
+	"UG-210\ten=Pallisa\tja=パッリサ\tzh-CN=帕利萨\n" +
+	"UG-211\ten=Soroti\tja=ソロティ\tzh-CN=索罗提\n" +
+	"UG-212\ten=Tororo\tja=トロロ\tzh-CN=托罗罗\n" +
+	"UG-213\ten=Kaberamaido\tja=カベラマイド\tzh-CN=卡贝拉马伊多\n" +
+	"UG-214\ten=Mayuge\tja=マユゲ\tzh-CN=马尤盖\n" +
+	"UG-215\ten=Sironko\tja=シロンコ\tzh-CN=锡龙科\n" +
+	"UG-216\ten=Amuria\tja=アムリア\n" +
+	"UG-217\ten=Budaka\tja=ブダカ\n" +
+	"UG-218\ten=Bududa\n" +
+	"UG-219\ten=Bukedea\n" +
+	"UG-220\ten=Bukwo\n" +
+	"UG-221\ten=Butaleja\tja=ブタレジャ\n" +
+	"UG-222\ten=Kaliro\tja=カリロ\n" +
+	"UG-223\ten=Manafwa\tja=マナフワ\n" +
+	"UG-224\ten=Namutumba\tja=ナムトゥンバ\n" +
+	"UG-225\ten=Bulambuli\n" +
+	"UG-226\ten=Buyende\n" +
+	"UG-227\ten=Kibuku\n" +
+	"UG-228\ten=Kween\n" +
+	"UG-229\ten=Luuka\n" +
+	"UG-230\ten=Namayingo\n" +
+	"UG-231\ten=Ngora\n" +
+	"UG-232\ten=Serere\n" +
+	"UG-233\ten=Butebo\n" +
+	"UG-234\ten=Namisindwa\n" +
+	"UG-235\ten=Bugweri\n" +
+	"UG-236\ten=Kapelebyong\n" +
+	"UG-237\ten=Kalaki\n" +
+	"UG-301\ten=Adjumani\tja=アジュマニ\tzh-CN=阿朱马尼\n" +
+	"UG-302\ten=Apac\tja=アパク\tzh-CN=阿帕克\n" +
+	"UG-303\ten=Arua\tja=アルア\tzh-CN=阿鲁阿\n" +
+	"UG-304\ten=Gulu\tja=グル\tzh-CN=古卢\n" +
+	"UG-305\ten=Kitgum\tja=キトゥグム\tzh-CN=基特古姆\n" +
+	"UG-306\ten=Kotido\tja=コティド\tzh-CN=科蒂多\n" +
+	"UG-307\ten=Lira\tja=リラ\tzh-CN=利拉\n" +
+	"UG-308\ten=Moroto\tja=モロト\tzh-CN=莫罗托\n" +
+	"UG-309\ten=Moyo\tja=モヨ\tzh-CN=莫约\n" +
+	"UG-310\ten=Nebbi\tja=ネビ\tzh-CN=内比\n" +
+	"UG-311\ten=Nakapiripirit\tja=ナカピリピリティ\tzh-CN=纳卡皮里皮里特\n" +
+	"UG-312\ten=Pader\tja=パデル\tzh-CN=帕德尔\n" +
+	"UG-313\ten=Yumbe\tja=ユンベ\tzh-CN=永贝\n" +
+	"UG-314\ten=Abim\tja=アビム\n" +
+	"UG-315\ten=Amolatar\tja=アモラタル\n" +
+	"UG-316\ten=Amuru\tja=アムル\n" +
+	"UG-317\ten=Dokolo\tja=ドコロ\n" +
+	"UG-318\ten=Kaabong\tja=カボング\n" +
+	"UG-319\ten=Koboko\tja=コボコ\n" +
+	"UG-320\ten=Maracha\tja=マナチャ\n" +
+	"UG-321\ten=Oyam\tja=オヤム\n" +
+	"UG-322\ten=Agago\n" +
+	"UG-323\ten=Alebtong\n" +
+	"UG-324\ten=Amudat\n" +
+	"UG-325\ten=Kole\n" +
+	"UG-326\ten=Lamwo\n" +
+	"UG-327\ten=Napak\n" +
+	"UG-328\ten=Nwoya\n" +
+	"UG-329\ten=Otuke\n" +
+	"UG-330\ten=Zombo\n" +
+	"UG-331\ten=Omoro\n" +
+	"UG-332\ten=Pakwach\n" +
+	"UG-333\ten=Kwania\n" +
+	"UG-334\ten=Nabilatuk\n" +
+	"UG-335\ten=Karenga\n" +
+	"UG-336\ten=Madi-Okollo\n" +
+	"UG-337\ten=Obongi\n" +
+	"UG-401\ten=Bundibugyo\tja=ブンディブギョ\tzh-CN=本迪布焦\n" +
+	"UG-402\ten=Bushenyi\tja=ブシェニ\tzh-CN=布谢尼\n" +
+	"UG-403\ten=Hoima\tja=ホイマ\tzh-CN=霍伊马\n" +
+	"UG-404\ten=Kabale\tja=カバレ\tzh-CN=卡巴莱\n" +
+	"UG-405\ten=Kabarole\tja=カバロレ\tzh-CN=卡巴罗莱\n" +
+	"UG-406\ten=Kasese\tja=カセセ\tzh-CN=卡塞塞\n" +
+	"UG-407\ten=Kibaale\tja=キバーレ\tzh-CN=基巴莱\n" +
+	"UG-408\ten=Kisoro\tja=キソロ\tzh-CN=基索罗\n" +
+	"UG-409\ten=Masindi\tja=マシンディ\tzh-CN=马辛迪\n" +
+	"UG-410\ten=Mbarara\tja=ムバララ\tzh-CN=姆巴拉拉\n" +
+	"UG-411\ten=Ntungamo\tja=ヌトゥンガモ\tzh-CN=恩通加莫\n" +
+	"UG-412\ten=Rukungiri\tja=ルクンギリ\tzh-CN=鲁昆吉里\n" +
+	"UG-413\ten=Kamwenge\tja=カムウェンゲ\tzh-CN=卡姆文盖\n" +
+	"UG-414\ten=Kanungu\tja=カヌング\tzh-CN=卡农古\n" +
+	"UG-415\ten=Kyenjojo\tja=キエンジョジョ\tzh-CN=基恩乔乔\n" +
+	"UG-416\ten=Buliisa\tja=ブリリサ\n" +
+	"UG-417\ten=Ibanda\tja=イバンダ\n" +
+	"UG-418\ten=Isingiro\tja=イシンギロ\n" +
+	"UG-419\ten=Kiruhura\tja=キルフラ\n" +
+	"UG-420\ten=Buhweju\n" +
+	"UG-421\ten=Kiryandongo\n" +
+	"UG-422\ten=Kyegegwa\n" +
+	"UG-423\ten=Mitooma\n" +
+	"UG-424\ten=Ntoroko\n" +
+	"UG-425\ten=Rubirizi\n" +
+	"UG-426\ten=Sheema\n" +
+	"UG-427\ten=Kagadi\n" +
+	"UG-428\ten=Kakumiro\n" +
+	"UG-429\ten=Rubanda\n" +
+	"UG-430\ten=Bunyangabu\n" +
+	"UG-431\ten=Rukiga\n" +
+	"UG-432\ten=Kikuube\n" +
+	"UG-433\ten=Kazo\n" +
+	"UG-434\ten=Kitagwenda\n" +
+	"UG-435\ten=Rwampara\n" +
+	"UG-C\ten=Central\tja=セントラル\tzh-CN=中部\n" +
+	"UG-E\ten=Eastern\tfr=Est\tzh-CN=东部区\n" +
+	"UG-N\ten=Northern\tfr=Nord\tzh-CN=北部区\n" +
+	"UG-W\ten=Western\tfr=Ouest\tzh-CN=西部区\n" +
+	"UM-67\tde=Johnston-Atoll\ten=Johnston Atoll\tfr=Atoll Johnston\tja=ジョンストン環礁\tzh-CN=约翰斯顿岛\n" +
+	"UM-71\tde=Midwayinseln\ten=Midway Islands\tes=Midway, islas\tfr=Îles Midway\tja=ミッドウェイ諸島\tpt-BR=Ilhas Midway\tzh-CN=中途岛\n" +
+	"UM-76\tde=Navassa-Insel\ten=Navassa Island\tfr=Île Navassa\tja=ナヴァッサ島\tzh-CN=纳弗沙岛\n" +
+	"UM-79\tde=Wake\ten=Wake Island\tes=Wake, isla\tfr=Wake\tja=ウェーク島\tpt-BR=Ilha Wake\tzh-CN=威克岛\n" +
+	"UM-81\tde=Baker-Insel\ten=Baker Island\tfr=Île Baker\tja=ベーカー島\tzh-CN=贝克岛\n" +
+	"UM-84\tde=Howland-Insel\ten=Howland Island\tfr=Ile Howland\tja=ハウランド島\tzh-CN=豪兰岛\n" +
+	"UM-86\tde=Jarvis-Insel\ten=Jarvis Island\tfr=Île Jarvis\tja=ジャーヴィス島\tzh-CN=贾维斯岛\n" +
+	"UM-89\tde=Kingman-Riff\ten=Kingman Reef\tja=キングマン岩礁\tzh-CN=金曼礁\n" +
+	"UM-95\tde=Palmyra-Atoll\ten=Palmyra Atoll\tfr=Atoll Palmyra\tja=パルミラ環礁\tzh-CN=巴尔米拉环礁\n" +
+	"US-AK\ten=Alaska\tja=アラスカ\tru=Аляска\tzh-CN=阿拉斯加州\n" +
+	"US-AL\ten=Alabama\tja=アラバマ\tru=Алабама\tzh-CN=亚拉巴马州\n" +
+	"US-AR\ten=Arkansas\tja=アーカンソー\tru=Арканзас\tzh-CN=阿肯色州\n" +
+	"US-AS\tde=Amerikanisch-Samoa\ten=American Samoa\tes=Samoa Estadounidense\tfr=Samoa américaines\tja=米領サモア\tru=Американское Самоа\tzh-CN=美属萨摩亚\n" +
+	"US-AZ\ten=Arizona\tja=アリゾナ\tru=Аризона\tzh-CN=亚利桑那州\n" +
+	"US-CA\tde=Kalifornien\ten=California\tfr=Californie\tja=カリフォルニア\tru=Калифорния\tzh-CN=加利福尼亚州\n" +
+	"US-CO\ten=Colorado\tja=コロラド\tru=Колорадо\tzh-CN=科罗拉多州\n" +
+	"US-CT\ten=Connecticut\tja=コネティカット\tru=Коннектикут\tzh-CN=康涅狄格州\n" +
+	"US-DC\ten=District of Columbia\tes=Distrito de Columbia\tfr=District de Columbia\tja=コロンビア特別区\tru=Вашингтон\tzh-CN=华盛顿哥伦比亚特区\n" +
+	"US-DE\ten=Delaware\tja=デラウェア\tru=Делавэр\tzh-CN=特拉华州\n" +
+	"US-FL\ten=Florida\tfr=Floride\tja=フロリダ\tru=Флорида\tzh-CN=佛罗里达州\n" +
+	"US-GA\tde=Georgien\ten=Georgia\tfr=Géorgie\tja=ジョージア\tru=Джорджия\tzh-CN=佐治亚州\n" +
+	"US-GU\ten=Guam\tja=グアム\tru=Гуам\tzh-CN=关岛\n" +
+	"US-HI\ten=Hawaii\tes=Hawái\tja=ハワイ\tru=Гавайи\tzh-CN=夏威夷州\n" +
+	"US-IA\ten=Iowa\tja=アイオワ\tru=Айова\tzh-CN=艾奥瓦州\n" +
+	"US-ID\ten=Idaho\tja=アイダホ\tru=Айдахо\tzh-CN=爱达荷州\n" +
+	"US-IL\ten=Illinois\tja=イリノイ\tru=Иллинойс\tzh-CN=伊利诺伊州\n" +
+	"US-IN\ten=Indiana\tja=インディアナ\tru=Индиана\tzh-CN=印第安纳州\n" +
+	"US-KS\ten=Kansas\tja=カンザス\tru=Канзас\tzh-CN=堪萨斯州\n" +
+	"US-KY\ten=Kentucky\tja=ケンタッキー\tru=Кентукки\tzh-CN=肯塔基州\n" +
+	"US-LA\ten=Louisiana\tes=Luisiana\tfr=Louisiane\tja=ルイジアナ\tru=Луизиана\tzh-CN=路易斯安那州\n" +
+	"US-MA\ten=Massachusetts\tja=マサチューセッツ\tru=Массачусетс\tzh-CN=马萨诸塞州\n" +
+	"US-MD\ten=Maryland\tja=メリーランド\n" +
+	"US-ME\ten=Maine\tja=メーン\tru=Мэн\tzh-CN=缅因州\n" +
+	"US-MI\ten=Michigan\tes=Míchigan\tja=ミシガン\tru=Мичиган\tzh-CN=密歇根州\n" +
+	"US-MN\ten=Minnesota\tja=ミネソタ\tru=Миннесота\tzh-CN=明尼苏达州\n" +
+	"US-MO\ten=Missouri\tja=ミズーリ\tru=Миссури\tzh-CN=密苏里州\n" +
+	"US-MP\tde=Nördliche Marianen\ten=Northern Mariana Islands\tes=Islas Marianas del Norte\tfr=Îles Mariannes du Nord\tja=北マリアナ諸島\tru=Северные Марианские Острова\tzh-CN=北马里亚纳群岛\n" +
+	"US-MS\ten=Mississippi\tes=Misisipi\tja=ミシシッピ\tru=Миссисипи\tzh-CN=密西西比州\n" +
+	"US-MT\ten=Montana\tja=モンタナ\tru=Монтана\tzh-CN=蒙塔纳\n" +
+	"US-NC\ten=North Carolina\tes=Carolina del Norte\tfr=Caroline du Nord\tja=ノースカロライナ\tru=Северная Каролина\tzh-CN=北卡罗来纳州\n" +
+	"US-ND\ten=North Dakota\tes=Dakota del Norte\tfr=Dakota du Nord\tja=ノースダコタ\tru=Северная Дакота\tzh-CN=北达科他州\n" +
+	"US-NE\ten=Nebraska\tja=ネブラスカ\tru=Небраска\tzh-CN=内布拉斯加州\n" +
+	"US-NH\ten=New Hampshire\tes=Nuevo Hampshire\tja=ニューハンプシャー\tru=Нью-Гэмпшир\tzh-CN=新罕布什尔州\n" +
+	"US-NJ\ten=New Jersey\tes=Nueva Jersey\tja=ニュージャージー\tru=Нью-Джерси\tzh-CN=新泽西州\n" +
+	"US-NM\ten=New Mexico\tes=Nuevo México\tfr=Nouveau-Mexique\tja=ニューメキシコ\tru=Нью-Мексико\tzh-CN=新墨西哥州\n" +
+	"US-NV\ten=Nevada\tja=ネヴァダ\tru=Невада\tzh-CN=内华达州\n" +
+	"US-NY\ten=New York\tes=Nueva York\tja=ニューヨーク\tru=Нью-Йорк\tzh-CN=纽约州\n" +
+	"US-OH\ten=Ohio\tja=オハイオ\tru=Огайо\tzh-CN=俄亥俄州\n" +
+	"US-OK\ten=Oklahoma\tja=オクラホマ\tru=Оклахома\tzh-CN=俄克拉何马州\n" +
+	"US-OR\ten=Oregon\tes=Oregón\tja=オレゴン\tru=Орегон\tzh-CN=俄勒冈州\n" +
+	"US-PA\ten=Pennsylvania\tes=Pensilvania\tfr=Pennsylvanie\tja=ペンシルヴェニア\tru=Пенсильвания\tzh-CN=宾夕法尼亚州\n" +
+	"US-PR\ten=Puerto Rico\tfr=Porto Rico\tja=プエルトリコ\tru=Пуэрто-Рико\tzh-CN=波多黎各\n" +
+	"US-RI\ten=Rhode Island\tja=ロードアイランド\tru=Род-Айленд\tzh-CN=罗德岛州\n" +
+	"US-SC\ten=South Carolina\tes=Carolina del Sur\tfr=Caroline du Sud\tja=サウスカロライナ\tru=Южная Каролина\tzh-CN=南卡罗莱那州\n" +
+	"US-SD\ten=South Dakota\tes=Dakota del Sur\tfr=Dakota du Sud\tja=サウスダコタ\tru=Южная Дакота\tzh-CN=南达科他州\n" +
+	"US-TN\ten=Tennessee\tja=テネシー\tru=Теннесси\tzh-CN=田纳西州\n" +
+	"US-TX\ten=Texas\tja=テキサス\tru=Техас\tzh-CN=得克萨斯州\n" +
+	"US-UM\ten=United States Minor Outlying Islands\tes=Islas Ultramarinas Menores de Estados Unidos\tfr=Îles mineures éloignées des États-Unis\tja=アメリカ合衆国外諸島\tru=Внешние малые острова США\tzh-CN=美国本土外小岛屿\n" +
+	"US-UT\ten=Utah\tja=ユタ\tru=Юта\tzh-CN=犹他州\n" +
+	"US-VA\ten=Virginia\tfr=Virginie\tja=ヴァージニア\tru=Вирджиния\tzh-CN=弗吉尼亚州\n" +
+	"US-VI\ten=Virgin Islands, U.S.\tfr=Îles Vierges des États-Unis\tru=Виргинские Острова\tzh-CN=美属维尔京群岛\n" +
+	"US-VT\ten=Vermont\tja=ヴァーモント\tru=Вермонт\tzh-CN=佛蒙特州\n" +
+	"US-WA\ten=Washington\tja=ワシントン\tru=Вашингтон\tzh-CN=华盛顿州\n" +
+	"US-WI\ten=Wisconsin\tja=ウィスコンシン\tru=Висконсин\tzh-CN=威斯康星州\n" +
+	"US-WV\ten=West Virginia\tes=Virginia Occidental\tfr=Virginia occidentale\tja=ウェストヴァージニア\tzh-CN=西维吉尼亚州\n" +
+	"US-WY\ten=Wyoming\tja=ワイオミング\tru=Вайоминг\tzh-CN=怀俄明州\n" +
+	"UY-AR\ten=Artigas\tja=アルテイガス\tzh-CN=阿蒂加斯\n" +
+	"UY-CA\ten=Canelones\tja=カネローネス\tzh-CN=卡内洛内斯\n" +
+	"UY-CL\ten=Cerro Largo\tja=セロラルゴ\tzh-CN=塞罗拉尔戈\n" +
+	"UY-CO\ten=Colonia\tja=コロニア\tzh-CN=科洛尼亚\n" +
+	"UY-DU\ten=Durazno\tja=ドゥラスノ\tzh-CN=杜拉斯诺\n" +
+	"UY-FD\ten=Florida\tfr=Floride\tja=フロリダ\tru=Флорида\tzh-CN=佛罗里达州\n" +
+	"UY-FS\ten=Flores\tja=フロレス\tzh-CN=弗洛雷斯\n" +
+	"UY-LA\ten=Lavalleja\tja=ラバイェハ\tzh-CN=拉瓦列哈\n" +
+	"UY-MA\ten=Maldonado\tja=マルドナド\tzh-CN=马尔多纳多\n" +
+	"UY-MO\ten=Montevideo\tja=モンテビデオ\tzh-CN=蒙特维多\n" +
+	"UY-PA\ten=Paysandú\tja=パイサンドゥ\n" +
+	"UY-RN\ten=Río Negro\tja=リオネグロ\tru=Рио-Негро\n" +
+	"UY-RO\ten=Rocha\tja=ロチャ\tzh-CN=罗恰\n" +
+	"UY-RV\ten=Rivera\tja=リベラ\tzh-CN=里维拉\n" +
+	"UY-SA\ten=Salto\tja=サルト\tzh-CN=萨尔托\n" +
+	"UY-SJ\ten=San José\tja=サンホゼ\n" +
+	"UY-SO\ten=Soriano\tja=ソリアノ\tzh-CN=索里亚诺\n" +
+	"UY-TA\ten=Tacuarembó\tja=タクアレンボ\n" +
+	"UY-TT\ten=Treinta y Tres\tja=トレインタ ィ トレス\tzh-CN=特雷因塔伊特雷斯\n" +
+	"UZ-AN\ten=Andijon\tja=アンジャン\tzh-CN=安集延\n" +
+	"UZ-BU\ten=Buxoro\tfr=Boukhara\tzh-CN=布哈拉\n" +
+	"UZ-FA\ten=Farg‘ona\n" +
+	"UZ-JI\ten=Jizzax\tfr=Jizzakh\tzh-CN=吉扎克\n" +
+	"UZ-NG\ten=Namangan\tja=ナマンガン\tzh-CN=纳曼干\n" +
+	"UZ-NW\ten=Navoiy\tja=ナボイ\tzh-CN=纳沃伊\n" +
+	"UZ-QA\ten=Qashqadaryo\tja=カシュカダリア\tzh-CN=卡什卡达里亚\n" +
+	"UZ-QR\ten=Qoraqalpog‘iston Respublikasi\tru=Каракалпакстан\n" +
+	"UZ-SA\ten=Samarqand\tfr=Samarcande\tja=サマルカンド\tru=Самарканд\tzh-CN=撒马尔罕\n" +
+	"UZ-SI\ten=Sirdaryo\tja=シルダリャ\tzh-CN=锡尔河\n" +
+	"UZ-SU\ten=Surxondaryo\tja=スルハンダリア\tzh-CN=苏尔汉河\n" +
+	"UZ-TK\ten=Toshkent\tfr=Tachkent\tja=タシケント\tru=Ташкент\tzh-CN=塔什干\n" +
+	"UZ-TO\ten=Toshkent\tfr=Tachkent\tja=タシケント\tru=Ташкент\tzh-CN=塔什干\n" +
+	"UZ-XO\ten=Xorazm\tja=ホレズム\tru=Хорезм\tzh-CN=花拉子模\n" +
+	"VC-01\ten=Charlotte\tzh-CN=沙洛\n" +
+	"VC-02\ten=Saint Andrew\tfr=Saint-Andrew\tru=Сент Эндрю\tzh-CN=圣安德鲁斯\n" +
+	"VC-03\ten=Saint David\tfr=Saint-David\tzh-CN=圣大卫\n" +
+	"VC-04\ten=Saint George\tfr=Saint-George\tja=セントジョージ\tru=Сент-Джордж\tzh-CN=圣乔治\n" +
+	"VC-05\ten=Saint Patrick\tfr=Saint-Patrick\tzh-CN=圣派屈克\n" +
+	"VC-06\ten=Grenadines\tja=グレナディーン\tzh-CN=格瑞那丁\n" +
+	"VE-A\ten=Distrito Capital\n" +
+	"VE-B\ten=Anzoátegui\tzh-CN=安索阿特吉州\n" +
+	"VE-C\ten=Apure\tja=アプレ\tzh-CN=阿普雷州\n" +
+	"VE-D\ten=Aragua\tja=アラゲグア\tzh-CN=阿拉瓜州\n" +
+	"VE-E\ten=Barinas\tja=バリナス\tzh-CN=巴里纳斯州\n" +
+	"VE-F\ten=Bolívar\tfr=Bolivar\tja=ボリバル\tzh-CN=玻利瓦尔省\n" +
+	"VE-G\ten=Carabobo\tja=カラボボ\tzh-CN=卡拉沃沃州\n" +
+	"VE-H\ten=Cojedes\tja=コジュデス\tzh-CN=科赫德斯州\n" +
+	"VE-I\ten=Falcón\tja=ファルコン\tzh-CN=法尔孔州\n" +
+	"VE-J\ten=Guárico\tja=グアリコ\tzh-CN=瓜里科州\n" +
+	"VE-K\ten=Lara\tja=ララ\tzh-CN=拉腊州\n" +
+	"VE-L\ten=Mérida\tja=メリダ\tzh-CN=梅里达州\n" +
+	"VE-M\ten=Miranda\tja=ミランダ\tzh-CN=米兰达州\n" +
+	"VE-N\ten=Monagas\tja=モナガス\tzh-CN=莫纳加斯州\n" +
+	"VE-O\ten=Nueva Esparta\tja=ヌエヴァ･エスパルタ\tzh-CN=新埃斯帕塔州\n" +
+	"VE-P\ten=Portuguesa\tja=ポルトゲサ\tzh-CN=波图格萨州\n" +
+	"VE-R\ten=Sucre\tja=スクレ\tzh-CN=苏克雷\n" +
+	"VE-S\ten=Táchira\tja=タチラ\tzh-CN=塔奇拉州\n" +
+	"VE-T\ten=Trujillo\tja=トルヒヨ\tzh-CN=特鲁希略州\n" +
+	"VE-U\ten=Yaracuy\tja=ヤラクイ\tzh-CN=亚拉奎州\n" +
+	"VE-V\ten=Zulia\tja=スリア\tzh-CN=苏利亚州\n" +
+	"VE-W\ten=Dependencias Federales\tfr=Dépendances fédérales\tzh-CN=联邦属地\n" +
+	"VE-X\ten=La Guaira\n" +
+	"VE-Y\ten=Delta Amacuro\tja=デルタ・アマクロ\tzh-CN=阿马库罗三角洲\n" +
+	"VE-Z\ten=Amazonas\tfr=Amazone\tja=アマゾナス\tzh-CN=亚马孙州\n" +
+	"VN-01\ten=Lai Châu\tja=ライチャウ\tzh-CN=莱州省\n" +
+	"VN-02\ten=Lào Cai\tja=ラオカイ\tzh-CN=老街省\n" +
+	"VN-03\ten=Hà Giang\tja=ハジアン\tzh-CN=河江省\n" +
+	"VN-04\ten=Cao Bằng\tja=カオバン\tzh-CN=高平省\n" +
+	"VN-05\ten=Sơn La\tja=ソンラ\tzh-CN=山罗省\n" +
+	"VN-06\ten=Yên Bái\tja=イェンバイ\tzh-CN=安沛省\n" +
+	"VN-07\ten=Tuyên Quang\tja=トゥエンクァン\tzh-CN=宣光省\n" +
+	"VN-09\ten=Lạng Sơn\tja=ランソン\tzh-CN=谅山省\n" +
+	"VN-13\ten=Quảng Ninh\tja=クァンニン\tzh-CN=广宁省\n" +
+	"VN-14\ten=Hòa Bình\n" +
+	"VN-18\ten=Ninh Bình\tja=ニンビン\tzh-CN=宁平省\n" +
+	"VN-20\ten=Thái Bình\tja=タイビン\tzh-CN=太平省\n" +
+	"VN-21\ten=Thanh Hóa\tja=タンホア\tzh-CN=清化省\n" +
+	"VN-22\ten=Nghệ An\tja=ゲーアン\tzh-CN=义安省\n" +
+	"VN-23\ten=Hà Tĩnh\n" +
+	"VN-24\ten=Quảng Bình\tja=クァンビン\tzh-CN=广平省\n" +
+	"VN-25\ten=Quảng Trị\tja=クァンチ\tzh-CN=广治省\n" +
+	"VN-26\ten=Thừa Thiên-Huế\tzh-CN=承天顺化省\n" +
+	"VN-27\ten=Quảng Nam\tja=クァンナム\tzh-CN=广南省\n" +
+	"VN-28\ten=Kon Tum\tja=コントゥム\tzh-CN=昆嵩省\n" +
+	"VN-29\ten=Quảng Ngãi\tja=クァンガイ\tzh-CN=广义省\n" +
+	"VN-30\ten=Gia Lai\tja=ジアライ\tzh-CN=嘉莱省\n" +
+	"VN-31\ten=Bình Định\tja=ビンディン\tzh-CN=平定省\n" +
+	"VN-32\ten=Phú Yên\tja=フーイェン\tzh-CN=富安省\n" +
+	"VN-33\ten=Đắk Lắk\n" +
+	"VN-34\ten=Khánh Hòa\tja=コンホア\tzh-CN=庆和省\n" +
+	"VN-35\ten=Lâm Đồng\tja=ラムドン\tzh-CN=林同省\n" +
+	"VN-36\ten=Ninh Thuận\tja=ニントゥアン\tzh-CN=宁顺省\n" +
+	"VN-37\ten=Tây Ninh\tja=テイニン\tzh-CN=西宁省\n" +
+	"VN-39\ten=Đồng Nai\tja=ドンナイ\tzh-CN=同奈省\n" +
+	"VN-40\ten=Bình Thuận\tja=ビントゥアン\tzh-CN=平顺省\n" +
+	"VN-41\ten=Long An\tja=ロンアン\tzh-CN=隆安省\n" +
+	"VN-43\ten=Bà Rịa - Vũng Tàu\n" +
+	"VN-44\ten=An Giang\tja=アンザン\tzh-CN=安江省\n" +
+	"VN-45\ten=Đồng Tháp\tja=ドンタプ\tzh-CN=同塔省\n" +
+	"VN-46\ten=Tiền Giang\tja=ティエンジアン\tzh-CN=前江省\n" +
+	"VN-47\ten=Kiến Giang\n" +
+	"VN-49\ten=Vĩnh Long\tja=ビンロン\tzh-CN=永隆省\n" +
+	"VN-50\ten=Bến Tre\tja=ベンチェ\tzh-CN=槟知省\n" +
+	"VN-51\ten=Trà Vinh\tja=チャビン\tzh-CN=茶荣省\n" +
+	"VN-52\ten=Sóc Trăng\tja=ソクチャン\tzh-CN=朔庄省\n" +
+	"VN-53\ten=Bắc Kạn\tja=バクカン\tzh-CN=北干省\n" +
+	"VN-54\ten=Bắc Giang\tja=バクジアン\tzh-CN=北江省\n" +
+	"VN-55\ten=Bạc Liêu\tja=バクリュウ\tzh-CN=薄辽省\n" +
+	"VN-56\ten=Bắc Ninh\tja=バクニン\tzh-CN=北宁省\n" +
+	"VN-57\ten=Bình Dương\tja=ビンドゥオン\tzh-CN=平阳省\n" +
+	"VN-58\ten=Bình Phước\tja=ビンフォク\tzh-CN=平福省\n" +
+	"VN-59\ten=Cà Mau\tja=カマウ\tzh-CN=金瓯省\n" +
+	"VN-61\ten=Hải Dương\n" +
+	"VN-63\ten=Hà Nam\tja=ハナム\tzh-CN=河南省\n" +
+	"VN-66\ten=Hưng Yên\tja=フンイェン\tzh-CN=兴安省\n" +
+	"VN-67\ten=Nam Định\tzh-CN=南定省\n" +
+	"VN-68\ten=Phú Thọ\tja=フート\tzh-CN=富寿省\n" +
+	"VN-69\ten=Thái Nguyên\tja=タイグエン\tzh-CN=太原省\n" +
+	"VN-70\ten=Vĩnh Phúc\tzh-CN=永富省\n" +
+	"VN-71\ten=Điện Biên\tzh-CN=奠边省\n" +
+	"VN-72\ten=Đắk Nông\tzh-CN=得农省\n" +
+	"VN-73\ten=Hậu Giang\tzh-CN=后江省\n" +
+	"VN-CT\ten=Cần Thơ\tzh-CN=芹苴市\n" +
+	"VN-DN\ten=Đà Nẵng\tja=ダナン\tru=Дананг\n" +
+	"VN-HN\ten=Hà Nội\tfr=Hanoi\tja=ハノイ\tru=Ханой\n" +
+	"VN-HP\ten=Hải Phòng\tfr=Hai Phong\tja=ハイフォン\n" +
+	"VN-SG\ten=Hồ Chí Minh\n" +
+	"VU-MAP\ten=Malampa\tzh-CN=马朗帕\n" +
+	"VU-PAM\ten=Pénama\n" +
+	"VU-SAM\ten=Sanma\tzh-CN=桑马\n" +
+	"VU-SEE\ten=Shéfa\n" +
+	"VU-TAE\ten=Taféa\n" +
+	"VU-TOB\ten=Torba\tzh-CN=托尔巴\n" +
+	"WF-AL\ten=Alo\n" +
+	"WF-SG\ten=Sigave\n" +
+	"WF-UV\ten=Uvea\n" +
+	"WS-AA\ten=A'ana\tzh-CN=阿纳\n" +
+	"WS-AL\ten=Aiga-i-le-Tai\tzh-CN=艾加伊勒泰\n" +
+	"WS-AT\ten=Atua\tzh-CN=阿图阿\n" +
+	"WS-FA\ten=Fa'asaleleaga\n" +
+	"WS-GE\ten=Gaga'emauga\tzh-CN=加加埃毛加\n" +
+	"WS-GI\ten=Gagaifomauga\tzh-CN=加盖福毛加\n" +
+	"WS-PA\ten=Palauli\tja=パラウリ\tzh-CN=帕劳利\n" +
+	"WS-SA\ten=Satupa'itea\tzh-CN=萨图帕伊泰阿\n" +
+	"WS-TU\ten=Tuamasaga\tzh-CN=图阿马萨加\n" +
+	"WS-VF\ten=Va'a-o-Fonoti\tzh-CN=瓦奥福诺蒂\n" +
+	"WS-VS\ten=Vaisigano\tzh-CN=韦西加诺\n" +
+	"YE-AB\ten=Abyan\n" +
+	"YE-AD\ten=‘Adan\n" +
+	"YE-AM\ten=‘Amrān\n" +
+	"YE-BA\ten=Al Bayḑā’\n" +
+	"YE-DA\ten=Aḑ Ḑāli‘\n" +
+	"YE-DH\ten=Dhamār\tja=ダマール\n" +
+	"YE-HD\tde=Ḩaḑramaut\ten=Ḩaḑramawt\tja=ハドラマウト\n" +
+	"YE-HJ\tde=Haddscha\ten=Ḩajjah\tja=ハッジャ\n" +
+	"YE-HU\tde=Al-Ḩudaida\ten=Al Ḩudaydah\tja=ホデイダ\n" +
+	"YE-IB\ten=Ibb\tja=イップ\tzh-CN=伊卜\n" +
+	"YE-JA\tde=Dschauf\ten=Al Jawf\tja=ジャウフ\n" +
+	"YE-LA\tde=Lahidsch\ten=Laḩij\tja=ラヘジ\n" +
+	"YE-MA\ten=Ma’rib\n" +
+	"YE-MR\tde=Al-Mahra\ten=Al Mahrah\tja=アルマハラ\tzh-CN=马哈拉\n" +
+	"YE-MW\tde=Al-Maḩwīt\ten=Al Maḩwīt\tja=アルマハウィット\n" +
+	"YE-RA\tde=Raima\ten=Raymah\tja=ライマ\n" +
+	"YE-SA\ten=Amānat al ‘Āşimah [city]\n" +
+	"YE-SD\ten=Şāʻdah\n" +
+	"YE-SH\tde=Schabwa\ten=Shabwah\tja=シャブワ\tzh-CN=夏卜瓦\n" +
+	"YE-SN\ten=Şanʻā’\n" +
+	"YE-SU\ten=Arkhabīl Suquţrá\n" +
+	"YE-TA\ten=Tāʻizz\n" +
+	"ZA-EC\tde=Ostkap\ten=Eastern Cape\tfr=Cap-Oriental\tja=東ケープ\tzh-CN=东开普省\n" +
+	"ZA-FS\tde=Freistaat\ten=Free State\tfr=État-Libre\tja=自由州\tzh-CN=奥兰治自由邦\n" +
+	"ZA-GP\ten=Gauteng\tja=ガウテング\tzh-CN=豪登省\n" +
+	"ZA-KZN\ten=Kwazulu-Natal\tja=クワズールーナタール\tzh-CN=夸祖鲁-纳塔尔省\n" +
+	"ZA-LP\ten=Limpopo\tja=リンポポ\tzh-CN=林波波省\n" +
+	"ZA-MP\ten=Mpumalanga\tja=マプマランガ\tzh-CN=普马兰加省\n" +
+	"ZA-NC\tde=Nordkap\ten=Northern Cape\tfr=Cap-du-Nord\tja=北ケープ\tzh-CN=北开普省\n" +
+	"ZA-NW\tde=Nordwest\ten=North-West\tfr=Nord-Ouest\n" +
+	"ZA-WC\tde=Westkap\ten=Western Cape\tfr=Cap-Occidental\tja=西ケープ\tzh-CN=西开普省\n" +
+	"ZM-01\ten=Western\tfr=Ouest\tzh-CN=西部区\n" +
+	"ZM-02\ten=Central\tja=セントラル\tzh-CN=中部\n" +
+	"ZM-03\ten=Eastern\tfr=Est\tzh-CN=东部区\n" +
+	"ZM-04\ten=Luapula\tja=ルアプラ\tzh-CN=卢阿普拉省\n" +
+	"ZM-05\ten=Northern\tfr=Nord\tzh-CN=北部区\n" +
+	"ZM-06\tde=Nordwestprovinz\ten=North-Western\tfr=North-Ouest\tja=北西部州\tzh-CN=西北省\n" +
+	"ZM-07\tde=Süd\ten=Southern\tfr=Sud\n" +
+	"ZM-08\ten=Copperbelt\tja=コッパーベルト\tzh-CN=铜带省\n" +
+	"ZM-09\ten=Lusaka\tja=ルサカ\tzh-CN=卢萨卡省\n" +
+	"ZM-10\ten=Muchinga\n" +
+	"ZW-BU\ten=Bulawayo\tja=ブラワヨ\tzh-CN=布拉瓦约\n" +
+	"ZW-HA\ten=Harare\tja=ハラレ\tzh-CN=哈拉雷\n" +
+	"ZW-MA\ten=Manicaland\tja=マニカランド\tzh-CN=马尼卡兰\n" +
+	"ZW-MC\ten=Mashonaland Central\tfr=Mashonaland central\tja=マショナランド・セントラル\tzh-CN=中马绍纳兰\n" +
+	"ZW-ME\ten=Mashonaland East\tfr=Mashonaland oriental\tja=マショナランド・イースト\tzh-CN=东马绍纳兰\n" +
+	"ZW-MI\ten=Midlands\tja=ミッドランズ\tzh-CN=中部\n" +
+	"ZW-MN\ten=Matabeleland North\tfr=Matabeleland septentrional\tja=北マタベランド\tzh-CN=北马塔贝莱兰\n" +
+	"ZW-MS\ten=Matabeleland South\tfr=Matabeleland méridional\tja=南マタベランド\tru=Южный Матабелеленд\tzh-CN=南马塔贝莱兰\n" +
+	"ZW-MV\ten=Masvingo\tja=マスビンゴ\tru=Масвинго\tzh-CN=马斯温戈\n" +
+	"ZW-MW\ten=Mashonaland West\tfr=Mashonaland occidental\tja=マショナランド・ウエスト\tru=Западный Машоналенд\tzh-CN=西马绍纳兰\n" +
+	""
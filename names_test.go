@@ -0,0 +1,70 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBundledNames(t *testing.T) {
+	Convey("Given only ISO codes", t, func() {
+		Convey("I expect localized country names", func() {
+			name, ok := CountryName("us", "de")
+			So(ok, ShouldBeTrue)
+			So(name, ShouldEqual, "Vereinigte Staaten")
+		})
+
+		Convey("I expect the first available locale to win", func() {
+			name, ok := CountryName("US", "xx", "fr")
+			So(ok, ShouldBeTrue)
+			So(name, ShouldEqual, "États-Unis")
+		})
+
+		Convey("I expect English when no locale is available", func() {
+			name, ok := SubdivisionName("US", "CA", "xx")
+			So(ok, ShouldBeTrue)
+			So(name, ShouldEqual, "California")
+		})
+
+		Convey("I expect localized subdivision names", func() {
+			name, ok := SubdivisionName("DE", "BY", "fr")
+			So(ok, ShouldBeTrue)
+			So(name, ShouldEqual, "Bavière")
+		})
+
+		Convey("I expect unknown codes to be reported", func() {
+			_, ok := CountryName("ZZ")
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
+
+func TestBundledCountryNameFallback(t *testing.T) {
+	Convey("Given a country with only an ISO code", t, func() {
+		country := Country{IsoCode: "DE", Names: map[string]string{"en": "Germany"}}
+
+		Convey("I expect the bundled names to fill in missing locales", func() {
+			name, _ := CountryName("DE", "fr")
+			So(country.Name("fr"), ShouldEqual, name)
+			So(country.Name(), ShouldEqual, "Germany")
+			So(Country{IsoCode: "FR"}.Name(), ShouldNotBeEmpty)
+		})
+	})
+}
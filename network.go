@@ -0,0 +1,66 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"net/netip"
+	"sort"
+)
+
+// Network returns the network MaxMind answered for, Traits.Network with
+// its host bits cleared; it is invalid when the response carries none
+func (r Response) Network() netip.Prefix {
+	return r.Traits.Network.Masked()
+}
+
+// Contains reports whether addr lies in the response's network, and so
+// shares its answer; IPv4-mapped addresses match their IPv4 network
+func (r Response) Contains(addr netip.Addr) bool {
+	network := r.Network()
+	return network.IsValid() && network.Contains(addr.Unmap())
+}
+
+// SameNetworkAs reports whether r and other were answered for the same
+// network.  Responses without a network are never the same.
+func (r Response) SameNetworkAs(other Response) bool {
+	network := r.Network()
+	return network.IsValid() && network == other.Network()
+}
+
+// GroupByNetwork groups successful results by the network of their
+// response, each group in the order given, and returns the networks
+// sorted so that output is stable.  Results that failed or carry no
+// network are left out.
+func GroupByNetwork(results []Result) ([]netip.Prefix, map[netip.Prefix][]Result) {
+	groups := map[netip.Prefix][]Result{}
+	var networks []netip.Prefix
+	for _, result := range results {
+		network := result.Response.Network()
+		if result.Err != nil || !network.IsValid() {
+			continue
+		}
+		if _, ok := groups[network]; !ok {
+			networks = append(networks, network)
+		}
+		groups[network] = append(groups[network], result)
+	}
+	sort.Slice(networks, func(i, j int) bool {
+		if c := networks[i].Addr().Compare(networks[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return networks[i].Bits() < networks[j].Bits()
+	})
+	return networks, groups
+}
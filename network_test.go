@@ -0,0 +1,72 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNetwork(t *testing.T) {
+	Convey("Given responses with networks", t, func() {
+		a := Response{Traits: Traits{Network: netip.MustParsePrefix("1.2.3.4/24")}}
+		b := Response{Traits: Traits{Network: netip.MustParsePrefix("1.2.3.0/24")}}
+		c := Response{Traits: Traits{Network: netip.MustParsePrefix("2a00:1450::/32")}}
+		none := Response{}
+
+		Convey("I expect the network masked", func() {
+			So(a.Network(), ShouldEqual, netip.MustParsePrefix("1.2.3.0/24"))
+			So(none.Network().IsValid(), ShouldBeFalse)
+		})
+
+		Convey("I expect containment to follow the network", func() {
+			So(a.Contains(netip.MustParseAddr("1.2.3.200")), ShouldBeTrue)
+			So(a.Contains(netip.MustParseAddr("::ffff:1.2.3.200")), ShouldBeTrue)
+			So(a.Contains(netip.MustParseAddr("1.2.4.1")), ShouldBeFalse)
+			So(c.Contains(netip.MustParseAddr("2a00:1450::1")), ShouldBeTrue)
+			So(none.Contains(netip.MustParseAddr("1.2.3.4")), ShouldBeFalse)
+		})
+
+		Convey("I expect only responses for one network to be the same", func() {
+			So(a.SameNetworkAs(b), ShouldBeTrue)
+			So(a.SameNetworkAs(c), ShouldBeFalse)
+			So(none.SameNetworkAs(none), ShouldBeFalse)
+		})
+
+		Convey("When I group results by network", func() {
+			networks, groups := GroupByNetwork([]Result{
+				{IpAddress: "2a00:1450::1", Response: c},
+				{IpAddress: "1.2.3.4", Response: a},
+				{IpAddress: "1.2.3.5", Response: b},
+				{IpAddress: "9.9.9.9", Response: none},
+				{IpAddress: "8.8.8.8", Err: errors.New("boom")},
+			})
+
+			Convey("I expect sorted networks and results in order", func() {
+				So(networks, ShouldResemble, []netip.Prefix{
+					netip.MustParsePrefix("1.2.3.0/24"),
+					netip.MustParsePrefix("2a00:1450::/32"),
+				})
+				group := groups[networks[0]]
+				So(group, ShouldHaveLength, 2)
+				So(group[0].IpAddress, ShouldEqual, "1.2.3.4")
+				So(group[1].IpAddress, ShouldEqual, "1.2.3.5")
+			})
+		})
+	})
+}
@@ -0,0 +1,120 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// Health classifies the outcome of Ping
+type Health int
+
+const (
+	// HealthOK means the web service answered with the credentials accepted
+	HealthOK Health = iota
+
+	// HealthUnauthorized means the credentials were rejected, or lack
+	// permission for the service
+	HealthUnauthorized
+
+	// HealthOutOfQueries means the credentials are good but the account
+	// can't pay for lookups, or a QuotaGuard is refusing them
+	HealthOutOfQueries
+
+	// HealthUnreachable means no answer came from the web service: a
+	// network failure, a timeout or a server error
+	HealthUnreachable
+)
+
+func (h Health) String() string {
+	switch h {
+	case HealthOK:
+		return "ok"
+	case HealthUnauthorized:
+		return "unauthorized"
+	case HealthOutOfQueries:
+		return "out of queries"
+	case HealthUnreachable:
+		return "unreachable"
+	default:
+		return "unknown"
+	}
+}
+
+// Ping checks that the web service is reachable and accepts the Api's
+// credentials, e.g. at startup or in a readiness probe, by looking up the
+// country of the caller's own address.  The web services have no free
+// endpoint, so each Ping costs one Country query; probes should be spaced
+// accordingly.  The error is that of the lookup, and nil for HealthOK.
+func (a *Api) Ping(ctx context.Context) (Health, error) {
+	_, err := a.CountryMe(ctx)
+	health := classifyHealth(err)
+	if health == HealthOK {
+		return HealthOK, nil
+	}
+	return health, err
+}
+
+// ValidateCredentials returns an error only when the web service rejects
+// the Api's credentials, so a service can refuse to start with bad keys but
+// still start while MaxMind is unreachable or the account is out of
+// queries.  Like Ping, it costs one Country query.
+func (a *Api) ValidateCredentials(ctx context.Context) error {
+	health, err := a.Ping(ctx)
+	if health == HealthUnauthorized {
+		return err
+	}
+	return nil
+}
+
+func classifyHealth(err error) Health {
+	if err == nil {
+		return HealthOK
+	}
+	if errors.Is(err, ErrQuotaExhausted) {
+		return HealthOutOfQueries
+	}
+	var decodeErr DecodeError
+	if errors.As(err, &decodeErr) {
+		return HealthOK // an answer, if an imperfect one
+	}
+
+	var v Error
+	if !errors.As(err, &v) {
+		return HealthUnreachable
+	}
+	switch v.Code {
+	case CodeAccountIdRequired, CodeAccountIdUnknown, CodeAuthorizationInvalid, CodeLicenseKeyRequired, CodePermissionRequired:
+		return HealthUnauthorized
+	case CodeOutOfQueries, CodeInsufficientFunds:
+		return HealthOutOfQueries
+	case "":
+		// a proxy or load balancer answered without a MaxMind code
+		switch v.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return HealthUnauthorized
+		case http.StatusPaymentRequired:
+			return HealthOutOfQueries
+		}
+		return HealthUnreachable
+	}
+	if v.StatusCode >= 500 {
+		return HealthUnreachable
+	}
+	// the web service answered and rejected only the address
+	return HealthOK
+}
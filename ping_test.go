@@ -0,0 +1,104 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPing(t *testing.T) {
+	Convey("Given a server answering with a given status and body", t, func() {
+		var path string
+		status, body := http.StatusOK, `{"country":{"iso_code":"US"}}`
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			path = req.URL.Path
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+		}))
+		defer server.Close()
+		api := New("blah-user-id", "blah-license-key", WithBaseURL(server.URL))
+		ctx := context.Background()
+
+		Convey("When the lookup succeeds", func() {
+			health, err := api.Ping(ctx)
+
+			Convey("I expect HealthOK from a Country lookup of the caller", func() {
+				So(health, ShouldEqual, HealthOK)
+				So(err, ShouldBeNil)
+				So(path, ShouldEqual, "/country/me")
+				So(api.ValidateCredentials(ctx), ShouldBeNil)
+			})
+		})
+
+		cases := []struct {
+			status int
+			body   string
+			health Health
+		}{
+			{http.StatusUnauthorized, `{"code":"AUTHORIZATION_INVALID","error":"bad key"}`, HealthUnauthorized},
+			{http.StatusForbidden, `{"code":"PERMISSION_REQUIRED","error":"no insights"}`, HealthUnauthorized},
+			{http.StatusForbidden, `<html>Forbidden</html>`, HealthUnauthorized},
+			{http.StatusPaymentRequired, `{"code":"OUT_OF_QUERIES","error":"out"}`, HealthOutOfQueries},
+			{http.StatusPaymentRequired, `{"code":"INSUFFICIENT_FUNDS","error":"out"}`, HealthOutOfQueries},
+			{http.StatusBadRequest, `{"code":"IP_ADDRESS_RESERVED","error":"reserved"}`, HealthOK},
+			{http.StatusBadGateway, `<html>Bad Gateway</html>`, HealthUnreachable},
+		}
+		for _, c := range cases {
+			Convey(fmt.Sprintf("When the server answers %d %s", c.status, c.body), func() {
+				status, body = c.status, c.body
+				health, err := api.Ping(ctx)
+
+				Convey(fmt.Sprintf("I expect %v", c.health), func() {
+					So(health, ShouldEqual, c.health)
+					So(err == nil, ShouldEqual, c.health == HealthOK)
+
+					err := api.ValidateCredentials(ctx)
+					So(err != nil, ShouldEqual, c.health == HealthUnauthorized)
+				})
+			})
+		}
+
+		Convey("When the quota guard refuses lookups", func() {
+			guard := &QuotaGuard{HardStop: true}
+			guard.observe(0)
+			health, err := api.Clone(WithQuotaGuard(guard)).Ping(ctx)
+
+			Convey("I expect HealthOutOfQueries", func() {
+				So(health, ShouldEqual, HealthOutOfQueries)
+				So(err, ShouldEqual, ErrQuotaExhausted)
+			})
+		})
+	})
+
+	Convey("Given a server that isn't there", t, func() {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+		health, err := New("blah-user-id", "blah-license-key", WithBaseURL(server.URL)).Ping(context.Background())
+
+		Convey("I expect HealthUnreachable", func() {
+			So(health, ShouldEqual, HealthUnreachable)
+			So(err, ShouldNotBeNil)
+			So(errors.Is(err, ErrQuotaExhausted), ShouldBeFalse)
+			So(health.String(), ShouldEqual, "unreachable")
+		})
+	})
+}
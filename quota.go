@@ -0,0 +1,125 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+)
+
+// QueriesRemainingHeader reports the account's remaining query balance
+const QueriesRemainingHeader = "X-MaxMind-Queries-Remaining"
+
+// QueriesRemaining returns the account's remaining query balance as of the
+// response, from the response header or, failing that, the response body
+func (r Response) QueriesRemaining() (int, bool) {
+	if v := r.Meta().Header.Get(QueriesRemainingHeader); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			return n, true
+		}
+	}
+	if r.MaxMind.QueriesRemaining > 0 {
+		return r.MaxMind.QueriesRemaining, true
+	}
+	return 0, false
+}
+
+// WithQuotaCallback calls fn with the remaining query balance after every
+// lookup that reports one, so callers can alert before the balance runs
+// out.  fn is called synchronously and should return quickly.
+func WithQuotaCallback(fn func(remaining int)) Option {
+	return func(a *Api) {
+		a.onQuota = fn
+	}
+}
+
+// ErrQuotaExhausted is returned without a request once a QuotaGuard with
+// HardStop sees the balance fall to its Reserve
+var ErrQuotaExhausted = errors.New("geoip2: query quota exhausted")
+
+// QuotaGuard tracks the remaining query balance reported by each response,
+// warns when it runs low and can refuse further paid lookups, so a runaway
+// batch can't drain the account.  Cached answers are always served.  The
+// balance is learned from responses, so lookups already in flight when the
+// Reserve is reached may still take it slightly below.
+type QuotaGuard struct {
+	// Threshold is the balance below which OnLow is called
+	Threshold int
+
+	// OnLow is called once when the balance drops below Threshold, and again
+	// only after it has been topped up above Threshold.  It is called
+	// synchronously and should return quickly.
+	OnLow func(remaining int)
+
+	// HardStop refuses lookups with ErrQuotaExhausted once the balance is
+	// at or below Reserve
+	HardStop bool
+	Reserve  int
+
+	mutex     sync.Mutex
+	remaining int
+	known     bool
+	low       bool
+}
+
+// WithQuotaGuard consults guard before each paid lookup and reports every
+// balance to it.  Clones of the Api share the guard.
+func WithQuotaGuard(guard *QuotaGuard) Option {
+	return func(a *Api) {
+		a.quota = guard
+	}
+}
+
+// Remaining returns the last balance reported, if any
+func (g *QuotaGuard) Remaining() (int, bool) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.remaining, g.known
+}
+
+// Reset forgets the balance, e.g. once the account has been topped up, so
+// that a stopped guard lets lookups through again
+func (g *QuotaGuard) Reset() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.remaining, g.known, g.low = 0, false, false
+}
+
+// allow reports whether a paid lookup may be sent
+func (g *QuotaGuard) allow() error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.HardStop && g.known && g.remaining <= g.Reserve {
+		return ErrQuotaExhausted
+	}
+	return nil
+}
+
+func (g *QuotaGuard) observe(remaining int) {
+	g.mutex.Lock()
+	g.remaining, g.known = remaining, true
+	crossed := remaining < g.Threshold && !g.low
+	if remaining < g.Threshold {
+		g.low = true
+	} else {
+		g.low = false
+	}
+	g.mutex.Unlock()
+
+	if crossed && g.OnLow != nil {
+		g.OnLow(remaining)
+	}
+}
@@ -0,0 +1,133 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestQuota(t *testing.T) {
+	Convey("Given an Api whose responses report the query balance", t, func() {
+		header := http.Header{}
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 200,
+				Header:     header,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		})
+		var reported []int
+		api = api.Clone(WithQuotaCallback(func(remaining int) {
+			reported = append(reported, remaining)
+		}))
+
+		Convey("When the header is present", func() {
+			header.Set(QueriesRemainingHeader, "42")
+			resp, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			Convey("I expect it to take precedence over the body", func() {
+				remaining, ok := resp.QueriesRemaining()
+				So(ok, ShouldBeTrue)
+				So(remaining, ShouldEqual, 42)
+				So(reported, ShouldResemble, []int{42})
+			})
+		})
+
+		Convey("When only the body reports the balance", func() {
+			resp, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			Convey("I expect the body value", func() {
+				remaining, ok := resp.QueriesRemaining()
+				So(ok, ShouldBeTrue)
+				So(remaining, ShouldEqual, resp.MaxMind.QueriesRemaining)
+				So(reported, ShouldResemble, []int{resp.MaxMind.QueriesRemaining})
+			})
+		})
+	})
+
+	Convey("Given a response without a balance", t, func() {
+		_, ok := Response{}.QueriesRemaining()
+
+		Convey("I expect it to be unknown", func() {
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
+
+func TestQuotaGuard(t *testing.T) {
+	Convey("Given an Api guarded against draining the account", t, func() {
+		remaining, calls := 5, 0
+		var warned []int
+		guard := &QuotaGuard{
+			Threshold: 4,
+			OnLow:     func(remaining int) { warned = append(warned, remaining) },
+			HardStop:  true,
+			Reserve:   2,
+		}
+		api := WithClientFunc(New("blah-user-id", "blah-license-key", WithQuotaGuard(guard), WithCache(NewLRUCache(10))), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			calls++
+			remaining--
+			header := http.Header{}
+			header.Set(QueriesRemainingHeader, strconv.Itoa(remaining))
+			return &http.Response{
+				StatusCode: 200,
+				Header:     header,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"traits":{"ip_address":"` + req.URL.Path[len(req.URL.Path)-7:] + `"}}`)),
+			}, nil
+		})
+
+		Convey("When a batch runs the balance down", func() {
+			var errs []error
+			for _, ip := range []string{"1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4", "5.5.5.5"} {
+				_, err := api.City(nil, ip)
+				errs = append(errs, err)
+			}
+
+			Convey("I expect one warning and a hard stop at the reserve", func() {
+				So(warned, ShouldResemble, []int{3})
+				So(calls, ShouldEqual, 3)
+				So(errs[2], ShouldBeNil)
+				So(errors.Is(errs[3], ErrQuotaExhausted), ShouldBeTrue)
+				left, ok := guard.Remaining()
+				So(ok, ShouldBeTrue)
+				So(left, ShouldEqual, 2)
+			})
+
+			Convey("I expect cached answers to be served still", func() {
+				_, err := api.City(nil, "1.1.1.1")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("I expect a reset to let lookups through again", func() {
+				guard.Reset()
+				remaining = 100
+				_, err := api.City(nil, "6.6.6.6")
+				So(err, ShouldBeNil)
+				_, err = api.City(nil, "7.7.7.7")
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,139 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WithRateLimit smooths requests to at most n per interval, allowing bursts
+// of up to n.  Every request sent counts, retries included, so the limit
+// holds however lookups are retried.  Lookups and retries wait for
+// capacity; one that would have to wait past its context's deadline fails
+// immediately with context.DeadlineExceeded.  A hedged request is only sent
+// when capacity is free, and a lookup refused by an open WithCircuitBreaker
+// takes no token.  Clones of the Api share the limit.
+func WithRateLimit(n int, per time.Duration) Option {
+	return func(a *Api) {
+		a.limiter = newRateLimiter(n, per)
+	}
+}
+
+type limiterKey struct{}
+
+// withLimiter returns a context in which the retries and hedges of a lookup
+// take their tokens from r
+func withLimiter(ctx context.Context, r *rateLimiter) context.Context {
+	if r == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, limiterKey{}, r)
+}
+
+func limiterFrom(ctx context.Context) *rateLimiter {
+	r, _ := ctx.Value(limiterKey{}).(*rateLimiter)
+	return r
+}
+
+// rateLimiter is a token bucket
+type rateLimiter struct {
+	rate  float64 // tokens per second
+	burst float64
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(n int, per time.Duration) *rateLimiter {
+	if n <= 0 || per <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		rate:   float64(n) / per.Seconds(),
+		burst:  float64(n),
+		tokens: float64(n),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long to wait before using it
+func (r *rateLimiter) reserve() time.Duration {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	r.tokens += now.Sub(r.last).Seconds() * r.rate
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.last = now
+
+	r.tokens--
+	if r.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-r.tokens / r.rate * float64(time.Second))
+}
+
+func (r *rateLimiter) cancel() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.tokens++
+}
+
+// take takes a token only if one is free now
+func (r *rateLimiter) take() bool {
+	if r.reserve() == 0 {
+		return true
+	}
+	r.cancel()
+	return false
+}
+
+// wrap takes the token of a lookup's first attempt; retries and hedges
+// take their own
+func (r *rateLimiter) wrap(doFunc DoFunc) DoFunc {
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		if err := r.wait(ctx); err != nil {
+			return nil, err
+		}
+		return doFunc(ctx, req)
+	}
+}
+
+func (r *rateLimiter) wait(ctx context.Context) error {
+	delay := r.reserve()
+	if delay == 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		r.cancel()
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		r.cancel()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,132 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRateLimit(t *testing.T) {
+	Convey("Given an Api limited to 5 lookups per 100ms", t, func() {
+		calls := 0
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		}).Clone(WithRateLimit(5, 100*time.Millisecond))
+
+		Convey("I expect a burst to pass and the rest to be smoothed", func() {
+			started := time.Now()
+			for i := 0; i < 7; i++ {
+				_, err := api.City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+			}
+			So(calls, ShouldEqual, 7)
+			So(time.Since(started), ShouldBeGreaterThanOrEqualTo, 35*time.Millisecond)
+		})
+
+		Convey("I expect a lookup that can't get capacity in time to fail fast", func() {
+			for i := 0; i < 5; i++ {
+				api.City(nil, "1.2.3.4")
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+			defer cancel()
+
+			_, err := api.City(ctx, "1.2.3.4")
+			So(err, ShouldEqual, context.DeadlineExceeded)
+			So(calls, ShouldEqual, 5)
+		})
+
+		Convey("I expect clones to share the limit", func() {
+			clone := api.Clone(WithTimeout(time.Second))
+			So(clone.limiter, ShouldEqual, api.limiter)
+		})
+	})
+
+	Convey("Given a limited Api that retries failures", t, func() {
+		var mutex sync.Mutex
+		calls := 0
+		api := WithClientFunc(New("blah-user-id", "blah-license-key", WithTimeout(200*time.Millisecond)), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			mutex.Lock()
+			calls++
+			mutex.Unlock()
+			return &http.Response{
+				StatusCode: 503,
+				Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		}).Clone(WithRetries(RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond}), WithRateLimit(2, time.Hour))
+
+		Convey("I expect every attempt to take a token", func() {
+			_, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldEqual, context.DeadlineExceeded)
+			So(calls, ShouldEqual, 2)
+		})
+	})
+
+	Convey("Given a limited Api behind an open circuit breaker", t, func() {
+		calls := 0
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: 503,
+				Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+			}, nil
+		}).Clone(WithRateLimit(2, time.Hour), WithCircuitBreaker(BreakerPolicy{FailureThreshold: 1, OpenDuration: 20 * time.Millisecond}))
+		api.City(nil, "1.2.3.4")
+
+		Convey("I expect refused lookups to take no token", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			for i := 0; i < 5; i++ {
+				_, err := api.City(ctx, "1.2.3.4")
+				So(errors.Is(err, ErrCircuitOpen), ShouldBeTrue)
+			}
+			time.Sleep(30 * time.Millisecond)
+
+			_, err := api.City(ctx, "1.2.3.4")
+			So(errors.Is(err, ErrCircuitOpen), ShouldBeFalse)
+			So(calls, ShouldEqual, 2)
+		})
+	})
+
+	Convey("Given a limited Api that hedges slow requests", t, func() {
+		var mutex sync.Mutex
+		calls := 0
+		api := WithClientFunc(New("blah-user-id", "blah-license-key", WithTimeout(50*time.Millisecond)), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			mutex.Lock()
+			calls++
+			mutex.Unlock()
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).Clone(WithHedging(5*time.Millisecond), WithRateLimit(1, time.Hour))
+
+		Convey("I expect no hedge without a free token", func() {
+			_, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldNotBeNil)
+			So(calls, ShouldEqual, 1)
+		})
+	})
+}
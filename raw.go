@@ -0,0 +1,47 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// errNoRaw is returned by RawMap for responses not decoded from the web
+// service
+var errNoRaw = errors.New("geoip2: response has no raw body")
+
+// Raw returns the body of the response exactly as the web service sent it,
+// including any fields this package doesn't decode yet.  It is nil for
+// responses read from a database, and for those from caches that store the
+// response's JSON, such as DiskCache; MarshalBinary keeps it.  A response
+// served from an LRUCache for a neighbouring address keeps the body of the
+// original lookup.
+func (r Response) Raw() json.RawMessage {
+	return json.RawMessage(r.raw)
+}
+
+// RawMap decodes Raw into a map, so the complete payload can be logged or
+// forwarded
+func (r Response) RawMap() (map[string]interface{}, error) {
+	if r.raw == nil {
+		return nil, errNoRaw
+	}
+	var v map[string]interface{}
+	if err := json.Unmarshal(r.raw, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
@@ -0,0 +1,96 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRaw(t *testing.T) {
+	Convey("Given a response with fields unknown to the package", t, func() {
+		body := `{"traits":{"ip_address":"1.2.3.4","brand_new_flag":true},"new_block":{"score":7}}`
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		})
+		resp, err := api.Insights(nil, "1.2.3.4")
+		So(err, ShouldBeNil)
+
+		Convey("I expect the body to be kept verbatim", func() {
+			So(string(resp.Raw()), ShouldEqual, body)
+			So(resp.Traits.IpAddress.String(), ShouldEqual, "1.2.3.4")
+		})
+
+		Convey("I expect the unknown fields to be reachable as a map", func() {
+			v, err := resp.RawMap()
+			So(err, ShouldBeNil)
+			So(v["new_block"], ShouldResemble, map[string]interface{}{"score": float64(7)})
+			So(v["traits"].(map[string]interface{})["brand_new_flag"], ShouldEqual, true)
+		})
+
+		Convey("I expect MarshalBinary to keep the body, unlike JSON", func() {
+			data, err := resp.MarshalBinary()
+			So(err, ShouldBeNil)
+			decoded := Response{}
+			So(decoded.UnmarshalBinary(data), ShouldBeNil)
+			So(string(decoded.Raw()), ShouldEqual, body)
+
+			data, err = json.Marshal(resp)
+			So(err, ShouldBeNil)
+			decoded = Response{}
+			So(json.Unmarshal(data, &decoded), ShouldBeNil)
+			So(decoded.Raw(), ShouldBeNil)
+		})
+	})
+
+	Convey("Given a partially malformed response decoded leniently", t, func() {
+		body := `{"city":{"geoname_id":"oops"},"country":{"iso_code":"US"}}`
+		for _, opts := range [][]Option{
+			{WithLenientDecoding()},
+			{WithLenientDecoding(), WithSections("city", "country")},
+		} {
+			api := WithClientFunc(New("blah-user-id", "blah-license-key", opts...), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+			})
+			resp, err := api.City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.DecodeErrors(), ShouldNotBeEmpty)
+
+			Convey(fmt.Sprintf("I expect the original body kept with %d options", len(opts)), func() {
+				So(string(resp.Raw()), ShouldEqual, body)
+				v, err := resp.RawMap()
+				So(err, ShouldBeNil)
+				So(v["city"], ShouldResemble, map[string]interface{}{"geoname_id": "oops"})
+			})
+		}
+	})
+
+	Convey("Given a constructed response", t, func() {
+		resp := MockResponse("1.2.3.4")
+
+		Convey("I expect no raw body", func() {
+			So(resp.Raw(), ShouldBeNil)
+			_, err := resp.RawMap()
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
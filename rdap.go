@@ -0,0 +1,259 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"time"
+)
+
+const rdapURL = "https://rdap.org/ip/"
+
+// rdapCacheSize bounds the networks an RDAPEnricher caches
+const rdapCacheSize = 10000
+
+// RDAPRecord holds the registration data for the network containing an
+// address, as published by the responsible regional internet registry.
+type RDAPRecord struct {
+	Handle       string `json:"handle,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Type         string `json:"type,omitempty"`
+	Country      string `json:"country,omitempty"`
+	StartAddress string `json:"start_address,omitempty"`
+	EndAddress   string `json:"end_address,omitempty"`
+	Registrant   string `json:"registrant,omitempty"`
+	AbuseEmail   string `json:"abuse_email,omitempty"`
+	AbusePhone   string `json:"abuse_phone,omitempty"`
+}
+
+// RDAPEnricher is an Enricher that looks up RDAP registration data.  Records
+// are cached for the full address range they describe, so neighbouring
+// addresses are served without another query.  The cache holds the
+// networks of the most recently used records, up to 10,000.
+type RDAPEnricher struct {
+	doFunc  func(ctx context.Context, req *http.Request) (*http.Response, error)
+	baseURL string
+	ttl     time.Duration
+	cache   *prefixLRU[RDAPRecord]
+}
+
+// NewRDAPEnricher returns an RDAPEnricher that queries the rdap.org
+// bootstrap service and caches each record for ttl.  A nil client uses
+// http.DefaultClient.
+func NewRDAPEnricher(client *http.Client, ttl time.Duration) *RDAPEnricher {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &RDAPEnricher{
+		doFunc:  wrap(client.Do),
+		baseURL: rdapURL,
+		ttl:     ttl,
+		cache:   newPrefixLRU[RDAPRecord](rdapCacheSize),
+	}
+}
+
+func (r *RDAPEnricher) Name() string {
+	return "rdap"
+}
+
+func (r *RDAPEnricher) Enrich(ctx context.Context, ipAddress string, resp Response) (interface{}, error) {
+	return r.Lookup(ctx, ipAddress)
+}
+
+func (r *RDAPEnricher) Lookup(ctx context.Context, ipAddress string) (RDAPRecord, error) {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return RDAPRecord{}, err
+	}
+	addr = addr.Unmap()
+
+	if record, ok := r.cached(addr); ok {
+		return record, nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", r.baseURL+addr.String(), nil)
+	if err != nil {
+		return RDAPRecord{}, err
+	}
+	req.Header.Set("Accept", "application/rdap+json")
+
+	resp, err := r.doFunc(ctx, req)
+	if err != nil {
+		return RDAPRecord{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 && resp.StatusCode < 600 {
+		return RDAPRecord{}, fmt.Errorf("rdap: lookup of %s failed with status %d", addr, resp.StatusCode)
+	}
+
+	// https://tools.ietf.org/html/rfc7483#section-5.4
+	network := rdapNetwork{}
+	if err := json.NewDecoder(resp.Body).Decode(&network); err != nil {
+		return RDAPRecord{}, err
+	}
+
+	record := network.record()
+	r.store(addr, record)
+	return record, nil
+}
+
+func (r *RDAPEnricher) cached(addr netip.Addr) (RDAPRecord, bool) {
+	return r.cache.lookup("rdap", addr)
+}
+
+// store caches record under each network of its address range
+func (r *RDAPEnricher) store(addr netip.Addr, record RDAPRecord) {
+	if r.ttl <= 0 {
+		return
+	}
+	for _, network := range rangePrefixes(record.StartAddress, record.EndAddress, addr) {
+		r.cache.setPrefix("rdap", network, record, r.ttl)
+	}
+}
+
+// rangePrefixes returns the fewest networks covering start to end, or the
+// single address addr when the registry omits the range or gives one that
+// doesn't contain it
+func rangePrefixes(startAddress, endAddress string, addr netip.Addr) []netip.Prefix {
+	single := []netip.Prefix{netip.PrefixFrom(addr, addr.BitLen())}
+	start, err := netip.ParseAddr(startAddress)
+	if err != nil {
+		return single
+	}
+	end, err := netip.ParseAddr(endAddress)
+	if err != nil {
+		return single
+	}
+	start, end = start.Unmap(), end.Unmap()
+	if start.BitLen() != end.BitLen() || start.Compare(addr) > 0 || addr.Compare(end) > 0 {
+		return single
+	}
+
+	var prefixes []netip.Prefix
+	for start.IsValid() && start.Compare(end) <= 0 {
+		// the largest aligned network starting at start that ends by end
+		bits := start.BitLen()
+		for bits > 0 {
+			wider := netip.PrefixFrom(start, bits-1).Masked()
+			if wider.Addr() != start || lastAddr(wider).Compare(end) > 0 {
+				break
+			}
+			bits--
+		}
+		prefix := netip.PrefixFrom(start, bits)
+		prefixes = append(prefixes, prefix)
+		start = lastAddr(prefix).Next()
+	}
+	return prefixes
+}
+
+// lastAddr returns the highest address of prefix
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Masked().Addr()
+	b := addr.As16()
+	offset := 128 - addr.BitLen()
+	for i := offset + prefix.Bits(); i < 128; i++ {
+		b[i/8] |= 1 << (7 - uint(i%8))
+	}
+	last := netip.AddrFrom16(b)
+	if addr.Is4() {
+		return last.Unmap()
+	}
+	return last
+}
+
+type rdapNetwork struct {
+	Handle       string       `json:"handle"`
+	Name         string       `json:"name"`
+	Type         string       `json:"type"`
+	Country      string       `json:"country"`
+	StartAddress string       `json:"startAddress"`
+	EndAddress   string       `json:"endAddress"`
+	Entities     []rdapEntity `json:"entities"`
+}
+
+type rdapEntity struct {
+	Roles      []string      `json:"roles"`
+	VCardArray []interface{} `json:"vcardArray"`
+	Entities   []rdapEntity  `json:"entities"`
+}
+
+func (n rdapNetwork) record() RDAPRecord {
+	record := RDAPRecord{
+		Handle:       n.Handle,
+		Name:         n.Name,
+		Type:         n.Type,
+		Country:      n.Country,
+		StartAddress: n.StartAddress,
+		EndAddress:   n.EndAddress,
+	}
+	for _, entity := range n.Entities {
+		entity.fill(&record)
+	}
+	return record
+}
+
+// fill walks the entity tree; registries such as ARIN nest the abuse
+// contact beneath the registrant rather than at the top level
+func (e rdapEntity) fill(record *RDAPRecord) {
+	for _, role := range e.Roles {
+		switch role {
+		case "registrant":
+			if record.Registrant == "" {
+				record.Registrant = e.vcard("fn")
+			}
+		case "abuse":
+			if record.AbuseEmail == "" {
+				record.AbuseEmail = e.vcard("email")
+			}
+			if record.AbusePhone == "" {
+				record.AbusePhone = e.vcard("tel")
+			}
+		}
+	}
+	for _, entity := range e.Entities {
+		entity.fill(record)
+	}
+}
+
+// vcard returns the text value of the named jCard property
+// https://tools.ietf.org/html/rfc7095
+func (e rdapEntity) vcard(name string) string {
+	if len(e.VCardArray) != 2 {
+		return ""
+	}
+	properties, ok := e.VCardArray[1].([]interface{})
+	if !ok {
+		return ""
+	}
+	for _, p := range properties {
+		property, ok := p.([]interface{})
+		if !ok || len(property) < 4 || property[0] != name {
+			continue
+		}
+		if value, ok := property[3].(string); ok {
+			return value
+		}
+	}
+	return ""
+}
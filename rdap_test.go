@@ -0,0 +1,181 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/netip"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var rdapSample = `
+{
+  "objectClassName": "ip network",
+  "handle":          "NET-1-2-3-0-1",
+  "startAddress":    "1.2.3.0",
+  "endAddress":      "1.2.3.255",
+  "name":            "LINKEM-NET",
+  "type":            "ALLOCATED PA",
+  "country":         "IT",
+  "entities": [
+    {
+      "objectClassName": "entity",
+      "roles": ["registrant"],
+      "vcardArray": ["vcard", [
+        ["version", {}, "text", "4.0"],
+        ["fn",      {}, "text", "Linkem spa"],
+        ["kind",    {}, "text", "org"]
+      ]],
+      "entities": [
+        {
+          "objectClassName": "entity",
+          "roles": ["abuse"],
+          "vcardArray": ["vcard", [
+            ["version", {}, "text", "4.0"],
+            ["fn",      {}, "text", "Abuse Desk"],
+            ["email",   {}, "text", "abuse@example.com"],
+            ["tel",     {"type": "voice"}, "uri", "tel:+39-06-1234567"]
+          ]]
+        }
+      ]
+    }
+  ]
+}`
+
+func TestRDAPEnricher(t *testing.T) {
+	Convey("Given an RDAPEnricher", t, func() {
+		calls := 0
+		enricher := NewRDAPEnricher(nil, time.Hour)
+		enricher.doFunc = func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			calls++
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(rdapSample)),
+			}, nil
+		}
+
+		Convey("When I enrich an address", func() {
+			v, err := enricher.Enrich(nil, "1.2.3.4", Response{})
+
+			Convey("I expect the registration data", func() {
+				So(err, ShouldBeNil)
+				record := v.(RDAPRecord)
+				So(record.Name, ShouldEqual, "LINKEM-NET")
+				So(record.Registrant, ShouldEqual, "Linkem spa")
+				So(record.AbuseEmail, ShouldEqual, "abuse@example.com")
+				So(record.AbusePhone, ShouldEqual, "tel:+39-06-1234567")
+			})
+
+			Convey("And then another address in the same range", func() {
+				record, err := enricher.Lookup(nil, "1.2.3.200")
+
+				Convey("I expect it to be served from the cache", func() {
+					So(err, ShouldBeNil)
+					So(record.Handle, ShouldEqual, "NET-1-2-3-0-1")
+					So(calls, ShouldEqual, 1)
+				})
+			})
+
+			Convey("And then an address outside the range", func() {
+				_, err := enricher.Lookup(nil, "1.2.4.1")
+
+				Convey("I expect another query", func() {
+					So(err, ShouldBeNil)
+					So(calls, ShouldEqual, 2)
+				})
+			})
+		})
+
+		Convey("When the cache holds a single network", func() {
+			enricher.cache = newPrefixLRU[RDAPRecord](1)
+			enricher.doFunc = func(ctx context.Context, req *http.Request) (*http.Response, error) {
+				calls++
+				network := strings.Join(strings.Split(path.Base(req.URL.Path), ".")[:3], ".") + "."
+				return &http.Response{
+					StatusCode: 200,
+					Body:       ioutil.NopCloser(strings.NewReader(strings.Replace(rdapSample, "1.2.3.", network, -1))),
+				}, nil
+			}
+
+			_, err := enricher.Lookup(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			_, err = enricher.Lookup(nil, "5.6.7.8")
+			So(err, ShouldBeNil)
+			_, err = enricher.Lookup(nil, "1.2.3.5")
+			So(err, ShouldBeNil)
+
+			Convey("I expect the older network to have been evicted", func() {
+				So(calls, ShouldEqual, 3)
+				So(enricher.cache.len(), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When I enrich an invalid address", func() {
+			_, err := enricher.Lookup(nil, "not-an-ip")
+
+			Convey("I expect an error without a query", func() {
+				So(err, ShouldNotBeNil)
+				So(calls, ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestRangePrefixes(t *testing.T) {
+	Convey("Given an address range", t, func() {
+		addr := netip.MustParseAddr("10.0.0.5")
+
+		Convey("When the range is a single network", func() {
+			prefixes := rangePrefixes("10.0.0.0", "10.0.0.255", addr)
+
+			Convey("I expect that network", func() {
+				So(prefixes, ShouldResemble, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/24")})
+			})
+		})
+
+		Convey("When the range is not aligned", func() {
+			prefixes := rangePrefixes("10.0.0.0", "10.0.2.127", addr)
+
+			Convey("I expect the fewest networks covering it", func() {
+				So(prefixes, ShouldResemble, []netip.Prefix{
+					netip.MustParsePrefix("10.0.0.0/23"),
+					netip.MustParsePrefix("10.0.2.0/25"),
+				})
+			})
+		})
+
+		Convey("When the range is IPv6", func() {
+			prefixes := rangePrefixes("2001:db8::", "2001:db8::ffff:ffff:ffff:ffff", netip.MustParseAddr("2001:db8::1"))
+
+			Convey("I expect a single /64", func() {
+				So(prefixes, ShouldResemble, []netip.Prefix{netip.MustParsePrefix("2001:db8::/64")})
+			})
+		})
+
+		Convey("When the range is missing or excludes the address", func() {
+			Convey("I expect just the address", func() {
+				So(rangePrefixes("", "", addr), ShouldResemble, []netip.Prefix{netip.MustParsePrefix("10.0.0.5/32")})
+				So(rangePrefixes("10.1.0.0", "10.1.0.255", addr), ShouldResemble, []netip.Prefix{netip.MustParsePrefix("10.0.0.5/32")})
+			})
+		})
+	})
+}
@@ -0,0 +1,162 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrNotRecorded is returned in RecordReplay mode for a lookup with no
+// recording
+var ErrNotRecorded = errors.New("geoip2: no recording for lookup")
+
+// RecordMode selects how WithRecording treats the web service
+type RecordMode int
+
+const (
+	// RecordReplay serves every lookup from the recordings and never sends
+	// a request
+	RecordReplay RecordMode = iota
+	// RecordAll sends every lookup and records the response, replacing any
+	// earlier recording
+	RecordAll
+	// RecordMissing serves lookups from the recordings when it can, and
+	// sends and records the rest
+	RecordMissing
+)
+
+// recording is the file kept for each lookup.  Only the response is kept,
+// with credentials and cookies removed from the headers.
+type recording struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// WithRecording records responses from the web service to files under dir,
+// named for the service and address, and replays them, so integration tests
+// run without credentials or network.  Server errors are never recorded.
+// Like other interceptors, recordings sit outside retries and hedging.
+//
+//	mode := geoip2.RecordReplay
+//	if os.Getenv("GEOIP2_RECORD") != "" {
+//		mode = geoip2.RecordAll
+//	}
+//	api := geoip2.New(userId, licenseKey, geoip2.WithRecording("testdata/geoip2", mode))
+func WithRecording(dir string, mode RecordMode) Option {
+	return WithInterceptor(func(next DoFunc) DoFunc {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			filename := filepath.Join(dir, recordingName(req))
+			if mode != RecordAll {
+				resp, err := replay(req, filename)
+				switch {
+				case err == nil:
+					return resp, nil
+				case os.IsNotExist(err) && mode == RecordMissing:
+					// send the request and record it below
+				case os.IsNotExist(err):
+					return nil, ErrNotRecorded
+				default:
+					return nil, err
+				}
+			}
+
+			resp, err := next(ctx, req)
+			if err != nil || resp.StatusCode >= 500 {
+				return resp, err
+			}
+			defer resp.Body.Close()
+			data, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			if err := record(filename, recording{StatusCode: resp.StatusCode, Header: RedactHeader(resp.Header), Body: string(data)}); err != nil {
+				return nil, err
+			}
+			return recorded(req, resp.StatusCode, resp.Header, string(data)), nil
+		}
+	})
+}
+
+// recordingName returns the file for a request, e.g. city_1.2.3.4.json.
+// Colons in IPv6 addresses are replaced as they aren't portable in names.
+func recordingName(req *http.Request) string {
+	service, ipAddress := path.Split(req.URL.Path)
+	service = path.Base(service)
+	return service + "_" + strings.ReplaceAll(ipAddress, ":", "-") + ".json"
+}
+
+func replay(req *http.Request, filename string) (*http.Response, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var v recording
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return recorded(req, v.StatusCode, v.Header, v.Body), nil
+}
+
+func record(filename string, v recording) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return err
+	}
+
+	// write atomically so a concurrent replay never sees a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(filename), filepath.Base(filename)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filename)
+}
+
+func recorded(req *http.Request, statusCode int, header http.Header, body string) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        strconv.Itoa(statusCode) + " " + http.StatusText(statusCode),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
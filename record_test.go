@@ -0,0 +1,88 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRecording(t *testing.T) {
+	Convey("Given a server standing in for MaxMind", t, func() {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			requests++
+			switch req.URL.Path {
+			case "/city/10.0.0.1":
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"code":"IP_ADDRESS_RESERVED","error":"reserved"}`))
+			case "/city/5.6.7.8":
+				w.WriteHeader(http.StatusServiceUnavailable)
+			default:
+				w.Header().Set("Set-Cookie", "session=secret")
+				w.Write([]byte(sample))
+			}
+		}))
+		defer server.Close()
+
+		dir := t.TempDir()
+		api := func(mode RecordMode) *Api {
+			return New("blah-user-id", "blah-license-key", WithBaseURL(server.URL), WithRecording(dir, mode))
+		}
+
+		Convey("When I record lookups", func() {
+			resp, err := api(RecordAll).City(nil, "2001:db8::1")
+			So(err, ShouldBeNil)
+			_, err = api(RecordAll).City(nil, "10.0.0.1")
+			So(errors.Is(err, ErrIPAddressReserved), ShouldBeTrue)
+			_, err = api(RecordAll).City(nil, "5.6.7.8")
+			So(err, ShouldNotBeNil)
+
+			Convey("I expect them to be replayed without the network", func() {
+				data, err := os.ReadFile(filepath.Join(dir, "city_2001-db8--1.json"))
+				So(err, ShouldBeNil)
+				So(string(data), ShouldNotContainSubstring, "secret")
+
+				replayed, err := api(RecordReplay).City(nil, "2001:db8::1")
+				So(err, ShouldBeNil)
+				So(replayed.Traits.Isp, ShouldEqual, resp.Traits.Isp)
+				So(replayed.Meta().StatusCode, ShouldEqual, http.StatusOK)
+
+				_, err = api(RecordReplay).City(nil, "10.0.0.1")
+				So(errors.Is(err, ErrIPAddressReserved), ShouldBeTrue)
+				So(requests, ShouldEqual, 3)
+			})
+
+			Convey("I expect server errors not to be recorded", func() {
+				_, err := api(RecordReplay).City(nil, "5.6.7.8")
+				So(errors.Is(err, ErrNotRecorded), ShouldBeTrue)
+			})
+
+			Convey("I expect RecordMissing to send only lookups not recorded", func() {
+				_, err := api(RecordMissing).City(nil, "2001:db8::1")
+				So(err, ShouldBeNil)
+				_, err = api(RecordMissing).Country(nil, "2001:db8::1")
+				So(err, ShouldBeNil)
+				So(requests, ShouldEqual, 4)
+			})
+		})
+	})
+}
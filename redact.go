@@ -0,0 +1,183 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"errors"
+	"net/http"
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces secrets wherever the package prints them
+const Redacted = "[REDACTED]"
+
+// RedactedIP replaces IP addresses when IP redaction is enabled
+const RedactedIP = "[IP]"
+
+// sensitiveHeaders carry credentials and are never printed verbatim
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Api-Key",
+}
+
+// ipCandidate matches runs that may be IPv4 or IPv6 addresses, optionally
+// with a port; each run is confirmed with netip before it is replaced
+var ipCandidate = regexp.MustCompile(`[0-9A-Fa-f:.]{3,}|\[[0-9A-Fa-f:.]+\](:[0-9]+)?`)
+
+func redact(secret string) string {
+	if secret == "" {
+		return `""`
+	}
+	return Redacted
+}
+
+// RedactHeader returns a copy of h that is safe to log or dump
+func RedactHeader(h http.Header) http.Header {
+	if h == nil {
+		return nil
+	}
+	clone := h.Clone()
+	for _, key := range sensitiveHeaders {
+		if _, ok := clone[key]; ok {
+			clone[key] = []string{Redacted}
+		}
+	}
+	return clone
+}
+
+// RedactIPs replaces every IP address in s with RedactedIP
+func RedactIPs(s string) string {
+	return ipCandidate.ReplaceAllStringFunc(s, func(run string) string {
+		// retry without trailing punctuation, as in "1.2.3.4: refused", but
+		// only after the whole run so "2001:db8::" stays intact
+		for _, candidate := range []string{run, strings.TrimRight(run, ":.")} {
+			suffix := run[len(candidate):]
+			if _, err := netip.ParseAddr(strings.Trim(candidate, "[]")); err == nil {
+				return RedactedIP + suffix
+			}
+			if _, err := netip.ParseAddrPort(candidate); err == nil {
+				return RedactedIP + suffix
+			}
+		}
+		return run
+	})
+}
+
+// WithIPRedaction scrubs IP addresses from errors returned by the Api, for
+// deployments where addresses are personal data
+func WithIPRedaction() Option {
+	return func(a *Api) {
+		a.redactIPs = true
+	}
+}
+
+// secretHolder is implemented by Authenticators so their credentials can be
+// scrubbed from errors
+type secretHolder interface {
+	secrets() []string
+}
+
+func (b BasicAuth) secrets() []string {
+	return []string{b.LicenseKey}
+}
+
+func (t BearerToken) secrets() []string {
+	return []string{string(t)}
+}
+
+func (h HeaderAuth) secrets() []string {
+	values := make([]string, 0, len(h))
+	for _, v := range h {
+		values = append(values, v)
+	}
+	return values
+}
+
+// redactor is the single place secrets and, optionally, IP addresses are
+// removed from anything the Api returns
+type redactor struct {
+	secrets []string
+	ips     bool
+}
+
+func (a *Api) redactor() redactor {
+	r := redactor{ips: a.redactIPs}
+	if a.licenseKey != "" {
+		r.secrets = append(r.secrets, a.licenseKey)
+	}
+	if holder, ok := a.authenticator().(secretHolder); ok {
+		for _, secret := range holder.secrets() {
+			if secret != "" {
+				r.secrets = append(r.secrets, secret)
+			}
+		}
+	}
+	return r
+}
+
+func (r redactor) String(s string) string {
+	for _, secret := range r.secrets {
+		s = strings.Replace(s, secret, Redacted, -1)
+	}
+	if r.ips {
+		s = RedactIPs(s)
+	}
+	return s
+}
+
+// Error returns err unchanged unless its message needs scrubbing, so that
+// sentinel comparisons continue to work in the common case
+func (r redactor) Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	if v, ok := err.(Error); ok {
+		v.Err = r.String(v.Err)
+		v.URL = r.String(v.URL)
+		v.Body = r.String(v.Body)
+		return v
+	}
+	msg := err.Error()
+	if scrubbed := r.String(msg); scrubbed != msg {
+		return redactedError{err: err, msg: scrubbed, redactor: r}
+	}
+	return err
+}
+
+// redactedError hides an error whose message held secrets.  The original
+// is never handed out: errors.As sees only the scrubbed errors it wraps,
+// while errors.Is still matches the original and its chain.
+type redactedError struct {
+	err      error
+	msg      string
+	redactor redactor
+}
+
+func (e redactedError) Error() string {
+	return e.msg
+}
+
+func (e redactedError) Is(target error) bool {
+	return errors.Is(e.err, target)
+}
+
+func (e redactedError) Unwrap() error {
+	return e.redactor.Error(errors.Unwrap(e.err))
+}
@@ -0,0 +1,159 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRedaction(t *testing.T) {
+	Convey("Given an Api whose transport fails with secrets in the message", t, func() {
+		failure := errors.New("Get https://geoip.maxmind.com/geoip/v2.1/city/1.2.3.4: proxy 10.0.0.1:3128 rejected blah-license-key and secret-token")
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return nil, failure
+		})
+
+		Convey("I expect the license key to be scrubbed", func() {
+			_, err := api.City(nil, "1.2.3.4")
+			So(err.Error(), ShouldNotContainSubstring, "blah-license-key")
+			So(err.Error(), ShouldContainSubstring, "1.2.3.4")
+			So(errors.Is(err, failure), ShouldBeTrue)
+		})
+
+		Convey("I expect the original error to be unreachable through errors.As", func() {
+			cause := fmt.Errorf("proxy rejected blah-license-key: %w", context.DeadlineExceeded)
+			failure = &url.Error{Op: "Get", URL: "https://geoip.maxmind.com/?key=blah-license-key", Err: cause}
+			_, err := api.City(nil, "1.2.3.4")
+
+			var urlErr *url.Error
+			So(errors.As(err, &urlErr), ShouldBeFalse)
+			So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+			So(errors.Is(err, failure), ShouldBeTrue)
+			for e := err; e != nil; e = errors.Unwrap(e) {
+				So(fmt.Sprintf("%+v", e), ShouldNotContainSubstring, "blah-license-key")
+			}
+		})
+
+		Convey("I expect authenticator secrets to be scrubbed", func() {
+			_, err := api.Clone(WithAuthenticator(BearerToken("secret-token"))).City(nil, "1.2.3.4")
+			So(err.Error(), ShouldNotContainSubstring, "secret-token")
+		})
+
+		Convey("I expect IP addresses to be scrubbed when requested", func() {
+			_, err := api.Clone(WithIPRedaction()).City(nil, "1.2.3.4")
+			So(err.Error(), ShouldNotContainSubstring, "1.2.3.4")
+			So(err.Error(), ShouldNotContainSubstring, "10.0.0.1")
+			So(err.Error(), ShouldContainSubstring, "/city/[IP]")
+		})
+	})
+
+	Convey("Given an Api whose credentials come from a rotating provider", t, func() {
+		key := "first-license-key"
+		provider := CredentialsFunc(func(ctx context.Context) (string, string, error) {
+			return "blah-user-id", key, nil
+		})
+		api := WithClientFunc(New("", "", WithCredentialsProvider(provider)), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			_, licenseKey, _ := req.BasicAuth()
+			return nil, errors.New("proxy rejected " + licenseKey)
+		})
+
+		Convey("When the key is rotated and a lookup fails", func() {
+			_, err := api.City(nil, "1.2.3.4")
+			So(err.Error(), ShouldNotContainSubstring, "first-license-key")
+
+			key = "second-license-key"
+			_, err = api.City(nil, "1.2.3.4")
+
+			Convey("I expect the key in use to be scrubbed", func() {
+				So(err.Error(), ShouldNotContainSubstring, "second-license-key")
+				So(fmt.Sprintf("%v %+v", err, err), ShouldNotContainSubstring, "license-key")
+				So(err.Error(), ShouldContainSubstring, Redacted)
+			})
+		})
+
+		Convey("I expect every key of an account pool to be scrubbed", func() {
+			pool := NewAccountPool(BasicAuth{UserId: "a", LicenseKey: "pool-key-a"}, BasicAuth{UserId: "b", LicenseKey: "pool-key-b"})
+			failing := WithClientFunc(New("", "", WithCredentialsProvider(pool)), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+				return nil, errors.New("rejected pool-key-a and pool-key-b")
+			})
+			_, err := failing.City(nil, "1.2.3.4")
+			So(err.Error(), ShouldNotContainSubstring, "pool-key")
+		})
+	})
+
+	Convey("Given an Api that receives a MaxMind error about an address", t, func() {
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: 404,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"code":"IP_ADDRESS_RESERVED","error":"The value 2001:db8::1 belongs to a reserved range"}`)),
+			}, nil
+		})
+
+		Convey("I expect the error to keep its type with the address scrubbed", func() {
+			_, err := api.Clone(WithIPRedaction()).City(nil, "2001:db8::1")
+			v, ok := err.(Error)
+			So(ok, ShouldBeTrue)
+			So(v.Code, ShouldEqual, "IP_ADDRESS_RESERVED")
+			So(v.Err, ShouldEqual, "The value [IP] belongs to a reserved range")
+			So(v.URL, ShouldNotContainSubstring, "2001:db8::1")
+			So(v.Body, ShouldNotContainSubstring, "2001:db8::1")
+		})
+	})
+
+	Convey("Given every output path of a monitored Api", t, func() {
+		var sent *http.Request
+		monitor := NewMonitor(10)
+		api := WithMonitor(WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			sent = req
+			return &http.Response{
+				StatusCode: 200,
+				Header:     http.Header{"Set-Cookie": {"session=blah-license-key"}},
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		}), monitor)
+
+		resp, err := api.City(nil, "1.2.3.4")
+		So(err, ShouldBeNil)
+
+		Convey("I expect no path to print the license key", func() {
+			outputs := []string{
+				fmt.Sprintf("%#v", api),
+				fmt.Sprintf("%v", RedactHeader(sent.Header)),
+				fmt.Sprintf("%v", resp.Meta().Header),
+				fmt.Sprintf("%v", api.Stats()),
+			}
+			for _, output := range outputs {
+				So(output, ShouldNotContainSubstring, "blah-license-key")
+				So(output, ShouldNotContainSubstring, "1.2.3.4")
+			}
+		})
+	})
+
+	Convey("Given text containing addresses", t, func() {
+		Convey("I expect only valid addresses to be replaced", func() {
+			So(RedactIPs("from 1.2.3.4 via [2001:db8::1]:443 and ::ffff:10.0.0.1 in 2001:db8::"), ShouldEqual, "from [IP] via [IP] and [IP] in [IP]")
+			So(RedactIPs("v2.1 at 12:30 on 2015.10.01 failed 999.1.1.1"), ShouldEqual, "v2.1 at 12:30 on 2015.10.01 failed 999.1.1.1")
+		})
+	})
+}
@@ -0,0 +1,109 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import "strings"
+
+// Region is a named grouping of ISO 3166-1 country codes
+type Region struct {
+	Name      string
+	Countries []string
+}
+
+// Contains reports whether isoCode is a member of the region
+func (r Region) Contains(isoCode string) bool {
+	return containsFold(r.Countries, isoCode)
+}
+
+// ContainsResponse reports whether the country of resp is a member of the region
+func (r Region) ContainsResponse(resp Response) bool {
+	return resp.Country.IsoCode != "" && r.Contains(resp.Country.IsoCode)
+}
+
+// Memberships as of January 2025
+var (
+	EU = Region{
+		Name: "EU",
+		Countries: []string{
+			"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+			"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+		},
+	}
+
+	EEA = Region{
+		Name:      "EEA",
+		Countries: append(append([]string{}, EU.Countries...), "IS", "LI", "NO"),
+	}
+
+	Schengen = Region{
+		Name: "Schengen",
+		Countries: []string{
+			"AT", "BE", "BG", "CH", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+			"IS", "IT", "LI", "LT", "LU", "LV", "MT", "NL", "NO", "PL", "PT", "RO", "SE", "SI", "SK",
+		},
+	}
+
+	GCC = Region{
+		Name:      "GCC",
+		Countries: []string{"AE", "BH", "KW", "OM", "QA", "SA"},
+	}
+)
+
+// GDPR lists the countries whose visitors RequiresGDPRConsent gates, the
+// EEA by default.  Services that also treat the UK GDPR or Swiss FADP alike
+// can extend it at start up, e.g.
+//
+//	geoip2.GDPR.Countries = append(geoip2.GDPR.Countries, "GB", "CH")
+var GDPR = Region{
+	Name:      "GDPR",
+	Countries: append([]string{}, EEA.Countries...),
+}
+
+// Regions lists the groupings known to RegionsOf
+var Regions = []Region{EU, EEA, Schengen, GCC}
+
+// RegionsOf returns the names of the Regions isoCode belongs to
+func RegionsOf(isoCode string) []string {
+	var names []string
+	for _, region := range Regions {
+		if region.Contains(isoCode) {
+			names = append(names, region.Name)
+		}
+	}
+	return names
+}
+
+// RegionByName looks up one of Regions, ignoring case
+func RegionByName(name string) (Region, bool) {
+	for _, region := range Regions {
+		if strings.EqualFold(region.Name, name) {
+			return region, true
+		}
+	}
+	return Region{}, false
+}
+
+// InEU, InEEA, InSchengen and InGCC report whether the country of resp is
+// a member of the corresponding region
+func InEU(resp Response) bool       { return resp.Country.IsInEuropeanUnion || EU.ContainsResponse(resp) }
+func InEEA(resp Response) bool      { return EEA.ContainsResponse(resp) }
+func InSchengen(resp Response) bool { return Schengen.ContainsResponse(resp) }
+func InGCC(resp Response) bool      { return GCC.ContainsResponse(resp) }
+
+// RequiresGDPRConsent reports whether the visitor is in a country listed in
+// GDPR, or one MaxMind flags as in the European Union
+func (r Response) RequiresGDPRConsent() bool {
+	return r.Country.IsInEuropeanUnion || GDPR.ContainsResponse(r)
+}
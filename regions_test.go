@@ -0,0 +1,74 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRegions(t *testing.T) {
+	Convey("Given the built in regions", t, func() {
+		Convey("I expect the memberships to have the expected sizes", func() {
+			So(len(EU.Countries), ShouldEqual, 27)
+			So(len(EEA.Countries), ShouldEqual, 30)
+			So(len(Schengen.Countries), ShouldEqual, 29)
+			So(len(GCC.Countries), ShouldEqual, 6)
+		})
+
+		Convey("I expect the helpers to use the country of the response", func() {
+			norway := Response{Country: Country{IsoCode: "NO"}, RegisteredCountry: RegisteredCountry{IsoCode: "SE"}}
+			So(InEU(norway), ShouldBeFalse)
+			So(InEEA(norway), ShouldBeTrue)
+			So(InSchengen(norway), ShouldBeTrue)
+			So(InGCC(Response{Country: Country{IsoCode: "qa"}}), ShouldBeTrue)
+			So(InEU(Response{}), ShouldBeFalse)
+		})
+
+		Convey("I expect membership queries across regions", func() {
+			So(RegionsOf("IE"), ShouldResemble, []string{"EU", "EEA"})
+			So(RegionsOf("CH"), ShouldResemble, []string{"Schengen"})
+			So(RegionsOf("US"), ShouldBeNil)
+
+			region, ok := RegionByName("schengen")
+			So(ok, ShouldBeTrue)
+			So(region.Contains("HR"), ShouldBeTrue)
+		})
+
+		Convey("I expect GDPR consent to follow the EEA or the EU flag", func() {
+			So(Response{Country: Country{IsoCode: "NO"}}.RequiresGDPRConsent(), ShouldBeTrue)
+			So(Response{Country: Country{IsoCode: "US"}}.RequiresGDPRConsent(), ShouldBeFalse)
+
+			flagged := Response{Country: Country{IsoCode: "XX", IsInEuropeanUnion: true}}
+			So(flagged.RequiresGDPRConsent(), ShouldBeTrue)
+			So(InEU(flagged), ShouldBeTrue)
+		})
+	})
+}
+
+func TestIsInEuropeanUnion(t *testing.T) {
+	Convey("Given a response flagged as in the European Union", t, func() {
+		var resp Response
+		err := json.Unmarshal([]byte(`{"country":{"iso_code":"FR","is_in_european_union":true},"registered_country":{"iso_code":"US"}}`), &resp)
+		So(err, ShouldBeNil)
+
+		Convey("I expect the flag on each country record", func() {
+			So(resp.Country.IsInEuropeanUnion, ShouldBeTrue)
+			So(resp.RegisteredCountry.IsInEuropeanUnion, ShouldBeFalse)
+		})
+	})
+}
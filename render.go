@@ -0,0 +1,104 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"encoding/json"
+	htmltemplate "html/template"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// Renderer applies a Go template to a single result or a slice of results,
+// e.g. to produce incident-report snippets or email summaries.
+type Renderer struct {
+	execute func(w io.Writer, data interface{}) error
+}
+
+// templateFuncs are available to every template
+var templateFuncs = map[string]interface{}{
+	"name":            localizedName,
+	"countryName":     bundledCountryName,
+	"subdivisionName": bundledSubdivisionName,
+	"json":            toJSON,
+	"join":            strings.Join,
+	"lower":           strings.ToLower,
+	"upper":           strings.ToUpper,
+}
+
+// NewTextRenderer parses text as a text/template
+func NewTextRenderer(text string) (*Renderer, error) {
+	t, err := template.New("geoip2").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return &Renderer{execute: t.Execute}, nil
+}
+
+// NewHTMLRenderer parses text as an html/template so that values are
+// escaped for inclusion in HTML documents
+func NewHTMLRenderer(text string) (*Renderer, error) {
+	t, err := htmltemplate.New("geoip2").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return &Renderer{execute: t.Execute}, nil
+}
+
+// NewRendererFromFile parses the template at path, using html/template for
+// files ending in .html or .htm and text/template otherwise
+func NewRendererFromFile(path string) (*Renderer, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".html", ".htm":
+		return NewHTMLRenderer(string(data))
+	default:
+		return NewTextRenderer(string(data))
+	}
+}
+
+// Render executes the template with data, typically a Response, an
+// EnrichedResult or a slice of either
+func (r *Renderer) Render(w io.Writer, data interface{}) error {
+	return r.execute(w, data)
+}
+
+func bundledCountryName(isoCode string, locales ...string) string {
+	name, _ := CountryName(isoCode, locales...)
+	return name
+}
+
+func bundledSubdivisionName(countryCode, isoCode string, locales ...string) string {
+	name, _ := SubdivisionName(countryCode, isoCode, locales...)
+	return name
+}
+
+func toJSON(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
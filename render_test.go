@@ -0,0 +1,70 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRenderer(t *testing.T) {
+	Convey("Given a complete maxmind response", t, func() {
+		resp := Response{}
+		err := json.NewDecoder(strings.NewReader(sample)).Decode(&resp)
+		So(err, ShouldBeNil)
+
+		Convey("When I render a text template", func() {
+			r, err := NewTextRenderer(`{{.Traits.IpAddress}} is in {{name .City.Names "ja"}}, {{name .Country.Names "xx"}} ({{upper .Continent.Code}})`)
+			So(err, ShouldBeNil)
+
+			buf := &bytes.Buffer{}
+			err = r.Render(buf, resp)
+
+			Convey("I expect the fields and localized names to be substituted", func() {
+				So(err, ShouldBeNil)
+				So(buf.String(), ShouldEqual, "1.2.3.4 is in ロサンゼルス市, United States (NA)")
+			})
+		})
+
+		Convey("When I render an HTML template for a batch", func() {
+			resp.Traits.Organization = "<script>"
+			r, err := NewHTMLRenderer(`<ul>{{range .}}<li>{{.Traits.Organization}}</li>{{end}}</ul>`)
+			So(err, ShouldBeNil)
+
+			buf := &bytes.Buffer{}
+			err = r.Render(buf, []Response{resp, resp})
+
+			Convey("I expect each result rendered with escaping", func() {
+				So(err, ShouldBeNil)
+				So(buf.String(), ShouldEqual, "<ul><li>&lt;script&gt;</li><li>&lt;script&gt;</li></ul>")
+			})
+		})
+
+		Convey("When the template is invalid", func() {
+			_, err := NewTextRenderer(`{{.City`)
+
+			Convey("I expect an error", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
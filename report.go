@@ -0,0 +1,164 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"fmt"
+	"hash/fnv"
+	htmltemplate "html/template"
+	"io"
+	"math"
+)
+
+// MapReport renders a batch of results as a standalone HTML page with a
+// Leaflet map of their locations, for sharing investigation results with
+// people who won't read JSON.
+type MapReport struct {
+	Title string
+
+	// ColorBy selects how markers are colored: "country" (the default) gives
+	// each country a stable hue, "risk" shades from green at a RiskScore of 0
+	// to red at 100
+	ColorBy string
+
+	// CentroidFallback plots results without coordinates at the centroid of
+	// their country instead of leaving them off the map
+	CentroidFallback bool
+}
+
+type mapPoint struct {
+	Lat    float64 `json:"lat"`
+	Lon    float64 `json:"lon"`
+	Radius int     `json:"radius"`
+	Color  string  `json:"color"`
+	Label  string  `json:"label"`
+}
+
+var mapTemplate = htmltemplate.Must(htmltemplate.New("map").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<link rel="stylesheet" href="https://unpkg.com/leaflet@1.9.4/dist/leaflet.css" integrity="sha256-p4NxAoJBhIIN+hmNHrzRCf9tD/miZyoHS5obTRR9BMY=" crossorigin="">
+<script src="https://unpkg.com/leaflet@1.9.4/dist/leaflet.js" integrity="sha256-20nQCchB9co0qIjJZRGuk2/Z9VM+kNiyxNV1lvTlZBo=" crossorigin=""></script>
+<style>
+  html, body { height: 100%; margin: 0; font-family: sans-serif; }
+  h1 { font-size: 1.1em; margin: 0; padding: 0.5em; }
+  #map { position: absolute; top: 2.2em; bottom: 0; width: 100%; }
+</style>
+</head>
+<body>
+<h1>{{.Title}} ({{len .Points}} located of {{.Total}})</h1>
+<div id="map"></div>
+<script>
+  var points = {{.Points}};
+  var map = L.map("map").setView([20, 0], 2);
+  L.tileLayer("https://tile.openstreetmap.org/{z}/{x}/{y}.png", {
+    maxZoom: 19,
+    attribution: "&copy; OpenStreetMap contributors"
+  }).addTo(map);
+
+  var bounds = [];
+  points.forEach(function (p) {
+    var marker = L.circleMarker([p.lat, p.lon], {radius: 6, color: p.color, fillColor: p.color, fillOpacity: 0.8});
+    var popup = document.createElement("div");
+    popup.textContent = p.label;
+    marker.bindPopup(popup).addTo(map);
+    if (p.radius > 0) {
+      L.circle([p.lat, p.lon], {radius: p.radius * 1000, color: p.color, weight: 1, fillOpacity: 0.05}).addTo(map);
+    }
+    bounds.push([p.lat, p.lon]);
+  });
+  if (bounds.length > 0) {
+    map.fitBounds(bounds, {padding: [20, 20], maxZoom: 10});
+  }
+</script>
+</body>
+</html>
+`))
+
+// Write renders the report for results.  Results without coordinates are
+// counted but not plotted.
+func (m MapReport) Write(w io.Writer, results []EnrichedResult) error {
+	title := m.Title
+	if title == "" {
+		title = "GeoIP2 lookup results"
+	}
+
+	points := []mapPoint{}
+	for _, result := range results {
+		resp := result.Response
+		if m.CentroidFallback {
+			resp, _ = resp.WithCentroidFallback()
+		}
+		location := resp.Location
+		if !location.hasCoordinates() {
+			continue
+		}
+
+		color := countryColor(result.Response.Country.IsoCode)
+		if m.ColorBy == "risk" {
+			color = riskColor(result.RiskScore)
+		}
+		points = append(points, mapPoint{
+			Lat:    location.Latitude,
+			Lon:    location.Longitude,
+			Radius: location.AccuracyRadius,
+			Color:  color,
+			Label:  mapLabel(result),
+		})
+	}
+
+	return mapTemplate.Execute(w, map[string]interface{}{
+		"Title":  title,
+		"Points": points,
+		"Total":  len(results),
+	})
+}
+
+func mapLabel(result EnrichedResult) string {
+	label := result.IpAddress
+	if place := kmlPlacemarkFor(result.Response).Description; place != "" {
+		label += " - " + place
+	}
+	if asn := result.Response.Traits.AutonomousSystemNumber; asn != 0 {
+		label += fmt.Sprintf(" (AS%d)", asn)
+	}
+	if result.RiskScore != 0 {
+		label += fmt.Sprintf(" risk %.1f", result.RiskScore)
+	}
+	if result.Decision != "" {
+		label += " " + result.Decision
+	}
+	return label
+}
+
+// countryColor gives each country code a stable, distinct hue
+func countryColor(isoCode string) string {
+	if isoCode == "" {
+		return "#808080"
+	}
+	h := fnv.New32a()
+	h.Write([]byte(isoCode))
+	return fmt.Sprintf("hsl(%d, 70%%, 45%%)", h.Sum32()%360)
+}
+
+func riskColor(score float64) string {
+	score = math.Max(0, math.Min(100, score))
+	return fmt.Sprintf("hsl(%d, 80%%, 45%%)", int(120-score*1.2))
+}
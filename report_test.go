@@ -0,0 +1,66 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMapReport(t *testing.T) {
+	Convey("Given a batch of enriched results", t, func() {
+		resp := Response{}
+		err := json.NewDecoder(strings.NewReader(sample)).Decode(&resp)
+		So(err, ShouldBeNil)
+
+		results := []EnrichedResult{
+			{IpAddress: "1.2.3.4", Response: resp, RiskScore: 90, Decision: "<reject>"},
+			{IpAddress: "10.0.0.1"},
+		}
+
+		Convey("When I write a map report", func() {
+			buf := &bytes.Buffer{}
+			err := MapReport{Title: "Incident 42", ColorBy: "risk"}.Write(buf, results)
+			So(err, ShouldBeNil)
+			html := buf.String()
+
+			Convey("I expect a standalone page plotting located results", func() {
+				So(html, ShouldContainSubstring, "<title>Incident 42</title>")
+				So(html, ShouldContainSubstring, "leaflet.js")
+				So(html, ShouldContainSubstring, "(1 located of 2)")
+				So(html, ShouldContainSubstring, `"lat":37.6293`)
+				So(html, ShouldContainSubstring, `hsl(12, 80%, 45%)`)
+			})
+
+			Convey("I expect labels to be escaped for the script context", func() {
+				So(html, ShouldNotContainSubstring, "<reject>")
+				So(html, ShouldContainSubstring, `\u003creject\u003e`)
+			})
+		})
+
+		Convey("I expect country colors to be stable", func() {
+			So(countryColor("US"), ShouldEqual, countryColor("US"))
+			So(countryColor("US"), ShouldNotEqual, countryColor("DE"))
+			So(countryColor(""), ShouldEqual, "#808080")
+		})
+	})
+}
@@ -0,0 +1,57 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import "context"
+
+type optionsKey struct{}
+
+// WithRequestOptions returns a context whose lookups apply opts over the
+// Api's own settings, so that one client can serve call paths with
+// different needs, e.g. a tight timeout on a login path and a relaxed one
+// for background enrichment:
+//
+//	ctx = geoip2.WithRequestOptions(ctx, geoip2.WithTimeout(200*time.Millisecond), geoip2.WithLocales("ja"))
+//	resp, err := api.City(ctx, ip)
+//
+// Options accumulate when contexts are nested, the innermost applied last.
+// Because they travel in the context they also reach an Api behind a
+// Lookuper, a Chain or an enricher.
+func WithRequestOptions(ctx context.Context, opts ...Option) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if len(opts) == 0 {
+		return ctx
+	}
+	inherited := requestOptions(ctx)
+	combined := make([]Option, 0, len(inherited)+len(opts))
+	combined = append(combined, inherited...)
+	combined = append(combined, opts...)
+	return context.WithValue(ctx, optionsKey{}, combined)
+}
+
+func requestOptions(ctx context.Context) []Option {
+	opts, _ := ctx.Value(optionsKey{}).([]Option)
+	return opts
+}
+
+// forRequest returns the Api to serve a lookup made with ctx
+func (a *Api) forRequest(ctx context.Context) *Api {
+	if opts := requestOptions(ctx); len(opts) > 0 {
+		return a.Clone(opts...)
+	}
+	return a
+}
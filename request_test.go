@@ -0,0 +1,116 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRequestOptions(t *testing.T) {
+	Convey("Given an Api with generous defaults", t, func() {
+		var received *http.Request
+		delay := time.Duration(0)
+		handler := func(w http.ResponseWriter, req *http.Request) {
+			received = req
+			select {
+			case <-time.After(delay):
+			case <-req.Context().Done():
+			}
+			w.Write([]byte(sample))
+		}
+		server := httptest.NewServer(http.HandlerFunc(handler))
+		defer server.Close()
+		other := httptest.NewServer(http.HandlerFunc(handler))
+		defer other.Close()
+
+		api := New("blah-user-id", "blah-license-key",
+			WithBaseURL(server.URL),
+			WithTimeout(5*time.Second),
+			WithLocales("en"),
+		)
+		var lookuper Lookuper = api
+
+		Convey("When a call asks for a tighter timeout", func() {
+			delay = time.Second
+			ctx := WithRequestOptions(context.Background(), WithTimeout(20*time.Millisecond))
+			started := time.Now()
+			_, err := lookuper.City(ctx, "1.2.3.4")
+
+			Convey("I expect the call to give up early", func() {
+				So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+				So(time.Since(started), ShouldBeLessThan, time.Second)
+			})
+		})
+
+		Convey("When a call asks for other locales", func() {
+			ctx := WithRequestOptions(context.Background(), WithLocales("ja", "en"))
+			resp, err := lookuper.City(ctx, "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			Convey("I expect them sent with that call only", func() {
+				So(received.Header.Get("Accept-Language"), ShouldEqual, "ja, en")
+				So(resp.Meta().Locales, ShouldResemble, []string{"ja", "en"})
+
+				api.City(context.Background(), "1.2.3.4")
+				So(received.Header.Get("Accept-Language"), ShouldEqual, "en")
+			})
+		})
+
+		Convey("When contexts with options are nested", func() {
+			ctx := WithRequestOptions(context.Background(), WithBaseURL(other.URL), WithLocales("de"))
+			ctx = WithRequestOptions(ctx, WithLocales("fr"))
+			_, err := lookuper.Country(ctx, "1.2.3.4")
+			So(err, ShouldBeNil)
+
+			Convey("I expect both applied, the inner last", func() {
+				So("http://"+received.Host, ShouldEqual, other.URL)
+				So(received.Header.Get("Accept-Language"), ShouldEqual, "fr")
+			})
+		})
+
+		Convey("When no options are given", func() {
+			ctx := context.Background()
+			So(WithRequestOptions(ctx), ShouldEqual, ctx)
+			So(api.forRequest(ctx), ShouldEqual, api)
+		})
+	})
+
+	Convey("Given a shared flight led by a lookup with a long timeout", t, func() {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			<-release
+			w.Write([]byte(sample))
+		}))
+		defer server.Close()
+		defer close(release)
+
+		api := New("blah-user-id", "blah-license-key", WithBaseURL(server.URL), WithSingleflight())
+		go api.City(context.Background(), "1.2.3.4")
+		time.Sleep(20 * time.Millisecond)
+
+		Convey("I expect a follower to keep its own timeout", func() {
+			ctx := WithRequestOptions(context.Background(), WithTimeout(20*time.Millisecond))
+			_, err := api.City(ctx, "1.2.3.4")
+			So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+		})
+	})
+}
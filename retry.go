@@ -0,0 +1,202 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RetryPolicy configures WithRetries.  Zero values take the defaults noted.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt, 3 by default
+	MaxRetries int
+
+	// BaseDelay is the backoff before the first retry, 100ms by default; it
+	// doubles for each further retry, with full jitter
+	BaseDelay time.Duration
+
+	// MaxDelay caps the backoff, 10s by default.  A Retry-After longer than
+	// MaxDelay is not waited for; the response is returned instead.
+	MaxDelay time.Duration
+
+	// Budget caps the retries sent per BudgetWindow, a minute by default,
+	// across every lookup of the Api and its clones, so that retries can't
+	// multiply the load on the web service during an outage.  Once it is
+	// spent, failures are returned without retrying.  Zero is unlimited.
+	Budget       int
+	BudgetWindow time.Duration
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxRetries == 0 {
+		p.MaxRetries = 3
+	}
+	if p.BaseDelay <= 0 {
+		p.BaseDelay = 100 * time.Millisecond
+	}
+	if p.MaxDelay <= 0 {
+		p.MaxDelay = 10 * time.Second
+	}
+	if p.BudgetWindow <= 0 {
+		p.BudgetWindow = time.Minute
+	}
+	return p
+}
+
+// WithRetries retries network errors, 429 and 5xx responses with
+// exponential backoff, honouring Retry-After.  A retry is never started if
+// its delay would outlast the context's deadline, nor once the policy's
+// Budget is spent.  The number of retries is reported in the response's
+// Meta.  Retries wrap whatever transport is configured, whether before or
+// after this option, and clones share the budget.
+func WithRetries(policy RetryPolicy) Option {
+	policy = policy.withDefaults()
+	r := &retrier{policy: policy}
+	if policy.Budget > 0 {
+		r.budget = &retryBudget{max: policy.Budget, window: policy.BudgetWindow}
+	}
+	return func(a *Api) {
+		a.retrier = r
+	}
+}
+
+type retrier struct {
+	policy RetryPolicy
+	budget *retryBudget
+}
+
+// retryBudget counts retries in fixed windows
+type retryBudget struct {
+	max    int
+	window time.Duration
+
+	mutex   sync.Mutex
+	started time.Time
+	used    int
+}
+
+// take reports whether a retry may be sent, counting it if so
+func (b *retryBudget) take() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if now := time.Now(); now.Sub(b.started) >= b.window {
+		b.started, b.used = now, 0
+	}
+	if b.used >= b.max {
+		return false
+	}
+	b.used++
+	return true
+}
+
+type retryCounterKey struct{}
+
+// countRetries returns a context in which retry records each retry in n
+func countRetries(ctx context.Context, n *int32) context.Context {
+	return context.WithValue(ctx, retryCounterKey{}, n)
+}
+
+func (r *retrier) wrap(doFunc DoFunc) DoFunc {
+	policy := r.policy
+	return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		for attempt := 0; ; attempt++ {
+			resp, err := doFunc(ctx, req.Clone(ctx))
+			if attempt >= policy.MaxRetries || !retryable(ctx, resp, err) {
+				return resp, err
+			}
+
+			delay := backoff(policy, attempt)
+			if resp != nil {
+				if after, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+					if after > policy.MaxDelay {
+						return resp, err
+					}
+					if after > delay {
+						delay = after
+					}
+				}
+			}
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+				return resp, err
+			}
+			if r.budget != nil && !r.budget.take() {
+				return resp, err
+			}
+
+			status := 0
+			if resp != nil {
+				status = resp.StatusCode
+				resp.Body.Close()
+			}
+			logRetry(ctx, attempt+1, delay, status, err)
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+
+			if limiter := limiterFrom(ctx); limiter != nil {
+				if err := limiter.wait(ctx); err != nil {
+					return nil, err
+				}
+			}
+			if n, ok := ctx.Value(retryCounterKey{}).(*int32); ok {
+				atomic.AddInt32(n, 1)
+			}
+		}
+	}
+}
+
+func retryable(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		// the caller giving up is not a transient failure
+		return ctx.Err() == nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+func backoff(policy RetryPolicy, attempt int) time.Duration {
+	ceiling := policy.BaseDelay << uint(attempt)
+	if ceiling > policy.MaxDelay || ceiling <= 0 {
+		ceiling = policy.MaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP date
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,179 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRetries(t *testing.T) {
+	Convey("Given a transport that fails a number of times", t, func() {
+		calls := 0
+		failures := 2
+		status := http.StatusServiceUnavailable
+		header := http.Header{}
+		var transportErr error
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			calls++
+			if calls <= failures {
+				if transportErr != nil {
+					return nil, transportErr
+				}
+				return &http.Response{
+					StatusCode: status,
+					Header:     header,
+					Body:       ioutil.NopCloser(strings.NewReader(`{"code":"SERVER_ERROR","error":"try again"}`)),
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(strings.NewReader(sample)),
+			}, nil
+		})
+		policy := RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: 50 * time.Millisecond}
+
+		Convey("When 5xx responses are retried", func() {
+			resp, err := api.Clone(WithRetries(policy)).City(nil, "1.2.3.4")
+
+			Convey("I expect the lookup to succeed and report its retries", func() {
+				So(err, ShouldBeNil)
+				So(calls, ShouldEqual, 3)
+				So(resp.Meta().Retries, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When network errors are retried", func() {
+			transportErr = errors.New("connection reset")
+			_, err := api.Clone(WithRetries(policy)).City(nil, "1.2.3.4")
+
+			Convey("I expect the lookup to succeed", func() {
+				So(err, ShouldBeNil)
+				So(calls, ShouldEqual, 3)
+			})
+		})
+
+		Convey("When failures outlast the retries", func() {
+			failures = 10
+			_, err := api.Clone(WithRetries(RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond})).City(nil, "1.2.3.4")
+
+			Convey("I expect the last error to be returned", func() {
+				So(err.(Error).Code, ShouldEqual, "SERVER_ERROR")
+				So(calls, ShouldEqual, 3)
+			})
+		})
+
+		Convey("When the server asks to retry after a delay", func() {
+			status = http.StatusTooManyRequests
+			header.Set("Retry-After", "0")
+			failures = 1
+			_, err := api.Clone(WithRetries(policy)).City(nil, "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(calls, ShouldEqual, 2)
+
+			Convey("I expect a Retry-After beyond MaxDelay not to be waited for", func() {
+				calls = 0
+				header.Set("Retry-After", "120")
+				started := time.Now()
+				_, err := api.Clone(WithRetries(policy)).City(nil, "1.2.3.4")
+				So(err, ShouldNotBeNil)
+				So(calls, ShouldEqual, 1)
+				So(time.Since(started), ShouldBeLessThan, time.Second)
+			})
+		})
+
+		Convey("When the delay would outlast the deadline", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+			defer cancel()
+			_, err := api.Clone(WithRetries(RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Second})).City(ctx, "1.2.3.4")
+
+			Convey("I expect the failure without waiting", func() {
+				So(err, ShouldNotBeNil)
+				So(calls, ShouldBeLessThanOrEqualTo, 2)
+			})
+		})
+
+		Convey("When the retry budget is spent", func() {
+			failures = 100
+			budgeted := api.Clone(WithRetries(RetryPolicy{MaxRetries: 5, BaseDelay: time.Millisecond, Budget: 3}))
+			_, err := budgeted.City(nil, "1.2.3.4")
+			So(err, ShouldNotBeNil)
+			So(calls, ShouldEqual, 4)
+
+			Convey("I expect clones to fail without retrying", func() {
+				_, err := budgeted.Clone(WithTimeout(time.Second)).City(nil, "1.2.3.4")
+				So(err, ShouldNotBeNil)
+				So(calls, ShouldEqual, 5)
+			})
+		})
+
+		Convey("When retries are given to New before the transport", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				calls++
+				if calls <= failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Write([]byte(sample))
+			}))
+			defer server.Close()
+
+			resp, err := New("blah-user-id", "blah-license-key",
+				WithRetries(policy),
+				WithHTTPClient(server.Client()),
+				WithBaseURL(server.URL),
+			).City(nil, "1.2.3.4")
+
+			Convey("I expect them to wrap the transport all the same", func() {
+				So(err, ShouldBeNil)
+				So(calls, ShouldEqual, 3)
+				So(resp.Meta().Retries, ShouldEqual, 2)
+			})
+		})
+
+		Convey("When the response is a client error", func() {
+			status = http.StatusBadRequest
+			_, err := api.Clone(WithRetries(policy)).City(nil, "1.2.3.4")
+
+			Convey("I expect no retry", func() {
+				So(err, ShouldNotBeNil)
+				So(calls, ShouldEqual, 1)
+			})
+		})
+	})
+
+	Convey("Given Retry-After values", t, func() {
+		Convey("I expect seconds and HTTP dates to be understood", func() {
+			d, ok := retryAfter("30")
+			So(ok, ShouldBeTrue)
+			So(d, ShouldEqual, 30*time.Second)
+
+			d, ok = retryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+			So(ok, ShouldBeTrue)
+			So(d, ShouldBeGreaterThan, 50*time.Second)
+
+			_, ok = retryAfter("soon")
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
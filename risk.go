@@ -0,0 +1,80 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+// IsAnonymized reports whether MaxMind flagged the address as hiding its
+// user by any means: an anonymous VPN, public or residential proxy, Tor exit
+// node, or the deprecated anonymous proxy flag.  The flags are returned by
+// the Insights service only.
+func (t Traits) IsAnonymized() bool {
+	return t.IsAnonymous || t.IsAnonymousVpn || t.IsPublicProxy || t.IsResidentialProxy ||
+		t.IsTorExitNode || t.IsAnonymousProxy
+}
+
+// RiskLevel grades an address for fraud screening
+type RiskLevel int
+
+const (
+	RiskLow RiskLevel = iota
+	RiskMedium
+	RiskHigh
+)
+
+func (l RiskLevel) String() string {
+	switch l {
+	case RiskMedium:
+		return "medium"
+	case RiskHigh:
+		return "high"
+	default:
+		return "low"
+	}
+}
+
+// RiskThresholds tunes RiskLevel
+type RiskThresholds struct {
+	// MinStaticIpScore raises addresses scoring below it, i.e. frequently
+	// reassigned ones, to at least RiskMedium; 0 ignores the score.  A
+	// response without a score, which decodes as zero, is not raised.
+	MinStaticIpScore float64
+
+	// HostingIsHigh grades hosting providers RiskHigh rather than
+	// RiskMedium, for services where visitors never come from data centres
+	HostingIsHigh bool
+}
+
+// DefaultRiskThresholds grades hosting providers as medium risk and ignores
+// the static IP score
+var DefaultRiskThresholds = RiskThresholds{}
+
+// RiskLevel grades the response from its anonymity traits.  Tor exit nodes,
+// anonymous VPNs and public proxies are RiskHigh; residential proxies,
+// hosting providers and other anonymous addresses are RiskMedium.  Responses
+// without these traits, such as those from the Country and City services,
+// are RiskLow.
+func (r Response) RiskLevel(thresholds RiskThresholds) RiskLevel {
+	t := r.Traits
+	switch {
+	case t.IsTorExitNode || t.IsAnonymousVpn || t.IsPublicProxy:
+		return RiskHigh
+	case t.IsHostingProvider && thresholds.HostingIsHigh:
+		return RiskHigh
+	case t.IsAnonymized() || t.IsHostingProvider:
+		return RiskMedium
+	case thresholds.MinStaticIpScore > 0 && !t.StaticIpScore.IsZero() && t.StaticIpScore.Float64() < thresholds.MinStaticIpScore:
+		return RiskMedium
+	}
+	return RiskLow
+}
@@ -0,0 +1,60 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRisk(t *testing.T) {
+	Convey("Given an Insights response with anonymity traits", t, func() {
+		resp := Response{}
+		err := json.Unmarshal([]byte(`{"traits":{"is_anonymous":true,"is_anonymous_vpn":true,"is_hosting_provider":true,"static_ip_score":1.5}}`), &resp)
+		So(err, ShouldBeNil)
+
+		Convey("I expect the traits to decode into typed fields", func() {
+			So(resp.Traits.IsAnonymous, ShouldBeTrue)
+			So(resp.Traits.IsAnonymousVpn, ShouldBeTrue)
+			So(resp.Traits.IsHostingProvider, ShouldBeTrue)
+			So(resp.Traits.IsTorExitNode, ShouldBeFalse)
+			So(resp.Traits.IsAnonymized(), ShouldBeTrue)
+		})
+
+		Convey("I expect an anonymous VPN to be high risk", func() {
+			So(resp.RiskLevel(DefaultRiskThresholds), ShouldEqual, RiskHigh)
+			So(resp.RiskLevel(DefaultRiskThresholds).String(), ShouldEqual, "high")
+		})
+	})
+
+	Convey("Given addresses with different traits", t, func() {
+		hosting := Response{Traits: Traits{IsHostingProvider: true}}
+		residential := Response{Traits: Traits{IsResidentialProxy: true}}
+		dynamic := Response{}
+		dynamic.Traits.StaticIpScore, _ = ParseDecimal("0.4")
+
+		Convey("I expect them to be graded by the thresholds", func() {
+			So(hosting.RiskLevel(DefaultRiskThresholds), ShouldEqual, RiskMedium)
+			So(hosting.RiskLevel(RiskThresholds{HostingIsHigh: true}), ShouldEqual, RiskHigh)
+			So(hosting.Traits.IsAnonymized(), ShouldBeFalse)
+			So(residential.RiskLevel(DefaultRiskThresholds), ShouldEqual, RiskMedium)
+			So(dynamic.RiskLevel(DefaultRiskThresholds), ShouldEqual, RiskLow)
+			So(dynamic.RiskLevel(RiskThresholds{MinStaticIpScore: 1}), ShouldEqual, RiskMedium)
+			So(Response{}.RiskLevel(RiskThresholds{MinStaticIpScore: 1}), ShouldEqual, RiskLow)
+		})
+	})
+}
@@ -0,0 +1,112 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import "strings"
+
+// SanctionsList is a named set of embargoed countries and regions.
+// Subdivisions are ISO 3166-2 codes such as "UA-43".
+type SanctionsList struct {
+	Name         string
+	Countries    []string
+	Subdivisions []string
+}
+
+// Sanctions is the set of lists a response is checked against
+type Sanctions []SanctionsList
+
+// DefaultSanctions holds the jurisdictions under comprehensive US embargo as
+// of 2024.  It is a starting point for gating, not legal advice; replace or
+// extend it to match your own compliance requirements.
+// https://ofac.treasury.gov/sanctions-programs-and-country-information
+var DefaultSanctions = Sanctions{
+	{
+		Name:         "ofac-comprehensive",
+		Countries:    []string{"CU", "IR", "KP", "SY"},
+		Subdivisions: []string{"UA-09", "UA-14", "UA-40", "UA-43"},
+	},
+}
+
+// SanctionsMatch records which list matched which field of a response
+type SanctionsMatch struct {
+	List    string `json:"list"`
+	Field   string `json:"field"`
+	IsoCode string `json:"iso_code"`
+}
+
+// SanctionsResult is the outcome of a sanctions check
+type SanctionsResult struct {
+	Sanctioned bool             `json:"sanctioned"`
+	Matches    []SanctionsMatch `json:"matches,omitempty"`
+}
+
+// Lists returns the names of the lists that matched, without duplicates
+func (r SanctionsResult) Lists() []string {
+	var names []string
+	for _, match := range r.Matches {
+		if !containsFold(names, match.List) {
+			names = append(names, match.List)
+		}
+	}
+	return names
+}
+
+// CheckSanctions checks resp against DefaultSanctions
+func CheckSanctions(resp Response) SanctionsResult {
+	return DefaultSanctions.Check(resp)
+}
+
+// Check reports every list matching the country, registered country,
+// represented country or subdivisions of resp.  Callers that only gate on
+// the physical location can filter the matches by Field.
+func (s Sanctions) Check(resp Response) SanctionsResult {
+	countries := []struct {
+		field   string
+		isoCode string
+	}{
+		{"country", resp.Country.IsoCode},
+		{"registered_country", resp.RegisteredCountry.IsoCode},
+		{"represented_country", resp.RepresentedCountry.IsoCode},
+	}
+
+	result := SanctionsResult{}
+	for _, list := range s {
+		for _, country := range countries {
+			if country.isoCode != "" && containsFold(list.Countries, country.isoCode) {
+				result.Matches = append(result.Matches, SanctionsMatch{List: list.Name, Field: country.field, IsoCode: country.isoCode})
+			}
+		}
+		for _, subdivision := range resp.Subdivisions {
+			if subdivision.IsoCode == "" || resp.Country.IsoCode == "" {
+				continue
+			}
+			code := resp.Country.IsoCode + "-" + subdivision.IsoCode
+			if containsFold(list.Subdivisions, code) {
+				result.Matches = append(result.Matches, SanctionsMatch{List: list.Name, Field: "subdivisions", IsoCode: code})
+			}
+		}
+	}
+	result.Sanctioned = len(result.Matches) > 0
+	return result
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSanctions(t *testing.T) {
+	Convey("Given the default sanctions lists", t, func() {
+		Convey("I expect an embargoed country to match", func() {
+			result := CheckSanctions(Response{Country: Country{IsoCode: "IR"}})
+			So(result.Sanctioned, ShouldBeTrue)
+			So(result.Matches, ShouldResemble, []SanctionsMatch{{List: "ofac-comprehensive", Field: "country", IsoCode: "IR"}})
+		})
+
+		Convey("I expect an embargoed region to match by subdivision", func() {
+			result := CheckSanctions(Response{
+				Country:      Country{IsoCode: "UA"},
+				Subdivisions: []Subdivision{{IsoCode: "43"}},
+			})
+			So(result.Sanctioned, ShouldBeTrue)
+			So(result.Matches[0].IsoCode, ShouldEqual, "UA-43")
+		})
+
+		Convey("I expect the registered country to be reported separately", func() {
+			result := CheckSanctions(Response{
+				Country:           Country{IsoCode: "DE"},
+				RegisteredCountry: RegisteredCountry{IsoCode: "cu"},
+			})
+			So(result.Sanctioned, ShouldBeTrue)
+			So(result.Matches[0].Field, ShouldEqual, "registered_country")
+		})
+
+		Convey("I expect other countries not to match", func() {
+			result := CheckSanctions(Response{
+				Country:      Country{IsoCode: "UA"},
+				Subdivisions: []Subdivision{{IsoCode: "30"}},
+			})
+			So(result.Sanctioned, ShouldBeFalse)
+			So(result.Matches, ShouldBeNil)
+		})
+	})
+
+	Convey("Given custom sanctions lists", t, func() {
+		sanctions := append(Sanctions{{Name: "internal", Countries: []string{"IR", "RU"}}}, DefaultSanctions...)
+
+		Convey("I expect every matching list to be named once", func() {
+			result := sanctions.Check(Response{Country: Country{IsoCode: "IR"}, RegisteredCountry: RegisteredCountry{IsoCode: "IR"}})
+			So(len(result.Matches), ShouldEqual, 4)
+			So(result.Lists(), ShouldResemble, []string{"internal", "ofac-comprehensive"})
+		})
+	})
+}
@@ -0,0 +1,85 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// WithSingleflight shares one request among concurrent lookups of the same
+// address and endpoint, e.g. the egress of a large NAT, and fills the cache
+// once.  The shared request is not cancelled when one of the callers gives
+// up, since the others are still waiting; it remains bounded by
+// WithTimeout.  Clones of the Api share in-flight requests.
+func WithSingleflight() Option {
+	return func(a *Api) {
+		a.inflight = &singleflight.Group{}
+	}
+}
+
+// shared performs fn once for all concurrent callers with the same key,
+// returning early with the context's error when ctx is done
+func (a *Api) shared(ctx context.Context, key string, fn func(context.Context) (Response, error)) (Response, error) {
+	if a.inflight == nil {
+		return fn(ctx)
+	}
+
+	ch := a.inflight.DoChan(a.flightKey(key), func() (interface{}, error) {
+		return fn(context.WithoutCancel(ctx))
+	})
+
+	// a follower's timeout holds even when it joins a flight led by a
+	// lookup with a longer one
+	if a.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.timeout)
+		defer cancel()
+	}
+	select {
+	case result := <-ch:
+		response, _ := result.Val.(Response)
+		return response, result.Err
+	case <-ctx.Done():
+		return Response{}, ctx.Err()
+	}
+}
+
+// flightKey extends a lookup's cache key with the settings that shape its
+// request or answer, since clones and per-request options may change them:
+// the endpoint, account, headers, locales and decoding
+func (a *Api) flightKey(key string) string {
+	var b strings.Builder
+	b.WriteString(key)
+	for _, v := range []string{a.baseURL, a.userId, a.userAgent, strings.Join(a.locales, ","), strings.Join(a.sections, ",")} {
+		b.WriteString("\x00")
+		b.WriteString(v)
+	}
+	fmt.Fprintf(&b, "\x00%t %t %d", a.lenient, a.strict, a.maxBodySize)
+
+	names := make([]string, 0, len(a.header))
+	for name := range a.header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&b, "\x00%s=%q", name, a.header[name])
+	}
+	return b.String()
+}
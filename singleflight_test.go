@@ -0,0 +1,126 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSingleflight(t *testing.T) {
+	Convey("Given an Api with singleflight and a slow web service", t, func() {
+		var calls int32
+		release := make(chan struct{})
+		api := WithClientFunc(New("blah-user-id", "blah-license-key", WithSingleflight(), WithCache(NewLRUCache(10))), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&calls, 1)
+			<-release
+			return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(sample))}, nil
+		})
+
+		Convey("When many callers look up the same address at once", func() {
+			const callers = 20
+			var wg sync.WaitGroup
+			errs := make([]error, callers)
+			for i := 0; i < callers; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					_, errs[i] = api.City(nil, "1.2.3.4")
+				}(i)
+			}
+
+			// a caller that gives up doesn't take the others with it
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			_, err := api.City(ctx, "1.2.3.4")
+			So(err, ShouldEqual, context.DeadlineExceeded)
+
+			close(release)
+			wg.Wait()
+
+			Convey("I expect one request shared by all of them", func() {
+				So(atomic.LoadInt32(&calls), ShouldEqual, 1)
+				for _, err := range errs {
+					So(err, ShouldBeNil)
+				}
+			})
+
+			Convey("I expect the cache to have been filled", func() {
+				_, err := api.City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+				So(atomic.LoadInt32(&calls), ShouldEqual, 1)
+			})
+		})
+
+		Convey("When callers look up different endpoints", func() {
+			close(release)
+			api.City(nil, "1.2.3.4")
+			api.Country(nil, "1.2.3.4")
+
+			Convey("I expect separate requests", func() {
+				So(atomic.LoadInt32(&calls), ShouldEqual, 2)
+			})
+		})
+
+		Convey("When concurrent callers differ in options that shape the answer", func() {
+			lookups := []func() error{
+				func() error { _, err := api.City(nil, "1.2.3.4"); return err },
+				func() error {
+					_, err := api.City(WithRequestOptions(context.Background(), WithHeader("X-Tenant", "a")), "1.2.3.4")
+					return err
+				},
+				func() error {
+					_, err := api.City(WithRequestOptions(context.Background(), WithLocales("ja")), "1.2.3.4")
+					return err
+				},
+				func() error { _, err := api.Clone(WithSections("city")).City(nil, "1.2.3.4"); return err },
+				func() error { _, err := api.Clone(WithLenientDecoding()).City(nil, "1.2.3.4"); return err },
+				func() error { _, err := api.Clone(WithHeader("X-Tenant", "a")).City(nil, "1.2.3.4"); return err },
+			}
+			var wg sync.WaitGroup
+			errs := make([]error, len(lookups))
+			for i, lookup := range lookups {
+				wg.Add(1)
+				go func(i int, lookup func() error) {
+					defer wg.Done()
+					errs[i] = lookup()
+				}(i, lookup)
+			}
+
+			// every lookup has reached the web service or joined a flight
+			for deadline := time.Now().Add(time.Second); atomic.LoadInt32(&calls) < 5 && time.Now().Before(deadline); {
+				time.Sleep(time.Millisecond)
+			}
+			time.Sleep(10 * time.Millisecond)
+			close(release)
+			wg.Wait()
+
+			Convey("I expect a request for each variant, shared only by identical ones", func() {
+				So(atomic.LoadInt32(&calls), ShouldEqual, 5)
+				for _, err := range errs {
+					So(err, ShouldBeNil)
+				}
+			})
+		})
+	})
+}
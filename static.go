@@ -0,0 +1,129 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// StaticLookuper is a Lookuper answering from responses added for networks,
+// for tests, local development and environments without MaxMind access.
+// The most specific network containing an address wins, and addresses in no
+// network are not found, so a StaticLookuper can also sit in a Chain.
+//
+//	stub := geoip2.NewStaticLookuper()
+//	stub.Add("10.0.0.0/8", geoip2.Response{Country: geoip2.Country{IsoCode: "US"}})
+//	stub.Add("10.1.2.3", geoip2.MockResponse("10.1.2.3"))
+type StaticLookuper struct {
+	mutex   sync.RWMutex
+	entries []staticEntry // most specific first
+}
+
+type staticEntry struct {
+	prefix netip.Prefix
+	resp   Response
+}
+
+var _ Lookuper = (*StaticLookuper)(nil)
+
+// NewStaticLookuper returns an empty StaticLookuper
+func NewStaticLookuper() *StaticLookuper {
+	return &StaticLookuper{}
+}
+
+// Add answers lookups within network, a CIDR or a single address, with
+// resp, replacing any response added for the same network
+func (s *StaticLookuper) Add(network string, resp Response) error {
+	var prefix netip.Prefix
+	var err error
+	if strings.Contains(network, "/") {
+		prefix, err = netip.ParsePrefix(network)
+	} else {
+		var addr netip.Addr
+		addr, err = netip.ParseAddr(network)
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	if err != nil {
+		return fmt.Errorf("geoip2: invalid network %q: %w", network, err)
+	}
+	s.AddPrefix(prefix, resp)
+	return nil
+}
+
+// AddPrefix answers lookups within prefix with resp
+func (s *StaticLookuper) AddPrefix(prefix netip.Prefix, resp Response) {
+	prefix = prefix.Masked()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i, entry := range s.entries {
+		if entry.prefix == prefix {
+			s.entries[i].resp = resp
+			return
+		}
+	}
+	s.entries = append(s.entries, staticEntry{prefix: prefix, resp: resp})
+	sort.SliceStable(s.entries, func(i, j int) bool {
+		return s.entries[i].prefix.Bits() > s.entries[j].prefix.Bits()
+	})
+}
+
+func (s *StaticLookuper) Country(ctx context.Context, ipAddress string) (Response, error) {
+	return s.lookup(ipAddress)
+}
+
+func (s *StaticLookuper) City(ctx context.Context, ipAddress string) (Response, error) {
+	return s.lookup(ipAddress)
+}
+
+func (s *StaticLookuper) Insights(ctx context.Context, ipAddress string) (Response, error) {
+	return s.lookup(ipAddress)
+}
+
+// lookup fills in the address, and the network unless the response names
+// one, as the web service would
+func (s *StaticLookuper) lookup(ipAddress string) (Response, error) {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return Response{}, Error{
+			Code: CodeIPAddressInvalid,
+			Err:  fmt.Sprintf("The value %q is not a valid IP address.", ipAddress),
+		}
+	}
+	addr = addr.Unmap()
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	for _, entry := range s.entries {
+		if !entry.prefix.Contains(addr) {
+			continue
+		}
+		resp := entry.resp
+		resp.Traits.IpAddress = addr
+		if !resp.Traits.Network.IsValid() {
+			resp.Traits.Network = entry.prefix
+		}
+		return resp, nil
+	}
+	return Response{}, Error{
+		Code: CodeIPAddressNotFound,
+		Err:  fmt.Sprintf("The address %s is not in the database.", addr),
+	}
+}
@@ -0,0 +1,66 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStaticLookuper(t *testing.T) {
+	Convey("Given a StaticLookuper with nested networks", t, func() {
+		stub := NewStaticLookuper()
+		So(stub.Add("10.0.0.0/8", Response{Country: Country{IsoCode: "US"}}), ShouldBeNil)
+		So(stub.Add("10.1.0.0/16", Response{Country: Country{IsoCode: "CA"}}), ShouldBeNil)
+		So(stub.Add("10.1.2.3", Response{Country: Country{IsoCode: "MX"}}), ShouldBeNil)
+
+		Convey("I expect the most specific network to answer", func() {
+			resp, err := stub.City(nil, "10.1.9.9")
+			So(err, ShouldBeNil)
+			So(resp.Country.IsoCode, ShouldEqual, "CA")
+			So(resp.Traits.IpAddress.String(), ShouldEqual, "10.1.9.9")
+			So(resp.Traits.Network.String(), ShouldEqual, "10.1.0.0/16")
+
+			resp, _ = stub.Insights(nil, "::ffff:10.1.2.3")
+			So(resp.Country.IsoCode, ShouldEqual, "MX")
+
+			resp, _ = stub.Country(nil, "10.200.0.1")
+			So(resp.Country.IsoCode, ShouldEqual, "US")
+		})
+
+		Convey("I expect a network added again to be replaced", func() {
+			stub.Add("10.0.0.0/8", Response{Country: Country{IsoCode: "GB"}})
+			resp, _ := stub.City(nil, "10.200.0.1")
+			So(resp.Country.IsoCode, ShouldEqual, "GB")
+		})
+
+		Convey("I expect other addresses not to be found, so a Chain falls back", func() {
+			_, err := stub.City(nil, "192.0.2.1")
+			So(errors.Is(err, ErrIPAddressNotFound), ShouldBeTrue)
+
+			resp, err := NewChain(stub, StaticResponse(Response{Country: Country{IsoCode: "FR"}})).City(nil, "192.0.2.1")
+			So(err, ShouldBeNil)
+			So(resp.Country.IsoCode, ShouldEqual, "FR")
+		})
+
+		Convey("I expect invalid input to be rejected", func() {
+			So(stub.Add("10.0.0.0/99", Response{}), ShouldNotBeNil)
+			_, err := stub.City(nil, "nope")
+			So(errors.Is(err, ErrIPAddressInvalid), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,87 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import "strings"
+
+// usStates holds the postal codes of the fifty states and DC, which are
+// the first level subdivisions of US answers.  Puerto Rico, Guam and the
+// other territories are answered as countries of their own.
+var usStates = map[string]string{
+	"AL": "Alabama", "AK": "Alaska", "AZ": "Arizona", "AR": "Arkansas",
+	"CA": "California", "CO": "Colorado", "CT": "Connecticut", "DE": "Delaware",
+	"DC": "District of Columbia", "FL": "Florida", "GA": "Georgia", "HI": "Hawaii",
+	"ID": "Idaho", "IL": "Illinois", "IN": "Indiana", "IA": "Iowa",
+	"KS": "Kansas", "KY": "Kentucky", "LA": "Louisiana", "ME": "Maine",
+	"MD": "Maryland", "MA": "Massachusetts", "MI": "Michigan", "MN": "Minnesota",
+	"MS": "Mississippi", "MO": "Missouri", "MT": "Montana", "NE": "Nebraska",
+	"NV": "Nevada", "NH": "New Hampshire", "NJ": "New Jersey", "NM": "New Mexico",
+	"NY": "New York", "NC": "North Carolina", "ND": "North Dakota", "OH": "Ohio",
+	"OK": "Oklahoma", "OR": "Oregon", "PA": "Pennsylvania", "RI": "Rhode Island",
+	"SC": "South Carolina", "SD": "South Dakota", "TN": "Tennessee", "TX": "Texas",
+	"UT": "Utah", "VT": "Vermont", "VA": "Virginia", "WA": "Washington",
+	"WV": "West Virginia", "WI": "Wisconsin", "WY": "Wyoming",
+}
+
+// USStateName names the state with the two letter postal code, reporting
+// false for anything but the fifty states and DC
+func USStateName(code string) (string, bool) {
+	name, ok := usStates[strings.ToUpper(code)]
+	return name, ok
+}
+
+// Code is the full ISO 3166-2 code of the subdivision within the country,
+// e.g. "US-CA" or "GB-ENG"
+func (s Subdivision) Code(countryIsoCode string) string {
+	if s.IsoCode == "" || countryIsoCode == "" {
+		return ""
+	}
+	return strings.ToUpper(countryIsoCode) + "-" + s.IsoCode
+}
+
+// MostSpecificSubdivision and LeastSpecificSubdivision return the last and
+// first of the Subdivisions, which run from largest to smallest, e.g.
+// England then Hampshire; ok is false when there are none
+func (r Response) MostSpecificSubdivision() (Subdivision, bool) {
+	if len(r.Subdivisions) == 0 {
+		return Subdivision{}, false
+	}
+	return r.Subdivisions[len(r.Subdivisions)-1], true
+}
+
+func (r Response) LeastSpecificSubdivision() (Subdivision, bool) {
+	if len(r.Subdivisions) == 0 {
+		return Subdivision{}, false
+	}
+	return r.Subdivisions[0], true
+}
+
+// USState returns the two letter code of the state of a US answer, e.g.
+// "CA", for sales tax and state privacy rules.  ok is false outside the US
+// and when the state is unknown.
+func (r Response) USState() (string, bool) {
+	if r.Country.IsoCode != "US" {
+		return "", false
+	}
+	s, ok := r.LeastSpecificSubdivision()
+	if !ok {
+		return "", false
+	}
+	code := strings.ToUpper(s.IsoCode)
+	if _, ok := usStates[code]; !ok {
+		return "", false
+	}
+	return code, true
+}
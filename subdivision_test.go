@@ -0,0 +1,81 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSubdivisions(t *testing.T) {
+	Convey("Given a response with two levels of subdivision", t, func() {
+		resp := Response{
+			Country: Country{IsoCode: "GB"},
+			Subdivisions: []Subdivision{
+				{IsoCode: "ENG", Names: map[string]string{"en": "England"}},
+				{IsoCode: "HAM", Names: map[string]string{"en": "Hampshire"}},
+			},
+		}
+
+		Convey("I expect the most and least specific ones in order", func() {
+			s, ok := resp.MostSpecificSubdivision()
+			So(ok, ShouldBeTrue)
+			So(s.IsoCode, ShouldEqual, "HAM")
+			So(s.Code(resp.Country.IsoCode), ShouldEqual, "GB-HAM")
+
+			s, ok = resp.LeastSpecificSubdivision()
+			So(ok, ShouldBeTrue)
+			So(s.IsoCode, ShouldEqual, "ENG")
+			So(resp.SubdivisionName(), ShouldEqual, "Hampshire")
+		})
+
+		Convey("I expect no US state", func() {
+			_, ok := resp.USState()
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given US responses", t, func() {
+		ca := Response{Country: Country{IsoCode: "US"}, Subdivisions: []Subdivision{{IsoCode: "CA"}}}
+		dc := Response{Country: Country{IsoCode: "US"}, Subdivisions: []Subdivision{{IsoCode: "dc"}}}
+		unknown := Response{Country: Country{IsoCode: "US"}}
+
+		Convey("I expect the two letter state", func() {
+			state, ok := ca.USState()
+			So(ok, ShouldBeTrue)
+			So(state, ShouldEqual, "CA")
+
+			state, ok = dc.USState()
+			So(ok, ShouldBeTrue)
+			So(state, ShouldEqual, "DC")
+
+			_, ok = unknown.USState()
+			So(ok, ShouldBeFalse)
+			_, ok = unknown.MostSpecificSubdivision()
+			So(ok, ShouldBeFalse)
+			So(unknown.SubdivisionName(), ShouldEqual, "")
+		})
+
+		Convey("I expect state names for the fifty states and DC", func() {
+			So(usStates, ShouldHaveLength, 51)
+			name, ok := USStateName("tx")
+			So(ok, ShouldBeTrue)
+			So(name, ShouldEqual, "Texas")
+			_, ok = USStateName("PR")
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,59 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var errNoTimeZone = errors.New("geoip2: location has no time zone")
+
+// zones caches loaded time zones by name, since time.LoadLocation reads
+// and parses the zone file on every call
+var zones sync.Map // string -> *time.Location
+
+// TimeLocation returns the location's time zone, e.g. for rendering
+// timestamps in the visitor's local time.  Zones are loaded once and
+// shared.  It relies on the system time zone database; binaries for
+// systems without one should import time/tzdata.
+func (l Location) TimeLocation() (*time.Location, error) {
+	if l.TimeZone == "" {
+		return nil, errNoTimeZone
+	}
+	if loc, ok := zones.Load(l.TimeZone); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(l.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	zones.Store(l.TimeZone, loc)
+	return loc, nil
+}
+
+// UTCOffset returns the offset from UTC, including daylight saving time, of
+// the location's time zone at the instant at; see TimeLocation
+func (l Location) UTCOffset(at time.Time) (time.Duration, error) {
+	loc, err := l.TimeLocation()
+	if err != nil {
+		return 0, err
+	}
+
+	_, offset := at.In(loc).Zone()
+	return time.Duration(offset) * time.Second, nil
+}
@@ -0,0 +1,81 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUTCOffset(t *testing.T) {
+	Convey("Given locations in different time zones", t, func() {
+		winter := time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+		summer := time.Date(2024, time.July, 15, 12, 0, 0, 0, time.UTC)
+
+		Convey("I expect daylight saving time to be included", func() {
+			newYork := Location{TimeZone: "America/New_York"}
+			offset, err := newYork.UTCOffset(winter)
+			So(err, ShouldBeNil)
+			So(offset, ShouldEqual, -5*time.Hour)
+
+			offset, err = newYork.UTCOffset(summer)
+			So(err, ShouldBeNil)
+			So(offset, ShouldEqual, -4*time.Hour)
+		})
+
+		Convey("I expect fractional offsets to be preserved", func() {
+			offset, err := Location{TimeZone: "Asia/Kolkata"}.UTCOffset(summer)
+			So(err, ShouldBeNil)
+			So(offset, ShouldEqual, 5*time.Hour+30*time.Minute)
+		})
+
+		Convey("I expect an error without a usable time zone", func() {
+			_, err := Location{}.UTCOffset(summer)
+			So(err, ShouldEqual, errNoTimeZone)
+
+			_, err = Location{TimeZone: "Nowhere/Special"}.UTCOffset(summer)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestTimeLocation(t *testing.T) {
+	Convey("Given a location with a time zone", t, func() {
+		l := Location{TimeZone: "Europe/Berlin"}
+
+		Convey("I expect the zone to be loaded once and reused", func() {
+			first, err := l.TimeLocation()
+			So(err, ShouldBeNil)
+			So(first.String(), ShouldEqual, "Europe/Berlin")
+
+			second, err := l.TimeLocation()
+			So(err, ShouldBeNil)
+			So(second, ShouldPointTo, first)
+
+			at := time.Date(2024, time.July, 15, 12, 0, 0, 0, time.UTC).In(first)
+			So(at.Hour(), ShouldEqual, 14)
+		})
+
+		Convey("I expect an error without a usable time zone", func() {
+			_, err := Location{}.TimeLocation()
+			So(err, ShouldEqual, errNoTimeZone)
+
+			_, err = Location{TimeZone: "Nowhere/Special"}.TimeLocation()
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
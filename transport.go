@@ -0,0 +1,122 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !js
+// +build !js
+
+package geoip2
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+func defaultClient() *http.Client {
+	return http.DefaultClient
+}
+
+// TransportConfig tunes the connections to the web service.  Zero fields
+// take the defaults of http.DefaultTransport, except MaxIdleConnsPerHost,
+// whose default of 2 is too few to keep warm connections for concurrent
+// lookups.
+type TransportConfig struct {
+	// MaxIdleConnsPerHost is the number of idle connections kept open to
+	// MaxMind, 16 by default
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout closes idle connections after this long, 90s by
+	// default
+	IdleConnTimeout time.Duration
+
+	// DialTimeout and KeepAlive configure new connections, 30s by default
+	DialTimeout time.Duration
+	KeepAlive   time.Duration
+
+	// TLSHandshakeTimeout bounds the TLS handshake, 10s by default
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout bounds the wait for response headers once the
+	// request is sent; none by default, as WithTimeout covers lookups
+	ResponseHeaderTimeout time.Duration
+
+	// DisableHTTP2 keeps connections on HTTP/1.1
+	DisableHTTP2 bool
+
+	// Proxy chooses the proxy for each request, e.g. http.ProxyURL(u); the
+	// default is http.ProxyFromEnvironment
+	Proxy func(*http.Request) (*url.URL, error)
+
+	// TLSClientConfig replaces the default TLS configuration, e.g. to trust
+	// the certificate of an intercepting egress proxy
+	TLSClientConfig *tls.Config
+}
+
+func (c TransportConfig) withDefaults() TransportConfig {
+	if c.MaxIdleConnsPerHost <= 0 {
+		c.MaxIdleConnsPerHost = 16
+	}
+	if c.IdleConnTimeout <= 0 {
+		c.IdleConnTimeout = 90 * time.Second
+	}
+	if c.DialTimeout <= 0 {
+		c.DialTimeout = 30 * time.Second
+	}
+	if c.KeepAlive <= 0 {
+		c.KeepAlive = 30 * time.Second
+	}
+	if c.TLSHandshakeTimeout <= 0 {
+		c.TLSHandshakeTimeout = 10 * time.Second
+	}
+	if c.Proxy == nil {
+		c.Proxy = http.ProxyFromEnvironment
+	}
+	return c
+}
+
+// Transport returns an http.Transport configured by c
+func (c TransportConfig) Transport() *http.Transport {
+	c = c.withDefaults()
+	dialer := &net.Dialer{Timeout: c.DialTimeout, KeepAlive: c.KeepAlive}
+	transport := &http.Transport{
+		Proxy:                 c.Proxy,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     !c.DisableHTTP2,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   c.MaxIdleConnsPerHost,
+		IdleConnTimeout:       c.IdleConnTimeout,
+		TLSHandshakeTimeout:   c.TLSHandshakeTimeout,
+		ResponseHeaderTimeout: c.ResponseHeaderTimeout,
+		ExpectContinueTimeout: time.Second,
+		TLSClientConfig:       c.TLSClientConfig,
+	}
+	if c.MaxIdleConnsPerHost > transport.MaxIdleConns {
+		transport.MaxIdleConns = c.MaxIdleConnsPerHost
+	}
+	if c.DisableHTTP2 {
+		// a non-nil empty map turns off the transport's HTTP/2 upgrade
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	return transport
+}
+
+// WithTransport sends requests over a transport configured by config
+// rather than http.DefaultTransport, without building an http.Client.
+// Like WithHTTPClient it replaces how requests are sent; hedging, retries
+// and the other wrappers apply to it whatever the order of the options.
+func WithTransport(config TransportConfig) Option {
+	return WithHTTPClient(&http.Client{Transport: config.Transport()})
+}
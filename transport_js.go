@@ -0,0 +1,65 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build js && wasm
+// +build js,wasm
+
+package geoip2
+
+import (
+	"context"
+	"net/http"
+)
+
+// on js/wasm net/http performs requests with the browser's Fetch API, which
+// reads its options from these request headers
+// https://developer.mozilla.org/en-US/docs/Web/API/fetch#parameters
+const (
+	fetchMode        = "js.fetch:mode"
+	fetchCredentials = "js.fetch:credentials"
+)
+
+// by default lookups are cross-origin and never carry the page's cookies
+func defaultClient() *http.Client {
+	return &http.Client{
+		Transport: fetchTransport{mode: "cors", credentials: "omit"},
+	}
+}
+
+// WithFetch returns a copy of api whose requests use the Fetch API with the
+// given mode ("cors", "same-origin", ...) and credentials ("omit",
+// "same-origin", "include"), e.g. when routing through a same-origin proxy
+// that relies on the admin tool's session cookie.
+func WithFetch(api *Api, mode, credentials string) *Api {
+	transport := fetchTransport{mode: mode, credentials: credentials}
+	return WithClientFunc(api, func(ctx context.Context, req *http.Request) (*http.Response, error) {
+		return transport.RoundTrip(req)
+	})
+}
+
+type fetchTransport struct {
+	mode        string
+	credentials string
+}
+
+func (f fetchTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if f.mode != "" {
+		req.Header.Set(fetchMode, f.mode)
+	}
+	if f.credentials != "" {
+		req.Header.Set(fetchCredentials, f.credentials)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
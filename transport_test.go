@@ -0,0 +1,86 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !js
+// +build !js
+
+package geoip2
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTransportConfig(t *testing.T) {
+	Convey("Given an empty TransportConfig", t, func() {
+		transport := TransportConfig{}.Transport()
+
+		Convey("I expect warm connections and the usual timeouts", func() {
+			So(transport.MaxIdleConnsPerHost, ShouldEqual, 16)
+			So(transport.IdleConnTimeout, ShouldEqual, 90*time.Second)
+			So(transport.TLSHandshakeTimeout, ShouldEqual, 10*time.Second)
+			So(transport.ForceAttemptHTTP2, ShouldBeTrue)
+			So(transport.TLSNextProto, ShouldBeNil)
+			So(transport.Proxy, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a tuned TransportConfig", t, func() {
+		proxy, _ := url.Parse("http://egress.internal:3128")
+		transport := TransportConfig{
+			MaxIdleConnsPerHost:   256,
+			TLSHandshakeTimeout:   time.Second,
+			ResponseHeaderTimeout: 2 * time.Second,
+			DisableHTTP2:          true,
+			Proxy:                 http.ProxyURL(proxy),
+			TLSClientConfig:       &tls.Config{MinVersion: tls.VersionTLS13},
+		}.Transport()
+
+		Convey("I expect each setting on the transport", func() {
+			So(transport.MaxIdleConnsPerHost, ShouldEqual, 256)
+			So(transport.MaxIdleConns, ShouldEqual, 256)
+			So(transport.TLSHandshakeTimeout, ShouldEqual, time.Second)
+			So(transport.ResponseHeaderTimeout, ShouldEqual, 2*time.Second)
+			So(transport.ForceAttemptHTTP2, ShouldBeFalse)
+			So(transport.TLSNextProto, ShouldNotBeNil)
+			So(transport.TLSClientConfig.MinVersion, ShouldEqual, tls.VersionTLS13)
+
+			req, _ := http.NewRequest("GET", DefaultBaseURL, nil)
+			chosen, err := transport.Proxy(req)
+			So(err, ShouldBeNil)
+			So(chosen.Host, ShouldEqual, "egress.internal:3128")
+		})
+	})
+
+	Convey("Given an Api over a configured transport", t, func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Write([]byte(sample))
+		}))
+		defer server.Close()
+		api := New("blah-user-id", "blah-license-key", WithBaseURL(server.URL), WithTransport(TransportConfig{MaxIdleConnsPerHost: 4}))
+
+		Convey("I expect lookups to go through it", func() {
+			resp, err := api.City(context.Background(), "1.2.3.4")
+			So(err, ShouldBeNil)
+			So(resp.City.Confidence, ShouldEqual, 25)
+		})
+	})
+}
@@ -0,0 +1,67 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import "time"
+
+// TTLPolicy chooses how long resp, returned by endpoint ("country", "city"
+// or "insights"), may be cached
+type TTLPolicy func(endpoint string, resp Response) time.Duration
+
+const (
+	minRecommendedTTL = time.Hour
+	maxRecommendedTTL = 30 * 24 * time.Hour
+)
+
+// RecommendedTTL is the default TTLPolicy.  Country answers are stable
+// for longer than city or insights answers, and answers for large networks
+// are stable for longer than those for small ones, which are more often
+// reassigned.  IPv6 prefixes are scaled so that a /48 is treated as an IPv4
+// /24.
+func RecommendedTTL(endpoint string, resp Response) time.Duration {
+	var ttl time.Duration
+	switch endpoint {
+	case "country":
+		ttl = 7 * 24 * time.Hour
+	case "city":
+		ttl = 24 * time.Hour
+	default:
+		ttl = 12 * time.Hour
+	}
+
+	if network := resp.Traits.Network; network.IsValid() {
+		bits := network.Bits()
+		if network.Addr().Is6() && !network.Addr().Is4In6() {
+			bits /= 2
+		}
+		switch {
+		case bits <= 16:
+			ttl *= 2
+		case bits <= 24:
+		case bits < 32:
+			ttl /= 2
+		default:
+			ttl /= 4
+		}
+	}
+
+	if ttl < minRecommendedTTL {
+		return minRecommendedTTL
+	}
+	if ttl > maxRecommendedTTL {
+		return maxRecommendedTTL
+	}
+	return ttl
+}
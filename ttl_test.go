@@ -0,0 +1,55 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRecommendedTTL(t *testing.T) {
+	Convey("Given responses for networks of different sizes", t, func() {
+		withNetwork := func(prefix string) Response {
+			return Response{Traits: Traits{Network: netip.MustParsePrefix(prefix)}}
+		}
+		day := 24 * time.Hour
+
+		Convey("I expect country answers to outlive city answers", func() {
+			So(RecommendedTTL("country", Response{}), ShouldEqual, 7*day)
+			So(RecommendedTTL("city", Response{}), ShouldEqual, day)
+			So(RecommendedTTL("insights", Response{}), ShouldEqual, 12*time.Hour)
+		})
+
+		Convey("I expect large networks to be cached longer than small ones", func() {
+			So(RecommendedTTL("country", withNetwork("10.0.0.0/12")), ShouldEqual, 14*day)
+			So(RecommendedTTL("city", withNetwork("10.0.0.0/24")), ShouldEqual, day)
+			So(RecommendedTTL("city", withNetwork("10.0.0.0/28")), ShouldEqual, 12*time.Hour)
+			So(RecommendedTTL("city", withNetwork("10.0.0.1/32")), ShouldEqual, 6*time.Hour)
+		})
+
+		Convey("I expect IPv6 prefixes to be scaled", func() {
+			So(RecommendedTTL("city", withNetwork("2001:db8::/48")), ShouldEqual, day)
+			So(RecommendedTTL("city", withNetwork("2001:db8::/64")), ShouldEqual, 6*time.Hour)
+		})
+
+		Convey("I expect the recommendation to stay within bounds", func() {
+			So(RecommendedTTL("insights", withNetwork("10.0.0.1/32")), ShouldEqual, 3*time.Hour)
+			So(RecommendedTTL("country", withNetwork("10.0.0.0/8")), ShouldBeLessThanOrEqualTo, 30*day)
+		})
+	})
+}
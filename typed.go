@@ -0,0 +1,168 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import "net/netip"
+
+// CountryResponse holds the fields returned by the Country web service
+// http://dev.maxmind.com/geoip/geoip2/web-services/#Country
+type CountryResponse struct {
+	Continent          Continent          `json:"continent,omitempty"`
+	Country            CountryRecord      `json:"country,omitempty"`
+	RegisteredCountry  RegisteredCountry  `json:"registered_country,omitempty"`
+	RepresentedCountry RepresentedCountry `json:"represented_country,omitempty"`
+	Traits             CountryTraits      `json:"traits,omitempty"`
+	MaxMind            MaxMind            `json:"maxmind,omitempty"`
+}
+
+// CityResponse holds the fields returned by the City web service
+// http://dev.maxmind.com/geoip/geoip2/web-services/#City
+type CityResponse struct {
+	City               CityRecord          `json:"city,omitempty"`
+	Continent          Continent           `json:"continent,omitempty"`
+	Country            CountryRecord       `json:"country,omitempty"`
+	Location           CityLocation        `json:"location,omitempty"`
+	Postal             PostalRecord        `json:"postal,omitempty"`
+	RegisteredCountry  RegisteredCountry   `json:"registered_country,omitempty"`
+	RepresentedCountry RepresentedCountry  `json:"represented_country,omitempty"`
+	Subdivisions       []SubdivisionRecord `json:"subdivisions,omitempty"`
+	Traits             CityTraits          `json:"traits,omitempty"`
+	MaxMind            MaxMind             `json:"maxmind,omitempty"`
+}
+
+// InsightsResponse holds every field, as returned by the Insights web service
+// http://dev.maxmind.com/geoip/geoip2/web-services/#Insights
+type InsightsResponse Response
+
+// CityRecord, CountryRecord, SubdivisionRecord and PostalRecord omit the
+// confidence values only Insights provides
+type CityRecord struct {
+	GeoNameId int               `json:"geoname_id,omitempty"`
+	Names     map[string]string `json:"names,omitempty"`
+}
+
+type CountryRecord struct {
+	GeoNameId         int               `json:"geoname_id,omitempty"`
+	IsInEuropeanUnion bool              `json:"is_in_european_union,omitempty"`
+	IsoCode           string            `json:"iso_code,omitempty"`
+	Names             map[string]string `json:"names,omitempty"`
+}
+
+type SubdivisionRecord struct {
+	GeoNameId int               `json:"geoname_id,omitempty"`
+	IsoCode   string            `json:"iso_code,omitempty"`
+	Names     map[string]string `json:"names,omitempty"`
+}
+
+type PostalRecord struct {
+	Code string `json:"code,omitempty"`
+}
+
+// CityLocation omits the demographic fields only Insights provides
+type CityLocation struct {
+	AccuracyRadius int     `json:"accuracy_radius,omitempty"`
+	Latitude       float64 `json:"latitude,omitempty"`
+	Longitude      float64 `json:"longitude,omitempty"`
+	MetroCode      int     `json:"metro_code,omitempty"`
+	TimeZone       string  `json:"time_zone,omitempty"`
+}
+
+type CountryTraits struct {
+	IsAnonymousProxy    bool         `json:"is_anonymous_proxy,omitempty"`
+	IsAnycast           bool         `json:"is_anycast,omitempty"`
+	IsSatelliteProvider bool         `json:"is_satellite_provider,omitempty"`
+	IpAddress           netip.Addr   `json:"ip_address,omitzero"`
+	Network             netip.Prefix `json:"network,omitzero"`
+}
+
+type CityTraits struct {
+	AutonomousSystemNumber       int          `json:"autonomous_system_number,omitempty"`
+	AutonomousSystemOrganization string       `json:"autonomous_system_organization,omitempty"`
+	Domain                       string       `json:"domain,omitempty"`
+	IsAnonymousProxy             bool         `json:"is_anonymous_proxy,omitempty"`
+	IsAnycast                    bool         `json:"is_anycast,omitempty"`
+	IsSatelliteProvider          bool         `json:"is_satellite_provider,omitempty"`
+	Isp                          string       `json:"isp,omitempty"`
+	IpAddress                    netip.Addr   `json:"ip_address,omitzero"`
+	MobileCountryCode            string       `json:"mobile_country_code,omitempty"`
+	MobileNetworkCode            string       `json:"mobile_network_code,omitempty"`
+	Network                      netip.Prefix `json:"network,omitzero"`
+	Organization                 string       `json:"organization,omitempty"`
+}
+
+// CountryResponse narrows r to the fields of the Country web service
+func (r Response) CountryResponse() CountryResponse {
+	return CountryResponse{
+		Continent:          r.Continent,
+		Country:            CountryRecord{GeoNameId: r.Country.GeoNameId, IsInEuropeanUnion: r.Country.IsInEuropeanUnion, IsoCode: r.Country.IsoCode, Names: r.Country.Names},
+		RegisteredCountry:  r.RegisteredCountry,
+		RepresentedCountry: r.RepresentedCountry,
+		Traits: CountryTraits{
+			IsAnonymousProxy:    r.Traits.IsAnonymousProxy,
+			IsAnycast:           r.Traits.IsAnycast,
+			IsSatelliteProvider: r.Traits.IsSatelliteProvider,
+			IpAddress:           r.Traits.IpAddress,
+			Network:             r.Traits.Network,
+		},
+		MaxMind: r.MaxMind,
+	}
+}
+
+// CityResponse narrows r to the fields of the City web service
+func (r Response) CityResponse() CityResponse {
+	v := CityResponse{
+		City:      CityRecord{GeoNameId: r.City.GeoNameId, Names: r.City.Names},
+		Continent: r.Continent,
+		Country:   CountryRecord{GeoNameId: r.Country.GeoNameId, IsInEuropeanUnion: r.Country.IsInEuropeanUnion, IsoCode: r.Country.IsoCode, Names: r.Country.Names},
+		Location: CityLocation{
+			AccuracyRadius: r.Location.AccuracyRadius,
+			Latitude:       r.Location.Latitude,
+			Longitude:      r.Location.Longitude,
+			MetroCode:      r.Location.MetroCode,
+			TimeZone:       r.Location.TimeZone,
+		},
+		Postal:             PostalRecord{Code: r.Postal.Code},
+		RegisteredCountry:  r.RegisteredCountry,
+		RepresentedCountry: r.RepresentedCountry,
+		Traits: CityTraits{
+			AutonomousSystemNumber:       r.Traits.AutonomousSystemNumber,
+			AutonomousSystemOrganization: r.Traits.AutonomousSystemOrganization,
+			Domain:                       r.Traits.Domain,
+			IsAnonymousProxy:             r.Traits.IsAnonymousProxy,
+			IsAnycast:                    r.Traits.IsAnycast,
+			IsSatelliteProvider:          r.Traits.IsSatelliteProvider,
+			Isp:                          r.Traits.Isp,
+			IpAddress:                    r.Traits.IpAddress,
+			MobileCountryCode:            r.Traits.MobileCountryCode,
+			MobileNetworkCode:            r.Traits.MobileNetworkCode,
+			Network:                      r.Traits.Network,
+			Organization:                 r.Traits.Organization,
+		},
+		MaxMind: r.MaxMind,
+	}
+	for _, subdivision := range r.Subdivisions {
+		v.Subdivisions = append(v.Subdivisions, SubdivisionRecord{
+			GeoNameId: subdivision.GeoNameId,
+			IsoCode:   subdivision.IsoCode,
+			Names:     subdivision.Names,
+		})
+	}
+	return v
+}
+
+// InsightsResponse returns r with every field
+func (r Response) InsightsResponse() InsightsResponse {
+	return InsightsResponse(r)
+}
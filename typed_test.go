@@ -0,0 +1,66 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTypedResponses(t *testing.T) {
+	Convey("Given an Insights response", t, func() {
+		resp := Response{}
+		So(json.Unmarshal([]byte(sample), &resp), ShouldBeNil)
+
+		Convey("When I narrow it to a CountryResponse", func() {
+			v := resp.CountryResponse()
+			data, err := json.Marshal(v)
+			So(err, ShouldBeNil)
+
+			Convey("I expect only country-level fields", func() {
+				So(v.Country.IsoCode, ShouldEqual, resp.Country.IsoCode)
+				So(v.Traits.Network, ShouldEqual, resp.Traits.Network)
+				So(string(data), ShouldNotContainSubstring, `"city"`)
+				So(string(data), ShouldNotContainSubstring, `"confidence"`)
+				So(string(data), ShouldNotContainSubstring, `"isp"`)
+			})
+		})
+
+		Convey("When I narrow it to a CityResponse", func() {
+			v := resp.CityResponse()
+			data, err := json.Marshal(v)
+			So(err, ShouldBeNil)
+
+			Convey("I expect the City fields without Insights-only values", func() {
+				So(v.City.Names, ShouldResemble, resp.City.Names)
+				So(v.Location.Latitude, ShouldEqual, resp.Location.Latitude)
+				So(v.Subdivisions[0].IsoCode, ShouldEqual, resp.Subdivisions[0].IsoCode)
+				So(v.Traits.Isp, ShouldEqual, resp.Traits.Isp)
+				So(string(data), ShouldNotContainSubstring, `"confidence"`)
+				So(string(data), ShouldNotContainSubstring, `"user_type"`)
+				So(string(data), ShouldNotContainSubstring, `"static_ip_score"`)
+				So(string(data), ShouldNotContainSubstring, `"population_density"`)
+			})
+		})
+
+		Convey("I expect an InsightsResponse to keep every field", func() {
+			v := resp.InsightsResponse()
+			So(v.Traits.UserType, ShouldEqual, resp.Traits.UserType)
+			So(v.City.Confidence, ShouldEqual, resp.City.Confidence)
+		})
+	})
+}
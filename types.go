@@ -14,81 +14,128 @@
 
 package geoip2
 
-import "fmt"
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+	"time"
+)
 
-type Error struct {
-	Code string `json:"code,omitempty"`
-	Err  string `json:"error,omitempty"`
+// localizedName returns the first of locales present in names, then the
+// first in the same language as one of locales, e.g. "pt-BR" for "pt-PT",
+// falling back to English
+func localizedName(names map[string]string, locales ...string) string {
+	for _, locale := range locales {
+		if name, ok := names[locale]; ok {
+			return name
+		}
+	}
+	for _, locale := range locales {
+		match := ""
+		for key := range names {
+			if language(key) == language(locale) && (match == "" || key < match) {
+				match = key
+			}
+		}
+		if match != "" {
+			return names[match]
+		}
+	}
+	return names["en"]
 }
 
-func (e Error) Error() string {
-	return fmt.Sprintf("%s: %s", e.Code, e.Err)
+func language(locale string) string {
+	if i := strings.IndexByte(locale, '-'); i >= 0 {
+		locale = locale[:i]
+	}
+	return strings.ToLower(locale)
 }
 
 type City struct {
-	Confidence int               `json:"confidence,omitempty"`
-	GeoNameId  int               `json:"geoname_id,omitempty"`
-	Names      map[string]string `json:"names,omitempty"`
+	Confidence int               `json:"confidence,omitempty" maxminddb:"confidence"`
+	GeoNameId  int               `json:"geoname_id,omitempty" maxminddb:"geoname_id"`
+	Names      map[string]string `json:"names,omitempty" maxminddb:"names"`
 }
 
 type Continent struct {
-	Code      string            `json:"code,omitempty"`
-	GeoNameId int               `json:"geoname_id,omitempty"`
-	Names     map[string]string `json:"names,omitempty"`
+	Code      string            `json:"code,omitempty" maxminddb:"code"`
+	GeoNameId int               `json:"geoname_id,omitempty" maxminddb:"geoname_id"`
+	Names     map[string]string `json:"names,omitempty" maxminddb:"names"`
 }
 
 type Country struct {
-	Confidence int               `json:"confidence,omitempty"`
-	GeoNameId  int               `json:"geoname_id,omitempty"`
-	IsoCode    string            `json:"iso_code,omitempty"`
-	Names      map[string]string `json:"names,omitempty"`
+	Confidence        int               `json:"confidence,omitempty" maxminddb:"confidence"`
+	GeoNameId         int               `json:"geoname_id,omitempty" maxminddb:"geoname_id"`
+	IsInEuropeanUnion bool              `json:"is_in_european_union,omitempty" maxminddb:"is_in_european_union"`
+	IsoCode           string            `json:"iso_code,omitempty" maxminddb:"iso_code"`
+	Names             map[string]string `json:"names,omitempty" maxminddb:"names"`
 }
 
 type Location struct {
-	AccuracyRadius    int     `json:"accuracy_radius,omitempty"`
-	AverageIncome     int     `json:"average_income,omitempty"`
-	Latitude          float64 `json:"latitude,omitempty"`
-	Longitude         float64 `json:"longitude,omitempty"`
-	MetroCode         int     `json:"metro_code,omitempty"`
-	PopulationDensity int     `json:"population_density,omitempty"`
-	TimeZone          string  `json:"time_zone,omitempty"`
+	AccuracyRadius    int     `json:"accuracy_radius,omitempty" maxminddb:"accuracy_radius"`
+	AverageIncome     int     `json:"average_income,omitempty" maxminddb:"average_income"`
+	Latitude          float64 `json:"latitude,omitempty" maxminddb:"latitude"`
+	Longitude         float64 `json:"longitude,omitempty" maxminddb:"longitude"`
+	MetroCode         int     `json:"metro_code,omitempty" maxminddb:"metro_code"`
+	PopulationDensity int     `json:"population_density,omitempty" maxminddb:"population_density"`
+	TimeZone          string  `json:"time_zone,omitempty" maxminddb:"time_zone"`
 }
 
 type Postal struct {
-	Code       string `json:"code,omitempty"`
-	Confidence int    `json:"confidence,omitempty"`
+	Code       string `json:"code,omitempty" maxminddb:"code"`
+	Confidence int    `json:"confidence,omitempty" maxminddb:"confidence"`
 }
 
 type RegisteredCountry struct {
-	GeoNameId int               `json:"geoname_id,omitempty"`
-	IsoCode   string            `json:"iso_code,omitempty"`
-	Names     map[string]string `json:"names,omitempty"`
+	GeoNameId         int               `json:"geoname_id,omitempty" maxminddb:"geoname_id"`
+	IsInEuropeanUnion bool              `json:"is_in_european_union,omitempty" maxminddb:"is_in_european_union"`
+	IsoCode           string            `json:"iso_code,omitempty" maxminddb:"iso_code"`
+	Names             map[string]string `json:"names,omitempty" maxminddb:"names"`
 }
 
 type RepresentedCountry struct {
-	GeoNameId int               `json:"geoname_id,omitempty"`
-	IsoCode   string            `json:"iso_code,omitempty"`
-	Names     map[string]string `json:"names,omitempty"`
-	Type      string            `json:"type,omitempty"`
+	GeoNameId         int               `json:"geoname_id,omitempty" maxminddb:"geoname_id"`
+	IsInEuropeanUnion bool              `json:"is_in_european_union,omitempty" maxminddb:"is_in_european_union"`
+	IsoCode           string            `json:"iso_code,omitempty" maxminddb:"iso_code"`
+	Names             map[string]string `json:"names,omitempty" maxminddb:"names"`
+	Type              string            `json:"type,omitempty" maxminddb:"type"`
 }
 
 type Subdivision struct {
-	Confidence int               `json:"confidence,omitempty"`
-	GeoNameId  int               `json:"geoname_id,omitempty"`
-	IsoCode    string            `json:"iso_code,omitempty"`
-	Names      map[string]string `json:"names,omitempty"`
+	Confidence int               `json:"confidence,omitempty" maxminddb:"confidence"`
+	GeoNameId  int               `json:"geoname_id,omitempty" maxminddb:"geoname_id"`
+	IsoCode    string            `json:"iso_code,omitempty" maxminddb:"iso_code"`
+	Names      map[string]string `json:"names,omitempty" maxminddb:"names"`
 }
 
 type Traits struct {
-	AutonomousSystemNumber       int    `json:"autonomous_system_number,omitempty"`
-	AutonomousSystemOrganization string `json:"autonomous_system_organization,omitempty"`
-	Domain                       string `json:"domain,omitempty"`
-	IsAnonymousProxy             bool   `json:"is_anonymous_proxy,omitempty"`
-	IsSatelliteProvider          bool   `json:"is_satellite_provider,omitempty"`
-	Isp                          string `json:"isp,omitempty"`
-	IpAddress                    string `json:"ip_address,omitempty"`
-	Organization                 string `json:"organization,omitempty"`
-	UserType                     string `json:"user_type,omitempty"`
+	AutonomousSystemNumber       int          `json:"autonomous_system_number,omitempty" maxminddb:"autonomous_system_number"`
+	AutonomousSystemOrganization string       `json:"autonomous_system_organization,omitempty" maxminddb:"autonomous_system_organization"`
+	ConnectionType               string       `json:"connection_type,omitempty" maxminddb:"connection_type"`
+	Domain                       string       `json:"domain,omitempty" maxminddb:"domain"`
+	IsAnonymous                  bool         `json:"is_anonymous,omitempty" maxminddb:"is_anonymous"`
+	IsAnonymousProxy             bool         `json:"is_anonymous_proxy,omitempty" maxminddb:"is_anonymous_proxy"`
+	IsAnonymousVpn               bool         `json:"is_anonymous_vpn,omitempty" maxminddb:"is_anonymous_vpn"`
+	IsAnycast                    bool         `json:"is_anycast,omitempty" maxminddb:"is_anycast"`
+	IsHostingProvider            bool         `json:"is_hosting_provider,omitempty" maxminddb:"is_hosting_provider"`
+	IsPublicProxy                bool         `json:"is_public_proxy,omitempty" maxminddb:"is_public_proxy"`
+	IsResidentialProxy           bool         `json:"is_residential_proxy,omitempty" maxminddb:"is_residential_proxy"`
+	IsSatelliteProvider          bool         `json:"is_satellite_provider,omitempty" maxminddb:"is_satellite_provider"`
+	IsTorExitNode                bool         `json:"is_tor_exit_node,omitempty" maxminddb:"is_tor_exit_node"`
+	Isp                          string       `json:"isp,omitempty" maxminddb:"isp"`
+	IpAddress                    netip.Addr   `json:"ip_address,omitzero"`
+	MobileCountryCode            string       `json:"mobile_country_code,omitempty" maxminddb:"mobile_country_code"`
+	MobileNetworkCode            string       `json:"mobile_network_code,omitempty" maxminddb:"mobile_network_code"`
+	Network                      netip.Prefix `json:"network,omitzero"`
+	Organization                 string       `json:"organization,omitempty" maxminddb:"organization"`
+	StaticIpScore                Decimal      `json:"static_ip_score,omitzero"`
+	UserType                     string       `json:"user_type,omitempty" maxminddb:"user_type"`
+}
+
+// IsMobile reports whether MaxMind identified the mobile network serving the
+// address.  The MCC and MNC are strings as leading zeros are significant.
+func (t Traits) IsMobile() bool {
+	return t.MobileCountryCode != "" && t.MobileNetworkCode != ""
 }
 
 type MaxMind struct {
@@ -96,14 +143,63 @@ type MaxMind struct {
 }
 
 type Response struct {
-	City               City               `json:"city,omitempty"`
-	Continent          Continent          `json:"continent,omitempty"`
-	Country            Country            `json:"country,omitempty"`
-	Location           Location           `json:"location,omitempty"`
-	Postal             Postal             `json:"postal,omitempty"`
-	RegisteredCountry  RegisteredCountry  `json:"registered_country,omitempty"`
-	RepresentedCountry RepresentedCountry `json:"represented_country,omitempty"`
-	Subdivisions       []Subdivision      `json:"subdivisions,omitempty"`
-	Traits             Traits             `json:"traits,omitempty"`
+	City               City               `json:"city,omitempty" maxminddb:"city"`
+	Continent          Continent          `json:"continent,omitempty" maxminddb:"continent"`
+	Country            Country            `json:"country,omitempty" maxminddb:"country"`
+	Location           Location           `json:"location,omitempty" maxminddb:"location"`
+	Postal             Postal             `json:"postal,omitempty" maxminddb:"postal"`
+	RegisteredCountry  RegisteredCountry  `json:"registered_country,omitempty" maxminddb:"registered_country"`
+	RepresentedCountry RepresentedCountry `json:"represented_country,omitempty" maxminddb:"represented_country"`
+	Subdivisions       []Subdivision      `json:"subdivisions,omitempty" maxminddb:"subdivisions"`
+	Traits             Traits             `json:"traits,omitempty" maxminddb:"traits"`
 	MaxMind            MaxMind            `json:"maxmind,omitempty"`
+
+	meta         *Meta
+	raw          []byte
+	decodeErrors []FieldError
+	notFound     bool
+}
+
+// Meta describes how a response was obtained.  RequestId is the identifier
+// MaxMind support asks for, and Billable reports whether the lookup was
+// charged against the account: only successful answers from the web service
+// are, never cache hits or errors.  Retrieved is when the web service
+// answered, which a cache hit keeps from the original lookup.  Centroid
+// reports that WithCentroidFallback placed the answer at its country's
+// centroid.
+type Meta struct {
+	Service     string
+	StatusCode  int
+	Header      http.Header
+	RequestId   string
+	ContentType string
+	Latency     time.Duration
+	Retrieved   time.Time
+	Retries     int
+	Locales     []string
+	Cached      bool
+	Billable    bool
+	Centroid    bool
+}
+
+// Found reports whether the response holds an answer.  It is false only
+// for the empty responses WithNotFoundAsEmpty substitutes for unresolvable
+// addresses.
+func (r Response) Found() bool {
+	return !r.notFound
+}
+
+// DecodeErrors lists the fields of an answer accepted by WithLenientDecoding
+// that could not be decoded
+func (r Response) DecodeErrors() []FieldError {
+	return r.decodeErrors
+}
+
+// Meta returns the request metadata for a response returned by Api; it is
+// the zero Meta for responses constructed or decoded by other means
+func (r Response) Meta() Meta {
+	if r.meta == nil {
+		return Meta{}
+	}
+	return *r.meta
 }
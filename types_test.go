@@ -0,0 +1,56 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"encoding/json"
+	"net/netip"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTraits(t *testing.T) {
+	Convey("Given a complete maxmind response", t, func() {
+		resp := Response{}
+		err := json.NewDecoder(strings.NewReader(sample)).Decode(&resp)
+		So(err, ShouldBeNil)
+
+		Convey("I expect anycast and mobile codes to be decoded", func() {
+			So(resp.Traits.IsAnycast, ShouldBeTrue)
+			So(resp.Traits.MobileCountryCode, ShouldEqual, "222")
+			So(resp.Traits.MobileNetworkCode, ShouldEqual, "01")
+			So(resp.Traits.IsMobile(), ShouldBeTrue)
+		})
+
+		Convey("I expect the address and network to be typed", func() {
+			So(resp.Traits.IpAddress, ShouldResemble, netip.MustParseAddr("1.2.3.4"))
+			So(resp.Traits.Network, ShouldResemble, netip.MustParsePrefix("1.2.3.0/24"))
+			So(resp.Traits.Network.Contains(resp.Traits.IpAddress), ShouldBeTrue)
+		})
+
+		Convey("I expect an unset address and network to be omitted", func() {
+			data, err := json.Marshal(Traits{})
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "{}")
+		})
+
+		Convey("I expect traits without mobile codes not to be mobile", func() {
+			So(Traits{}.IsMobile(), ShouldBeFalse)
+			So(Traits{MobileCountryCode: "222"}.IsMobile(), ShouldBeFalse)
+		})
+	})
+}
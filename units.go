@@ -0,0 +1,100 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const kilometersPerMile = 1.609344
+
+func KilometersToMiles(km float64) float64 {
+	return km / kilometersPerMile
+}
+
+func MilesToKilometers(mi float64) float64 {
+	return mi * kilometersPerMile
+}
+
+// AccuracyRadiusMiles returns the accuracy radius, which MaxMind reports in
+// kilometers, in miles
+func (l Location) AccuracyRadiusMiles() float64 {
+	return KilometersToMiles(float64(l.AccuracyRadius))
+}
+
+// FormatAccuracyRadius formats the accuracy radius for display, e.g.
+// "~50 km / ~31 mi"; see FormatDistance
+func (l Location) FormatAccuracyRadius(locale string) string {
+	return FormatDistance(float64(l.AccuracyRadius), locale)
+}
+
+// FormatDistance formats an approximate distance given in kilometers in both
+// units.  Miles come first for locales that customarily use them (en-US,
+// en-GB, ...) and the decimal separator follows the locale's language.
+func FormatDistance(km float64, locale string) string {
+	metric := "~" + formatApprox(km, locale) + " km"
+	imperial := "~" + formatApprox(KilometersToMiles(km), locale) + " mi"
+	if usesMiles(locale) {
+		return imperial + " / " + metric
+	}
+	return metric + " / " + imperial
+}
+
+// formatApprox rounds to a whole number, keeping one decimal place for
+// values under ten so that short distances don't all collapse to ~0 or ~1
+func formatApprox(v float64, locale string) string {
+	if v >= 10 {
+		return strconv.FormatFloat(math.Round(v), 'f', 0, 64)
+	}
+
+	s := fmt.Sprintf("%.1f", v)
+	s = strings.TrimSuffix(s, ".0")
+	if usesDecimalComma(locale) {
+		s = strings.Replace(s, ".", ",", 1)
+	}
+	return s
+}
+
+func localeParts(locale string) (language, region string) {
+	parts := strings.FieldsFunc(locale, func(r rune) bool { return r == '-' || r == '_' })
+	if len(parts) > 0 {
+		language = strings.ToLower(parts[0])
+	}
+	if len(parts) > 1 {
+		region = strings.ToUpper(parts[len(parts)-1])
+	}
+	return language, region
+}
+
+func usesMiles(locale string) bool {
+	_, region := localeParts(locale)
+	switch region {
+	case "US", "GB", "LR", "MM":
+		return true
+	}
+	return false
+}
+
+func usesDecimalComma(locale string) bool {
+	language, _ := localeParts(locale)
+	switch language {
+	case "de", "es", "fr", "it", "nl", "pl", "pt", "ru", "sv", "tr":
+		return true
+	}
+	return false
+}
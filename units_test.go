@@ -0,0 +1,49 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUnits(t *testing.T) {
+	Convey("Given distances in kilometers and miles", t, func() {
+		Convey("I expect conversions to be inverses", func() {
+			So(KilometersToMiles(1.609344), ShouldAlmostEqual, 1)
+			So(MilesToKilometers(KilometersToMiles(50)), ShouldAlmostEqual, 50)
+		})
+
+		Convey("I expect the accuracy radius in miles", func() {
+			So(Location{AccuracyRadius: 50}.AccuracyRadiusMiles(), ShouldAlmostEqual, 31.0686, 0.0001)
+		})
+
+		Convey("I expect metric first for most locales", func() {
+			So(Location{AccuracyRadius: 50}.FormatAccuracyRadius("en"), ShouldEqual, "~50 km / ~31 mi")
+			So(FormatDistance(5, "ja"), ShouldEqual, "~5 km / ~3.1 mi")
+		})
+
+		Convey("I expect miles first where miles are customary", func() {
+			So(FormatDistance(50, "en-US"), ShouldEqual, "~31 mi / ~50 km")
+			So(FormatDistance(1, "en_GB"), ShouldEqual, "~0.6 mi / ~1 km")
+		})
+
+		Convey("I expect locale decimal separators", func() {
+			So(FormatDistance(5, "de"), ShouldEqual, "~5 km / ~3,1 mi")
+			So(FormatDistance(2.5, "pt-BR"), ShouldEqual, "~2,5 km / ~1,6 mi")
+		})
+	})
+}
@@ -0,0 +1,365 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// DefaultDownloadURL is the root of MaxMind's database downloads
+// https://dev.maxmind.com/geoip/updating-databases#directly-downloading-databases
+const DefaultDownloadURL = "https://download.maxmind.com/geoip/databases/"
+
+// UpdaterOption configures an Updater
+type UpdaterOption func(*Updater)
+
+// WithDownloadURL downloads from baseURL, e.g. a mirror, in place of
+// DefaultDownloadURL
+func WithDownloadURL(baseURL string) UpdaterOption {
+	return func(u *Updater) {
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		u.baseURL = baseURL
+	}
+}
+
+// WithDownloadClient downloads with client instead of http.DefaultClient
+func WithDownloadClient(client *http.Client) UpdaterOption {
+	return func(u *Updater) {
+		u.client = client
+	}
+}
+
+// WithReloadReaders reloads readers each time a new database is installed
+func WithReloadReaders(readers ...*Reader) UpdaterOption {
+	return func(u *Updater) {
+		u.readers = append(u.readers, readers...)
+	}
+}
+
+// Updater keeps a local database current, as geoipupdate does.  Each
+// Update compares the checksum MaxMind publishes with that of the last
+// download, and only when it differs downloads the edition, verifies the
+// checksum and the database, and atomically replaces the file before
+// reloading any Readers.  An interrupted download is kept beside the
+// database and resumed by the next Update.
+//
+//	updater := geoip2.NewUpdater(userId, licenseKey, "GeoLite2-City", "/var/lib/geoip/GeoLite2-City.mmdb",
+//		geoip2.WithReloadReaders(reader))
+//	go updater.Run(ctx, 24*time.Hour, func(err error) { log.Println(err) })
+type Updater struct {
+	userId     string
+	licenseKey string
+	edition    string
+	path       string
+	baseURL    string
+	client     *http.Client
+	readers    []*Reader
+}
+
+// NewUpdater returns an Updater installing edition, e.g. "GeoLite2-City",
+// at path
+func NewUpdater(userId, licenseKey, edition, path string, opts ...UpdaterOption) *Updater {
+	u := &Updater{
+		userId:     userId,
+		licenseKey: licenseKey,
+		edition:    edition,
+		path:       path,
+		baseURL:    DefaultDownloadURL,
+		client:     http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
+}
+
+// checksumPath holds the checksum of the archive last installed, beside
+// the database
+func (u *Updater) checksumPath() string {
+	return u.path + ".sha256"
+}
+
+// Update installs the current edition if it differs from the one at path,
+// reporting whether it did.  On error the existing database is untouched.
+func (u *Updater) Update(ctx context.Context) (bool, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// the checksum file reads "{sha256}  {archive name}"
+	body, err := u.get(ctx, "tar.gz.sha256")
+	if err != nil {
+		return false, err
+	}
+	data, err := io.ReadAll(io.LimitReader(body, 1024))
+	body.Close()
+	if err != nil {
+		return false, err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return false, fmt.Errorf("geoip2: empty checksum for %s", u.edition)
+	}
+	checksum := strings.ToLower(fields[0])
+
+	if installed, err := os.ReadFile(u.checksumPath()); err == nil && strings.TrimSpace(string(installed)) == checksum {
+		if _, err := os.Stat(u.path); err == nil {
+			return false, nil
+		}
+	}
+
+	if err := u.install(ctx, checksum); err != nil {
+		return false, err
+	}
+	for _, reader := range u.readers {
+		if err := reader.Reload(u.path); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+func (u *Updater) install(ctx context.Context, checksum string) error {
+	dir := filepath.Dir(u.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	// an interrupted download is kept, and resumed by the next attempt at
+	// the same edition
+	part := u.partPath(checksum)
+	stale, _ := filepath.Glob(u.path + ".*.part")
+	for _, name := range stale {
+		if name != part {
+			os.Remove(name)
+		}
+	}
+	if err := u.download(ctx, part); err != nil {
+		return fmt.Errorf("geoip2: unable to download %s: %w", u.edition, err)
+	}
+	defer os.Remove(part)
+
+	archive, err := os.Open(part)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, archive); err != nil {
+		return err
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != checksum {
+		return fmt.Errorf("geoip2: checksum mismatch for %s: got %s, expected %s", u.edition, sum, checksum)
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(u.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	err = extractDatabase(archive, tmp)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("geoip2: unable to extract %s: %w", u.edition, err)
+	}
+
+	db, err := maxminddb.Open(tmp.Name())
+	if err != nil {
+		return fmt.Errorf("geoip2: downloaded %s is invalid: %w", u.edition, err)
+	}
+	err = db.Verify()
+	db.Close()
+	if err != nil {
+		return fmt.Errorf("geoip2: downloaded %s is invalid: %w", u.edition, err)
+	}
+
+	if err := os.Rename(tmp.Name(), u.path); err != nil {
+		return err
+	}
+	return os.WriteFile(u.checksumPath(), []byte(checksum+"\n"), 0o644)
+}
+
+// partPath holds the archive of the edition with checksum while it is
+// downloaded
+func (u *Updater) partPath(checksum string) string {
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune("0123456789abcdef", r) {
+			return r
+		}
+		return -1
+	}, checksum)
+	if len(name) > 16 {
+		name = name[:16]
+	}
+	return u.path + "." + name + ".part"
+}
+
+// download appends the archive to part, asking only for the bytes it lacks
+func (u *Updater) download(ctx context.Context, part string) error {
+	f, err := os.OpenFile(part, os.O_WRONLY|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	body, resumed, err := u.getFrom(ctx, "tar.gz", offset)
+	if e, ok := err.(Error); ok && offset > 0 && e.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		// already complete; the checksum decides whether it is intact
+		return f.Close()
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+	defer body.Close()
+
+	if !resumed && offset > 0 {
+		if err := f.Truncate(0); err != nil {
+			f.Close()
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	_, err = io.Copy(f, body)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// extractDatabase copies the .mmdb file in the gzipped tar r to w
+func extractDatabase(r io.Reader, w io.Writer) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	archive := tar.NewReader(gz)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return fmt.Errorf("no database in archive")
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag == tar.TypeReg && strings.HasSuffix(header.Name, ".mmdb") {
+			if _, err := io.Copy(w, archive); err != nil {
+				return err
+			}
+			// read to the end of the gzip stream so the trailer is checked
+			_, err := io.Copy(io.Discard, gz)
+			return err
+		}
+	}
+}
+
+func (u *Updater) get(ctx context.Context, suffix string) (io.ReadCloser, error) {
+	body, _, err := u.getFrom(ctx, suffix, 0)
+	return body, err
+}
+
+// getFrom asks for the download from offset, reporting whether the server
+// resumed there or sent the whole file
+func (u *Updater) getFrom(ctx context.Context, suffix string, offset int64) (io.ReadCloser, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u.baseURL+u.edition+"/download?suffix="+suffix, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	req.SetBasicAuth(u.userId, u.licenseKey)
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	if resp.StatusCode == http.StatusPartialContent && offset > 0 &&
+		strings.HasPrefix(resp.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset)) {
+		return resp.Body, true, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, false, Error{StatusCode: resp.StatusCode, URL: req.URL.String(), Body: string(body)}
+	}
+	return resp.Body, false, nil
+}
+
+// DefaultUpdateInterval is how often Run updates when given no interval.
+// MaxMind publishes most editions twice a week, so daily is plenty.
+const DefaultUpdateInterval = 24 * time.Hour
+
+// Run updates immediately and then every interval, or DefaultUpdateInterval
+// if it isn't positive, until ctx is done.  Update errors are passed to
+// onError, which may be nil.
+func (u *Updater) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	if interval <= 0 {
+		interval = DefaultUpdateInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := u.Update(ctx); err != nil && onError != nil {
+			onError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// String and GoString redact the license key however the Updater is
+// formatted
+func (u Updater) String() string {
+	return fmt.Sprintf("geoip2.Updater{userId: %q, licenseKey: %s, edition: %q}", u.userId, redact(u.licenseKey), u.edition)
+}
+
+func (u Updater) GoString() string {
+	return u.String()
+}
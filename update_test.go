@@ -0,0 +1,205 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// archiveDatabase packs the database at path as MaxMind does
+func archiveDatabase(t *testing.T, path string) []byte {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buffer := &bytes.Buffer{}
+	gz := gzip.NewWriter(buffer)
+	archive := tar.NewWriter(gz)
+	archive.WriteHeader(&tar.Header{Name: "GeoLite2-City_20240102/", Typeflag: tar.TypeDir, Mode: 0o755})
+	archive.WriteHeader(&tar.Header{Name: "GeoLite2-City_20240102/GeoLite2-City.mmdb", Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(data))})
+	archive.Write(data)
+	archive.Close()
+	gz.Close()
+	return buffer.Bytes()
+}
+
+func TestUpdater(t *testing.T) {
+	Convey("Given MaxMind's download endpoint", t, func() {
+		archive := archiveDatabase(t, writeCityDatabase(t, "Mountain View"))
+		checksum := func() string {
+			sum := sha256.Sum256(archive)
+			return hex.EncodeToString(sum[:])
+		}
+		var downloads int
+		var interrupt bool
+		var ranges []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if userId, licenseKey, _ := req.BasicAuth(); userId != "blah-user-id" || licenseKey != "blah-license-key" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte("Invalid license key"))
+				return
+			}
+			switch req.URL.Path + "?" + req.URL.RawQuery {
+			case "/GeoLite2-City/download?suffix=tar.gz.sha256":
+				fmt.Fprintf(w, "%s  GeoLite2-City_20240102.tar.gz\n", checksum())
+			case "/GeoLite2-City/download?suffix=tar.gz":
+				downloads++
+				ranges = append(ranges, req.Header.Get("Range"))
+				if interrupt {
+					// the connection drops halfway through
+					interrupt = false
+					w.Header().Set("Content-Length", fmt.Sprint(len(archive)))
+					w.Write(archive[:len(archive)/2])
+					w.(http.Flusher).Flush()
+					panic(http.ErrAbortHandler)
+				}
+				http.ServeContent(w, req, "", time.Time{}, bytes.NewReader(archive))
+			default:
+				http.NotFound(w, req)
+			}
+		}))
+		defer server.Close()
+
+		path := filepath.Join(t.TempDir(), "geoip", "GeoLite2-City.mmdb")
+		updater := NewUpdater("blah-user-id", "blah-license-key", "GeoLite2-City", path, WithDownloadURL(server.URL))
+		ctx := context.Background()
+
+		Convey("When I update", func() {
+			updated, err := updater.Update(ctx)
+			So(err, ShouldBeNil)
+			So(updated, ShouldBeTrue)
+
+			reader, err := NewFromFile(path)
+			So(err, ShouldBeNil)
+			defer reader.Close()
+
+			Convey("I expect the database installed and not downloaded again", func() {
+				resp, err := reader.City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+				So(resp.City.Names["en"], ShouldEqual, "Mountain View")
+
+				updated, err := updater.Update(ctx)
+				So(err, ShouldBeNil)
+				So(updated, ShouldBeFalse)
+				So(downloads, ShouldEqual, 1)
+			})
+
+			Convey("I expect a new edition to be reloaded into open readers", func() {
+				archive = archiveDatabase(t, writeCityDatabase(t, "Sunnyvale"))
+				updater := NewUpdater("blah-user-id", "blah-license-key", "GeoLite2-City", path, WithDownloadURL(server.URL), WithReloadReaders(reader))
+				updated, err := updater.Update(ctx)
+				So(err, ShouldBeNil)
+				So(updated, ShouldBeTrue)
+
+				resp, _ := reader.City(nil, "1.2.3.4")
+				So(resp.City.Names["en"], ShouldEqual, "Sunnyvale")
+			})
+
+			Convey("I expect a corrupt download to leave the database untouched", func() {
+				checksum = func() string { return "0000" }
+				_, err := updater.Update(ctx)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "checksum mismatch")
+
+				resp, err := reader.City(nil, "1.2.3.4")
+				So(err, ShouldBeNil)
+				So(resp.City.Names["en"], ShouldEqual, "Mountain View")
+				files, _ := os.ReadDir(filepath.Dir(path))
+				So(files, ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("When I run it without an interval", func() {
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				updater.Run(runCtx, 0, nil)
+			}()
+			for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+				if _, err := os.Stat(path); err == nil {
+					break
+				}
+			}
+			cancel()
+			<-done
+
+			Convey("I expect it to update on the default interval rather than panic", func() {
+				_, err := os.Stat(path)
+				So(err, ShouldBeNil)
+				So(downloads, ShouldEqual, 1)
+			})
+		})
+
+		Convey("When a download is interrupted", func() {
+			interrupt = true
+			_, err := updater.Update(ctx)
+			So(err, ShouldNotBeNil)
+			parts, _ := filepath.Glob(path + ".*.part")
+			So(parts, ShouldHaveLength, 1)
+
+			Convey("I expect the next update to resume it", func() {
+				updated, err := updater.Update(ctx)
+				So(err, ShouldBeNil)
+				So(updated, ShouldBeTrue)
+				So(ranges, ShouldResemble, []string{"", fmt.Sprintf("bytes=%d-", len(archive)/2)})
+
+				reader, err := NewFromFile(path)
+				So(err, ShouldBeNil)
+				defer reader.Close()
+				resp, _ := reader.City(nil, "1.2.3.4")
+				So(resp.City.Names["en"], ShouldEqual, "Mountain View")
+
+				files, _ := os.ReadDir(filepath.Dir(path))
+				So(files, ShouldHaveLength, 2)
+			})
+
+			Convey("I expect a new edition to start afresh", func() {
+				archive = archiveDatabase(t, writeCityDatabase(t, "Sunnyvale"))
+				updated, err := updater.Update(ctx)
+				So(err, ShouldBeNil)
+				So(updated, ShouldBeTrue)
+				So(ranges, ShouldResemble, []string{"", ""})
+
+				parts, _ := filepath.Glob(path + ".*.part")
+				So(parts, ShouldBeEmpty)
+			})
+		})
+
+		Convey("I expect a refused license key to be reported, redacted", func() {
+			updater := NewUpdater("blah-user-id", "wrong", "GeoLite2-City", path, WithDownloadURL(server.URL))
+			_, err := updater.Update(ctx)
+			So(err.(Error).StatusCode, ShouldEqual, http.StatusUnauthorized)
+			So(fmt.Sprint(updater), ShouldNotContainSubstring, "wrong")
+		})
+	})
+}
@@ -0,0 +1,213 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Prices are the cost of one query to each service, in the account's
+// currency
+type Prices map[string]float64
+
+// DefaultPrices are MaxMind's published per-query list prices in US
+// dollars at the time of writing; accounts with other rates should pass
+// their own
+var DefaultPrices = Prices{
+	"country":  0.0001,
+	"city":     0.0003,
+	"insights": 0.002,
+}
+
+// UsageStats counts the lookups of one service.  Requests counts queries
+// sent, Billable those answered successfully, and CacheHits those the
+// cache answered instead.
+type UsageStats struct {
+	Requests  int     `json:"requests"`
+	Billable  int     `json:"billable"`
+	CacheHits int     `json:"cache_hits"`
+	Cost      float64 `json:"cost"`
+	Saved     float64 `json:"saved"`
+}
+
+// UsageReport is the usage of every service over a period
+type UsageReport struct {
+	Since    time.Time             `json:"since"`
+	Until    time.Time             `json:"until"`
+	Services map[string]UsageStats `json:"services"`
+	Cost     float64               `json:"cost"`
+	Saved    float64               `json:"saved"`
+}
+
+// String summarises the report in one line for logging
+func (r UsageReport) String() string {
+	services := make([]string, 0, len(r.Services))
+	for service := range r.Services {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	parts := make([]string, 0, len(services)+1)
+	for _, service := range services {
+		s := r.Services[service]
+		parts = append(parts, fmt.Sprintf("%s: %d billable of %d requests, %d cached (cost %.4f, saved %.4f)",
+			service, s.Billable, s.Requests, s.CacheHits, s.Cost, s.Saved))
+	}
+	parts = append(parts, fmt.Sprintf("total cost %.4f, saved %.4f", r.Cost, r.Saved))
+	return strings.Join(parts, "; ")
+}
+
+// Usage is an Instrumentation that counts the lookups of each service and
+// estimates their cost, to tell which service drives the bill.  Only
+// successful answers are billed; the cache hits are priced as savings.
+type Usage struct {
+	prices Prices
+
+	mutex    sync.Mutex
+	since    time.Time
+	services map[string]*UsageStats
+}
+
+var _ Instrumentation = (*Usage)(nil)
+
+// NewUsage returns a Usage pricing queries at prices, or DefaultPrices when
+// prices is nil
+func NewUsage(prices Prices) *Usage {
+	if prices == nil {
+		prices = DefaultPrices
+	}
+	return &Usage{
+		prices:   prices,
+		since:    time.Now(),
+		services: map[string]*UsageStats{},
+	}
+}
+
+func (u *Usage) stats(service string) *UsageStats {
+	s, ok := u.services[service]
+	if !ok {
+		s = &UsageStats{}
+		u.services[service] = s
+	}
+	return s
+}
+
+func (u *Usage) ObserveRequest(endpoint string, status int, latency time.Duration, err error) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	s := u.stats(endpoint)
+	s.Requests++
+	if status >= 200 && status < 300 {
+		s.Billable++
+		s.Cost += u.prices[endpoint]
+	}
+}
+
+func (u *Usage) ObserveCache(endpoint string, hit bool) {
+	if !hit {
+		return
+	}
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	s := u.stats(endpoint)
+	s.CacheHits++
+	s.Saved += u.prices[endpoint]
+}
+
+func (u *Usage) ObserveQueriesRemaining(remaining int) {}
+
+// Report returns the usage since the Usage was created or last reset
+func (u *Usage) Report() UsageReport {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	return u.report()
+}
+
+// Reset returns the usage as Report does and starts counting afresh
+func (u *Usage) Reset() UsageReport {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+
+	report := u.report()
+	u.since = report.Until
+	u.services = map[string]*UsageStats{}
+	return report
+}
+
+func (u *Usage) report() UsageReport {
+	report := UsageReport{
+		Since:    u.since,
+		Until:    time.Now(),
+		Services: make(map[string]UsageStats, len(u.services)),
+	}
+	for service, s := range u.services {
+		report.Services[service] = *s
+		report.Cost += s.Cost
+		report.Saved += s.Saved
+	}
+	return report
+}
+
+// Run passes the usage of each interval to fn, e.g. to log a summary,
+// until ctx is done, resetting the counts each time
+//
+//	go usage.Run(ctx, time.Hour, func(r geoip2.UsageReport) { log.Println("maxmind usage:", r) })
+func (u *Usage) Run(ctx context.Context, interval time.Duration, fn func(UsageReport)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn(u.Reset())
+		}
+	}
+}
+
+// MultiInstrumentation reports every observation to each of
+// instrumentations in turn, e.g. to both a Prometheus collector and a
+// Usage
+func MultiInstrumentation(instrumentations ...Instrumentation) Instrumentation {
+	return multiInstrumentation(append([]Instrumentation(nil), instrumentations...))
+}
+
+type multiInstrumentation []Instrumentation
+
+func (m multiInstrumentation) ObserveRequest(endpoint string, status int, latency time.Duration, err error) {
+	for _, i := range m {
+		i.ObserveRequest(endpoint, status, latency, err)
+	}
+}
+
+func (m multiInstrumentation) ObserveCache(endpoint string, hit bool) {
+	for _, i := range m {
+		i.ObserveCache(endpoint, hit)
+	}
+}
+
+func (m multiInstrumentation) ObserveQueriesRemaining(remaining int) {
+	for _, i := range m {
+		i.ObserveQueriesRemaining(remaining)
+	}
+}
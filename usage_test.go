@@ -0,0 +1,93 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUsage(t *testing.T) {
+	Convey("Given a cached Api that counts its usage", t, func() {
+		status := http.StatusOK
+		usage := NewUsage(Prices{"country": 0.5, "city": 1, "insights": 4})
+		api := WithClientFunc(New("blah-user-id", "blah-license-key"), func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			body := sample
+			if status != http.StatusOK {
+				body = `{"code":"IP_ADDRESS_NOT_FOUND","error":"not found"}`
+			}
+			return &http.Response{StatusCode: status, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+		}).Clone(WithCache(NewLRUCache(10)), WithInstrumentation(usage))
+
+		Convey("When I make lookups of several services", func() {
+			api.City(nil, "1.2.3.4")
+			api.City(nil, "1.2.3.4")
+			api.City(nil, "1.2.3.4")
+			api.Insights(nil, "1.2.3.4")
+			status = http.StatusNotFound
+			api.Country(nil, "5.6.7.8")
+			report := usage.Report()
+
+			Convey("I expect each service counted and priced", func() {
+				So(report.Services["city"], ShouldResemble, UsageStats{Requests: 1, Billable: 1, CacheHits: 2, Cost: 1, Saved: 2})
+				So(report.Services["insights"], ShouldResemble, UsageStats{Requests: 1, Billable: 1, Cost: 4})
+				So(report.Services["country"], ShouldResemble, UsageStats{Requests: 1})
+				So(report.Cost, ShouldEqual, 5)
+				So(report.Saved, ShouldEqual, 2)
+				So(report.String(), ShouldContainSubstring, "city: 1 billable of 1 requests, 2 cached (cost 1.0000, saved 2.0000)")
+			})
+
+			Convey("I expect a reset to start counting afresh", func() {
+				So(usage.Reset().Cost, ShouldEqual, 5)
+				So(usage.Report().Services, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the usage is reported periodically", func() {
+			reports := make(chan UsageReport, 1)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			api.City(nil, "1.2.3.4")
+			go usage.Run(ctx, 10*time.Millisecond, func(r UsageReport) {
+				select {
+				case reports <- r:
+				default:
+				}
+			})
+
+			Convey("I expect the interval's usage", func() {
+				r := <-reports
+				So(r.Services["city"].Billable, ShouldEqual, 1)
+				So(r.Until, ShouldHappenAfter, r.Since)
+			})
+		})
+	})
+
+	Convey("Given usage combined with other instrumentation", t, func() {
+		first, second := NewUsage(nil), NewUsage(nil)
+		MultiInstrumentation(first, second).ObserveRequest("insights", 200, time.Millisecond, nil)
+
+		Convey("I expect each to observe, at the default prices", func() {
+			So(first.Report().Cost, ShouldEqual, DefaultPrices["insights"])
+			So(second.Report().Services["insights"].Billable, ShouldEqual, 1)
+		})
+	})
+}
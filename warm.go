@@ -0,0 +1,89 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNoCache is returned by Warm for an Api without a cache
+var ErrNoCache = errors.New("geoip2: no cache configured")
+
+// Warm looks up each address with service, one of "country", "city" and
+// "insights", so that the cache answers the first real lookups of known hot
+// addresses, e.g. after a deploy.  Lookups go through the Api as any other,
+// so rate limits and quota guards apply, and addresses already cached, or
+// covered by a cached network, cost nothing.  Warm returns the number of
+// addresses it looked up and the first failure, if any, after trying every
+// address; a quota guard that closes stops it early.
+func (a *Api) Warm(ctx context.Context, service string, ipAddresses []string, concurrency int) (int, error) {
+	if a.cache == nil {
+		return 0, ErrNoCache
+	}
+	var lookup LookupFunc
+	switch service {
+	case "country":
+		lookup = a.Country
+	case "city":
+		lookup = a.City
+	case "insights":
+		lookup = a.Insights
+	default:
+		return 0, fmt.Errorf("geoip2: unknown service %q", service)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		mutex     sync.Mutex
+		warmed    int
+		failed    error
+		exhausted bool
+	)
+	counted := func(ctx context.Context, ipAddress string) (Response, error) {
+		resp, err := lookup(ctx, ipAddress)
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch {
+		case errors.Is(err, ErrQuotaExhausted):
+			if !exhausted {
+				exhausted, failed = true, err
+				cancel()
+			}
+		case err != nil:
+			if failed == nil && ctx.Err() == nil {
+				failed = fmt.Errorf("%s: %w", ipAddress, err)
+			}
+		case !resp.Meta().Cached:
+			warmed++
+		}
+		return resp, err
+	}
+
+	_, err := Batch(ctx, counted, ipAddresses, concurrency)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if exhausted || err == nil {
+		return warmed, failed
+	}
+	return warmed, err
+}
@@ -0,0 +1,103 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWarm(t *testing.T) {
+	Convey("Given a server with a limited balance", t, func() {
+		var calls int32
+		var balance int32 = 100
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			if strings.HasSuffix(req.URL.Path, "/192.0.2.1") {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"code":"IP_ADDRESS_NOT_FOUND","error":"not found"}`))
+				return
+			}
+			fmt.Fprintf(w, `{"country":{"iso_code":"US"},"maxmind":{"queries_remaining":%d}}`, atomic.AddInt32(&balance, -1))
+		}))
+		defer server.Close()
+		api := New("blah-user-id", "blah-license-key", WithBaseURL(server.URL), WithCache(NewLRUCache(100)))
+		ctx := context.Background()
+
+		Convey("When I warm the cache with hot addresses", func() {
+			warmed, err := api.Warm(ctx, "city", []string{"1.1.1.1", "8.8.8.8", "1.1.1.1", "9.9.9.9"}, 2)
+			So(err, ShouldBeNil)
+
+			Convey("I expect each address looked up once", func() {
+				So(warmed, ShouldEqual, 3)
+				So(atomic.LoadInt32(&calls), ShouldEqual, 3)
+			})
+
+			Convey("I expect later lookups answered from the cache", func() {
+				resp, err := api.City(ctx, "8.8.8.8")
+				So(err, ShouldBeNil)
+				So(resp.Meta().Cached, ShouldBeTrue)
+
+				warmed, err := api.Warm(ctx, "city", []string{"8.8.8.8", "9.9.9.9"}, 2)
+				So(err, ShouldBeNil)
+				So(warmed, ShouldEqual, 0)
+				So(atomic.LoadInt32(&calls), ShouldEqual, 3)
+			})
+		})
+
+		Convey("When an address fails", func() {
+			warmed, err := api.Warm(ctx, "country", []string{"1.1.1.1", "192.0.2.1", "8.8.8.8"}, 1)
+
+			Convey("I expect the others warmed and the failure reported", func() {
+				So(warmed, ShouldEqual, 2)
+				So(errors.Is(err, ErrIPAddressNotFound), ShouldBeTrue)
+				So(err.Error(), ShouldStartWith, "192.0.2.1: ")
+			})
+		})
+
+		Convey("When the quota guard closes", func() {
+			atomic.StoreInt32(&balance, 4)
+			api = api.Clone(WithQuotaGuard(&QuotaGuard{HardStop: true, Reserve: 1}))
+			warmed, err := api.Warm(ctx, "city", []string{"1.1.1.1", "2.2.2.2", "3.3.3.3", "4.4.4.4", "5.5.5.5"}, 1)
+
+			Convey("I expect warming to stop", func() {
+				So(err, ShouldEqual, ErrQuotaExhausted)
+				So(warmed, ShouldEqual, 3)
+				So(atomic.LoadInt32(&calls), ShouldEqual, 3)
+			})
+		})
+
+		Convey("When the service is unknown", func() {
+			_, err := api.Warm(ctx, "town", []string{"1.1.1.1"}, 1)
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given an Api without a cache", t, func() {
+		_, err := New("blah-user-id", "blah-license-key").Warm(context.Background(), "city", []string{"1.1.1.1"}, 1)
+
+		Convey("I expect ErrNoCache", func() {
+			So(err, ShouldEqual, ErrNoCache)
+		})
+	})
+}
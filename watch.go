@@ -0,0 +1,219 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// WatchEvent reports that a watched address answers differently than it
+// did at the previous poll.  Moved is set when the country, subdivisions
+// or city changed; Flagged lists the traits that have become set since,
+// named as in Verdict.Reasons, e.g. "hosting_provider" or "anonymous_vpn".
+type WatchEvent struct {
+	IpAddress string    `json:"ip_address"`
+	Moved     bool      `json:"moved"`
+	Flagged   []string  `json:"flagged,omitempty"`
+	Changes   []Change  `json:"changes"`
+	Previous  Response  `json:"previous"`
+	Current   Response  `json:"current"`
+	Detected  time.Time `json:"detected"`
+}
+
+// Watcher polls a set of addresses, such as office egress IPs, and reports
+// when their answers change.  Unlike a Reenricher it keeps its state in
+// memory and is managed in code rather than from an inventory file.
+//
+// Polls bypass the Api's cache, so a changed answer is seen at once; pair
+// the Api with WithRateLimit to pace them.
+type Watcher struct {
+	lookup      LookupFunc
+	concurrency int
+	opts        []DiffOption
+
+	mutex   sync.Mutex
+	watched map[string]*Response
+}
+
+// NewWatcher returns a Watcher that performs lookup, e.g. api.Insights to
+// see traits change, with up to concurrency lookups in flight.  opts
+// control which differences are reported; IgnoreVolatile is a sensible
+// default.
+func NewWatcher(lookup LookupFunc, concurrency int, opts ...DiffOption) *Watcher {
+	return &Watcher{
+		lookup:      lookup,
+		concurrency: concurrency,
+		opts:        opts,
+		watched:     map[string]*Response{},
+	}
+}
+
+// Watch adds addresses to the set.  Their first poll records a baseline
+// and reports nothing.
+func (w *Watcher) Watch(ipAddresses ...string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for _, ipAddress := range ipAddresses {
+		if _, ok := w.watched[ipAddress]; !ok {
+			w.watched[ipAddress] = nil
+		}
+	}
+}
+
+// Unwatch removes addresses from the set
+func (w *Watcher) Unwatch(ipAddresses ...string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for _, ipAddress := range ipAddresses {
+		delete(w.watched, ipAddress)
+	}
+}
+
+// Watched returns the watched addresses, sorted
+func (w *Watcher) Watched() []string {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	ipAddresses := make([]string, 0, len(w.watched))
+	for ipAddress := range w.watched {
+		ipAddresses = append(ipAddresses, ipAddress)
+	}
+	sort.Strings(ipAddresses)
+	return ipAddresses
+}
+
+// Last returns the answer of the latest successful poll of ipAddress
+func (w *Watcher) Last(ipAddress string) (Response, bool) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if last := w.watched[ipAddress]; last != nil {
+		return *last, true
+	}
+	return Response{}, false
+}
+
+// Poll looks up every watched address and returns the events, sorted by
+// address.  Failed lookups keep their previous answer and are reported
+// together in the returned error.
+func (w *Watcher) Poll(ctx context.Context) ([]WatchEvent, error) {
+	ipAddresses := w.Watched()
+	ctx = WithRequestOptions(ctx, WithCache(nil))
+
+	now := time.Now()
+	var events []WatchEvent
+	var errs []error
+	for ipAddress, result := range Stream(ctx, w.lookup, slices.Values(ipAddresses), w.concurrency) {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ipAddress, result.Err))
+			continue
+		}
+
+		w.mutex.Lock()
+		previous, watched := w.watched[ipAddress]
+		if watched {
+			current := result.Response
+			w.watched[ipAddress] = &current
+		}
+		w.mutex.Unlock()
+
+		if !watched || previous == nil {
+			continue
+		}
+		if changes := Diff(*previous, result.Response, w.opts...); len(changes) > 0 {
+			events = append(events, WatchEvent{
+				IpAddress: ipAddress,
+				Moved:     moved(*previous, result.Response),
+				Flagged:   flagged(*previous, result.Response),
+				Changes:   changes,
+				Previous:  *previous,
+				Current:   result.Response,
+				Detected:  now,
+			})
+		}
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].IpAddress < events[j].IpAddress
+	})
+	return events, errors.Join(errs...)
+}
+
+// Run polls every interval until ctx is done, passing each event to
+// onEvent.  Errors are passed to onError if it is not nil.
+func (w *Watcher) Run(ctx context.Context, interval time.Duration, onEvent func(WatchEvent), onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		events, err := w.Poll(ctx)
+		if err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+		for _, event := range events {
+			onEvent(event)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func moved(previous, current Response) bool {
+	if previous.Country.IsoCode != current.Country.IsoCode || previous.City.GeoNameId != current.City.GeoNameId {
+		return true
+	}
+	return !slices.EqualFunc(previous.Subdivisions, current.Subdivisions, func(a, b Subdivision) bool {
+		return a.IsoCode == b.IsoCode
+	})
+}
+
+func flagged(previous, current Response) []string {
+	was, is := previous.Traits, current.Traits
+	var names []string
+	for _, trait := range []struct {
+		was, is bool
+		name    string
+	}{
+		{was.IsTorExitNode, is.IsTorExitNode, "tor_exit_node"},
+		{was.IsAnonymousVpn, is.IsAnonymousVpn, "anonymous_vpn"},
+		{was.IsPublicProxy, is.IsPublicProxy, "public_proxy"},
+		{was.IsResidentialProxy, is.IsResidentialProxy, "residential_proxy"},
+		{was.IsHostingProvider, is.IsHostingProvider, "hosting_provider"},
+		{was.IsAnonymous, is.IsAnonymous, "anonymous"},
+	} {
+		if trait.is && !trait.was {
+			names = append(names, trait.name)
+		}
+	}
+	return names
+}
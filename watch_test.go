@@ -0,0 +1,136 @@
+//	Copyright 2015 Matt Ho
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+//go:build !tinygo && !geoip2_tiny
+// +build !tinygo,!geoip2_tiny
+
+package geoip2
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWatcher(t *testing.T) {
+	Convey("Given a Watcher over two addresses", t, func() {
+		var mutex sync.Mutex
+		answers := map[string]Response{
+			"1.2.3.4": {Country: Country{IsoCode: "GB"}, Subdivisions: []Subdivision{{IsoCode: "ENG"}}},
+			"5.6.7.8": {Country: Country{IsoCode: "US"}, Traits: Traits{UserType: "business"}},
+		}
+		var cached bool
+		lookup := func(ctx context.Context, ipAddress string) (Response, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			a := New("blah-user-id", "blah-license-key", WithCache(NewLRUCache(10))).forRequest(ctx)
+			cached = cached || a.cache != nil
+			if ipAddress == "9.9.9.9" {
+				return Response{}, errors.New("boom")
+			}
+			return answers[ipAddress], nil
+		}
+		watcher := NewWatcher(lookup, 2, IgnoreVolatile())
+		watcher.Watch("5.6.7.8", "1.2.3.4", "1.2.3.4")
+		So(watcher.Watched(), ShouldResemble, []string{"1.2.3.4", "5.6.7.8"})
+
+		Convey("When the first poll records a baseline", func() {
+			events, err := watcher.Poll(context.Background())
+			So(err, ShouldBeNil)
+			So(events, ShouldBeEmpty)
+			So(cached, ShouldBeFalse)
+
+			last, ok := watcher.Last("1.2.3.4")
+			So(ok, ShouldBeTrue)
+			So(last.Country.IsoCode, ShouldEqual, "GB")
+
+			Convey("I expect nothing reported while answers hold", func() {
+				events, err := watcher.Poll(context.Background())
+				So(err, ShouldBeNil)
+				So(events, ShouldBeEmpty)
+			})
+
+			Convey("I expect moves and new flags reported", func() {
+				mutex.Lock()
+				answers["1.2.3.4"] = Response{Country: Country{IsoCode: "NL"}}
+				answers["5.6.7.8"] = Response{Country: Country{IsoCode: "US"}, Traits: Traits{UserType: "hosting", IsHostingProvider: true, IsAnonymousVpn: true}}
+				mutex.Unlock()
+
+				events, err := watcher.Poll(context.Background())
+				So(err, ShouldBeNil)
+				So(events, ShouldHaveLength, 2)
+
+				So(events[0].IpAddress, ShouldEqual, "1.2.3.4")
+				So(events[0].Moved, ShouldBeTrue)
+				So(events[0].Flagged, ShouldBeEmpty)
+				So(events[0].Previous.Country.IsoCode, ShouldEqual, "GB")
+				So(events[0].Current.Country.IsoCode, ShouldEqual, "NL")
+
+				So(events[1].IpAddress, ShouldEqual, "5.6.7.8")
+				So(events[1].Moved, ShouldBeFalse)
+				So(events[1].Flagged, ShouldResemble, []string{"anonymous_vpn", "hosting_provider"})
+				So(events[1].Changes, ShouldNotBeEmpty)
+			})
+
+			Convey("I expect failures reported and unwatched addresses dropped", func() {
+				watcher.Watch("9.9.9.9")
+				watcher.Unwatch("5.6.7.8")
+				events, err := watcher.Poll(context.Background())
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "9.9.9.9: boom")
+				So(events, ShouldBeEmpty)
+				So(watcher.Watched(), ShouldResemble, []string{"1.2.3.4", "9.9.9.9"})
+				_, ok := watcher.Last("5.6.7.8")
+				So(ok, ShouldBeFalse)
+			})
+		})
+
+		Convey("When it runs", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			received := make(chan WatchEvent, 1)
+			done := make(chan struct{})
+			go func() {
+				watcher.Run(ctx, 5*time.Millisecond, func(e WatchEvent) { received <- e }, nil)
+				close(done)
+			}()
+
+			// wait for the baseline before changing the answer
+			for {
+				if _, ok := watcher.Last("1.2.3.4"); ok {
+					break
+				}
+				time.Sleep(time.Millisecond)
+			}
+			mutex.Lock()
+			answers["1.2.3.4"] = Response{Country: Country{IsoCode: "FR"}}
+			mutex.Unlock()
+
+			Convey("I expect the change delivered", func() {
+				select {
+				case e := <-received:
+					So(e.IpAddress, ShouldEqual, "1.2.3.4")
+					So(e.Current.Country.IsoCode, ShouldEqual, "FR")
+				case <-time.After(time.Second):
+					t.Fatal("no event")
+				}
+				cancel()
+				<-done
+			})
+		})
+	})
+}